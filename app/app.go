@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	v1_5 "github.com/KYVENetwork/chain/app/upgrades/v1_5"
+	v1_6 "github.com/KYVENetwork/chain/app/upgrades/v1_6"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -416,6 +417,17 @@ func New(
 		),
 	)
 
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v1_6.UpgradeName,
+		v1_6.CreateUpgradeHandler(
+			app.ModuleManager,
+			app.Configurator(),
+			app.DelegationKeeper,
+			app.PoolKeeper,
+			app.StakersKeeper,
+		),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,107 @@
+package v1_6
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/log"
+
+	delegationKeeper "github.com/KYVENetwork/chain/x/delegation/keeper"
+	delegationTypes "github.com/KYVENetwork/chain/x/delegation/types"
+	poolKeeper "github.com/KYVENetwork/chain/x/pool/keeper"
+	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakersKeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
+	stakersTypes "github.com/KYVENetwork/chain/x/stakers/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const (
+	UpgradeName = "v1.6.0"
+)
+
+var logger log.Logger
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	delegationKeeper delegationKeeper.Keeper,
+	poolKeeper *poolKeeper.Keeper,
+	stakersKeeper *stakersKeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		logger = sdkCtx.Logger().With("upgrade", UpgradeName)
+		logger.Info(fmt.Sprintf("performing upgrade %v", UpgradeName))
+
+		// Run KYVE migrations
+
+		// migrate delegations
+		migrateDelegationModule(sdkCtx, delegationKeeper)
+
+		// migrate pool
+		migratePoolModule(sdkCtx, poolKeeper)
+
+		// migrate stakers
+		migrateStakersModule(sdkCtx, stakersKeeper)
+
+		// Run cosmos migrations
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
+
+func migrateDelegationModule(sdkCtx sdk.Context, delegationKeeper delegationKeeper.Keeper) {
+	// The auto-restake and pool delegation params did not exist before,
+	// therefore they are initialized with their default values.
+	params := delegationKeeper.GetParams(sdkCtx)
+	params.AutoRestakeEpoch = delegationTypes.DefaultAutoRestakeEpoch
+	params.AutoRestakeBatchSize = delegationTypes.DefaultAutoRestakeBatchSize
+	params.PoolRebalanceBatchSize = delegationTypes.DefaultPoolRebalanceBatchSize
+	params.MinPoolDelegation = delegationTypes.DefaultMinPoolDelegation
+	delegationKeeper.SetParams(sdkCtx, params)
+
+	logger.Info("migrated Delegation module")
+}
+
+func migratePoolModule(sdkCtx sdk.Context, poolKeeper *poolKeeper.Keeper) {
+	// The dynamic inflation and max stakers params did not exist before,
+	// therefore they are initialized with their default values.
+	params := poolKeeper.GetParams(sdkCtx)
+	params.DynamicInflationShare = poolTypes.DefaultDynamicInflationShare
+	params.DynamicInflationMode = poolTypes.DefaultDynamicInflationMode
+	params.FunderPayoutsWindow = poolTypes.DefaultFunderPayoutsWindow
+	params.MaxStakersPerPool = poolTypes.DefaultMaxStakersPerPool
+	params.MaxStakersPerPoolLimit = poolTypes.DefaultMaxStakersPerPoolLimit
+	poolKeeper.SetParams(sdkCtx, params)
+
+	logger.Info("migrated Pool module")
+}
+
+func migrateStakersModule(sdkCtx sdk.Context, stakersKeeper *stakersKeeper.Keeper) {
+	// The index2 of the leave pool queue used to only hold a marker, it now
+	// holds the queue index of the entry. Setting every entry again rewrites
+	// the index2 in the new format.
+	for _, leavePoolEntry := range stakersKeeper.GetAllLeavePoolEntries(sdkCtx) {
+		stakersKeeper.SetLeavePoolEntry(sdkCtx, leavePoolEntry)
+	}
+
+	// The commission limits did not exist before, therefore existing stakers
+	// get the default limits, which do not restrict them in any way.
+	for _, staker := range stakersKeeper.GetAllStakers(sdkCtx) {
+		staker.MaxCommission = stakersTypes.DefaultMaxCommission
+		staker.MaxCommissionChange = stakersTypes.DefaultMaxCommissionChange
+		stakersKeeper.Migration_SetStaker(sdkCtx, staker)
+	}
+
+	// The maintenance params did not exist before, therefore they are
+	// initialized with their default values.
+	params := stakersKeeper.GetParams(sdkCtx)
+	params.MaintenancePeriod = stakersTypes.DefaultMaintenancePeriod
+	params.MaintenanceBudget = stakersTypes.DefaultMaintenanceBudget
+	stakersKeeper.SetParams(sdkCtx, params)
+
+	logger.Info("migrated Stakers module")
+}
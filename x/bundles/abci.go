@@ -5,6 +5,7 @@ import (
 	"github.com/KYVENetwork/chain/util"
 	bundlesKeeper "github.com/KYVENetwork/chain/x/bundles/keeper"
 	"github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	// Auth
@@ -37,58 +38,85 @@ func SplitInflation(ctx sdk.Context, k bundlesKeeper.Keeper, bk util.BankKeeper,
 		return
 	}
 
-	// track actual distributed block provision for protocol
-	distributed := uint64(0)
-
-	// calculate total inflation share weight of pools to get each pool's reward share
-	totalInflationShareWeight := math.LegacyZeroDec()
+	// only include active pools
+	activePools := make([]pooltypes.Pool, 0)
 
 	for _, pool := range pk.GetAllPools(ctx) {
-		// only include active pools
 		if err := k.AssertPoolCanRun(ctx, pool.Id); err == nil {
-			totalInflationShareWeight = totalInflationShareWeight.Add(pool.InflationShareWeight)
+			activePools = append(activePools, pool)
 		}
 	}
 
-	// if the total inflation share weight is zero all rewards go the chain
-	if totalInflationShareWeight.IsZero() {
+	// calculate each pool's share based on its inflation share weight and its demand
+	allocations := pk.GetInflationAllocations(ctx, activePools)
+
+	totalShare := math.LegacyZeroDec()
+	for _, allocation := range allocations {
+		totalShare = totalShare.Add(allocation.Share)
+	}
+
+	// if the total share is zero all rewards go the chain
+	if totalShare.IsZero() {
 		return
 	}
 
-	for _, pool := range pk.GetAllPools(ctx) {
-		// only include active pools
-		if err := k.AssertPoolCanRun(ctx, pool.Id); err == nil {
-			// calculate pool share based of inflation share weight
-			amount := uint64(pool.InflationShareWeight.
-				Quo(totalInflationShareWeight).
-				Mul(math.LegacyNewDec(protocolBlockProvision)).
-				TruncateInt64())
-
-			// transfer funds to pool account
-			if err := util.TransferFromModuleToAddress(bk, ctx, authTypes.FeeCollectorName, pool.GetPoolAccount().String(), amount); err != nil {
-				util.PanicHalt(uk, ctx, err.Error())
-			}
+	// calculate the truncated amount of each pool and track the actual distributed
+	// block provision for protocol
+	amounts := make([]uint64, len(allocations))
+	distributed := uint64(0)
 
-			// track transferred $KYVE to protocol
-			distributed += amount
-		}
+	for i, allocation := range allocations {
+		amounts[i] = uint64(allocation.Share.
+			Mul(math.LegacyNewDec(protocolBlockProvision)).
+			TruncateInt64())
+
+		distributed += amounts[i]
 	}
 
-	// calculate if a remainder is left
-	remainder := uint64(protocolBlockProvision) - distributed
+	// the shares do not necessarily add up to one, e.g. if every pool has zero
+	// inflation share weight only the dynamic part gets distributed. The part
+	// which is not covered by the shares stays with the chain, therefore the
+	// remainder only consists of what got lost due to truncation.
+	distributable := uint64(totalShare.Mul(math.LegacyNewDec(protocolBlockProvision)).RoundInt64())
+
+	// the remainder which is left due to truncation is added to the first
+	// active pool which has a positive share
+	remainder := uint64(0)
+	if distributable > distributed {
+		remainder = distributable - distributed
+	}
+
+	remainderIndex := -1
 
 	if remainder > 0 {
-		// find an active pool
-		for _, pool := range pk.GetAllPools(ctx) {
-			if err := k.AssertPoolCanRun(ctx, pool.Id); err != nil {
-				// add remainder to first active pool we find
-				if err := util.TransferFromModuleToAddress(bk, ctx, authTypes.FeeCollectorName, pool.GetPoolAccount().String(), remainder); err != nil {
-					util.PanicHalt(uk, ctx, err.Error())
-				}
+		for i, allocation := range allocations {
+			if allocation.Share.IsPositive() {
+				remainderIndex = i
+				break
 			}
 		}
 	}
 
+	for i, pool := range activePools {
+		amount, poolRemainder := amounts[i], uint64(0)
+		if i == remainderIndex {
+			poolRemainder = remainder
+			amount += remainder
+		}
+
+		if amount == 0 {
+			continue
+		}
+
+		// transfer funds to pool account
+		if err := util.TransferFromModuleToAddress(bk, ctx, authTypes.FeeCollectorName, pool.GetPoolAccount().String(), amount); err != nil {
+			util.PanicHalt(uk, ctx, err.Error())
+		}
+
+		// track received $KYVE in the inflation ledger of the pool
+		pk.RecordInflationReceived(ctx, pool.Id, amount, poolRemainder)
+	}
+
 	// rest gets transferred to chain
-	k.Logger().Info("split portion of minted coins to protocol", "amount", protocolBlockProvision)
+	k.Logger().Info("split portion of minted coins to protocol", "amount", distributed+remainder)
 }
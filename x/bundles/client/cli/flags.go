@@ -0,0 +1,17 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagExpiration = "expiration"
+)
+
+func flagSetGrantAuthorization() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagExpiration, 0, "The unix timestamp in seconds after which the authorization expires, zero means no expiration")
+
+	return fs
+}
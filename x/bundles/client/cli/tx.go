@@ -19,6 +19,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdClaimUploaderRole())
+	cmd.AddCommand(CmdGrantAuthorization())
 	cmd.AddCommand(CmdSkipUploaderRole())
 	cmd.AddCommand(CmdSubmitBundleProposal())
 	cmd.AddCommand(CmdVoteBundleProposal())
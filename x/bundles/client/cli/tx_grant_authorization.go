@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-authorization [grantee] [msg_type_url] [pool_ids]",
+		Short: "Grant the grantee the authorization to execute a bundles message in the given comma-separated pools",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGrantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			argMsgTypeUrl := args[1]
+
+			var argPoolIds []uint64
+			for _, poolId := range strings.Split(args[2], ",") {
+				id, err := cast.ToUint64E(strings.TrimSpace(poolId))
+				if err != nil {
+					return err
+				}
+				argPoolIds = append(argPoolIds, id)
+			}
+
+			expiration, err := cmd.Flags().GetUint64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewBundlesAuthorization(argMsgTypeUrl, argPoolIds, expiration)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			var grantExpiration *time.Time
+			if expiration > 0 {
+				t := time.Unix(int64(expiration), 0)
+				grantExpiration = &t
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), argGrantee, authorization, grantExpiration)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetGrantAuthorization())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
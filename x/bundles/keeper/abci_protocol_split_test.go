@@ -5,6 +5,7 @@ import (
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
 	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,6 +20,9 @@ TEST CASES - abci.go
 * pool should split inflation funds depending on inflation share weight
 * pools with zero inflation share weight should receive nothing
 * every pool has zero inflation share weight
+* every pool has zero inflation share weight but a positive demand
+* remainder should only be added to the first active pool
+* inflation ledger should track the received inflation of every pool
 
 */
 
@@ -274,4 +278,206 @@ var _ = Describe("abci.go", Ordered, func() {
 		Expect(b1).To(BeZero())
 		Expect(b2).To(BeZero())
 	})
+
+	It("every pool has zero inflation share weight but a positive demand", func() {
+		// ARRANGE
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.DynamicInflationShare = math.LegacyMustNewDecFromStr("0.5")
+		params.DynamicInflationMode = pooltypes.DYNAMIC_INFLATION_MODE_STAKE
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.InflationShareWeight = math.LegacyZeroDec()
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1_A,
+		})
+
+		// ACT
+		s.Commit()
+
+		// ASSERT
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		b := s.App().BankKeeper.GetBalance(s.Ctx(), pool.GetPoolAccount(), globalTypes.Denom).Amount.Uint64()
+
+		// only the dynamic part gets distributed, the static part stays with the chain
+		ledger, found := s.App().PoolKeeper.GetInflationLedger(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(b).To(BeNumerically(">", 0))
+		Expect(ledger.TotalReceived).To(Equal(b))
+		Expect(ledger.TotalRemainder).To(BeNumerically("<=", 1))
+	})
+
+	It("remainder should only be added to the first active pool", func() {
+		// ARRANGE
+		for p := 0; p < 3; p++ {
+			s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+				Authority:            gov,
+				Name:                 "PoolTest",
+				Runtime:              "@kyve/test",
+				Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+				Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+				StartKey:             "0",
+				UploadInterval:       60,
+				InflationShareWeight: math.LegacyNewDec(1_000_000),
+				MinDelegation:        100 * i.KYVE,
+				MaxBundleSize:        100,
+				Version:              "0.0.0",
+				Binaries:             "{}",
+				StorageProviderId:    2,
+				CompressionId:        1,
+			})
+		}
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		// pool 0 stays inactive, pools 1 to 3 become active
+		valaddresses := [][]string{
+			{i.VALADDRESS_0_A, i.VALADDRESS_1_A},
+			{i.VALADDRESS_0_B, i.VALADDRESS_1_B},
+			{i.VALADDRESS_0_C, i.VALADDRESS_1_C},
+		}
+
+		for p, v := range valaddresses {
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    i.STAKER_0,
+				PoolId:     uint64(p + 1),
+				Valaddress: v[0],
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    i.STAKER_1,
+				PoolId:     uint64(p + 1),
+				Valaddress: v[1],
+			})
+		}
+
+		for t := 0; t < 10; t++ {
+			// ACT
+			s.Commit()
+
+			balances := make([]uint64, 4)
+			for p := range balances {
+				pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), uint64(p))
+				balances[p] = s.App().BankKeeper.GetBalance(s.Ctx(), pool.GetPoolAccount(), globalTypes.Denom).Amount.Uint64()
+			}
+
+			// ASSERT
+			Expect(balances[0]).To(BeZero())
+			Expect(balances[1]).To(BeNumerically(">=", balances[2]))
+			Expect(balances[1] - balances[2]).To(BeNumerically("<=", 3*(t+1)))
+			Expect(balances[2]).To(Equal(balances[3]))
+
+			ledger1, _ := s.App().PoolKeeper.GetInflationLedger(s.Ctx(), 1)
+			ledger2, _ := s.App().PoolKeeper.GetInflationLedger(s.Ctx(), 2)
+
+			Expect(ledger1.TotalRemainder).To(Equal(balances[1] - balances[2]))
+			Expect(ledger2.TotalRemainder).To(BeZero())
+		}
+	})
+
+	It("inflation ledger should track the received inflation of every pool", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(2_000_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     1,
+			Valaddress: i.VALADDRESS_0_B,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     1,
+			Valaddress: i.VALADDRESS_1_B,
+		})
+
+		// ACT
+		for t := 0; t < 10; t++ {
+			s.Commit()
+		}
+
+		// ASSERT
+		pool0, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		b0 := s.App().BankKeeper.GetBalance(s.Ctx(), pool0.GetPoolAccount(), globalTypes.Denom).Amount.Uint64()
+
+		pool1, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		b1 := s.App().BankKeeper.GetBalance(s.Ctx(), pool1.GetPoolAccount(), globalTypes.Denom).Amount.Uint64()
+
+		Expect(b0).To(BeZero())
+		Expect(b1).To(BeNumerically(">", 0))
+
+		_, found := s.App().PoolKeeper.GetInflationLedger(s.Ctx(), 0)
+		Expect(found).To(BeFalse())
+
+		ledger, found := s.App().PoolKeeper.GetInflationLedger(s.Ctx(), 1)
+		Expect(found).To(BeTrue())
+		Expect(ledger.TotalReceived).To(Equal(b1))
+		Expect(ledger.TotalRemainder).To(BeNumerically("<", b1))
+		Expect(ledger.LastReceived).To(BeNumerically(">", 0))
+		Expect(ledger.LastHeight).To(Equal(s.Ctx().BlockHeight() - 1))
+
+		res, err := s.App().QueryKeeper.InflationLedger(s.Ctx(), &querytypes.QueryInflationLedgerRequest{PoolId: 0})
+		Expect(err).To(BeNil())
+		Expect(res.InflationLedger.TotalReceived).To(BeZero())
+
+		res, err = s.App().QueryKeeper.InflationLedger(s.Ctx(), &querytypes.QueryInflationLedgerRequest{PoolId: 1})
+		Expect(err).To(BeNil())
+		Expect(res.InflationLedger).To(Equal(ledger))
+
+		_, err = s.App().QueryKeeper.InflationLedger(s.Ctx(), &querytypes.QueryInflationLedgerRequest{PoolId: 2})
+		Expect(err).To(HaveOccurred())
+	})
 })
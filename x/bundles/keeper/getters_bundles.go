@@ -45,6 +45,13 @@ func (k Keeper) GetBundleProposal(ctx sdk.Context, poolId uint64) (val types.Bun
 	return val, true
 }
 
+// RemoveBundleProposal removes the bundle proposal of the given pool with id `poolId`
+func (k Keeper) RemoveBundleProposal(ctx sdk.Context, poolId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BundleKeyPrefix)
+	store.Delete(types.BundleProposalKey(poolId))
+}
+
 // GetAllBundleProposals returns all bundle proposals of all pools
 func (k Keeper) GetAllBundleProposals(ctx sdk.Context) (list []types.BundleProposal) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
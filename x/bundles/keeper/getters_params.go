@@ -41,6 +41,11 @@ func (k Keeper) GetMaxPoints(ctx sdk.Context) (res uint64) {
 	return k.GetParams(ctx).MaxPoints
 }
 
+// GetPointDecayWindow returns the PointDecayWindow param
+func (k Keeper) GetPointDecayWindow(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).PointDecayWindow
+}
+
 // SetParams sets the x/bundles module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	_ = k.BundlesParams.Set(ctx, params)
@@ -31,6 +31,13 @@ func (k Keeper) GetRoundRobinProgress(ctx sdk.Context, poolId uint64) (val types
 	return val, true
 }
 
+// RemoveRoundRobinProgress removes the round-robin progress of a pool
+func (k Keeper) RemoveRoundRobinProgress(ctx sdk.Context, poolId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.RoundRobinProgressPrefix)
+	store.Delete(types.RoundRobinProgressKey(poolId))
+}
+
 // GetAllRoundRobinProgress returns the round-robin progress of all pools
 func (k Keeper) GetAllRoundRobinProgress(ctx sdk.Context) (list []types.RoundRobinProgress) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
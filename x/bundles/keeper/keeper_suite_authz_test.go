@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - authz
+
+* Grantee claims the uploader role in an authorized pool
+* Grantee can not claim the uploader role in a pool which is not authorized
+* Grantee can not claim the uploader role after the authorization expired
+* Grantee can not execute another message than the authorized one
+* Authorization of a message outside of the bundles protocol is invalid
+* Authorization without pool ids is invalid
+
+*/
+
+var _ = Describe("authz", Ordered, func() {
+	var s *i.KeeperTestSuite
+
+	grant := func(granter string, authorization *bundletypes.BundlesAuthorization) {
+		Expect(authorization.ValidateBasic()).To(Succeed())
+		Expect(s.App().AuthzKeeper.SaveGrant(
+			s.Ctx(),
+			sdk.MustAccAddressFromBech32(i.ALICE),
+			sdk.MustAccAddressFromBech32(granter),
+			authorization,
+			nil,
+		)).To(Succeed())
+	}
+
+	exec := func(msg sdk.Msg) error {
+		_, err := s.App().AuthzKeeper.DispatchActions(s.Ctx(), sdk.MustAccAddressFromBech32(i.ALICE), []sdk.Msg{msg})
+		return err
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create two clean pools for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		for poolId, valaddresses := range [][]string{{i.VALADDRESS_0_A, i.VALADDRESS_1_A}, {i.VALADDRESS_0_B, i.VALADDRESS_1_B}} {
+			s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+				Authority:            gov,
+				Name:                 "PoolTest",
+				Runtime:              "@kyve/test",
+				Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+				Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+				StartKey:             "0",
+				UploadInterval:       60,
+				InflationShareWeight: math.LegacyNewDec(10_000),
+				MinDelegation:        100 * i.KYVE,
+				MaxBundleSize:        100,
+				Version:              "0.0.0",
+				Binaries:             "{}",
+				StorageProviderId:    2,
+				CompressionId:        1,
+			})
+
+			s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+				Creator:          i.ALICE,
+				PoolId:           uint64(poolId),
+				Amounts:          i.KYVECoins(100 * i.T_KYVE),
+				AmountsPerBundle: i.KYVECoins(1 * i.T_KYVE),
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    i.STAKER_0,
+				PoolId:     uint64(poolId),
+				Valaddress: valaddresses[0],
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    i.STAKER_1,
+				PoolId:     uint64(poolId),
+				Valaddress: valaddresses[1],
+			})
+		}
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Grantee claims the uploader role in an authorized pool", func() {
+		// ARRANGE
+		grant(i.VALADDRESS_0_A, bundletypes.NewBundlesAuthorization(
+			sdk.MsgTypeURL(&bundletypes.MsgClaimUploaderRole{}), []uint64{0}, 0,
+		))
+
+		// ACT
+		err := exec(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		Expect(err).NotTo(HaveOccurred())
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_0))
+	})
+
+	It("Grantee can not claim the uploader role in a pool which is not authorized", func() {
+		// ARRANGE
+		grant(i.VALADDRESS_0_B, bundletypes.NewBundlesAuthorization(
+			sdk.MsgTypeURL(&bundletypes.MsgClaimUploaderRole{}), []uint64{0}, 0,
+		))
+
+		// ACT
+		err := exec(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0_B,
+			Staker:  i.STAKER_0,
+			PoolId:  1,
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("pool 1 is not authorized"))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 1)
+		Expect(bundleProposal.NextUploader).To(BeEmpty())
+	})
+
+	It("Grantee can not claim the uploader role after the authorization expired", func() {
+		// ARRANGE
+		expiration := uint64(s.Ctx().BlockTime().Add(time.Hour).Unix())
+
+		grant(i.VALADDRESS_0_A, bundletypes.NewBundlesAuthorization(
+			sdk.MsgTypeURL(&bundletypes.MsgClaimUploaderRole{}), []uint64{0}, expiration,
+		))
+
+		s.CommitAfterSeconds(60 * 60)
+
+		// ACT
+		err := exec(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("authorization expired"))
+	})
+
+	It("Grantee can not execute another message than the authorized one", func() {
+		// ARRANGE
+		grant(i.VALADDRESS_0_A, bundletypes.NewBundlesAuthorization(
+			sdk.MsgTypeURL(&bundletypes.MsgVoteBundleProposal{}), []uint64{0}, 0,
+		))
+
+		// ACT
+		err := exec(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(BeEmpty())
+	})
+
+	It("Authorization of a message outside of the bundles protocol is invalid", func() {
+		// ACT
+		authorization := bundletypes.NewBundlesAuthorization(
+			sdk.MsgTypeURL(&stakertypes.MsgJoinPool{}), []uint64{0}, 0,
+		)
+
+		// ASSERT
+		Expect(authorization.ValidateBasic()).To(HaveOccurred())
+	})
+
+	It("Authorization without pool ids is invalid", func() {
+		// ACT
+		authorization := bundletypes.NewBundlesAuthorization(
+			sdk.MsgTypeURL(&bundletypes.MsgClaimUploaderRole{}), []uint64{}, 0,
+		)
+
+		// ASSERT
+		Expect(authorization.ValidateBasic()).To(HaveOccurred())
+	})
+})
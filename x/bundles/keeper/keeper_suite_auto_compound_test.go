@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - auto-compound commission
+
+* Produce a valid bundle with foreign delegations and auto-compounding disabled
+* Produce a valid bundle with foreign delegations and auto-compounding enabled
+
+*/
+
+var _ = Describe("auto-compound commission", Ordered, func() {
+	var s *i.KeeperTestSuite
+
+	amountPerBundle := int64(10_000)
+
+	// produceValidBundle finalizes a bundle uploaded by the first staker
+	produceValidBundle := func() {
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0_A,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     0,
+			BundleSize:    100,
+			FromKey:       "0",
+			ToKey:         "99",
+			BundleSummary: "test_value",
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_A,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_1_A,
+			Staker:        i.STAKER_1,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash2",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "100",
+			ToKey:         "199",
+			BundleSummary: "test_value2",
+		})
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyZeroDec(),
+			MinDelegation:        0 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    1,
+			CompressionId:        1,
+		})
+
+		// create funders
+		s.RunTxFundersSuccess(&fundersTypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+
+		// set storage cost to 0.5
+		bundleParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+		bundleParams.StorageCosts = append(bundleParams.StorageCosts, bundletypes.StorageCost{StorageProviderId: 1, Cost: math.LegacyMustNewDecFromStr("0.5")})
+		s.App().BundlesKeeper.SetParams(s.Ctx(), bundleParams)
+
+		// set funders params
+		s.App().FundersKeeper.SetParams(s.Ctx(), fundersTypes.NewParams([]*fundersTypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globalTypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewInt(amountPerBundle),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}, 0))
+
+		s.RunTxPoolSuccess(&fundersTypes.MsgFundPool{
+			Creator:          i.ALICE,
+			Amounts:          sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(100*i.KYVE))),
+			AmountsPerBundle: sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, amountPerBundle)),
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1_A,
+		})
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.ALICE,
+			Staker:  i.STAKER_0,
+			Amount:  300 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.BOB,
+			Staker:  i.STAKER_1,
+			Amount:  300 * i.KYVE,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Produce a valid bundle with foreign delegations and auto-compounding disabled", func() {
+		// ACT
+		produceValidBundle()
+
+		// ASSERT
+		uploader, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		// assert commission rewards
+		// (total_bundle_payout - treasury_reward - storage_cost) * commission + storage_cost
+		// (10_000 - (10_000 * 0.01) - (100 * 0.5)) * 0.1 + (100 * 0.5)
+		Expect(uploader.CommissionRewards.AmountOf(globalTypes.Denom).Uint64()).To(Equal(uint64(1035)))
+
+		// assert delegation rewards
+		// (total_bundle_payout - treasury_reward - storage_cost) * (1 - commission) * (delegation / total_delegation)
+		// (10_000 - (10_000 * 0.01) - (100 * 0.5)) * (1 - 0.1) * (100 / 400)
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(globalTypes.Denom).Uint64()).To(Equal(uint64(2216)))
+		// (10_000 - (10_000 * 0.01) - (100 * 0.5)) * (1 - 0.1) * (300 / 400)
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.ALICE).AmountOf(globalTypes.Denom).Uint64()).To(Equal(uint64(6648)))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(100 * i.KYVE))
+	})
+
+	It("Produce a valid bundle with foreign delegations and auto-compounding enabled", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgUpdateAutoCompoundCommission{
+			Creator:                i.STAKER_0,
+			AutoCompoundCommission: true,
+		})
+
+		initialBalanceStaker0 := s.GetBalanceFromAddress(i.STAKER_0)
+
+		// ACT
+		produceValidBundle()
+
+		// ASSERT
+		uploader, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		// assert commission rewards got compounded
+		Expect(uploader.CommissionRewards.IsZero()).To(BeTrue())
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(100*i.KYVE + 1035))
+
+		// assert delegation rewards are the same as without auto-compounding,
+		// the self-delegation rewards got withdrawn by the compounding delegation
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).IsZero()).To(BeTrue())
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(initialBalanceStaker0 + 2216))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.ALICE).AmountOf(globalTypes.Denom).Uint64()).To(Equal(uint64(6648)))
+	})
+})
@@ -209,6 +209,9 @@ var _ = Describe("funding bundles", Ordered, func() {
 		// assert individual balances
 		balanceAlice := s.GetCoinsFromAddress(i.ALICE)
 		Expect(balanceAlice.String()).To(Equal(initialBalanceAlice.Sub(i.ACoin(100 * i.T_KYVE)).String()))
+
+		// assert recorded funder payouts
+		Expect(s.App().PoolKeeper.GetRecentFunderPayouts(s.Ctx(), 0)).To(Equal(10 * i.KYVE))
 	})
 
 	It("Produce a valid bundle with multiple funders and same funding amounts", func() {
@@ -1849,11 +1849,12 @@ var _ = Describe("inflation splitting", Ordered, func() {
 		// additionally, pool-0 produced a bundle -> subtract PoolInflationPayoutRate (1 - 0.1 = 0.9)
 		// formula: (inflation - teamRewards) * inflationShare * inflationShareWeighOfPool * (1-PoolInflationPayoutRate)
 		// (340112344399 - 847940) * 0.1 * 1 / 11 * 0.9
-		// Evaluates to 2782730425, however due to multiple roundings to actual amount is 2782730381
+		// Evaluates to 2782730425, however due to multiple roundings and the remainder of the inflation
+		// split which is added to the first active pool the actual amount is 2782730471
 		// second pool
 		// (340112344399 - 847940) * 0.1 * 10 / 11
 		// Evaluates to 30919226950
-		Expect(finalBalancePool0).To(Equal(uint64(2782730381)))
+		Expect(finalBalancePool0).To(Equal(uint64(2782730471)))
 		Expect(finalBalancePool1).To(Equal(uint64(30919226950)))
 
 		// assert bundle reward
@@ -1862,8 +1863,8 @@ var _ = Describe("inflation splitting", Ordered, func() {
 		// the total payout is here just the inflation payout
 		// (inflation - teamRewards)*inflationShare - balancePool0 - balancePool1
 		// (340112344399 - 847940) * 0.1 * 1 / 11 * 0.1
-		// evaluates to 309192269, due to multiple rounding: 309192264
-		totalPayout := math.LegacyNewDec(309192264)
+		// evaluates to 309192269, due to multiple rounding and the remainder of the inflation split: 309192274
+		totalPayout := math.LegacyNewDec(309192274)
 
 		networkFee := s.App().BundlesKeeper.GetNetworkFee(s.Ctx())
 		treasuryReward := totalPayout.Mul(networkFee).TruncateDec()
@@ -2001,17 +2002,18 @@ var _ = Describe("inflation splitting", Ordered, func() {
 		// assert pool balance
 		finalBalancePool0 := s.GetBalanceFromPool(0)
 		finalBalancePool1 := s.GetBalanceFromPool(1)
-		// Both pools have inflation-weight 1
+		// Both pools have inflation-weight 1, but pool-0 additionally received the remainder
+		// of the inflation split and has a balance of 17005574873 before the payout
 		// however, pool-0 produced a bundle -> subtract PoolInflationPayoutRate (1 - 0.2 = 0.8)
-		// 17005574822 * 0.8
-		Expect(finalBalancePool0).To(Equal(uint64(13604459858)))
+		// 17005574873 * 0.8
+		Expect(finalBalancePool0).To(Equal(uint64(13604459899)))
 		Expect(finalBalancePool1).To(Equal(uint64(17005574822)))
 
 		// assert bundle reward
 		uploader, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
 
 		// the total payout is here just the inflation payout
-		totalPayout := math.LegacyNewDec(17005574822 - 13604459858)
+		totalPayout := math.LegacyNewDec(17005574873 - 13604459899)
 
 		networkFee := s.App().BundlesKeeper.GetNetworkFee(s.Ctx())
 		treasuryReward := totalPayout.Mul(networkFee).TruncateDec()
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - maintenance
+
+* One validator in maintenance does not vote for one proposal
+* One validator does not vote for one proposal after the maintenance has ended
+* Validators in maintenance are excluded from the quorum
+* Validators in maintenance are not chosen as next uploader
+* Next uploader in maintenance does not receive points for an upload timeout
+
+*/
+
+var _ = Describe("maintenance", Ordered, func() {
+	var s *i.KeeperTestSuite
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		msg := &pooltypes.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		}
+		s.RunTxPoolSuccess(msg)
+
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.KYVECoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.KYVECoins(1 * i.T_KYVE),
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1_A,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_2,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_2,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_2_A,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0_A,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     0,
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_A,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		s.CommitAfterSeconds(60)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("One validator in maintenance does not vote for one proposal", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		// ACT
+		// do not vote
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_1_A,
+			Staker:        i.STAKER_1,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+
+		// ASSERT
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_2)
+		Expect(valaccountVoter.Points).To(BeZero())
+	})
+
+	It("One validator does not vote for one proposal after the maintenance has ended", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_2,
+			PoolId:   0,
+			Duration: 30,
+		})
+
+		s.CommitAfterSeconds(30)
+
+		// ACT
+		// do not vote
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_1_A,
+			Staker:        i.STAKER_1,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+
+		// ASSERT
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_2)
+		Expect(valaccountVoter.Points).To(Equal(uint64(1)))
+	})
+
+	It("Validators in maintenance are excluded from the quorum", func() {
+		// ARRANGE
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		Expect(voteDistribution.Valid).To(Equal(200 * i.KYVE))
+		Expect(voteDistribution.Total).To(Equal(250 * i.KYVE))
+
+		// ACT
+		s.RunTxStakersSuccess(&stakertypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_1,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		// ASSERT
+		voteDistribution = s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		Expect(voteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Total).To(Equal(150 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_VALID))
+	})
+
+	It("Validators in maintenance are not chosen as next uploader", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_1,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		// ACT
+		// only the votes of validators in maintenance exist, so the quorum is not reached
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		Expect(bundleProposal.StorageId).To(BeEmpty())
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_2))
+
+		valaccountUploader, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccountUploader.Points).To(BeZero())
+
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccountVoter.Points).To(BeZero())
+	})
+
+	It("Next uploader in maintenance does not receive points for an upload timeout", func() {
+		// ARRANGE
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_1))
+
+		s.RunTxStakersSuccess(&stakertypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_1,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(s.App().BundlesKeeper.GetUploadTimeout(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		bundleProposal, _ = s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_0))
+
+		valaccountUploader, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(valaccountUploader.Points).To(BeZero())
+
+		finalizedBundle, found := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+		Expect(finalizedBundle.Uploader).To(Equal(i.STAKER_0))
+	})
+})
@@ -0,0 +1,454 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - point decay
+
+* One validator does not vote for multiple proposals in a row
+* One validator votes after having not voted previously multiple times
+* Points of one validator decay after the window has passed
+* One validator does not vote for multiple proposals and reaches max points within the window
+* One validator does not vote for every second proposal and never reaches max points
+
+*/
+
+var _ = Describe("point decay", Ordered, func() {
+	var s *i.KeeperTestSuite
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// enable point decay with a window of ten rounds
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.PointDecayWindow = 600
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		// create clean pool for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		msg := &pooltypes.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		}
+		s.RunTxPoolSuccess(msg)
+
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.KYVECoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.KYVECoins(1 * i.T_KYVE),
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1_A,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_2,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_2,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_2_A,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.VALADDRESS_0_A,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     0,
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_A,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		s.CommitAfterSeconds(60)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("One validator does not vote for multiple proposals in a row", func() {
+		// ACT
+		for r := 1; r <= 3; r++ {
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0_A,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+				Creator:   i.VALADDRESS_1_A,
+				Staker:    i.STAKER_1,
+				PoolId:    0,
+				StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				Vote:      bundletypes.VOTE_TYPE_VALID,
+			})
+
+			s.CommitAfterSeconds(60)
+
+			// do not vote with voter 3
+		}
+
+		// ASSERT
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_2)
+		Expect(valaccountVoter.Points).To(Equal(uint64(3)))
+		Expect(valaccountVoter.PointTimestamps).To(HaveLen(3))
+	})
+
+	It("One validator votes after having not voted previously multiple times", func() {
+		// ARRANGE
+		for r := 1; r <= 3; r++ {
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0_A,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+				Creator:   i.VALADDRESS_1_A,
+				Staker:    i.STAKER_1,
+				PoolId:    0,
+				StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				Vote:      bundletypes.VOTE_TYPE_VALID,
+			})
+
+			s.CommitAfterSeconds(60)
+
+			// do not vote
+		}
+
+		// ACT
+		for r := 4; r <= 5; r++ {
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0_A,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+				Creator:   i.VALADDRESS_1_A,
+				Staker:    i.STAKER_1,
+				PoolId:    0,
+				StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				Vote:      bundletypes.VOTE_TYPE_VALID,
+			})
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+				Creator:   i.VALADDRESS_2_A,
+				Staker:    i.STAKER_2,
+				PoolId:    0,
+				StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				Vote:      bundletypes.VOTE_TYPE_VALID,
+			})
+
+			s.CommitAfterSeconds(60)
+		}
+
+		// ASSERT
+		// points are not reset by voting but only decay over time
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_2)
+		Expect(valaccountVoter.Points).To(Equal(uint64(4)))
+	})
+
+	It("Points of one validator decay after the window has passed", func() {
+		// ARRANGE
+		for r := 1; r <= 3; r++ {
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0_A,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+				Creator:   i.VALADDRESS_1_A,
+				Staker:    i.STAKER_1,
+				PoolId:    0,
+				StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				Vote:      bundletypes.VOTE_TYPE_VALID,
+			})
+
+			s.CommitAfterSeconds(60)
+
+			// do not vote
+		}
+
+		// ACT
+		for r := 4; r <= 15; r++ {
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0_A,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+				Creator:   i.VALADDRESS_1_A,
+				Staker:    i.STAKER_1,
+				PoolId:    0,
+				StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				Vote:      bundletypes.VOTE_TYPE_VALID,
+			})
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+				Creator:   i.VALADDRESS_2_A,
+				Staker:    i.STAKER_2,
+				PoolId:    0,
+				StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				Vote:      bundletypes.VOTE_TYPE_VALID,
+			})
+
+			s.CommitAfterSeconds(60)
+		}
+
+		// ASSERT
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_2)
+		Expect(valaccountVoter.Points).To(BeZero())
+		Expect(valaccountVoter.PointTimestamps).To(BeEmpty())
+	})
+
+	It("One validator does not vote for multiple proposals and reaches max points within the window", func() {
+		// ARRANGE
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.MaxPoints = 3
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		for r := 1; r <= 4; r++ {
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0_A,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+				Creator:   i.VALADDRESS_1_A,
+				Staker:    i.STAKER_1,
+				PoolId:    0,
+				StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				Vote:      bundletypes.VOTE_TYPE_VALID,
+			})
+
+			s.CommitAfterSeconds(60)
+
+			// do not vote
+		}
+
+		// ASSERT
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(HaveLen(2))
+
+		_, valaccountFound := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_2)
+		Expect(valaccountFound).To(BeFalse())
+
+		// check if voter got slashed
+		slashAmountRatio := s.App().DelegationKeeper.GetTimeoutSlash(s.Ctx())
+		expectedBalance := 50*i.KYVE - uint64(math.LegacyNewDec(int64(50*i.KYVE)).Mul(slashAmountRatio).TruncateInt64())
+
+		Expect(expectedBalance).To(Equal(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_2, i.STAKER_2)))
+	})
+
+	It("One validator does not vote for every second proposal and never reaches max points", func() {
+		// ARRANGE
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.MaxPoints = 3
+		params.PointDecayWindow = 120
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		for r := 1; r <= 12; r++ {
+			// overwrite next uploader for test purposes
+			bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+			bundleProposal.NextUploader = i.STAKER_0
+			s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+				Creator:       i.VALADDRESS_0_A,
+				Staker:        i.STAKER_0,
+				PoolId:        0,
+				StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				DataSize:      100,
+				DataHash:      "test_hash",
+				FromIndex:     uint64(r * 100),
+				BundleSize:    100,
+				FromKey:       "test_key",
+				ToKey:         "test_key",
+				BundleSummary: "test_value",
+			})
+
+			s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+				Creator:   i.VALADDRESS_1_A,
+				Staker:    i.STAKER_1,
+				PoolId:    0,
+				StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				Vote:      bundletypes.VOTE_TYPE_VALID,
+			})
+
+			// only vote with voter 3 in every second round
+			if r%2 == 0 {
+				s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+					Creator:   i.VALADDRESS_2_A,
+					Staker:    i.STAKER_2,
+					PoolId:    0,
+					StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+					Vote:      bundletypes.VOTE_TYPE_VALID,
+				})
+			}
+
+			s.CommitAfterSeconds(60)
+		}
+
+		// ASSERT
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(HaveLen(3))
+
+		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_2)
+		Expect(valaccountVoter.Points).To(BeNumerically("<", 3))
+	})
+})
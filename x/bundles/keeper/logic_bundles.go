@@ -7,6 +7,7 @@ import (
 	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
 
 	delegationTypes "github.com/KYVENetwork/chain/x/delegation/types"
+	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
 
 	"github.com/KYVENetwork/chain/util"
 	"github.com/KYVENetwork/chain/x/bundles/types"
@@ -22,7 +23,7 @@ func (k Keeper) AssertPoolCanRun(ctx sdk.Context, poolId uint64) error {
 	}
 
 	// Error if the pool is upgrading.
-	if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
+	if pool.UpgradePlan.IsUpgrading(uint64(ctx.BlockTime().Unix())) {
 		return types.ErrPoolCurrentlyUpgrading
 	}
 
@@ -31,6 +32,11 @@ func (k Keeper) AssertPoolCanRun(ctx sdk.Context, poolId uint64) error {
 		return types.ErrPoolDisabled
 	}
 
+	// Error if the pool is paused.
+	if pool.IsPaused(uint64(ctx.BlockTime().Unix())) {
+		return types.ErrPoolPaused
+	}
+
 	// Error if the end key is reached. The pool will simply halt if this is the case,
 	// it is the responsibility of the protocol nodes to reach final consensus and that
 	// a bundle does not exceed the end_key
@@ -94,6 +100,16 @@ func (k Keeper) AssertCanVote(ctx sdk.Context, poolId uint64, staker string, vot
 		return types.ErrAlreadyVotedInvalid
 	}
 
+	// Check if the other valaddress of the staker has already voted on the bundle.
+	// Only one of both valaddresses is allowed to vote per bundle proposal.
+	if util.ContainsString(bundleProposal.VotersAbstain, staker) {
+		hasVotedSecondary := util.ContainsString(bundleProposal.VotersSecondary, staker)
+
+		if hasVotedSecondary != k.stakerKeeper.IsSecondaryValaddress(ctx, poolId, staker, voter) {
+			return types.ErrOtherValaddressVoted
+		}
+	}
+
 	return nil
 }
 
@@ -174,8 +190,38 @@ func (k Keeper) slashDelegatorsAndRemoveStaker(ctx sdk.Context, poolId uint64, s
 	k.stakerKeeper.LeavePool(ctx, stakerAddress, poolId)
 }
 
-// resetPoints resets the points from a valaccount to zero
+// getPointWindowStart returns the UNIX-timestamp at or before which points
+// are expired if point decay is enabled
+func (k Keeper) getPointWindowStart(ctx sdk.Context) uint64 {
+	now := uint64(ctx.BlockTime().Unix())
+	window := k.GetPointDecayWindow(ctx)
+
+	if now < window {
+		return 0
+	}
+
+	return now - window
+}
+
+// resetPoints resets the points from a valaccount to zero. If point decay
+// is enabled only the points outside the decay window are removed instead.
 func (k Keeper) resetPoints(ctx sdk.Context, poolId uint64, stakerAddress string) {
+	if k.GetPointDecayWindow(ctx) > 0 {
+		previousPoints, currentPoints := k.stakerKeeper.DecayPoints(ctx, poolId, stakerAddress, k.getPointWindowStart(ctx))
+
+		// only emit an event if points have actually expired
+		if currentPoints < previousPoints {
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventPointsDecayed{
+				PoolId:         poolId,
+				Staker:         stakerAddress,
+				PreviousPoints: previousPoints,
+				CurrentPoints:  currentPoints,
+			})
+		}
+
+		return
+	}
+
 	previousPoints := k.stakerKeeper.ResetPoints(ctx, poolId, stakerAddress)
 
 	// only reset points if valaccount has at least a point
@@ -188,10 +234,16 @@ func (k Keeper) resetPoints(ctx sdk.Context, poolId uint64, stakerAddress string
 }
 
 // addPoint increases the points of a valaccount with one and automatically
-// slashes and removes the staker once he reaches max points
+// slashes and removes the staker once he reaches max points. If point decay
+// is enabled only the points within the decay window are counted.
 func (k Keeper) addPoint(ctx sdk.Context, poolId uint64, stakerAddress string) {
 	// Add one point to staker in given pool
-	points := k.stakerKeeper.IncrementPoints(ctx, poolId, stakerAddress)
+	var points uint64
+	if k.GetPointDecayWindow(ctx) > 0 {
+		points = k.stakerKeeper.IncrementDecayingPoints(ctx, poolId, stakerAddress, k.getPointWindowStart(ctx))
+	} else {
+		points = k.stakerKeeper.IncrementPoints(ctx, poolId, stakerAddress)
+	}
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventPointIncreased{
 		PoolId:        poolId,
@@ -209,7 +261,7 @@ func (k Keeper) addPoint(ctx sdk.Context, poolId uint64, stakerAddress string) {
 // handleNonVoters checks if stakers in a pool voted on the current bundle proposal
 // if a staker did not vote at all on a bundle proposal he received points
 // if a staker receives a certain number of points he receives a timeout slash and gets
-// kicked out of a pool
+// kicked out of a pool. Stakers in maintenance do not receive points.
 func (k Keeper) handleNonVoters(ctx sdk.Context, poolId uint64) {
 	voters := map[string]bool{}
 	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
@@ -227,7 +279,7 @@ func (k Keeper) handleNonVoters(ctx sdk.Context, poolId uint64) {
 	}
 
 	for _, staker := range k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, poolId) {
-		if !voters[staker] {
+		if !voters[staker] && !k.stakerKeeper.IsInMaintenance(ctx, poolId, staker) {
 			k.addPoint(ctx, poolId, staker)
 		}
 	}
@@ -349,6 +401,10 @@ func (k Keeper) registerBundleProposalFromUploader(ctx sdk.Context, msg *types.M
 		CompressionId:     pool.CurrentCompressionId,
 	}
 
+	if k.stakerKeeper.IsSecondaryValaddress(ctx, msg.PoolId, msg.Staker, msg.Creator) {
+		bundleProposal.VotersSecondary = append(bundleProposal.VotersSecondary, msg.Staker)
+	}
+
 	k.SetBundleProposal(ctx, bundleProposal)
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventBundleProposed{
@@ -474,10 +530,29 @@ func (k Keeper) calculateVotingPower(delegation uint64) (votingPower uint64) {
 	return
 }
 
+// excludeStakersInMaintenance adds all stakers of the round-robin set which are
+// currently in maintenance to the excluded stakers.
+func (k Keeper) excludeStakersInMaintenance(ctx sdk.Context, poolId uint64, vs RoundRobinValidatorSet, excluded []string) []string {
+	excludedMap := make(map[string]bool)
+	for _, entry := range excluded {
+		excludedMap[entry] = true
+	}
+
+	for _, entry := range vs.Validators {
+		if !excludedMap[entry.Address] && k.stakerKeeper.IsInMaintenance(ctx, poolId, entry.Address) {
+			excluded = append(excluded, entry.Address)
+		}
+	}
+
+	return excluded
+}
+
 // chooseNextUploader selects the next uploader based on a fixed set of stakers in a pool.
 // It is guaranteed that someone is chosen deterministically if the round-robin set itself is not empty.
+// Stakers in maintenance are only chosen if every staker is excluded.
 func (k Keeper) chooseNextUploader(ctx sdk.Context, poolId uint64, excluded ...string) (nextUploader string) {
 	vs := k.LoadRoundRobinValidatorSet(ctx, poolId)
+	excluded = k.excludeStakersInMaintenance(ctx, poolId, vs, excluded)
 	nextUploader = vs.NextProposer(excluded...)
 	k.SaveRoundRobinValidatorSet(ctx, vs)
 	return
@@ -499,6 +574,7 @@ func (k Keeper) chooseNextUploaderFromList(ctx sdk.Context, poolId uint64, inclu
 			excluded = append(excluded, entry.Address)
 		}
 	}
+	excluded = k.excludeStakersInMaintenance(ctx, poolId, vs, excluded)
 
 	nextUploader = vs.NextProposer(excluded...)
 	k.SaveRoundRobinValidatorSet(ctx, vs)
@@ -506,7 +582,8 @@ func (k Keeper) chooseNextUploaderFromList(ctx sdk.Context, poolId uint64, inclu
 }
 
 // GetVoteDistribution is an internal function evaluates the quorum status
-// based on the voting power of the current bundle proposal.
+// based on the voting power of the current bundle proposal. The voting power
+// of stakers in maintenance is neither counted in the votes nor in the total.
 func (k Keeper) GetVoteDistribution(ctx sdk.Context, poolId uint64) (voteDistribution types.VoteDistribution) {
 	bundleProposal, found := k.GetBundleProposal(ctx, poolId)
 	if !found {
@@ -516,7 +593,7 @@ func (k Keeper) GetVoteDistribution(ctx sdk.Context, poolId uint64) (voteDistrib
 	// get voting power for valid
 	for _, voter := range bundleProposal.VotersValid {
 		// valaccount was found the voter is active in the pool
-		if k.stakerKeeper.DoesValaccountExist(ctx, poolId, voter) {
+		if k.stakerKeeper.DoesValaccountExist(ctx, poolId, voter) && !k.stakerKeeper.IsInMaintenance(ctx, poolId, voter) {
 			delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
 			voteDistribution.Valid += k.calculateVotingPower(delegation)
 		}
@@ -525,7 +602,7 @@ func (k Keeper) GetVoteDistribution(ctx sdk.Context, poolId uint64) (voteDistrib
 	// get voting power for invalid
 	for _, voter := range bundleProposal.VotersInvalid {
 		// valaccount was found the voter is active in the pool
-		if k.stakerKeeper.DoesValaccountExist(ctx, poolId, voter) {
+		if k.stakerKeeper.DoesValaccountExist(ctx, poolId, voter) && !k.stakerKeeper.IsInMaintenance(ctx, poolId, voter) {
 			delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
 			voteDistribution.Invalid += k.calculateVotingPower(delegation)
 		}
@@ -534,14 +611,18 @@ func (k Keeper) GetVoteDistribution(ctx sdk.Context, poolId uint64) (voteDistrib
 	// get voting power for abstain
 	for _, voter := range bundleProposal.VotersAbstain {
 		// valaccount was found the voter is active in the pool
-		if k.stakerKeeper.DoesValaccountExist(ctx, poolId, voter) {
+		if k.stakerKeeper.DoesValaccountExist(ctx, poolId, voter) && !k.stakerKeeper.IsInMaintenance(ctx, poolId, voter) {
 			delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
 			voteDistribution.Abstain += k.calculateVotingPower(delegation)
 		}
 	}
 
-	// get total voting power
+	// get total voting power, stakers in maintenance are not part of the quorum
 	for _, staker := range k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, poolId) {
+		if k.stakerKeeper.IsInMaintenance(ctx, poolId, staker) {
+			continue
+		}
+
 		delegation := k.delegationKeeper.GetDelegationAmount(ctx, staker)
 		voteDistribution.Total += k.calculateVotingPower(delegation)
 	}
@@ -581,6 +662,11 @@ func (k Keeper) tallyBundleProposal(ctx sdk.Context, bundleProposal types.Bundle
 			return types.TallyResult{}, err
 		}
 
+		// track the value of the funders payout so it can be used as demand
+		// for the dynamic share of the protocol inflation
+		whitelist := k.fundersKeeper.GetCoinWhitelistMap(ctx)
+		k.poolKeeper.RecordFunderPayouts(ctx, poolId, fundersTypes.GetCoinsScore(fundersPayout, whitelist))
+
 		// charge the inflation pool
 		inflationPayout, err := k.poolKeeper.ChargeInflationPool(ctx, poolId)
 		if err != nil {
@@ -608,6 +694,12 @@ func (k Keeper) tallyBundleProposal(ctx sdk.Context, bundleProposal types.Bundle
 			return types.TallyResult{}, err
 		}
 
+		// compound the commission rewards of the uploader only after the delegators
+		// got paid out, so the new self-delegation does not dilute their rewards
+		if err := k.stakerKeeper.CompoundCommissionRewards(ctx, bundleProposal.Uploader); err != nil {
+			return types.TallyResult{}, err
+		}
+
 		// slash stakers who voted incorrectly
 		for _, voter := range bundleProposal.VotersInvalid {
 			k.slashDelegatorsAndRemoveStaker(ctx, poolId, voter, delegationTypes.SLASH_TYPE_VOTE)
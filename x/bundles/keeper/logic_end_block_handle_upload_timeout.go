@@ -14,7 +14,7 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 	// Iterate over all pool Ids.
 	for _, pool := range k.poolKeeper.GetAllPools(ctx) {
 		err := k.AssertPoolCanRun(ctx, pool.Id)
-		bundleProposal, _ := k.GetBundleProposal(ctx, pool.Id)
+		bundleProposal, found := k.GetBundleProposal(ctx, pool.Id)
 
 		// Check if pool is active
 		if err != nil {
@@ -29,6 +29,12 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 					Total:   0,
 					Status:  types.BUNDLE_STATUS_DISABLED,
 				}, "")
+			} else if err == types.ErrPoolPaused && found {
+				// while the pool is paused the upload interval and timeout get restarted
+				// every block, so nobody receives points for the time of the pause
+				bundleProposal.NextUploader = ""
+				bundleProposal.UpdatedAt = uint64(ctx.BlockTime().Unix())
+				k.SetBundleProposal(ctx, bundleProposal)
 			} else if bundleProposal.NextUploader != "" {
 				bundleProposal.NextUploader = ""
 				k.SetBundleProposal(ctx, bundleProposal)
@@ -112,8 +118,8 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 		}
 
 		// Now we increase the points of the valaccount
-		// (if he is still participating in the pool)
-		if k.stakerKeeper.DoesValaccountExist(ctx, pool.Id, timedoutUploader) {
+		// (if he is still participating in the pool and not in maintenance)
+		if k.stakerKeeper.DoesValaccountExist(ctx, pool.Id, timedoutUploader) && !k.stakerKeeper.IsInMaintenance(ctx, pool.Id, timedoutUploader) {
 			k.addPoint(ctx, pool.Id, timedoutUploader)
 		}
 	}
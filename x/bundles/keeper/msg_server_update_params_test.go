@@ -37,6 +37,9 @@ TEST CASES - msg_server_update_params.go
 * Update max points
 * Update max points with invalid value
 
+* Update point decay window
+* Update point decay window with invalid value
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -70,6 +73,7 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(params.StorageCosts).To(Equal(types.DefaultStorageCosts))
 		Expect(params.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(params.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(params.PointDecayWindow).To(Equal(types.DefaultPointDecayWindow))
 	})
 
 	It("Invalid authority (transaction)", func() {
@@ -505,4 +509,76 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
 		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
 	})
+
+	It("Update point decay window", func() {
+		// ARRANGE
+		payload := `{
+			"point_decay_window": 3600
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCosts).To(Equal(types.DefaultStorageCosts))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.PointDecayWindow).To(Equal(uint64(3600)))
+	})
+
+	It("Update point decay window with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"point_decay_window": "invalid"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().BundlesKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.UploadTimeout).To(Equal(types.DefaultUploadTimeout))
+		Expect(updatedParams.StorageCosts).To(Equal(types.DefaultStorageCosts))
+		Expect(updatedParams.NetworkFee).To(Equal(types.DefaultNetworkFee))
+		Expect(updatedParams.MaxPoints).To(Equal(types.DefaultMaxPoints))
+		Expect(updatedParams.PointDecayWindow).To(Equal(types.DefaultPointDecayWindow))
+	})
 })
@@ -43,6 +43,11 @@ func (k msgServer) VoteBundleProposal(
 		return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrInvalidVote.Error(), msg.Vote)
 	}
 
+	// remember which valaddress of the staker voted on the bundle proposal
+	if k.stakerKeeper.IsSecondaryValaddress(ctx, msg.PoolId, msg.Staker, msg.Creator) && !util.ContainsString(bundleProposal.VotersSecondary, msg.Staker) {
+		bundleProposal.VotersSecondary = append(bundleProposal.VotersSecondary, msg.Staker)
+	}
+
 	k.SetBundleProposal(ctx, bundleProposal)
 
 	// reset points as user has now proven to be active
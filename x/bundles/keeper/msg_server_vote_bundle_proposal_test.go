@@ -21,6 +21,10 @@ TEST CASES - msg_server_vote_bundle_proposal.go
 * Try to vote invalid on proposal after abstain vote
 * Try to vote unspecified on proposal
 * Try to vote as not the first voter on bundle proposal
+* Vote with the secondary valaddress
+* Try to vote with the secondary valaddress after the valaddress voted abstain
+* Try to vote with the valaddress after the secondary valaddress voted abstain
+* Vote valid with the secondary valaddress after it voted abstain
 
 */
 
@@ -254,4 +258,140 @@ var _ = Describe("msg_server_vote_bundle_proposal.go", Ordered, func() {
 		Expect(bundleProposal.VotersAbstain).NotTo(ContainElement(i.STAKER_1))
 		Expect(bundleProposal.VotersAbstain).NotTo(ContainElement(i.STAKER_2))
 	})
+
+	It("Vote with the secondary valaddress", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_1,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_1_B,
+		})
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_B,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		Expect(bundleProposal.VotersValid).To(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersSecondary).To(ConsistOf(i.STAKER_1))
+
+		// the valaddress can not vote again
+		s.RunTxBundlesError(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_A,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_INVALID,
+		})
+	})
+
+	It("Try to vote with the secondary valaddress after the valaddress voted abstain", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_1,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_1_B,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_A,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_ABSTAIN,
+		})
+
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_B,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(bundletypes.ErrOtherValaddressVoted))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		Expect(bundleProposal.VotersValid).NotTo(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersAbstain).To(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersSecondary).To(BeEmpty())
+	})
+
+	It("Try to vote with the valaddress after the secondary valaddress voted abstain", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_1,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_1_B,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_B,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_ABSTAIN,
+		})
+
+		// ACT
+		_, err := s.RunTx(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_A,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		Expect(err).To(MatchError(bundletypes.ErrOtherValaddressVoted))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		Expect(bundleProposal.VotersValid).NotTo(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersAbstain).To(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersSecondary).To(ConsistOf(i.STAKER_1))
+	})
+
+	It("Vote valid with the secondary valaddress after it voted abstain", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_1,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_1_B,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_B,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_ABSTAIN,
+		})
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1_B,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		Expect(bundleProposal.VotersValid).To(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersAbstain).NotTo(ContainElement(i.STAKER_1))
+		Expect(bundleProposal.VotersSecondary).To(ConsistOf(i.STAKER_1))
+	})
 })
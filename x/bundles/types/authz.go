@@ -0,0 +1,73 @@
+package types
+
+import (
+	"context"
+	"slices"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &BundlesAuthorization{}
+
+// AuthorizableMessages are the type urls of the messages which can be
+// granted with a BundlesAuthorization.
+var AuthorizableMessages = []string{
+	"/kyve.bundles.v1beta1.MsgSubmitBundleProposal",
+	"/kyve.bundles.v1beta1.MsgVoteBundleProposal",
+	"/kyve.bundles.v1beta1.MsgClaimUploaderRole",
+	"/kyve.bundles.v1beta1.MsgSkipUploaderRole",
+}
+
+// NewBundlesAuthorization creates a new BundlesAuthorization object.
+func NewBundlesAuthorization(msgTypeUrl string, poolIds []uint64, expiration uint64) *BundlesAuthorization {
+	return &BundlesAuthorization{
+		MsgTypeUrl: msgTypeUrl,
+		PoolIds:    poolIds,
+		Expiration: expiration,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a BundlesAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept. The message is only accepted if
+// it targets one of the authorized pools and the authorization has not
+// expired yet.
+func (a BundlesAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, errors.Wrapf(errorsTypes.ErrInvalidType, "expected %s, got %s", a.MsgTypeUrl, sdk.MsgTypeURL(msg))
+	}
+
+	if a.Expiration > 0 && uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()) >= a.Expiration {
+		return authz.AcceptResponse{}, errors.Wrapf(errorsTypes.ErrUnauthorized, ErrAuthorizationExpired.Error(), a.Expiration)
+	}
+
+	poolMsg, ok := msg.(interface{ GetPoolId() uint64 })
+	if !ok {
+		return authz.AcceptResponse{}, errors.Wrapf(errorsTypes.ErrInvalidType, "message %s has no pool id", a.MsgTypeUrl)
+	}
+
+	if !slices.Contains(a.PoolIds, poolMsg.GetPoolId()) {
+		return authz.AcceptResponse{}, errors.Wrapf(errorsTypes.ErrUnauthorized, ErrPoolNotAuthorized.Error(), poolMsg.GetPoolId())
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a BundlesAuthorization) ValidateBasic() error {
+	if !slices.Contains(AuthorizableMessages, a.MsgTypeUrl) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "message %s can not be authorized", a.MsgTypeUrl)
+	}
+
+	if len(a.PoolIds) == 0 {
+		return errors.Wrap(errorsTypes.ErrInvalidRequest, "at least one pool id is required")
+	}
+
+	return nil
+}
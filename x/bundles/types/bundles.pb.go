@@ -103,6 +103,9 @@ type BundleProposal struct {
 	StorageProviderId uint32 `protobuf:"varint,15,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
 	// compression_id the id of the compression type with which the data was compressed
 	CompressionId uint32 `protobuf:"varint,16,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
+	// voters_secondary list of all stakers who voted or proposed with their
+	// secondary valaddress for the current proposal
+	VotersSecondary []string `protobuf:"bytes,17,rep,name=voters_secondary,json=votersSecondary,proto3" json:"voters_secondary,omitempty"`
 }
 
 func (m *BundleProposal) Reset()         { *m = BundleProposal{} }
@@ -250,6 +253,13 @@ func (m *BundleProposal) GetCompressionId() uint32 {
 	return 0
 }
 
+func (m *BundleProposal) GetVotersSecondary() []string {
+	if m != nil {
+		return m.VotersSecondary
+	}
+	return nil
+}
+
 // FinalizedBundle represents a bundle proposal where the majority
 // agreed on its validity
 type FinalizedBundle struct {
@@ -752,65 +762,66 @@ func init() {
 }
 
 var fileDescriptor_889cf76d77a4de2b = []byte{
-	// 918 bytes of a gzipped FileDescriptorProto
+	// 934 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdd, 0x6e, 0x1a, 0x47,
-	0x14, 0x66, 0x01, 0xf3, 0x73, 0x60, 0x09, 0x9d, 0xfc, 0x78, 0xed, 0xd4, 0x14, 0x13, 0x55, 0x42,
-	0x55, 0x05, 0x8a, 0x7b, 0xd1, 0x6b, 0x1c, 0x40, 0xdd, 0xc6, 0x21, 0x74, 0x37, 0xa0, 0xa6, 0xaa,
-	0xb4, 0x1a, 0xd8, 0x31, 0x8c, 0x0c, 0x3b, 0xab, 0xdd, 0x81, 0x18, 0x3f, 0x41, 0xa5, 0x4a, 0x55,
-	0xdf, 0xa1, 0x7d, 0x8c, 0x3e, 0x40, 0x2f, 0x73, 0xd9, 0xcb, 0xca, 0x7e, 0x91, 0x6a, 0x66, 0x76,
-	0x31, 0xc4, 0xb8, 0xcd, 0x4d, 0xef, 0x38, 0xdf, 0xf7, 0xcd, 0x99, 0xf3, 0xf3, 0x0d, 0x0b, 0xb5,
-	0x8b, 0xd5, 0x92, 0x34, 0x47, 0x0b, 0xcf, 0x9d, 0x91, 0xb0, 0xb9, 0x7c, 0x3e, 0x22, 0x1c, 0x3f,
-	0x8f, 0xe3, 0x86, 0x1f, 0x30, 0xce, 0xd0, 0x23, 0xa1, 0x69, 0xc4, 0x58, 0xa4, 0x39, 0x7c, 0x34,
-	0x61, 0x13, 0x26, 0x05, 0x4d, 0xf1, 0x4b, 0x69, 0x6b, 0xbf, 0xa7, 0xa1, 0x74, 0x2a, 0x95, 0xfd,
-	0x80, 0xf9, 0x2c, 0xc4, 0x33, 0xb4, 0x0f, 0x59, 0x9f, 0xb1, 0x99, 0x43, 0x5d, 0x43, 0xab, 0x6a,
-	0xf5, 0xb4, 0x95, 0x11, 0xa1, 0xe9, 0xa2, 0x23, 0x80, 0x90, 0xb3, 0x00, 0x4f, 0x88, 0xe0, 0x92,
-	0x55, 0xad, 0x9e, 0xb7, 0xf2, 0x11, 0x62, 0xba, 0xe8, 0x10, 0x72, 0x0b, 0x7f, 0xc6, 0xb0, 0x4b,
-	0x02, 0x23, 0x25, 0xc9, 0x75, 0x8c, 0x9e, 0x81, 0xee, 0x91, 0x4b, 0xee, 0xac, 0x05, 0x69, 0x29,
-	0x28, 0x0a, 0x70, 0x10, 0x8b, 0x9e, 0x42, 0xde, 0xc5, 0x1c, 0x3b, 0x21, 0xbd, 0x22, 0xc6, 0x9e,
-	0xbc, 0x3a, 0x27, 0x00, 0x9b, 0x5e, 0x11, 0xf4, 0x19, 0x14, 0x54, 0x47, 0x8a, 0xce, 0x48, 0x1a,
-	0x14, 0x24, 0x05, 0x8f, 0x21, 0xc3, 0x99, 0x73, 0x41, 0x56, 0x46, 0x56, 0xe6, 0xde, 0xe3, 0xec,
-	0x25, 0x59, 0xa1, 0xcf, 0xa1, 0x14, 0x9f, 0x5b, 0xcc, 0xe7, 0x38, 0x58, 0x19, 0x39, 0x49, 0xeb,
-	0xd1, 0x51, 0x05, 0xae, 0xef, 0x9e, 0xe2, 0x70, 0x6a, 0xe4, 0x55, 0xf5, 0x02, 0xf8, 0x06, 0x87,
-	0x53, 0xd1, 0xf8, 0xc2, 0x77, 0x31, 0x27, 0xae, 0x83, 0xb9, 0x01, 0xf2, 0xea, 0x7c, 0x84, 0xb4,
-	0x38, 0x3a, 0x86, 0xe2, 0x92, 0x71, 0x12, 0x84, 0xce, 0x12, 0xcf, 0xa8, 0x6b, 0x14, 0xaa, 0xa9,
-	0x7a, 0xde, 0x2a, 0x28, 0x6c, 0x28, 0x20, 0x51, 0x45, 0x24, 0xa1, 0x9e, 0x12, 0x15, 0xa5, 0x48,
-	0x57, 0xa8, 0xa9, 0xc0, 0x0d, 0x19, 0x1e, 0x85, 0x1c, 0x53, 0xcf, 0xd0, 0x37, 0x65, 0x2d, 0x05,
-	0xa2, 0x03, 0xc8, 0x9d, 0x07, 0x6c, 0x2e, 0x9b, 0x2d, 0xc9, 0x5a, 0xb3, 0x22, 0x16, 0xed, 0x36,
-	0xe0, 0x61, 0xbc, 0x23, 0x3f, 0x60, 0x4b, 0xea, 0x92, 0x40, 0x2c, 0xeb, 0x41, 0x55, 0xab, 0xeb,
-	0xd6, 0x27, 0x11, 0xd5, 0x8f, 0x18, 0x53, 0xde, 0x38, 0x66, 0x73, 0x3f, 0x20, 0x61, 0x48, 0x99,
-	0x27, 0xa4, 0x65, 0x29, 0xd5, 0x37, 0x50, 0xd3, 0xad, 0xfd, 0x92, 0x86, 0x07, 0x5d, 0xea, 0xe1,
-	0x19, 0xbd, 0x22, 0xae, 0xf2, 0xcb, 0xfd, 0x3e, 0x29, 0x41, 0x32, 0xf2, 0x47, 0xda, 0x4a, 0xd2,
-	0x0f, 0x7d, 0x93, 0xfa, 0x37, 0xdf, 0xa4, 0x3f, 0xf0, 0xcd, 0x11, 0x80, 0xec, 0x94, 0x7a, 0x2e,
-	0xb9, 0x8c, 0x3c, 0x91, 0x17, 0x88, 0x29, 0x00, 0x31, 0x08, 0xce, 0x22, 0x52, 0x39, 0x22, 0xcb,
-	0x99, 0xa2, 0xfe, 0x47, 0x3b, 0xb4, 0xa1, 0x78, 0x1e, 0xcf, 0x22, 0x36, 0x44, 0xe1, 0xe4, 0xb8,
-	0xb1, 0xeb, 0xd9, 0x35, 0xd6, 0x53, 0x6b, 0x71, 0xab, 0x70, 0x7e, 0x1b, 0x6c, 0x2d, 0xb1, 0xf0,
-	0x51, 0x4b, 0x2c, 0x7e, 0xfc, 0x12, 0xf5, 0x1d, 0x4b, 0x44, 0xdf, 0x42, 0x29, 0xe4, 0xf8, 0x82,
-	0x38, 0x21, 0x19, 0x2f, 0x02, 0xca, 0x95, 0x79, 0x0a, 0x27, 0xcf, 0x76, 0x57, 0x6e, 0x0b, 0xad,
-	0x1d, 0x49, 0x2d, 0x3d, 0xdc, 0x0c, 0x6b, 0x2f, 0xa0, 0xb0, 0xd1, 0x19, 0x7a, 0x02, 0x99, 0x29,
-	0xa1, 0x93, 0x29, 0x8f, 0xad, 0xa0, 0x22, 0xf4, 0x29, 0xe4, 0x39, 0x9d, 0x93, 0x90, 0xe3, 0xb9,
-	0x1f, 0x39, 0xe2, 0x16, 0xa8, 0x8d, 0x41, 0xdf, 0xba, 0x04, 0xd5, 0xa1, 0x2c, 0x1f, 0x82, 0x23,
-	0xfc, 0xee, 0xf8, 0xec, 0x1d, 0x09, 0xa2, 0x84, 0x25, 0x89, 0x0f, 0x19, 0x27, 0x7d, 0x81, 0x0a,
-	0x25, 0x67, 0x1c, 0xcf, 0x36, 0x95, 0x2a, 0x7f, 0x49, 0xe2, 0x6b, 0x65, 0xad, 0x0b, 0x48, 0x19,
-	0x76, 0x48, 0x02, 0x31, 0x88, 0x8e, 0xc7, 0x83, 0xd5, 0xbd, 0x05, 0x1b, 0x90, 0x5d, 0x2a, 0x9d,
-	0x4c, 0xb7, 0x67, 0xc5, 0x61, 0xed, 0x7b, 0x28, 0x6f, 0xe5, 0x79, 0x85, 0x7d, 0xd4, 0x86, 0x5c,
-	0x44, 0x87, 0x86, 0x56, 0x4d, 0xd5, 0x0b, 0x27, 0xf5, 0xdd, 0xb3, 0xbc, 0x5b, 0x81, 0xb5, 0x3e,
-	0x59, 0x7b, 0x0b, 0xc7, 0x16, 0x5b, 0x78, 0xae, 0xc5, 0x46, 0xd4, 0xb3, 0xa9, 0x37, 0x99, 0x11,
-	0xf9, 0xaf, 0x81, 0x39, 0x0b, 0xfa, 0x01, 0x9b, 0x88, 0x0d, 0x8a, 0xc2, 0xb0, 0xeb, 0x8a, 0x9f,
-	0xb2, 0xe2, 0xbc, 0x15, 0x87, 0xe2, 0xfd, 0xf8, 0x91, 0x4a, 0xd6, 0x9c, 0xb2, 0xd6, 0x71, 0xed,
-	0x67, 0x0d, 0xd0, 0x6d, 0xee, 0x75, 0xb2, 0x7b, 0x9f, 0xee, 0x8f, 0xa0, 0xc7, 0x67, 0x9d, 0x19,
-	0x0d, 0xb9, 0x91, 0x94, 0x5d, 0x7d, 0xbd, 0xbb, 0xab, 0xff, 0xac, 0xda, 0x2a, 0xc6, 0xd9, 0xce,
-	0x68, 0xc8, 0xbf, 0xf8, 0x43, 0x83, 0xa2, 0x9a, 0x84, 0xcd, 0x31, 0x5f, 0x84, 0xe8, 0x08, 0x0e,
-	0x4e, 0x07, 0xbd, 0xf6, 0x59, 0xc7, 0xb1, 0xdf, 0xb4, 0xde, 0x0c, 0x6c, 0x67, 0xd0, 0xb3, 0xfb,
-	0x9d, 0x17, 0x66, 0xd7, 0xec, 0xb4, 0xcb, 0x09, 0xb4, 0x0f, 0x0f, 0xb7, 0xe9, 0x61, 0xeb, 0xcc,
-	0x6c, 0x97, 0x35, 0x74, 0x00, 0x8f, 0xb7, 0x09, 0xb3, 0xa7, 0xa8, 0x24, 0x3a, 0x84, 0x27, 0xdb,
-	0x54, 0xef, 0xb5, 0xd3, 0x1d, 0xf4, 0xda, 0x76, 0x39, 0x85, 0x9e, 0xc2, 0xfe, 0x1d, 0xee, 0xbb,
-	0xc1, 0x6b, 0x6b, 0xf0, 0xaa, 0x9c, 0xbe, 0x7b, 0xb0, 0x6d, 0xda, 0xad, 0xd3, 0xb3, 0x4e, 0xbb,
-	0xbc, 0x77, 0x98, 0xfe, 0xe9, 0xb7, 0x4a, 0xe2, 0xb4, 0xfb, 0xe7, 0x75, 0x45, 0x7b, 0x7f, 0x5d,
-	0xd1, 0xfe, 0xbe, 0xae, 0x68, 0xbf, 0xde, 0x54, 0x12, 0xef, 0x6f, 0x2a, 0x89, 0xbf, 0x6e, 0x2a,
-	0x89, 0x1f, 0xbe, 0x9c, 0x50, 0x3e, 0x5d, 0x8c, 0x1a, 0x63, 0x36, 0x6f, 0xbe, 0x7c, 0x3b, 0xec,
-	0xf4, 0x08, 0x7f, 0xc7, 0x82, 0x8b, 0xe6, 0x78, 0x8a, 0xa9, 0xd7, 0xbc, 0x5c, 0x7f, 0xaf, 0xf9,
-	0xca, 0x27, 0xe1, 0x28, 0x23, 0x3f, 0xbd, 0x5f, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x45, 0x66,
-	0x7a, 0xef, 0xcc, 0x07, 0x00, 0x00,
+	0x14, 0xf6, 0x02, 0xe6, 0xe7, 0xc0, 0x12, 0x32, 0xf9, 0xf1, 0xda, 0xa9, 0x29, 0x26, 0xaa, 0x44,
+	0xab, 0x0a, 0x14, 0xf7, 0xa2, 0xd7, 0x38, 0x80, 0xba, 0x8d, 0x43, 0xe8, 0x6e, 0x40, 0x4d, 0x55,
+	0x69, 0x35, 0xb0, 0x63, 0x18, 0x19, 0x76, 0x56, 0xbb, 0x03, 0x31, 0x7e, 0x82, 0x4a, 0x95, 0xaa,
+	0xbe, 0x43, 0x5f, 0xa3, 0x0f, 0xd0, 0xbb, 0xe6, 0xb2, 0x97, 0x95, 0xfd, 0x22, 0xd5, 0xcc, 0xec,
+	0x62, 0xb0, 0x71, 0x9b, 0x9b, 0xde, 0x71, 0xbe, 0xef, 0x9b, 0x33, 0xe7, 0xe7, 0x1b, 0x16, 0xaa,
+	0xe7, 0xcb, 0x05, 0x69, 0x0c, 0xe7, 0x9e, 0x3b, 0x25, 0x61, 0x63, 0xf1, 0x62, 0x48, 0x38, 0x7e,
+	0x11, 0xc7, 0x75, 0x3f, 0x60, 0x9c, 0xa1, 0xc7, 0x42, 0x53, 0x8f, 0xb1, 0x48, 0x73, 0xf0, 0x78,
+	0xcc, 0xc6, 0x4c, 0x0a, 0x1a, 0xe2, 0x97, 0xd2, 0x56, 0xff, 0x4c, 0x41, 0xf1, 0x44, 0x2a, 0x7b,
+	0x01, 0xf3, 0x59, 0x88, 0xa7, 0x68, 0x0f, 0x32, 0x3e, 0x63, 0x53, 0x87, 0xba, 0x86, 0x56, 0xd1,
+	0x6a, 0x29, 0x2b, 0x2d, 0x42, 0xd3, 0x45, 0x87, 0x00, 0x21, 0x67, 0x01, 0x1e, 0x13, 0xc1, 0x25,
+	0x2a, 0x5a, 0x2d, 0x67, 0xe5, 0x22, 0xc4, 0x74, 0xd1, 0x01, 0x64, 0xe7, 0xfe, 0x94, 0x61, 0x97,
+	0x04, 0x46, 0x52, 0x92, 0xab, 0x18, 0x3d, 0x07, 0xdd, 0x23, 0x17, 0xdc, 0x59, 0x09, 0x52, 0x52,
+	0x50, 0x10, 0x60, 0x3f, 0x16, 0x3d, 0x83, 0x9c, 0x8b, 0x39, 0x76, 0x42, 0x7a, 0x49, 0x8c, 0x5d,
+	0x79, 0x75, 0x56, 0x00, 0x36, 0xbd, 0x24, 0xe8, 0x53, 0xc8, 0xab, 0x8e, 0x14, 0x9d, 0x96, 0x34,
+	0x28, 0x48, 0x0a, 0x9e, 0x40, 0x9a, 0x33, 0xe7, 0x9c, 0x2c, 0x8d, 0x8c, 0xcc, 0xbd, 0xcb, 0xd9,
+	0x2b, 0xb2, 0x44, 0x9f, 0x41, 0x31, 0x3e, 0x37, 0x9f, 0xcd, 0x70, 0xb0, 0x34, 0xb2, 0x92, 0xd6,
+	0xa3, 0xa3, 0x0a, 0x5c, 0xdd, 0x3d, 0xc1, 0xe1, 0xc4, 0xc8, 0xa9, 0xea, 0x05, 0xf0, 0x0d, 0x0e,
+	0x27, 0xa2, 0xf1, 0xb9, 0xef, 0x62, 0x4e, 0x5c, 0x07, 0x73, 0x03, 0xe4, 0xd5, 0xb9, 0x08, 0x69,
+	0x72, 0x74, 0x04, 0x85, 0x05, 0xe3, 0x24, 0x08, 0x9d, 0x05, 0x9e, 0x52, 0xd7, 0xc8, 0x57, 0x92,
+	0xb5, 0x9c, 0x95, 0x57, 0xd8, 0x40, 0x40, 0xa2, 0x8a, 0x48, 0x42, 0x3d, 0x25, 0x2a, 0x48, 0x91,
+	0xae, 0x50, 0xd3, 0x5b, 0xdc, 0x92, 0xe1, 0x61, 0xc8, 0x31, 0xf5, 0x0c, 0x7d, 0x5d, 0xd6, 0x54,
+	0x20, 0xda, 0x87, 0xec, 0x59, 0xc0, 0x66, 0xb2, 0xd9, 0xa2, 0xac, 0x35, 0x23, 0x62, 0xd1, 0x6e,
+	0x1d, 0x1e, 0xc5, 0x3b, 0xf2, 0x03, 0xb6, 0xa0, 0x2e, 0x09, 0xc4, 0xb2, 0x1e, 0x54, 0xb4, 0x9a,
+	0x6e, 0x3d, 0x8c, 0xa8, 0x5e, 0xc4, 0x98, 0xf2, 0xc6, 0x11, 0x9b, 0xf9, 0x01, 0x09, 0x43, 0xca,
+	0x3c, 0x21, 0x2d, 0x49, 0xa9, 0xbe, 0x86, 0x9a, 0x2e, 0xfa, 0x1c, 0x4a, 0x51, 0x61, 0x21, 0x19,
+	0x31, 0xcf, 0x15, 0x73, 0x7c, 0x28, 0x4b, 0x7b, 0xa0, 0x70, 0x3b, 0x86, 0xab, 0xbf, 0xa4, 0xe0,
+	0x41, 0x87, 0x7a, 0x78, 0x4a, 0x2f, 0x89, 0xab, 0xac, 0x75, 0xbf, 0xa5, 0x8a, 0x90, 0x88, 0xac,
+	0x94, 0xb2, 0x12, 0xf4, 0xb6, 0xc5, 0x92, 0xff, 0x66, 0xb1, 0xd4, 0x2d, 0x8b, 0x1d, 0x02, 0xc8,
+	0xa1, 0x50, 0xcf, 0x25, 0x17, 0x91, 0x7d, 0x72, 0x02, 0x31, 0x05, 0x20, 0x66, 0xc6, 0x59, 0x44,
+	0x2a, 0xf3, 0x64, 0x38, 0x53, 0xd4, 0xff, 0xe8, 0x9c, 0x16, 0x14, 0xce, 0xe2, 0x59, 0xc4, 0xde,
+	0xc9, 0x1f, 0x1f, 0xd5, 0xb7, 0xbd, 0xd0, 0xfa, 0x6a, 0x6a, 0x4d, 0x6e, 0xe5, 0xcf, 0x6e, 0x82,
+	0x8d, 0x7d, 0xe7, 0x3f, 0x6a, 0xdf, 0x85, 0x8f, 0xdf, 0xb7, 0xbe, 0x6d, 0xdf, 0xdf, 0x42, 0x31,
+	0xe4, 0xf8, 0x9c, 0x88, 0x75, 0xcf, 0x03, 0xca, 0x95, 0xcf, 0xf2, 0xc7, 0xcf, 0xb7, 0x57, 0x6e,
+	0x0b, 0xad, 0x1d, 0x49, 0x2d, 0x3d, 0x5c, 0x0f, 0xab, 0x2f, 0x21, 0xbf, 0xd6, 0x19, 0x7a, 0x0a,
+	0xe9, 0x09, 0xa1, 0xe3, 0x09, 0x8f, 0xad, 0xa0, 0x22, 0xf4, 0x09, 0xe4, 0x38, 0x9d, 0x91, 0x90,
+	0xe3, 0x99, 0x1f, 0x39, 0xe2, 0x06, 0xa8, 0x8e, 0x40, 0xdf, 0xb8, 0x04, 0xd5, 0xa0, 0x24, 0xdf,
+	0x8c, 0x23, 0xfc, 0xe7, 0xf8, 0xec, 0x3d, 0x09, 0xa2, 0x84, 0x45, 0x89, 0x0f, 0x18, 0x27, 0x3d,
+	0x81, 0x0a, 0x25, 0x67, 0x1c, 0x4f, 0xd7, 0x95, 0x2a, 0x7f, 0x51, 0xe2, 0x2b, 0x65, 0xb5, 0x03,
+	0x48, 0x19, 0x76, 0x40, 0x02, 0x31, 0x88, 0xb6, 0xc7, 0x83, 0xe5, 0xbd, 0x05, 0x1b, 0x90, 0x59,
+	0x28, 0x9d, 0x4c, 0xb7, 0x6b, 0xc5, 0x61, 0xf5, 0x7b, 0x28, 0x6d, 0xe4, 0x79, 0x8d, 0x7d, 0xd4,
+	0x82, 0x6c, 0x44, 0x87, 0x86, 0x56, 0x49, 0xd6, 0xf2, 0xc7, 0xb5, 0xed, 0xb3, 0xbc, 0x5b, 0x81,
+	0xb5, 0x3a, 0x59, 0x7d, 0x07, 0x47, 0x16, 0x9b, 0x7b, 0xae, 0xc5, 0x86, 0xd4, 0xb3, 0xa9, 0x37,
+	0x9e, 0x12, 0xf9, 0x07, 0x83, 0x39, 0x0b, 0x7a, 0x01, 0x1b, 0x8b, 0x0d, 0x8a, 0xc2, 0xb0, 0xeb,
+	0x8a, 0x9f, 0xb2, 0xe2, 0x9c, 0x15, 0x87, 0xe2, 0xfd, 0xf8, 0x91, 0x4a, 0xd6, 0x9c, 0xb4, 0x56,
+	0x71, 0xf5, 0x67, 0x0d, 0xd0, 0x4d, 0xee, 0x55, 0xb2, 0x7b, 0x9f, 0xee, 0x8f, 0xa0, 0xc7, 0x67,
+	0x9d, 0x29, 0x0d, 0xb9, 0x91, 0x90, 0x5d, 0x7d, 0xbd, 0xbd, 0xab, 0xff, 0xac, 0xda, 0x2a, 0xc4,
+	0xd9, 0x4e, 0x69, 0xc8, 0xbf, 0xf8, 0x5d, 0x83, 0x82, 0x9a, 0x84, 0xcd, 0x31, 0x9f, 0x87, 0xe8,
+	0x10, 0xf6, 0x4f, 0xfa, 0xdd, 0xd6, 0x69, 0xdb, 0xb1, 0xdf, 0x36, 0xdf, 0xf6, 0x6d, 0xa7, 0xdf,
+	0xb5, 0x7b, 0xed, 0x97, 0x66, 0xc7, 0x6c, 0xb7, 0x4a, 0x3b, 0x68, 0x0f, 0x1e, 0x6d, 0xd2, 0x83,
+	0xe6, 0xa9, 0xd9, 0x2a, 0x69, 0x68, 0x1f, 0x9e, 0x6c, 0x12, 0x66, 0x57, 0x51, 0x09, 0x74, 0x00,
+	0x4f, 0x37, 0xa9, 0xee, 0x1b, 0xa7, 0xd3, 0xef, 0xb6, 0xec, 0x52, 0x12, 0x3d, 0x83, 0xbd, 0x3b,
+	0xdc, 0x77, 0xfd, 0x37, 0x56, 0xff, 0x75, 0x29, 0x75, 0xf7, 0x60, 0xcb, 0xb4, 0x9b, 0x27, 0xa7,
+	0xed, 0x56, 0x69, 0xf7, 0x20, 0xf5, 0xd3, 0x6f, 0xe5, 0x9d, 0x93, 0xce, 0x1f, 0x57, 0x65, 0xed,
+	0xc3, 0x55, 0x59, 0xfb, 0xfb, 0xaa, 0xac, 0xfd, 0x7a, 0x5d, 0xde, 0xf9, 0x70, 0x5d, 0xde, 0xf9,
+	0xeb, 0xba, 0xbc, 0xf3, 0xc3, 0x97, 0x63, 0xca, 0x27, 0xf3, 0x61, 0x7d, 0xc4, 0x66, 0x8d, 0x57,
+	0xef, 0x06, 0xed, 0x2e, 0xe1, 0xef, 0x59, 0x70, 0xde, 0x18, 0x4d, 0x30, 0xf5, 0x1a, 0x17, 0xab,
+	0x4f, 0x3b, 0x5f, 0xfa, 0x24, 0x1c, 0xa6, 0xe5, 0x57, 0xfa, 0xab, 0x7f, 0x06, 0x00, 0x5d, 0x83,
+	0x8d, 0xcf, 0xf7, 0x07, 0x00, 0x00,
 }
 
 func (m *BundleProposal) Marshal() (dAtA []byte, err error) {
@@ -833,6 +844,17 @@ func (m *BundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VotersSecondary) > 0 {
+		for iNdEx := len(m.VotersSecondary) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersSecondary[iNdEx])
+			copy(dAtA[i:], m.VotersSecondary[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersSecondary[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if m.CompressionId != 0 {
 		i = encodeVarintBundles(dAtA, i, uint64(m.CompressionId))
 		i--
@@ -1357,6 +1379,12 @@ func (m *BundleProposal) Size() (n int) {
 	if m.CompressionId != 0 {
 		n += 2 + sovBundles(uint64(m.CompressionId))
 	}
+	if len(m.VotersSecondary) > 0 {
+		for _, s := range m.VotersSecondary {
+			l = len(s)
+			n += 2 + l + sovBundles(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1982,6 +2010,38 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersSecondary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersSecondary = append(m.VotersSecondary, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBundles(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptoCodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
@@ -12,6 +13,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgVoteBundleProposal{}, "kyve/bundles/MsgVoteBundleProposal", nil)
 	cdc.RegisterConcrete(&MsgClaimUploaderRole{}, "kyve/bundles/MsgClaimUploaderRole", nil)
 	cdc.RegisterConcrete(&MsgSkipUploaderRole{}, "kyve/bundles/MsgSkipUploaderRole", nil)
+	cdc.RegisterConcrete(&BundlesAuthorization{}, "kyve/bundles/BundlesAuthorization", nil)
 }
 
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
@@ -20,6 +22,8 @@ func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimUploaderRole{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSkipUploaderRole{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
+
+	registry.RegisterImplementations((*authz.Authorization)(nil), &BundlesAuthorization{})
 }
 
 var Amino = codec.NewLegacyAmino()
@@ -17,6 +17,7 @@ var (
 	ErrInvalidStorageId        = errors.Register(ModuleName, 1120, "current storageId %v does not match provided storageId")
 	ErrPoolDisabled            = errors.Register(ModuleName, 1121, "pool is disabled")
 	ErrPoolCurrentlyUpgrading  = errors.Register(ModuleName, 1122, "pool currently upgrading")
+	ErrPoolPaused              = errors.Register(ModuleName, 1123, "pool is paused")
 	ErrMinDelegationNotReached = errors.Register(ModuleName, 1200, "min delegation not reached")
 	ErrBundleDropped           = errors.Register(ModuleName, 1202, "bundle proposal is dropped")
 	ErrAlreadyVotedValid       = errors.Register(ModuleName, 1204, "already voted valid on bundle proposal")
@@ -24,4 +25,7 @@ var (
 	ErrAlreadyVotedAbstain     = errors.Register(ModuleName, 1206, "already voted abstain on bundle proposal")
 	ErrVotingPowerTooHigh      = errors.Register(ModuleName, 1207, "staker in pool has too much voting power")
 	ErrEndKeyReached           = errors.Register(ModuleName, 1208, "end key reached")
+	ErrOtherValaddressVoted    = errors.Register(ModuleName, 1209, "other valaddress of staker already voted on bundle proposal")
+	ErrPoolNotAuthorized       = errors.Register(ModuleName, 1210, "pool %v is not authorized")
+	ErrAuthorizationExpired    = errors.Register(ModuleName, 1211, "authorization expired at %v")
 )
@@ -793,6 +793,81 @@ func (m *EventPointsReset) GetStaker() string {
 	return ""
 }
 
+// EventPointsDecayed is an event emitted when points of a staker
+// expired because they left the point decay window.
+// emitted_by: MsgSubmitBundleProposal, MsgVoteBundleProposal, MsgSkipUploaderRole
+type EventPointsDecayed struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the address of the staker whose points decayed
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// previous_points is the amount of points the staker had before
+	PreviousPoints uint64 `protobuf:"varint,3,opt,name=previous_points,json=previousPoints,proto3" json:"previous_points,omitempty"`
+	// current_points is the amount of points the staker has now
+	CurrentPoints uint64 `protobuf:"varint,4,opt,name=current_points,json=currentPoints,proto3" json:"current_points,omitempty"`
+}
+
+func (m *EventPointsDecayed) Reset()         { *m = EventPointsDecayed{} }
+func (m *EventPointsDecayed) String() string { return proto.CompactTextString(m) }
+func (*EventPointsDecayed) ProtoMessage()    {}
+func (*EventPointsDecayed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02f505e55d81e92, []int{8}
+}
+func (m *EventPointsDecayed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPointsDecayed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPointsDecayed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPointsDecayed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPointsDecayed.Merge(m, src)
+}
+func (m *EventPointsDecayed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPointsDecayed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPointsDecayed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPointsDecayed proto.InternalMessageInfo
+
+func (m *EventPointsDecayed) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventPointsDecayed) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventPointsDecayed) GetPreviousPoints() uint64 {
+	if m != nil {
+		return m.PreviousPoints
+	}
+	return 0
+}
+
+func (m *EventPointsDecayed) GetCurrentPoints() uint64 {
+	if m != nil {
+		return m.CurrentPoints
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateParams)(nil), "kyve.bundles.v1beta1.EventUpdateParams")
 	proto.RegisterType((*EventBundleVote)(nil), "kyve.bundles.v1beta1.EventBundleVote")
@@ -802,71 +877,74 @@ func init() {
 	proto.RegisterType((*EventSkippedUploaderRole)(nil), "kyve.bundles.v1beta1.EventSkippedUploaderRole")
 	proto.RegisterType((*EventPointIncreased)(nil), "kyve.bundles.v1beta1.EventPointIncreased")
 	proto.RegisterType((*EventPointsReset)(nil), "kyve.bundles.v1beta1.EventPointsReset")
+	proto.RegisterType((*EventPointsDecayed)(nil), "kyve.bundles.v1beta1.EventPointsDecayed")
 }
 
 func init() { proto.RegisterFile("kyve/bundles/v1beta1/events.proto", fileDescriptor_a02f505e55d81e92) }
 
 var fileDescriptor_a02f505e55d81e92 = []byte{
-	// 942 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xcf, 0xa5, 0x8e, 0x93, 0x5b, 0xff, 0x89, 0x7d, 0x0d, 0x70, 0x0d, 0xad, 0x9b, 0x18, 0x21,
-	0x82, 0x8a, 0x6c, 0x35, 0xbc, 0x21, 0x24, 0x94, 0x84, 0x56, 0x58, 0x95, 0x90, 0x75, 0x69, 0x2b,
-	0xc1, 0xcb, 0x69, 0xed, 0x5d, 0xdb, 0x2b, 0xdf, 0xdd, 0x9e, 0x76, 0xf7, 0xec, 0x38, 0x9f, 0x02,
-	0x09, 0xf1, 0x45, 0xe0, 0x2b, 0xf0, 0xd0, 0xc7, 0x3e, 0xf2, 0x84, 0x50, 0xf2, 0x45, 0xd0, 0xce,
-	0xee, 0x5d, 0x1c, 0xe3, 0x42, 0xc3, 0xdb, 0xcd, 0x6f, 0x7e, 0x33, 0xf3, 0xdb, 0x99, 0xd9, 0x3d,
-	0x74, 0x38, 0x5d, 0xcc, 0x68, 0x77, 0x90, 0x25, 0x24, 0xa2, 0xb2, 0x3b, 0x7b, 0x3a, 0xa0, 0x0a,
-	0x3f, 0xed, 0xd2, 0x19, 0x4d, 0x94, 0xec, 0xa4, 0x82, 0x2b, 0xee, 0xed, 0x69, 0x4a, 0xc7, 0x52,
-	0x3a, 0x96, 0xb2, 0xbf, 0x37, 0xe6, 0x63, 0x0e, 0x84, 0xae, 0xfe, 0x32, 0xdc, 0xfd, 0xf6, 0xda,
-	0x74, 0x79, 0xac, 0xe1, 0xac, 0x2f, 0x99, 0x62, 0x81, 0xe3, 0x9c, 0xf2, 0x68, 0x2d, 0x45, 0x5d,
-	0x18, 0x77, 0xfb, 0x37, 0x07, 0x35, 0x9f, 0x69, 0x89, 0xaf, 0x52, 0x82, 0x15, 0xed, 0x43, 0xa8,
-	0x77, 0x82, 0x10, 0x8f, 0x48, 0x68, 0x12, 0xf9, 0xce, 0x81, 0x73, 0x54, 0x39, 0x7e, 0xd8, 0x59,
-	0x27, 0xbe, 0x63, 0x22, 0x4e, 0x4b, 0x6f, 0xfe, 0x7c, 0xbc, 0x11, 0xb8, 0x3c, 0x22, 0x37, 0x29,
-	0x12, 0x3a, 0xcf, 0x53, 0x6c, 0xbe, 0x7f, 0x8a, 0x84, 0xce, 0x6d, 0x0a, 0x1f, 0x6d, 0xa7, 0x78,
-	0x11, 0x71, 0x4c, 0xfc, 0x7b, 0x07, 0xce, 0x91, 0x1b, 0xe4, 0x66, 0xfb, 0x17, 0x07, 0xed, 0x82,
-	0xea, 0x53, 0x48, 0xf5, 0x9a, 0x2b, 0xea, 0x7d, 0x84, 0xb6, 0x53, 0xce, 0xa3, 0x90, 0x11, 0x10,
-	0x5c, 0x0a, 0xca, 0xda, 0xec, 0x11, 0xef, 0x43, 0x54, 0x96, 0x0a, 0x4f, 0xa9, 0x00, 0x15, 0x6e,
-	0x60, 0x2d, 0xef, 0x11, 0x42, 0x52, 0x71, 0x81, 0xc7, 0x54, 0xc7, 0x98, 0x0a, 0xae, 0x45, 0x7a,
-	0xc4, 0x3b, 0x46, 0xa5, 0x19, 0x57, 0xd4, 0x2f, 0x1d, 0x38, 0x47, 0xf5, 0xe3, 0xd6, 0x7a, 0xe9,
-	0xba, 0xf2, 0xcb, 0x45, 0x4a, 0x03, 0xe0, 0xb6, 0x7f, 0xbf, 0x87, 0xee, 0x2f, 0xe9, 0xea, 0x0b,
-	0x9e, 0x72, 0x49, 0xc9, 0xbb, 0xb5, 0xd5, 0xd1, 0x26, 0x23, 0xa0, 0xab, 0x14, 0x6c, 0x32, 0xf2,
-	0x5f, 0x9a, 0xf6, 0xd1, 0x4e, 0x96, 0xea, 0x0e, 0x50, 0x01, 0xba, 0xdc, 0xa0, 0xb0, 0xbd, 0x8f,
-	0x91, 0x4b, 0xb0, 0xc2, 0xa1, 0x64, 0x97, 0xd4, 0xdf, 0x82, 0x8c, 0x3b, 0x1a, 0x38, 0x67, 0x97,
-	0x54, 0xe7, 0x1d, 0x09, 0x1e, 0x87, 0x2c, 0x21, 0xf4, 0xc2, 0x2f, 0x83, 0xd7, 0xd5, 0x48, 0x4f,
-	0x03, 0xde, 0x63, 0x54, 0x31, 0x27, 0x33, 0xd1, 0xdb, 0xe0, 0x47, 0x06, 0x82, 0xf8, 0x07, 0x68,
-	0x07, 0xe2, 0xa7, 0x74, 0xe1, 0xef, 0x98, 0x59, 0x68, 0xfb, 0x05, 0x5d, 0x78, 0x1f, 0xa0, 0xb2,
-	0xe2, 0xe0, 0x70, 0xc1, 0xb1, 0xa5, 0xb8, 0x86, 0x3f, 0x45, 0xf5, 0x3c, 0x65, 0x16, 0xc7, 0x58,
-	0x2c, 0x7c, 0x04, 0xee, 0x9a, 0xcd, 0x6a, 0xc0, 0x42, 0xf5, 0x04, 0xcb, 0x89, 0x5f, 0x31, 0x47,
-	0xd2, 0xc0, 0x77, 0x58, 0x4e, 0xb4, 0xac, 0xd4, 0xb6, 0x30, 0xc4, 0xca, 0xaf, 0x1a, 0x59, 0x39,
-	0x74, 0xa2, 0xbc, 0x0e, 0xba, 0x9f, 0xb7, 0x2b, 0x15, 0x7c, 0xc6, 0x08, 0x15, 0xba, 0x6f, 0xb5,
-	0x03, 0xe7, 0xa8, 0x16, 0x34, 0xad, 0xab, 0x6f, 0x3d, 0x3d, 0xa2, 0x45, 0x0d, 0x79, 0x9c, 0x0a,
-	0x2a, 0x25, 0xe3, 0x89, 0xa6, 0xd6, 0x81, 0x5a, 0x5b, 0x42, 0x7b, 0xa4, 0xfd, 0xeb, 0x16, 0xda,
-	0x5b, 0x1a, 0xe3, 0x73, 0x96, 0xe0, 0x88, 0x5d, 0xde, 0x65, 0x8e, 0x7b, 0x68, 0x6b, 0x86, 0x23,
-	0x3b, 0xc2, 0x52, 0x60, 0x0c, 0xbd, 0xd0, 0x2c, 0x31, 0x78, 0x09, 0xf0, 0xdc, 0xd4, 0x1e, 0x3c,
-	0x90, 0x0a, 0xb3, 0xc4, 0x8e, 0x2e, 0x37, 0x75, 0x26, 0xc5, 0x15, 0x8e, 0xec, 0xd0, 0x8c, 0xe1,
-	0x7d, 0x05, 0x3b, 0xad, 0x32, 0x09, 0xb3, 0xaa, 0x1f, 0xb7, 0xd7, 0xaf, 0xa7, 0xd1, 0x7f, 0x0e,
-	0xcc, 0xc0, 0x46, 0xe8, 0x26, 0x8c, 0xb2, 0x84, 0x50, 0x21, 0xc3, 0x14, 0x2f, 0x78, 0xa6, 0xec,
-	0x44, 0x6b, 0x16, 0xed, 0x03, 0xe8, 0x7d, 0x8e, 0x1a, 0x2c, 0x19, 0x45, 0x58, 0xe9, 0x4e, 0x59,
-	0xa2, 0x0b, 0x1a, 0x76, 0x0b, 0xdc, 0x52, 0x3f, 0x43, 0xbb, 0x82, 0xce, 0xb1, 0x20, 0xa1, 0x12,
-	0x14, 0xcb, 0xac, 0x18, 0x76, 0xdd, 0xc0, 0x2f, 0x2d, 0xba, 0x44, 0x2c, 0xd6, 0xb8, 0xb2, 0x4c,
-	0x7c, 0x95, 0x2f, 0xf3, 0x13, 0xd4, 0xb4, 0x44, 0x42, 0x23, 0x3a, 0x86, 0x62, 0x30, 0x7f, 0x37,
-	0x68, 0x18, 0xc7, 0xb7, 0x05, 0xee, 0x1d, 0xa2, 0x6a, 0x5e, 0x1e, 0x3a, 0x55, 0x03, 0x5e, 0xc5,
-	0xd6, 0x86, 0x7e, 0x1d, 0xa2, 0xea, 0x28, 0x9f, 0xa2, 0x5e, 0xa5, 0x3a, 0x1c, 0xa4, 0x52, 0x60,
-	0x27, 0xea, 0xd6, 0xdd, 0xda, 0x5d, 0xb9, 0x5b, 0x9f, 0xa0, 0x5a, 0x42, 0x2f, 0xd4, 0x8d, 0xea,
-	0x06, 0x10, 0xaa, 0x1a, 0x2c, 0x34, 0x7f, 0x83, 0x1e, 0xae, 0x1c, 0x2e, 0xcc, 0x97, 0x73, 0xc8,
-	0xa5, 0xf2, 0x9b, 0x10, 0xf3, 0xe0, 0xf6, 0x49, 0xcf, 0x0d, 0xe3, 0x8c, 0x4b, 0xe5, 0x7d, 0x8d,
-	0xf6, 0x57, 0x13, 0x0c, 0x79, 0x1c, 0x33, 0x58, 0x4b, 0xdf, 0x83, 0x70, 0xff, 0x76, 0xf8, 0x59,
-	0xe1, 0x6f, 0x8f, 0x90, 0x0f, 0x3b, 0x7b, 0x16, 0x61, 0x16, 0xd3, 0x82, 0x11, 0xf0, 0x88, 0xbe,
-	0xff, 0xde, 0x1e, 0xa2, 0xaa, 0x7e, 0xb5, 0x8b, 0x73, 0x9a, 0x17, 0xa8, 0x92, 0xd0, 0x79, 0x9e,
-	0xaf, 0xfd, 0xb3, 0x63, 0x0b, 0x9d, 0x4f, 0x59, 0x9a, 0xfe, 0xdf, 0x42, 0x4f, 0x50, 0x33, 0x15,
-	0x74, 0xc6, 0x78, 0x26, 0x57, 0xab, 0x35, 0x72, 0x47, 0xd1, 0xd9, 0x55, 0x55, 0xa5, 0x7f, 0xaa,
-	0x8a, 0xed, 0xc3, 0xdb, 0xe7, 0x2c, 0x51, 0xbd, 0x64, 0xa8, 0x37, 0xee, 0xdf, 0x2e, 0xec, 0xbb,
-	0x7e, 0x0a, 0xfa, 0x85, 0xc8, 0x84, 0xa0, 0x89, 0x0a, 0x53, 0x9d, 0x4a, 0xda, 0x1b, 0x5c, 0xb3,
-	0x28, 0xe4, 0x97, 0xed, 0x33, 0xd4, 0xb8, 0x29, 0x27, 0x03, 0x2a, 0xa9, 0xba, 0x73, 0xad, 0xd3,
-	0xe7, 0x6f, 0xae, 0x5a, 0xce, 0xdb, 0xab, 0x96, 0xf3, 0xd7, 0x55, 0xcb, 0xf9, 0xe9, 0xba, 0xb5,
-	0xf1, 0xf6, 0xba, 0xb5, 0xf1, 0xc7, 0x75, 0x6b, 0xe3, 0xc7, 0x2f, 0xc6, 0x4c, 0x4d, 0xb2, 0x41,
-	0x67, 0xc8, 0xe3, 0xee, 0x8b, 0x1f, 0x5e, 0x3f, 0xfb, 0x9e, 0xaa, 0x39, 0x17, 0xd3, 0xee, 0x70,
-	0x82, 0x59, 0xd2, 0xbd, 0x28, 0x7e, 0xe7, 0x6a, 0x91, 0x52, 0x39, 0x28, 0xc3, 0xaf, 0xfc, 0xcb,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xd8, 0x77, 0xc7, 0x81, 0x08, 0x00, 0x00,
+	// 966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x35, 0x1d, 0xf9, 0x87, 0xa3, 0x7f, 0xc6, 0xdf, 0x57, 0xc6, 0x4d, 0x14, 0x5b, 0x45, 0x11,
+	0x17, 0x29, 0x24, 0xc4, 0xdd, 0x15, 0x05, 0x0a, 0xdb, 0x49, 0x50, 0x21, 0x40, 0x21, 0xd0, 0x49,
+	0x80, 0x76, 0x43, 0x8c, 0x34, 0x57, 0xd2, 0x40, 0x24, 0x87, 0x98, 0x19, 0x4a, 0x96, 0x9f, 0xa2,
+	0x40, 0xd1, 0x17, 0x69, 0x5f, 0xa1, 0x8b, 0x2c, 0xb3, 0xec, 0xaa, 0x28, 0xec, 0x17, 0x29, 0xe6,
+	0x87, 0xb4, 0xac, 0xaa, 0x68, 0x9c, 0x9d, 0xe6, 0xdc, 0x73, 0xcf, 0x3d, 0x73, 0xef, 0x25, 0x45,
+	0x74, 0x38, 0x5d, 0xcc, 0xa0, 0x3b, 0xc8, 0x12, 0x12, 0x81, 0xe8, 0xce, 0x9e, 0x0d, 0x40, 0xe2,
+	0x67, 0x5d, 0x98, 0x41, 0x22, 0x45, 0x27, 0xe5, 0x4c, 0x32, 0x6f, 0x4f, 0x51, 0x3a, 0x96, 0xd2,
+	0xb1, 0x94, 0xfd, 0xbd, 0x31, 0x1b, 0x33, 0x4d, 0xe8, 0xaa, 0x5f, 0x86, 0xbb, 0xdf, 0x5e, 0x2b,
+	0x97, 0xe7, 0x1a, 0xce, 0xfa, 0x92, 0x29, 0xe6, 0x38, 0xce, 0x29, 0x8f, 0xd6, 0x52, 0xe4, 0x85,
+	0x09, 0xb7, 0x7f, 0x73, 0x50, 0xf3, 0x85, 0xb2, 0xf8, 0x26, 0x25, 0x58, 0x42, 0x5f, 0xa7, 0x7a,
+	0x27, 0x08, 0xb1, 0x88, 0x84, 0x46, 0xc8, 0x77, 0x0e, 0x9c, 0xa3, 0xf2, 0xf1, 0xc3, 0xce, 0x3a,
+	0xf3, 0x1d, 0x93, 0x71, 0x5a, 0x7a, 0xf7, 0xe7, 0xe3, 0x8d, 0xc0, 0x65, 0x11, 0xb9, 0x91, 0x48,
+	0x60, 0x9e, 0x4b, 0x6c, 0x7e, 0xb8, 0x44, 0x02, 0x73, 0x2b, 0xe1, 0xa3, 0x9d, 0x14, 0x2f, 0x22,
+	0x86, 0x89, 0x7f, 0xef, 0xc0, 0x39, 0x72, 0x83, 0xfc, 0xd8, 0xfe, 0xc5, 0x41, 0x75, 0xed, 0xfa,
+	0x54, 0x4b, 0xbd, 0x65, 0x12, 0xbc, 0x4f, 0xd0, 0x4e, 0xca, 0x58, 0x14, 0x52, 0xa2, 0x0d, 0x97,
+	0x82, 0x6d, 0x75, 0xec, 0x11, 0xef, 0xff, 0x68, 0x5b, 0x48, 0x3c, 0x05, 0xae, 0x5d, 0xb8, 0x81,
+	0x3d, 0x79, 0x8f, 0x10, 0x12, 0x92, 0x71, 0x3c, 0x86, 0x90, 0xe6, 0x15, 0x5c, 0x8b, 0xf4, 0x88,
+	0x77, 0x8c, 0x4a, 0x33, 0x26, 0xc1, 0x2f, 0x1d, 0x38, 0x47, 0xb5, 0xe3, 0xd6, 0x7a, 0xeb, 0xaa,
+	0xf2, 0xeb, 0x45, 0x0a, 0x81, 0xe6, 0xb6, 0x7f, 0xbf, 0x87, 0xee, 0x2f, 0xf9, 0xea, 0x73, 0x96,
+	0x32, 0x01, 0xe4, 0xdf, 0xbd, 0xd5, 0xd0, 0x26, 0x25, 0xda, 0x57, 0x29, 0xd8, 0xa4, 0xe4, 0xbf,
+	0x3c, 0xed, 0xa3, 0xdd, 0x2c, 0x55, 0x1d, 0x00, 0xae, 0x7d, 0xb9, 0x41, 0x71, 0xf6, 0x3e, 0x45,
+	0x2e, 0xc1, 0x12, 0x87, 0x82, 0x5e, 0x82, 0xbf, 0xa5, 0x15, 0x77, 0x15, 0x70, 0x4e, 0x2f, 0x41,
+	0xe9, 0x8e, 0x38, 0x8b, 0x43, 0x9a, 0x10, 0xb8, 0xf0, 0xb7, 0x75, 0xd4, 0x55, 0x48, 0x4f, 0x01,
+	0xde, 0x63, 0x54, 0x36, 0x37, 0x33, 0xd9, 0x3b, 0x3a, 0x8e, 0x0c, 0xa4, 0xf3, 0x1f, 0xa0, 0x5d,
+	0x9d, 0x3f, 0x85, 0x85, 0xbf, 0x6b, 0x66, 0xa1, 0xce, 0xaf, 0x60, 0xe1, 0xfd, 0x0f, 0x6d, 0x4b,
+	0xa6, 0x03, 0xae, 0x0e, 0x6c, 0x49, 0xa6, 0xe0, 0xcf, 0x51, 0x2d, 0x97, 0xcc, 0xe2, 0x18, 0xf3,
+	0x85, 0x8f, 0x74, 0xb8, 0x6a, 0x55, 0x0d, 0x58, 0xb8, 0x9e, 0x60, 0x31, 0xf1, 0xcb, 0xe6, 0x4a,
+	0x0a, 0xf8, 0x0e, 0x8b, 0x89, 0xb2, 0x95, 0xda, 0x16, 0x86, 0x58, 0xfa, 0x15, 0x63, 0x2b, 0x87,
+	0x4e, 0xa4, 0xd7, 0x41, 0xf7, 0xf3, 0x76, 0xa5, 0x9c, 0xcd, 0x28, 0x01, 0xae, 0xfa, 0x56, 0x3d,
+	0x70, 0x8e, 0xaa, 0x41, 0xd3, 0x86, 0xfa, 0x36, 0xd2, 0x23, 0xca, 0xd4, 0x90, 0xc5, 0x29, 0x07,
+	0x21, 0x28, 0x4b, 0x14, 0xb5, 0xa6, 0xa9, 0xd5, 0x25, 0xb4, 0x47, 0xda, 0xbf, 0x6e, 0xa1, 0xbd,
+	0xa5, 0x31, 0xbe, 0xa4, 0x09, 0x8e, 0xe8, 0xe5, 0x5d, 0xe6, 0xb8, 0x87, 0xb6, 0x66, 0x38, 0xb2,
+	0x23, 0x2c, 0x05, 0xe6, 0xa0, 0x16, 0x9a, 0x26, 0x06, 0x2f, 0x69, 0x3c, 0x3f, 0xaa, 0x08, 0x1e,
+	0x08, 0x89, 0x69, 0x62, 0x47, 0x97, 0x1f, 0x95, 0x92, 0x64, 0x12, 0x47, 0x76, 0x68, 0xe6, 0xe0,
+	0x7d, 0xad, 0x77, 0x5a, 0x66, 0x42, 0xcf, 0xaa, 0x76, 0xdc, 0x5e, 0xbf, 0x9e, 0xc6, 0xff, 0xb9,
+	0x66, 0x06, 0x36, 0x43, 0x35, 0x61, 0x94, 0x25, 0x04, 0xb8, 0x08, 0x53, 0xbc, 0x60, 0x99, 0xb4,
+	0x13, 0xad, 0x5a, 0xb4, 0xaf, 0x41, 0xef, 0x0b, 0xd4, 0xa0, 0xc9, 0x28, 0xc2, 0x52, 0x75, 0xca,
+	0x12, 0x5d, 0xed, 0xa1, 0x5e, 0xe0, 0x96, 0xfa, 0x04, 0xd5, 0x39, 0xcc, 0x31, 0x27, 0xa1, 0xe4,
+	0x80, 0x45, 0x56, 0x0c, 0xbb, 0x66, 0xe0, 0xd7, 0x16, 0x5d, 0x22, 0x16, 0x6b, 0x5c, 0x5e, 0x26,
+	0xbe, 0xb1, 0xa8, 0xf7, 0x14, 0x35, 0x2d, 0x91, 0x40, 0x04, 0x63, 0x5d, 0x4c, 0xcf, 0xdf, 0x0d,
+	0x1a, 0x26, 0xf0, 0xbc, 0xc0, 0xbd, 0x43, 0x54, 0xc9, 0xcb, 0xeb, 0x4e, 0x55, 0x35, 0xaf, 0x6c,
+	0x6b, 0xeb, 0x7e, 0x1d, 0xa2, 0xca, 0x28, 0x9f, 0xa2, 0x5a, 0xa5, 0x9a, 0xbe, 0x48, 0xb9, 0xc0,
+	0x4e, 0xe4, 0xad, 0x67, 0xab, 0xbe, 0xf2, 0x6c, 0x7d, 0x86, 0xaa, 0x09, 0x5c, 0xc8, 0x1b, 0xd7,
+	0x0d, 0x4d, 0xa8, 0x28, 0xb0, 0xf0, 0xfc, 0x2d, 0x7a, 0xb8, 0x72, 0xb9, 0x30, 0x5f, 0xce, 0x21,
+	0x13, 0xd2, 0x6f, 0xea, 0x9c, 0x07, 0xb7, 0x6f, 0x7a, 0x6e, 0x18, 0x67, 0x4c, 0x48, 0xef, 0x1b,
+	0xb4, 0xbf, 0x2a, 0x30, 0x64, 0x71, 0x4c, 0xf5, 0x5a, 0xfa, 0x9e, 0x4e, 0xf7, 0x6f, 0xa7, 0x9f,
+	0x15, 0xf1, 0xf6, 0x08, 0xf9, 0x7a, 0x67, 0xcf, 0x22, 0x4c, 0x63, 0x28, 0x18, 0x01, 0x8b, 0xe0,
+	0xc3, 0xf7, 0xf6, 0x10, 0x55, 0xd4, 0x5b, 0xbb, 0xb8, 0xa7, 0x79, 0x03, 0x95, 0x13, 0x98, 0xe7,
+	0x7a, 0xed, 0x9f, 0x1d, 0x5b, 0xe8, 0x7c, 0x4a, 0xd3, 0xf4, 0x63, 0x0b, 0x3d, 0x45, 0xcd, 0x94,
+	0xc3, 0x8c, 0xb2, 0x4c, 0xac, 0x56, 0x6b, 0xe4, 0x81, 0xa2, 0xb3, 0xab, 0xae, 0x4a, 0xff, 0x74,
+	0x15, 0xdb, 0x17, 0x6f, 0x9f, 0xd1, 0x44, 0xf6, 0x92, 0xa1, 0xda, 0x38, 0x20, 0x77, 0xff, 0x53,
+	0x50, 0x6f, 0x88, 0x8c, 0x73, 0x48, 0x64, 0x98, 0x2a, 0x29, 0x61, 0x9f, 0xe0, 0xaa, 0x45, 0xb5,
+	0xbe, 0x68, 0x9f, 0xa1, 0xc6, 0x4d, 0x39, 0x11, 0x80, 0x00, 0x79, 0xe7, 0x5a, 0xea, 0x5f, 0xcc,
+	0x5b, 0x52, 0x79, 0x0e, 0x43, 0xbc, 0xf8, 0x18, 0xcf, 0x4f, 0x50, 0xbd, 0xe8, 0xe5, 0x2d, 0xd3,
+	0xb5, 0x1c, 0x36, 0x05, 0xd6, 0x5c, 0xae, 0xb4, 0xe6, 0x72, 0xa7, 0x2f, 0xdf, 0x5d, 0xb5, 0x9c,
+	0xf7, 0x57, 0x2d, 0xe7, 0xaf, 0xab, 0x96, 0xf3, 0xd3, 0x75, 0x6b, 0xe3, 0xfd, 0x75, 0x6b, 0xe3,
+	0x8f, 0xeb, 0xd6, 0xc6, 0x8f, 0x5f, 0x8e, 0xa9, 0x9c, 0x64, 0x83, 0xce, 0x90, 0xc5, 0xdd, 0x57,
+	0x3f, 0xbc, 0x7d, 0xf1, 0x3d, 0xc8, 0x39, 0xe3, 0xd3, 0xee, 0x70, 0x82, 0x69, 0xd2, 0xbd, 0x28,
+	0x3e, 0x33, 0xe4, 0x22, 0x05, 0x31, 0xd8, 0xd6, 0x9f, 0x18, 0x5f, 0xfd, 0x3d, 0x00, 0x24, 0xfc,
+	0x35, 0x62, 0x19, 0x09, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -1370,6 +1448,51 @@ func (m *EventPointsReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPointsDecayed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPointsDecayed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPointsDecayed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentPoints != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentPoints))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousPoints != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1627,6 +1750,28 @@ func (m *EventPointsReset) Size() (n int) {
 	return n
 }
 
+func (m *EventPointsDecayed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PreviousPoints != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousPoints))
+	}
+	if m.CurrentPoints != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentPoints))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3329,6 +3474,145 @@ func (m *EventPointsReset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPointsDecayed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPointsDecayed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPointsDecayed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPoints", wireType)
+			}
+			m.PreviousPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPoints", wireType)
+			}
+			m.CurrentPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
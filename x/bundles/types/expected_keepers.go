@@ -24,13 +24,19 @@ type PoolKeeper interface {
 	ChargeInflationPool(ctx sdk.Context, poolId uint64) (payout uint64, err error)
 	GetProtocolInflationShare(ctx sdk.Context) (res math.LegacyDec)
 	GetMaxVotingPowerPerPool(ctx sdk.Context) (res math.LegacyDec)
+	RecordFunderPayouts(ctx sdk.Context, poolId uint64, value uint64)
+	GetInflationAllocations(ctx sdk.Context, pools []pooltypes.Pool) (allocations []pooltypes.PoolInflationAllocation)
+	RecordInflationReceived(ctx sdk.Context, poolId uint64, amount uint64, remainder uint64)
 }
 
 type StakerKeeper interface {
 	GetAllStakerAddressesOfPool(ctx sdk.Context, poolId uint64) (stakers []string)
 	GetCommission(ctx sdk.Context, stakerAddress string) math.LegacyDec
 	IncreaseStakerCommissionRewards(ctx sdk.Context, address string, payerModuleName string, amount sdk.Coins) error
+	CompoundCommissionRewards(ctx sdk.Context, address string) error
 	AssertValaccountAuthorized(ctx sdk.Context, poolId uint64, stakerAddress string, valaddress string) error
+	IsSecondaryValaddress(ctx sdk.Context, poolId uint64, stakerAddress string, valaddress string) bool
+	IsInMaintenance(ctx sdk.Context, poolId uint64, stakerAddress string) bool
 
 	DoesStakerExist(ctx sdk.Context, staker string) bool
 	DoesValaccountExist(ctx sdk.Context, poolId uint64, stakerAddress string) bool
@@ -39,6 +45,8 @@ type StakerKeeper interface {
 
 	IncrementPoints(ctx sdk.Context, poolId uint64, stakerAddress string) (newPoints uint64)
 	ResetPoints(ctx sdk.Context, poolId uint64, stakerAddress string) (previousPoints uint64)
+	IncrementDecayingPoints(ctx sdk.Context, poolId uint64, stakerAddress string, windowStart uint64) (newPoints uint64)
+	DecayPoints(ctx sdk.Context, poolId uint64, stakerAddress string, windowStart uint64) (previousPoints uint64, currentPoints uint64)
 }
 
 type DelegationKeeper interface {
@@ -18,18 +18,23 @@ var DefaultNetworkFee = math.LegacyMustNewDecFromStr("0.01")
 // DefaultMaxPoints ...
 var DefaultMaxPoints = uint64(24)
 
+// DefaultPointDecayWindow ...
+var DefaultPointDecayWindow = uint64(0)
+
 // NewParams creates a new Params instance
 func NewParams(
 	uploadTimeout uint64,
 	storageCosts []StorageCost,
 	networkFee math.LegacyDec,
 	maxPoints uint64,
+	pointDecayWindow uint64,
 ) Params {
 	return Params{
-		UploadTimeout: uploadTimeout,
-		StorageCosts:  storageCosts,
-		NetworkFee:    networkFee,
-		MaxPoints:     maxPoints,
+		UploadTimeout:    uploadTimeout,
+		StorageCosts:     storageCosts,
+		NetworkFee:       networkFee,
+		MaxPoints:        maxPoints,
+		PointDecayWindow: pointDecayWindow,
 	}
 }
 
@@ -40,6 +45,7 @@ func DefaultParams() Params {
 		DefaultStorageCosts,
 		DefaultNetworkFee,
 		DefaultMaxPoints,
+		DefaultPointDecayWindow,
 	)
 }
 
@@ -63,5 +69,9 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidateNumber(p.PointDecayWindow); err != nil {
+		return err
+	}
+
 	return nil
 }
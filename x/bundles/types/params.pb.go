@@ -82,6 +82,10 @@ type Params struct {
 	NetworkFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=network_fee,json=networkFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_fee"`
 	// max_points ...
 	MaxPoints uint64 `protobuf:"varint,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// point_decay_window is the time in seconds after which a point expires.
+	// Max points are then evaluated against the points received within
+	// this sliding window. If zero, points are reset on every vote instead.
+	PointDecayWindow uint64 `protobuf:"varint,5,opt,name=point_decay_window,json=pointDecayWindow,proto3" json:"point_decay_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -138,6 +142,13 @@ func (m *Params) GetMaxPoints() uint64 {
 	return 0
 }
 
+func (m *Params) GetPointDecayWindow() uint64 {
+	if m != nil {
+		return m.PointDecayWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StorageCost)(nil), "kyve.bundles.v1beta1.StorageCost")
 	proto.RegisterType((*Params)(nil), "kyve.bundles.v1beta1.Params")
@@ -146,30 +157,32 @@ func init() {
 func init() { proto.RegisterFile("kyve/bundles/v1beta1/params.proto", fileDescriptor_cfd3a74b72a01aaa) }
 
 var fileDescriptor_cfd3a74b72a01aaa = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcd, 0x4a, 0xeb, 0x40,
-	0x14, 0xc7, 0x93, 0x36, 0x14, 0x3a, 0xbd, 0xbd, 0x70, 0x73, 0xbb, 0x08, 0x8a, 0xe9, 0x87, 0x08,
-	0x5d, 0xc8, 0x0c, 0xd5, 0x85, 0xfb, 0x5a, 0x0b, 0x62, 0x91, 0x12, 0x45, 0xd0, 0x4d, 0x98, 0x24,
-	0x63, 0x1a, 0xda, 0xe4, 0x84, 0xcc, 0x24, 0xb6, 0x6f, 0xe1, 0x63, 0x75, 0xd9, 0xa5, 0xb8, 0x28,
-	0x92, 0xbe, 0x88, 0xe4, 0xc3, 0x8f, 0x85, 0x0b, 0x77, 0xc9, 0xff, 0xfc, 0xce, 0x99, 0xdf, 0xe1,
-	0xa0, 0xee, 0x7c, 0x95, 0x30, 0x62, 0xc5, 0x81, 0xb3, 0x60, 0x9c, 0x24, 0x03, 0x8b, 0x09, 0x3a,
-	0x20, 0x21, 0x8d, 0xa8, 0xcf, 0x71, 0x18, 0x81, 0x00, 0xb5, 0x95, 0x21, 0xb8, 0x44, 0x70, 0x89,
-	0xec, 0xb5, 0x5c, 0x70, 0x21, 0x07, 0x48, 0xf6, 0x55, 0xb0, 0xbd, 0x04, 0x35, 0x6e, 0x04, 0x44,
-	0xd4, 0x65, 0xe7, 0xc0, 0x85, 0x8a, 0xd1, 0x7f, 0x5e, 0xfc, 0x9a, 0x61, 0x04, 0x89, 0xe7, 0xb0,
-	0xc8, 0xf4, 0x1c, 0x4d, 0xee, 0xc8, 0xfd, 0xa6, 0xf1, 0xaf, 0x2c, 0x4d, 0xcb, 0xca, 0xa5, 0xa3,
-	0x9e, 0x21, 0xc5, 0x06, 0x2e, 0xb4, 0x4a, 0x47, 0xee, 0xd7, 0x87, 0x87, 0xeb, 0x6d, 0x5b, 0x7a,
-	0xdd, 0xb6, 0xf7, 0x6d, 0xe0, 0x3e, 0x70, 0xee, 0xcc, 0xb1, 0x07, 0xc4, 0xa7, 0x62, 0x86, 0x27,
-	0xcc, 0xa5, 0xf6, 0x6a, 0xc4, 0x6c, 0x23, 0x6f, 0xe8, 0xa5, 0x32, 0xaa, 0x4d, 0x73, 0x69, 0xf5,
-	0x08, 0xfd, 0x8d, 0xc3, 0x05, 0x50, 0xc7, 0x14, 0x9e, 0xcf, 0x20, 0x16, 0xf9, 0x73, 0x8a, 0xd1,
-	0x2c, 0xd2, 0xdb, 0x22, 0x54, 0x27, 0xa8, 0xf9, 0xa1, 0x96, 0x4d, 0xe0, 0x5a, 0xa5, 0x53, 0xed,
-	0x37, 0x4e, 0xba, 0xf8, 0xa7, 0x6d, 0xf1, 0xb7, 0xa5, 0x86, 0x4a, 0xa6, 0x65, 0xfc, 0xe1, 0x5f,
-	0x11, 0x57, 0x47, 0xa8, 0x11, 0x30, 0xf1, 0x04, 0xd1, 0xdc, 0x7c, 0x64, 0x4c, 0xab, 0xfe, 0xde,
-	0x1f, 0x95, 0x7d, 0x63, 0xc6, 0xd4, 0x03, 0x84, 0x7c, 0xba, 0x34, 0x43, 0xf0, 0x02, 0xc1, 0x35,
-	0x25, 0xd7, 0xae, 0xfb, 0x74, 0x39, 0xcd, 0x83, 0xe1, 0x78, 0x9d, 0xea, 0xf2, 0x26, 0xd5, 0xe5,
-	0xb7, 0x54, 0x97, 0x9f, 0x77, 0xba, 0xb4, 0xd9, 0xe9, 0xd2, 0xcb, 0x4e, 0x97, 0x1e, 0x8e, 0x5d,
-	0x4f, 0xcc, 0x62, 0x0b, 0xdb, 0xe0, 0x93, 0xab, 0xfb, 0xbb, 0x8b, 0xeb, 0x62, 0x26, 0xb1, 0x67,
-	0xd4, 0x0b, 0xc8, 0xf2, 0xf3, 0xbe, 0x62, 0x15, 0x32, 0x6e, 0xd5, 0xf2, 0x5b, 0x9d, 0xbe, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0xc3, 0xde, 0x6b, 0xd2, 0xfc, 0x01, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x93, 0x6e, 0x5c, 0xd8, 0xa9, 0x15, 0x1d, 0xf7, 0x10, 0x14, 0xb3, 0xdd, 0x8a, 0xd0,
+	0x43, 0x99, 0xa1, 0x7a, 0xf0, 0x5e, 0x6b, 0x41, 0x2c, 0x52, 0xa2, 0x28, 0x7a, 0x09, 0x93, 0xcc,
+	0x98, 0x0e, 0x6d, 0xf2, 0x86, 0xcc, 0x24, 0x6d, 0xbe, 0x45, 0x3f, 0x56, 0x8f, 0x3d, 0x8a, 0x87,
+	0x22, 0xed, 0x17, 0x91, 0x4c, 0xe2, 0x9f, 0x83, 0x07, 0x6f, 0xc9, 0xf3, 0xfc, 0xde, 0xe4, 0xf7,
+	0x32, 0x83, 0x6e, 0x57, 0x55, 0x29, 0x68, 0x58, 0xa4, 0x7c, 0x2d, 0x14, 0x2d, 0xc7, 0xa1, 0xd0,
+	0x6c, 0x4c, 0x33, 0x96, 0xb3, 0x44, 0x91, 0x2c, 0x07, 0x0d, 0xf8, 0xba, 0x46, 0x48, 0x8b, 0x90,
+	0x16, 0x79, 0x74, 0x1d, 0x43, 0x0c, 0x06, 0xa0, 0xf5, 0x53, 0xc3, 0x0e, 0x4a, 0xd4, 0x7d, 0xaf,
+	0x21, 0x67, 0xb1, 0x78, 0x05, 0x4a, 0x63, 0x82, 0x1e, 0xaa, 0xe6, 0x35, 0xc8, 0x72, 0x28, 0x25,
+	0x17, 0x79, 0x20, 0xb9, 0x6b, 0xf7, 0xed, 0x61, 0xcf, 0x7f, 0xd0, 0x56, 0x8b, 0xb6, 0x79, 0xc3,
+	0xf1, 0x4b, 0xe4, 0x44, 0xa0, 0xb4, 0xdb, 0xe9, 0xdb, 0xc3, 0xab, 0xc9, 0xd3, 0xfd, 0xf1, 0xc6,
+	0xfa, 0x7e, 0xbc, 0x79, 0x1c, 0x81, 0x4a, 0x40, 0x29, 0xbe, 0x22, 0x12, 0x68, 0xc2, 0xf4, 0x92,
+	0xcc, 0x45, 0xcc, 0xa2, 0x6a, 0x2a, 0x22, 0xdf, 0x0c, 0x0c, 0x76, 0x1d, 0x74, 0xb9, 0x30, 0xd2,
+	0xf8, 0x19, 0xba, 0x57, 0x64, 0x6b, 0x60, 0x3c, 0xd0, 0x32, 0x11, 0x50, 0x68, 0xf3, 0x3b, 0xc7,
+	0xef, 0x35, 0xe9, 0x87, 0x26, 0xc4, 0x73, 0xd4, 0xfb, 0xa5, 0x56, 0x7f, 0x41, 0xb9, 0x9d, 0xfe,
+	0xc5, 0xb0, 0xfb, 0xfc, 0x96, 0xfc, 0x6b, 0x5b, 0xf2, 0xd7, 0x52, 0x13, 0xa7, 0xd6, 0xf2, 0xef,
+	0xaa, 0x3f, 0x91, 0xc2, 0x53, 0xd4, 0x4d, 0x85, 0xde, 0x40, 0xbe, 0x0a, 0xbe, 0x0a, 0xe1, 0x5e,
+	0xfc, 0xbf, 0x3f, 0x6a, 0xe7, 0x66, 0x42, 0xe0, 0x27, 0x08, 0x25, 0x6c, 0x1b, 0x64, 0x20, 0x53,
+	0xad, 0x5c, 0xc7, 0x68, 0x5f, 0x25, 0x6c, 0xbb, 0x30, 0x01, 0x1e, 0x21, 0x6c, 0xaa, 0x80, 0x8b,
+	0x88, 0x55, 0xc1, 0x46, 0xa6, 0x1c, 0x36, 0xee, 0x1d, 0x83, 0xdd, 0x37, 0xcd, 0xb4, 0x2e, 0x3e,
+	0x99, 0x7c, 0x32, 0xdb, 0x9f, 0x3c, 0xfb, 0x70, 0xf2, 0xec, 0x1f, 0x27, 0xcf, 0xde, 0x9d, 0x3d,
+	0xeb, 0x70, 0xf6, 0xac, 0x6f, 0x67, 0xcf, 0xfa, 0x32, 0x8a, 0xa5, 0x5e, 0x16, 0x21, 0x89, 0x20,
+	0xa1, 0x6f, 0x3f, 0x7f, 0x7c, 0xfd, 0xae, 0x31, 0xa0, 0xd1, 0x92, 0xc9, 0x94, 0x6e, 0x7f, 0xdf,
+	0x06, 0x5d, 0x65, 0x42, 0x85, 0x97, 0xe6, 0x64, 0x5f, 0xfc, 0x1c, 0x00, 0x48, 0xcf, 0x63, 0xe6,
+	0x2a, 0x02, 0x00, 0x00,
 }
 
 func (m *StorageCost) Marshal() (dAtA []byte, err error) {
@@ -230,6 +243,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PointDecayWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PointDecayWindow))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.MaxPoints != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxPoints))
 		i--
@@ -312,6 +330,9 @@ func (m *Params) Size() (n int) {
 	if m.MaxPoints != 0 {
 		n += 1 + sovParams(uint64(m.MaxPoints))
 	}
+	if m.PointDecayWindow != 0 {
+		n += 1 + sovParams(uint64(m.PointDecayWindow))
+	}
 	return n
 }
 
@@ -559,6 +580,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointDecayWindow", wireType)
+			}
+			m.PointDecayWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointDecayWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -20,9 +20,16 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdDelegate())
+	cmd.AddCommand(CmdDelegateMulti())
+	cmd.AddCommand(CmdDelegateToPool())
+	cmd.AddCommand(CmdUndelegateFromPool())
 	cmd.AddCommand(CmdUndelegate())
+	cmd.AddCommand(CmdCancelUnbonding())
 	cmd.AddCommand(CmdRedelegate())
 	cmd.AddCommand(CmdWithdrawRewards())
+	cmd.AddCommand(CmdWithdrawAllRewards())
+	cmd.AddCommand(CmdUpdateAutoRestake())
+	cmd.AddCommand(CmdSetWithdrawAddress())
 
 	return cmd
 }
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdCancelUnbonding() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unbonding [index] [amount]",
+		Short: "Cancel the given amount of an unbonding delegation",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argIndex, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argAmount, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelUnbonding{
+				Creator: clientCtx.GetFromAddress().String(),
+				Index:   argIndex,
+				Amount:  argAmount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdDelegateMulti() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-multi [staker:value,...] [total_amount]",
+		Short: "Delegate to multiple stakers, the values are amounts if the total amount is zero, otherwise weights",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTotalAmount, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			var argEntries []types.DelegateMultiEntry
+			for _, pair := range strings.Split(args[0], ",") {
+				parts := strings.Split(strings.TrimSpace(pair), ":")
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid staker value pair %s", pair)
+				}
+
+				value, err := cast.ToUint64E(parts[1])
+				if err != nil {
+					return err
+				}
+
+				entry := types.DelegateMultiEntry{Staker: parts[0]}
+				if argTotalAmount > 0 {
+					entry.Weight = value
+				} else {
+					entry.Amount = value
+				}
+				argEntries = append(argEntries, entry)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDelegateMulti{
+				Creator: clientCtx.GetFromAddress().String(),
+				Entries: argEntries,
+				Amount:  argTotalAmount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -37,6 +37,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetRedelegationCooldown(ctx, entry)
 	}
 
+	for _, entry := range genState.AutoRestakeList {
+		k.SetAutoRestake(ctx, entry)
+	}
+
+	k.SetAutoRestakeState(ctx, genState.AutoRestakeState)
+
+	for _, entry := range genState.WithdrawAddressList {
+		k.SetWithdrawAddress(ctx, entry)
+	}
+
+	for _, entry := range genState.PoolDelegatorList {
+		k.SetPoolDelegator(ctx, entry)
+	}
+
+	for _, entry := range genState.PoolRebalanceList {
+		k.SetPoolRebalance(ctx, entry)
+	}
+
 	k.InitMemStore(ctx)
 }
 
@@ -60,5 +78,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.RedelegationCooldownList = k.GetAllRedelegationCooldownEntries(ctx)
 
+	genesis.AutoRestakeList = k.GetAllAutoRestakes(ctx)
+
+	genesis.AutoRestakeState = k.GetAutoRestakeState(ctx)
+
+	genesis.WithdrawAddressList = k.GetAllWithdrawAddresses(ctx)
+
+	genesis.PoolDelegatorList = k.GetAllPoolDelegators(ctx)
+
+	genesis.PoolRebalanceList = k.GetAllPoolRebalances(ctx)
+
 	return genesis
 }
@@ -58,6 +58,14 @@ func (k Keeper) GetTotalAndHighestDelegationOfPool(ctx sdk.Context, poolId uint6
 	return
 }
 
+// SchedulePoolRebalance marks the pool delegations of the given pool to be spread
+// again across the current stakers of the pool. The rebalance starts in the next
+// begin block, so that it happens only once if multiple stakers change. A rebalance
+// which is already in progress starts again with the first pool delegator.
+func (k Keeper) SchedulePoolRebalance(ctx sdk.Context, poolId uint64) {
+	k.SetPoolRebalance(ctx, types.PoolRebalance{PoolId: poolId})
+}
+
 // PayoutRewards transfers `amount` from the `payerModuleName`-module to the delegation module.
 // It then awards these tokens internally to all delegators of staker `staker`.
 // Delegators can then receive these rewards if they call the `withdraw`-transaction.
@@ -85,6 +93,37 @@ func (k Keeper) PayoutRewards(ctx sdk.Context, staker string, amount sdk.Coins,
 	return nil
 }
 
+// DelegateFromModule delegates `amount` of $KYVE in the name of `delegator` to the
+// staker `staker`, whereby the funds are transferred from the `payerModuleName`-module
+// instead of the delegator's account. If the staker does not exist or the
+// module to module transfer fails, the method fails and returns an error.
+func (k Keeper) DelegateFromModule(ctx sdk.Context, staker string, delegator string, amount uint64, payerModuleName string) error {
+	// Assert there is an amount
+	if amount == 0 {
+		return nil
+	}
+
+	if !k.stakersKeeper.DoesStakerExist(ctx, staker) {
+		return errors.WithType(types.ErrStakerDoesNotExist, staker)
+	}
+
+	// Performs logical delegation without transferring the amount
+	k.performDelegation(ctx, staker, delegator, amount)
+
+	// Transfer tokens to the delegation module
+	if err := util.TransferFromModuleToModule(k.bankKeeper, ctx, payerModuleName, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventDelegate{
+		Address: delegator,
+		Staker:  staker,
+		Amount:  amount,
+	})
+
+	return nil
+}
+
 // SlashDelegators reduces the delegation of all delegators of `staker` by fraction
 // and transfers the amount to the Treasury.
 func (k Keeper) SlashDelegators(ctx sdk.Context, poolId uint64, staker string, slashType types.SlashType) {
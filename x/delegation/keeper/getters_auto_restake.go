@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	storeTypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoRestake enables the auto-restake for the delegator of the given staker
+func (k Keeper) SetAutoRestake(ctx sdk.Context, autoRestake types.AutoRestake) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.AutoRestakeKeyPrefix)
+	b := k.cdc.MustMarshal(&autoRestake)
+	store.Set(types.AutoRestakeKey(autoRestake.Delegator, autoRestake.Staker), b)
+}
+
+// DoesAutoRestakeExist returns true if the delegator has enabled
+// the auto-restake for the given staker
+func (k Keeper) DoesAutoRestakeExist(ctx sdk.Context, stakerAddress string, delegatorAddress string) bool {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.AutoRestakeKeyPrefix)
+	return store.Has(types.AutoRestakeKey(delegatorAddress, stakerAddress))
+}
+
+// RemoveAutoRestake disables the auto-restake for the delegator of the given staker
+func (k Keeper) RemoveAutoRestake(ctx sdk.Context, stakerAddress string, delegatorAddress string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.AutoRestakeKeyPrefix)
+	store.Delete(types.AutoRestakeKey(delegatorAddress, stakerAddress))
+}
+
+// GetAllAutoRestakes returns all auto-restake entries
+func (k Keeper) GetAllAutoRestakes(ctx sdk.Context) (list []types.AutoRestake) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.AutoRestakeKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AutoRestake
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getAutoRestakeBatch returns up to `limit` auto-restake entries starting at
+// the given store key. It also returns the key of the next entry, which is
+// nil if there are no more entries.
+func (k Keeper) getAutoRestakeBatch(ctx sdk.Context, start []byte, limit uint64) (list []types.AutoRestake, next []byte) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.AutoRestakeKeyPrefix)
+	iterator := store.Iterator(start, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(list)) >= limit {
+			return list, append([]byte{}, iterator.Key()...)
+		}
+
+		var val types.AutoRestake
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list, nil
+}
+
+// GetAutoRestakeState returns the progress of the auto-restake epochs
+func (k Keeper) GetAutoRestakeState(ctx sdk.Context) (state types.AutoRestakeState) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	b := store.Get(types.AutoRestakeStateKey)
+
+	if b == nil {
+		return state
+	}
+
+	k.cdc.MustUnmarshal(b, &state)
+	return
+}
+
+// SetAutoRestakeState saves the progress of the auto-restake epochs
+func (k Keeper) SetAutoRestakeState(ctx sdk.Context, state types.AutoRestakeState) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	b := k.cdc.MustMarshal(&state)
+	store.Set(types.AutoRestakeStateKey, b)
+}
@@ -50,6 +50,26 @@ func (k Keeper) GetTimeoutSlash(ctx sdk.Context) (res math.LegacyDec) {
 	return k.GetParams(ctx).TimeoutSlash
 }
 
+// GetAutoRestakeEpoch returns the AutoRestakeEpoch param
+func (k Keeper) GetAutoRestakeEpoch(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).AutoRestakeEpoch
+}
+
+// GetAutoRestakeBatchSize returns the AutoRestakeBatchSize param
+func (k Keeper) GetAutoRestakeBatchSize(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).AutoRestakeBatchSize
+}
+
+// GetPoolRebalanceBatchSize returns the PoolRebalanceBatchSize param
+func (k Keeper) GetPoolRebalanceBatchSize(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).PoolRebalanceBatchSize
+}
+
+// GetMinPoolDelegation returns the MinPoolDelegation param
+func (k Keeper) GetMinPoolDelegation(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MinPoolDelegation
+}
+
 func (k Keeper) getSlashFraction(ctx sdk.Context, slashType types.SlashType) (slashAmountRatio math.LegacyDec) {
 	// Retrieve slash fraction from params
 	switch slashType {
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// `PoolDelegator` is created for every delegator (address) that delegates
+// to a pool. The delegation itself is held by a derived account, which
+// is a regular delegator of the current stakers of the pool.
+// To query the current pool delegation use `GetPoolDelegationAmount()`.
+
+// SetPoolDelegator set a specific pool delegator in the store from its index
+func (k Keeper) SetPoolDelegator(ctx sdk.Context, poolDelegator types.PoolDelegator) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolDelegatorKeyPrefix)
+	b := k.cdc.MustMarshal(&poolDelegator)
+	store.Set(types.PoolDelegatorKey(
+		poolDelegator.Delegator,
+		poolDelegator.PoolId,
+	), b)
+
+	indexStore := prefix.NewStore(storeAdapter, types.PoolDelegatorKeyPrefixIndex2)
+	indexStore.Set(types.PoolDelegatorKeyIndex2(
+		poolDelegator.PoolId,
+		poolDelegator.Delegator,
+	), []byte{1})
+
+	// The account index stores the entire entry, as the account
+	// can not be converted back to the delegator and the pool
+	accountStore := prefix.NewStore(storeAdapter, types.PoolDelegatorKeyPrefixIndex3)
+	accountStore.Set(types.PoolDelegatorKeyIndex3(poolDelegator.Account), b)
+}
+
+// GetPoolDelegator returns a pool delegator from its index
+func (k Keeper) GetPoolDelegator(ctx sdk.Context, delegatorAddress string, poolId uint64) (val types.PoolDelegator, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolDelegatorKeyPrefix)
+	b := store.Get(types.PoolDelegatorKey(delegatorAddress, poolId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetPoolDelegatorByAccount returns the pool delegator of the given derived account
+func (k Keeper) GetPoolDelegatorByAccount(ctx sdk.Context, account string) (val types.PoolDelegator, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolDelegatorKeyPrefixIndex3)
+	b := store.Get(types.PoolDelegatorKeyIndex3(account))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePoolDelegator removes a pool delegator from the store
+func (k Keeper) RemovePoolDelegator(ctx sdk.Context, poolDelegator types.PoolDelegator) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolDelegatorKeyPrefix)
+	store.Delete(types.PoolDelegatorKey(
+		poolDelegator.Delegator,
+		poolDelegator.PoolId,
+	))
+
+	indexStore := prefix.NewStore(storeAdapter, types.PoolDelegatorKeyPrefixIndex2)
+	indexStore.Delete(types.PoolDelegatorKeyIndex2(
+		poolDelegator.PoolId,
+		poolDelegator.Delegator,
+	))
+
+	accountStore := prefix.NewStore(storeAdapter, types.PoolDelegatorKeyPrefixIndex3)
+	accountStore.Delete(types.PoolDelegatorKeyIndex3(poolDelegator.Account))
+}
+
+// GetPoolDelegatorsOfDelegator returns all pool delegations of the given delegator
+func (k Keeper) GetPoolDelegatorsOfDelegator(ctx sdk.Context, delegatorAddress string) (list []types.PoolDelegator) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, util.GetByteKey(types.PoolDelegatorKeyPrefix, delegatorAddress))
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PoolDelegator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPoolDelegatorsOfPool returns all pool delegations of the given pool
+func (k Keeper) GetPoolDelegatorsOfPool(ctx sdk.Context, poolId uint64) (list []types.PoolDelegator) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, util.GetByteKey(types.PoolDelegatorKeyPrefixIndex2, poolId))
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		poolDelegator, _ := k.GetPoolDelegator(ctx, string(iterator.Key()), poolId)
+		list = append(list, poolDelegator)
+	}
+
+	return
+}
+
+// getPoolDelegatorsBatch returns up to `limit` pool delegators of the given pool
+// starting at the given store key. It also returns the key of the next pool
+// delegator, which is nil if there are no more pool delegators.
+func (k Keeper) getPoolDelegatorsBatch(ctx sdk.Context, poolId uint64, start []byte, limit uint64) (list []types.PoolDelegator, next []byte) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, util.GetByteKey(types.PoolDelegatorKeyPrefixIndex2, poolId))
+	iterator := store.Iterator(start, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(list)) >= limit {
+			return list, append([]byte{}, iterator.Key()...)
+		}
+
+		poolDelegator, _ := k.GetPoolDelegator(ctx, string(iterator.Key()), poolId)
+		list = append(list, poolDelegator)
+	}
+
+	return list, nil
+}
+
+// GetAllPoolDelegators returns all pool delegators (of all pools)
+func (k Keeper) GetAllPoolDelegators(ctx sdk.Context) (list []types.PoolDelegator) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolDelegatorKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PoolDelegator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ######################
+// === POOL REBALANCE ===
+// ######################
+
+// SetPoolRebalance set a specific pool rebalance in the store from its index
+func (k Keeper) SetPoolRebalance(ctx sdk.Context, poolRebalance types.PoolRebalance) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolRebalanceKeyPrefix)
+	b := k.cdc.MustMarshal(&poolRebalance)
+	store.Set(types.PoolRebalanceKey(poolRebalance.PoolId), b)
+}
+
+// RemovePoolRebalance removes the rebalance of the given pool
+func (k Keeper) RemovePoolRebalance(ctx sdk.Context, poolId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolRebalanceKeyPrefix)
+	store.Delete(types.PoolRebalanceKey(poolId))
+}
+
+// GetAllPoolRebalances returns the rebalances of all pools which need to be rebalanced
+func (k Keeper) GetAllPoolRebalances(ctx sdk.Context) (list []types.PoolRebalance) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolRebalanceKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PoolRebalance
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
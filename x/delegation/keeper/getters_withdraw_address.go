@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	storeTypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetWithdrawAddress sets the address which receives the rewards of the given address
+func (k Keeper) SetWithdrawAddress(ctx sdk.Context, withdrawAddress types.WithdrawAddress) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.WithdrawAddressKeyPrefix)
+	b := k.cdc.MustMarshal(&withdrawAddress)
+	store.Set(types.WithdrawAddressKey(withdrawAddress.Address), b)
+}
+
+// GetWithdrawAddress returns the address which receives the rewards of the
+// given address. If no withdraw address is set the address itself is returned.
+// The rewards of a pool delegation account are received by its delegator.
+func (k Keeper) GetWithdrawAddress(ctx sdk.Context, address string) string {
+	if poolDelegator, found := k.GetPoolDelegatorByAccount(ctx, address); found {
+		address = poolDelegator.Delegator
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.WithdrawAddressKeyPrefix)
+
+	b := store.Get(types.WithdrawAddressKey(address))
+	if b == nil {
+		return address
+	}
+
+	var val types.WithdrawAddress
+	k.cdc.MustUnmarshal(b, &val)
+	return val.WithdrawAddress
+}
+
+// RemoveWithdrawAddress resets the withdraw address to the given address itself
+func (k Keeper) RemoveWithdrawAddress(ctx sdk.Context, address string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.WithdrawAddressKeyPrefix)
+	store.Delete(types.WithdrawAddressKey(address))
+}
+
+// GetAllWithdrawAddresses returns all withdraw address entries
+func (k Keeper) GetAllWithdrawAddresses(ctx sdk.Context) (list []types.WithdrawAddress) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.WithdrawAddressKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.WithdrawAddress
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProcessAutoRestakeQueue restakes the rewards of all delegators who have
+// enabled the auto-restake once every auto-restake epoch. To keep the gas
+// consumption of a single block bounded, at most `AutoRestakeBatchSize`
+// delegators are processed per block and the epoch continues in the next
+// block until all delegators have been processed. If one of both params is
+// zero auto-restaking is disabled.
+func (k Keeper) ProcessAutoRestakeQueue(ctx sdk.Context) {
+	epoch, batchSize := k.GetAutoRestakeEpoch(ctx), k.GetAutoRestakeBatchSize(ctx)
+	if epoch == 0 || batchSize == 0 {
+		return
+	}
+
+	state := k.GetAutoRestakeState(ctx)
+	now := uint64(ctx.BlockTime().Unix())
+
+	if !state.InProgress {
+		if now < state.NextEpoch {
+			return
+		}
+
+		// start a new epoch
+		state.InProgress = true
+		state.NextKey = nil
+		state.NextEpoch = now + epoch
+	}
+
+	batch, next := k.getAutoRestakeBatch(ctx, state.NextKey, batchSize)
+
+	for _, autoRestake := range batch {
+		k.performAutoRestake(ctx, autoRestake.Staker, autoRestake.Delegator)
+	}
+
+	state.NextKey = next
+	state.InProgress = next != nil
+
+	k.SetAutoRestakeState(ctx, state)
+}
+
+// performAutoRestake withdraws the rewards of the delegator and delegates the
+// $KYVE part of it to the same staker again. All other denoms are transferred
+// to the delegator. If the delegator no longer delegates to the staker, the
+// auto-restake is disabled.
+func (k Keeper) performAutoRestake(ctx sdk.Context, stakerAddress string, delegatorAddress string) {
+	if !k.DoesDelegatorExist(ctx, stakerAddress, delegatorAddress) {
+		k.RemoveAutoRestake(ctx, stakerAddress, delegatorAddress)
+		return
+	}
+
+	// Only restake if there are $KYVE rewards
+	if k.GetOutstandingRewards(ctx, stakerAddress, delegatorAddress).AmountOf(globalTypes.Denom).IsZero() {
+		return
+	}
+
+	// Update in-memory staker index for efficient queries
+	k.RemoveStakerIndex(ctx, stakerAddress)
+	defer k.SetStakerIndex(ctx, stakerAddress)
+
+	reward := k.f1WithdrawRewards(ctx, stakerAddress, delegatorAddress)
+	amount := reward.AmountOf(globalTypes.Denom).Uint64()
+
+	// Transfer all other denoms to the withdraw address of the delegator
+	remaining := sdk.NewCoins()
+	for _, coin := range reward {
+		if coin.Denom != globalTypes.Denom {
+			remaining = remaining.Add(coin)
+		}
+	}
+
+	if !remaining.IsZero() {
+		recipient := sdk.MustAccAddressFromBech32(k.GetWithdrawAddress(ctx, delegatorAddress))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, remaining); err != nil {
+			util.PanicHalt(k.upgradeKeeper, ctx, "no money left in module")
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventWithdrawRewards{
+			Address: delegatorAddress,
+			Staker:  stakerAddress,
+			Amounts: remaining.String(),
+		})
+	}
+
+	// The $KYVE rewards are already in the delegation module, therefore
+	// the delegation only needs to be increased internally.
+	delegationAmount := k.f1RemoveDelegator(ctx, stakerAddress, delegatorAddress)
+	k.f1CreateDelegator(ctx, stakerAddress, delegatorAddress, delegationAmount+amount)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventAutoRestake{
+		Address: delegatorAddress,
+		Staker:  stakerAddress,
+		Amount:  amount,
+	})
+}
@@ -52,16 +52,20 @@ func (k Keeper) performUndelegation(ctx sdk.Context, stakerAddress string, deleg
 		redelegation = undelegatedAmount - amount
 		// ... create a new delegator entry with the remaining amount
 		k.f1CreateDelegator(ctx, stakerAddress, delegatorAddress, redelegation)
+	} else {
+		// the delegator is fully undelegated, so there is nothing left to restake
+		k.RemoveAutoRestake(ctx, stakerAddress, delegatorAddress)
 	}
 
 	return undelegatedAmount - redelegation
 }
 
-// performWithdrawal withdraws all pending rewards from a user and transfers it.
+// performWithdrawal withdraws all pending rewards from a user and transfers it
+// to the withdraw address of the user.
 // The amount is returned by the function.
 func (k Keeper) performWithdrawal(ctx sdk.Context, stakerAddress, delegatorAddress string) (sdk.Coins, error) {
 	reward := k.f1WithdrawRewards(ctx, stakerAddress, delegatorAddress)
-	recipient, errAddress := sdk.AccAddressFromBech32(delegatorAddress)
+	recipient, errAddress := sdk.AccAddressFromBech32(k.GetWithdrawAddress(ctx, delegatorAddress))
 	if errAddress != nil {
 		return nil, errAddress
 	}
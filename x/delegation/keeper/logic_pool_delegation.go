@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPoolDelegationAmount returns the current amount the delegator has delegated
+// to the given pool, i.e. the sum of all delegations of the pool delegation account.
+// Slashes of the underlying stakers are therefore already considered.
+func (k Keeper) GetPoolDelegationAmount(ctx sdk.Context, delegatorAddress string, poolId uint64) (amount uint64) {
+	account := types.PoolDelegationAccount(poolId, delegatorAddress)
+	for _, staker := range k.GetStakersByDelegator(ctx, account) {
+		amount += k.GetDelegationAmountOfDelegator(ctx, staker, account)
+	}
+	return amount
+}
+
+// splitEqually splits the amount into equal parts for the given stakers.
+// The remainder is distributed to the first stakers one by one.
+func splitEqually(amount uint64, stakers []string) map[string]uint64 {
+	shares := make(map[string]uint64)
+	count := uint64(len(stakers))
+	for i, staker := range stakers {
+		shares[staker] = amount / count
+		if uint64(i) < amount%count {
+			shares[staker] += 1
+		}
+	}
+	return shares
+}
+
+// performPoolDelegation delegates the amount in equal parts to the given stakers
+// in the name of the pool delegation account. The pool delegator is created
+// if it does not exist yet. The tokens need to be in the delegation module already.
+func (k Keeper) performPoolDelegation(ctx sdk.Context, poolId uint64, delegatorAddress string, stakers []string, amount uint64) {
+	poolDelegator, found := k.GetPoolDelegator(ctx, delegatorAddress, poolId)
+	if !found {
+		poolDelegator = types.PoolDelegator{
+			Delegator: delegatorAddress,
+			PoolId:    poolId,
+			Account:   types.PoolDelegationAccount(poolId, delegatorAddress),
+		}
+		k.SetPoolDelegator(ctx, poolDelegator)
+	}
+
+	shares := splitEqually(amount, stakers)
+	for _, staker := range stakers {
+		if shares[staker] > 0 {
+			k.performDelegation(ctx, staker, poolDelegator.Account, shares[staker])
+		}
+	}
+}
+
+// performPoolUndelegation undelegates the amount from the pool delegation. Every
+// staker position is reduced proportionally to its size. If the amount is greater
+// than the available amount, only the available amount will be undelegated.
+// The undelegated amount is returned, the tokens remain in the delegation module.
+func (k Keeper) performPoolUndelegation(ctx sdk.Context, poolDelegator types.PoolDelegator, amount uint64) (undelegatedAmount uint64) {
+	stakers := k.GetStakersByDelegator(ctx, poolDelegator.Account)
+
+	positions := make(map[string]uint64)
+	total := uint64(0)
+	for _, staker := range stakers {
+		positions[staker] = k.GetDelegationAmountOfDelegator(ctx, staker, poolDelegator.Account)
+		total += positions[staker]
+	}
+
+	if amount > total {
+		amount = total
+	}
+
+	if amount == 0 {
+		return 0
+	}
+
+	// Calculate the proportional part of every position. Due to rounding
+	// the remainder is taken from the first positions which have capacity left.
+	parts := make(map[string]uint64)
+	remainder := amount
+	for _, staker := range stakers {
+		parts[staker] = math.NewIntFromUint64(positions[staker]).
+			Mul(math.NewIntFromUint64(amount)).
+			Quo(math.NewIntFromUint64(total)).
+			Uint64()
+		remainder -= parts[staker]
+	}
+	for _, staker := range stakers {
+		if remainder == 0 {
+			break
+		}
+		capacity := positions[staker] - parts[staker]
+		if capacity > remainder {
+			capacity = remainder
+		}
+		parts[staker] += capacity
+		remainder -= capacity
+	}
+
+	for _, staker := range stakers {
+		if parts[staker] > 0 {
+			undelegatedAmount += k.performUndelegation(ctx, staker, poolDelegator.Account, parts[staker])
+		}
+	}
+
+	// Remove the pool delegator once nothing is delegated anymore
+	if len(k.GetStakersByDelegator(ctx, poolDelegator.Account)) == 0 {
+		k.RemovePoolDelegator(ctx, poolDelegator)
+	}
+
+	return undelegatedAmount
+}
+
+// rebalancePoolDelegator spreads the entire pool delegation in equal parts across
+// the given stakers. All outstanding rewards are withdrawn beforehand.
+func (k Keeper) rebalancePoolDelegator(ctx sdk.Context, poolDelegator types.PoolDelegator, stakers []string) {
+	total := uint64(0)
+	for _, staker := range k.GetStakersByDelegator(ctx, poolDelegator.Account) {
+		total += k.performUndelegation(ctx, staker, poolDelegator.Account, k.GetDelegationAmountOfDelegator(ctx, staker, poolDelegator.Account))
+	}
+
+	shares := splitEqually(total, stakers)
+	for _, staker := range stakers {
+		if shares[staker] > 0 {
+			k.performDelegation(ctx, staker, poolDelegator.Account, shares[staker])
+		}
+	}
+}
+
+// ProcessPoolRebalances is called in the begin block and rebalances the pool
+// delegations of all pools whose stakers have changed. At most
+// `PoolRebalanceBatchSize` pool delegators are rebalanced per block, the
+// remaining ones are processed in the next blocks. If a pool has no stakers
+// left the delegations remain where they are.
+func (k Keeper) ProcessPoolRebalances(ctx sdk.Context) {
+	remaining := k.GetPoolRebalanceBatchSize(ctx)
+
+	for _, poolRebalance := range k.GetAllPoolRebalances(ctx) {
+		if remaining == 0 {
+			return
+		}
+
+		stakers := k.stakersKeeper.GetAllStakerAddressesOfPool(ctx, poolRebalance.PoolId)
+		if len(stakers) == 0 {
+			k.RemovePoolRebalance(ctx, poolRebalance.PoolId)
+			continue
+		}
+
+		batch, next := k.getPoolDelegatorsBatch(ctx, poolRebalance.PoolId, poolRebalance.NextKey, remaining)
+
+		for _, poolDelegator := range batch {
+			k.rebalancePoolDelegator(ctx, poolDelegator, stakers)
+		}
+
+		remaining -= uint64(len(batch))
+
+		if next == nil {
+			k.RemovePoolRebalance(ctx, poolRebalance.PoolId)
+		} else {
+			poolRebalance.NextKey = next
+			k.SetPoolRebalance(ctx, poolRebalance)
+		}
+
+		if len(batch) == 0 {
+			continue
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventRebalancePoolDelegations{
+			PoolId:     poolRebalance.PoolId,
+			Stakers:    uint64(len(stakers)),
+			Delegators: uint64(len(batch)),
+			Completed:  next == nil,
+		})
+	}
+}
@@ -31,6 +31,24 @@ func (k Keeper) StartUnbondingDelegator(ctx sdk.Context, staker string, delegato
 	k.SetUndelegationQueueEntry(ctx, undelegationQueueEntry)
 }
 
+// StartUnbondingPoolDelegator creates a queue entry to schedule the unbonding
+// of a pool delegation. As the underlying stakers can change during the unbonding
+// time, the amount is undelegated proportionally from all stakers at the end.
+func (k Keeper) StartUnbondingPoolDelegator(ctx sdk.Context, poolId uint64, delegatorAddress string, amount uint64) {
+	queueState := k.GetQueueState(ctx)
+	queueState.HighIndex += 1
+	k.SetQueueState(ctx, queueState)
+
+	k.SetUndelegationQueueEntry(ctx, types.UndelegationQueueEntry{
+		Delegator:      delegatorAddress,
+		Index:          queueState.HighIndex,
+		Amount:         amount,
+		CreationTime:   uint64(ctx.BlockTime().Unix()),
+		PoolDelegation: true,
+		PoolId:         poolId,
+	})
+}
+
 // ProcessDelegatorUnbondingQueue is called in the end block and
 // checks the queue for entries that have surpassed the unbonding time.
 // If the unbonding time is reached, the actual unbonding is performed
@@ -57,7 +75,14 @@ func (k Keeper) ProcessDelegatorUnbondingQueue(ctx sdk.Context) {
 		if undelegationEntry.CreationTime+k.GetUnbondingDelegationTime(ctx) <= uint64(ctx.BlockTime().Unix()) {
 
 			// Perform undelegation and save undelegated amount to then transfer back to the user
-			undelegatedAmount := k.performUndelegation(ctx, undelegationEntry.Staker, undelegationEntry.Delegator, undelegationEntry.Amount)
+			var undelegatedAmount uint64
+			if undelegationEntry.PoolDelegation {
+				if poolDelegator, found := k.GetPoolDelegator(ctx, undelegationEntry.Delegator, undelegationEntry.PoolId); found {
+					undelegatedAmount = k.performPoolUndelegation(ctx, poolDelegator, undelegationEntry.Amount)
+				}
+			} else {
+				undelegatedAmount = k.performUndelegation(ctx, undelegationEntry.Staker, undelegationEntry.Delegator, undelegationEntry.Amount)
+			}
 
 			// Transfer the money
 			if err := util.TransferFromModuleToAddress(
@@ -71,11 +96,19 @@ func (k Keeper) ProcessDelegatorUnbondingQueue(ctx sdk.Context) {
 			}
 
 			// Emit a delegation event.
-			_ = ctx.EventManager().EmitTypedEvent(&types.EventUndelegate{
-				Address: undelegationEntry.Delegator,
-				Staker:  undelegationEntry.Staker,
-				Amount:  undelegatedAmount,
-			})
+			if undelegationEntry.PoolDelegation {
+				_ = ctx.EventManager().EmitTypedEvent(&types.EventUndelegateFromPool{
+					Address: undelegationEntry.Delegator,
+					PoolId:  undelegationEntry.PoolId,
+					Amount:  undelegatedAmount,
+				})
+			} else {
+				_ = ctx.EventManager().EmitTypedEvent(&types.EventUndelegate{
+					Address: undelegationEntry.Delegator,
+					Staker:  undelegationEntry.Staker,
+					Amount:  undelegatedAmount,
+				})
+			}
 
 			k.RemoveUndelegationQueueEntry(ctx, &undelegationEntry)
 
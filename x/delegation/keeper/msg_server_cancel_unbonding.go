@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CancelUnbonding removes the given amount from an unbonding entry of the delegator.
+// As the tokens remain delegated to the staker during the unbonding time the
+// delegation does not need to be restored, the entry is only shrunk or removed
+// from the queue. If the entry is removed the queue skips the missing index.
+func (k msgServer) CancelUnbonding(goCtx context.Context, msg *types.MsgCancelUnbonding) (*types.MsgCancelUnbondingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only the delegator of the unbonding entry can cancel it
+	undelegationEntry, found := k.GetUndelegationQueueEntry(ctx, msg.Index)
+	if !found || undelegationEntry.Delegator != msg.Creator {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrUnbondingEntryNotFound.Error(), msg.Index)
+	}
+
+	// Do not allow to cancel more than currently unbonding
+	if msg.Amount > undelegationEntry.Amount {
+		return nil, types.ErrNotEnoughUnbonding.Wrapf("%d > %d", msg.Amount, undelegationEntry.Amount)
+	}
+
+	if msg.Amount == undelegationEntry.Amount {
+		k.RemoveUndelegationQueueEntry(ctx, &undelegationEntry)
+	} else {
+		undelegationEntry.Amount -= msg.Amount
+		k.SetUndelegationQueueEntry(ctx, undelegationEntry)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventCancelUnbonding{
+		Address: msg.Creator,
+		Staker:  undelegationEntry.Staker,
+		Index:   msg.Index,
+		Amount:  msg.Amount,
+	})
+
+	return &types.MsgCancelUnbondingResponse{}, nil
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_cancel_unbonding.go
+
+* Cancel an unbonding entry which does not exist
+* Cancel an unbonding entry of another delegator
+* Cancel more than currently unbonding
+* Cancel the entire unbonding entry
+* Cancel a part of the unbonding entry and await unbonding
+* Cancel the first of two unbonding entries and await unbonding
+
+*/
+
+var _ = Describe("msg_server_cancel_unbonding.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	const aliceSelfDelegation = 100 * i.KYVE
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreatePool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  aliceSelfDelegation,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgUndelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  5 * i.KYVE,
+		})
+
+		s.CommitAfterSeconds(1)
+	})
+
+	AfterEach(func() {
+		CheckAndContinueChainForOneMonth(s)
+	})
+
+	It("Cancel an unbonding entry which does not exist", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgCancelUnbonding{
+			Creator: i.DUMMY[0],
+			Index:   2,
+			Amount:  5 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Amount).To(Equal(5 * i.KYVE))
+	})
+
+	It("Cancel an unbonding entry of another delegator", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgCancelUnbonding{
+			Creator: i.DUMMY[1],
+			Index:   1,
+			Amount:  5 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Amount).To(Equal(5 * i.KYVE))
+	})
+
+	It("Cancel more than currently unbonding", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgCancelUnbonding{
+			Creator: i.DUMMY[0],
+			Index:   1,
+			Amount:  6 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Amount).To(Equal(5 * i.KYVE))
+	})
+
+	It("Cancel the entire unbonding entry", func() {
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgCancelUnbonding{
+			Creator: i.DUMMY[0],
+			Index:   1,
+			Amount:  5 * i.KYVE,
+		})
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(990 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(aliceSelfDelegation + 10*i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+
+		queueState := s.App().DelegationKeeper.GetQueueState(s.Ctx())
+		Expect(queueState.LowIndex).To(Equal(uint64(1)))
+		Expect(queueState.HighIndex).To(Equal(uint64(1)))
+	})
+
+	It("Cancel a part of the unbonding entry and await unbonding", func() {
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgCancelUnbonding{
+			Creator: i.DUMMY[0],
+			Index:   1,
+			Amount:  2 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Index).To(Equal(uint64(1)))
+		Expect(unbondingEntries[0].Amount).To(Equal(3 * i.KYVE))
+
+		// ACT
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(993 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(aliceSelfDelegation + 7*i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(7 * i.KYVE))
+	})
+
+	It("Cancel the first of two unbonding entries and await unbonding", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgUndelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  3 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgCancelUnbonding{
+			Creator: i.DUMMY[0],
+			Index:   1,
+			Amount:  5 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Index).To(Equal(uint64(2)))
+		Expect(unbondingEntries[0].Amount).To(Equal(3 * i.KYVE))
+
+		// ACT
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(993 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(7 * i.KYVE))
+
+		queueState := s.App().DelegationKeeper.GetQueueState(s.Ctx())
+		Expect(queueState.LowIndex).To(Equal(uint64(2)))
+		Expect(queueState.HighIndex).To(Equal(uint64(2)))
+	})
+})
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	stdMath "math"
+
+	sdkErrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/KYVENetwork/chain/util"
+
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DelegateMulti handles the transaction of delegating to multiple stakers at once.
+// The amount of each staker is either given directly or calculated from the weights
+// of the stakers. The same requirements as for a single delegation apply to every
+// staker. If one of them fails, nothing is delegated at all.
+func (k msgServer) DelegateMulti(goCtx context.Context, msg *types.MsgDelegateMulti) (*types.MsgDelegateMultiResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, entry := range msg.Entries {
+		if !k.stakersKeeper.DoesStakerExist(ctx, entry.Staker) {
+			return nil, sdkErrors.WithType(types.ErrStakerDoesNotExist, entry.Staker)
+		}
+	}
+
+	amounts := splitDelegateMulti(msg)
+
+	// The total is transferred as int64, therefore it must not exceed it
+	total := math.ZeroInt()
+	for _, amount := range amounts {
+		total = total.Add(math.NewIntFromUint64(amount))
+	}
+	if !total.IsInt64() {
+		return nil, sdkErrors.Wrapf(errorsTypes.ErrInvalidRequest, "total amount %s exceeds %d", total, int64(stdMath.MaxInt64))
+	}
+
+	for index, entry := range msg.Entries {
+		if amounts[index] == 0 {
+			continue
+		}
+
+		// Performs logical delegation without transferring the amount
+		k.performDelegation(ctx, entry.Staker, msg.Creator, amounts[index])
+	}
+
+	// Transfer tokens from sender to this module.
+	if transferErr := util.TransferFromAddressToModule(k.bankKeeper, ctx, msg.Creator, types.ModuleName, total.Uint64()); transferErr != nil {
+		return nil, transferErr
+	}
+
+	// Emit a delegation event for every staker.
+	for index, entry := range msg.Entries {
+		if amounts[index] == 0 {
+			continue
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventDelegate{
+			Address: msg.Creator,
+			Staker:  entry.Staker,
+			Amount:  amounts[index],
+		})
+	}
+
+	return &types.MsgDelegateMultiResponse{}, nil
+}
+
+// splitDelegateMulti returns the amount which gets delegated to every entry.
+// If a total amount is given it is split according to the weights, whereby
+// the remainder is added to the first entries one by one.
+func splitDelegateMulti(msg *types.MsgDelegateMulti) []uint64 {
+	amounts := make([]uint64, len(msg.Entries))
+
+	if msg.Amount == 0 {
+		for index, entry := range msg.Entries {
+			amounts[index] = entry.Amount
+		}
+		return amounts
+	}
+
+	totalWeight := math.ZeroInt()
+	for _, entry := range msg.Entries {
+		totalWeight = totalWeight.Add(math.NewIntFromUint64(entry.Weight))
+	}
+
+	remainder := msg.Amount
+	for index, entry := range msg.Entries {
+		amounts[index] = math.NewIntFromUint64(msg.Amount).
+			Mul(math.NewIntFromUint64(entry.Weight)).
+			Quo(totalWeight).
+			Uint64()
+		remainder -= amounts[index]
+	}
+
+	for index, entry := range msg.Entries {
+		if remainder == 0 {
+			break
+		}
+		if entry.Weight > 0 {
+			amounts[index] += 1
+			remainder -= 1
+		}
+	}
+
+	return amounts
+}
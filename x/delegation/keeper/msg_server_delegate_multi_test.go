@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"math"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/keeper"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_delegate_multi.go
+
+* Delegate to multiple stakers with amounts
+* Delegate to multiple stakers with weights
+* Delegate to multiple stakers with weights and remainder
+* Delegate to a staker which does not exist
+* Delegate with duplicated stakers
+* Delegate with amounts and weights
+* Delegate with zero weights
+* Delegate with a zero amount
+* Delegate with amounts whose total overflows
+* Delegate with a total amount which exceeds int64
+* Delegate more than available
+
+*/
+
+var _ = Describe("msg_server_delegate_multi.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreatePool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  0,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.BOB,
+			Amount:  0,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.CHARLIE,
+			Amount:  0,
+		})
+	})
+
+	AfterEach(func() {
+		CheckAndContinueChainForOneMonth(s)
+	})
+
+	It("Delegate to multiple stakers with amounts", func() {
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Amount: 10 * i.KYVE},
+				{Staker: i.BOB, Amount: 20 * i.KYVE},
+			},
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(970 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, i.DUMMY[0])).To(Equal(20 * i.KYVE))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.CHARLIE, i.DUMMY[0])).To(BeFalse())
+	})
+
+	It("Delegate to multiple stakers with weights", func() {
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Weight: 1},
+				{Staker: i.BOB, Weight: 2},
+				{Staker: i.CHARLIE, Weight: 3},
+			},
+			Amount: 60 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(940 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, i.DUMMY[0])).To(Equal(20 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.CHARLIE, i.DUMMY[0])).To(Equal(30 * i.KYVE))
+	})
+
+	It("Delegate to multiple stakers with weights and remainder", func() {
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Weight: 0},
+				{Staker: i.BOB, Weight: 1},
+				{Staker: i.CHARLIE, Weight: 1},
+			},
+			Amount: 11,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000*i.KYVE - 11))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, i.DUMMY[0])).To(Equal(uint64(6)))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.CHARLIE, i.DUMMY[0])).To(Equal(uint64(5)))
+	})
+
+	It("Delegate to a staker which does not exist", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Amount: 10 * i.KYVE},
+				{Staker: i.DUMMY[1], Amount: 10 * i.KYVE},
+			},
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+	})
+
+	It("Delegate with duplicated stakers", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Amount: 10 * i.KYVE},
+				{Staker: i.ALICE, Amount: 10 * i.KYVE},
+			},
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+	})
+
+	It("Delegate with amounts and weights", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Weight: 1},
+				{Staker: i.BOB, Amount: 10 * i.KYVE},
+			},
+			Amount: 10 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+	})
+
+	It("Delegate with zero weights", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Weight: 0},
+				{Staker: i.BOB, Weight: 0},
+			},
+			Amount: 10 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+	})
+
+	It("Delegate more than available", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Amount: 600 * i.KYVE},
+				{Staker: i.BOB, Amount: 600 * i.KYVE},
+			},
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.BOB, i.DUMMY[0])).To(BeFalse())
+	})
+
+	It("Delegate with a zero amount", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Amount: 10 * i.KYVE},
+				{Staker: i.BOB, Amount: 0},
+			},
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.BOB, i.DUMMY[0])).To(BeFalse())
+	})
+
+	It("Delegate with amounts whose total overflows", func() {
+		// ARRANGE
+		msg := &types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Amount: math.MaxInt64},
+				{Staker: i.BOB, Amount: math.MaxInt64},
+				{Staker: i.CHARLIE, Amount: 3},
+			},
+		}
+
+		// ACT
+		s.RunTxDelegatorError(msg)
+		_, err := keeper.NewMsgServerImpl(s.App().DelegationKeeper).DelegateMulti(s.Ctx(), msg)
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.BOB, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.CHARLIE, i.DUMMY[0])).To(BeFalse())
+	})
+
+	It("Delegate with a total amount which exceeds int64", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateMulti{
+			Creator: i.DUMMY[0],
+			Entries: []types.DelegateMultiEntry{
+				{Staker: i.ALICE, Weight: 1},
+				{Staker: i.BOB, Weight: 1},
+			},
+			Amount: math.MaxInt64 + 1,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+	})
+})
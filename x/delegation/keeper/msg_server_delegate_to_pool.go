@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DelegateToPool handles the transaction of delegating a specific amount of $KYVE to a pool.
+// The amount is delegated in equal parts to all current stakers of the pool. Once the
+// stakers of the pool change, the delegation is spread again across the new stakers.
+func (k msgServer) DelegateToPool(goCtx context.Context, msg *types.MsgDelegateToPool) (*types.MsgDelegateToPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.poolKeeper.AssertPoolExists(ctx, msg.PoolId); err != nil {
+		return nil, err
+	}
+
+	stakers := k.stakersKeeper.GetAllStakerAddressesOfPool(ctx, msg.PoolId)
+	if len(stakers) == 0 {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrPoolHasNoStakers.Error(), msg.PoolId)
+	}
+
+	// Prevent small pool delegations, as they are rebalanced on every staker change
+	minPoolDelegation := k.GetMinPoolDelegation(ctx)
+	if poolDelegation := k.GetPoolDelegationAmount(ctx, msg.Creator, msg.PoolId) + msg.Amount; poolDelegation < minPoolDelegation {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrPoolDelegationTooLow.Error(), poolDelegation, minPoolDelegation)
+	}
+
+	// Performs logical delegation without transferring the amount
+	k.performPoolDelegation(ctx, msg.PoolId, msg.Creator, stakers, msg.Amount)
+
+	// Transfer tokens from sender to this module.
+	if transferErr := util.TransferFromAddressToModule(k.bankKeeper, ctx, msg.Creator, types.ModuleName, msg.Amount); transferErr != nil {
+		return nil, transferErr
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventDelegateToPool{
+		Address: msg.Creator,
+		PoolId:  msg.PoolId,
+		Amount:  msg.Amount,
+	})
+
+	return &types.MsgDelegateToPoolResponse{}, nil
+}
@@ -0,0 +1,476 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_delegate_to_pool.go
+
+* Delegate to a pool which does not exist
+* Delegate to a pool without stakers
+* Delegate to a pool with two stakers
+* Delegate to a pool and withdraw rewards
+* Delegate to a pool and get slashed
+* Undelegate more than delegated to the pool
+* Undelegate from a pool and await unbonding
+* Rebalance the pool delegation after a staker joined
+* Rebalance the pool delegation after a staker left
+* Delegate less than the minimum pool delegation to a pool
+* Undelegate from a pool and keep less than the minimum pool delegation
+* Rebalance many pool delegators in batches over multiple blocks
+* Restart the rebalance if the stakers change during a rebalance
+
+*/
+
+var _ = Describe("msg_server_delegate_to_pool.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	account := types.PoolDelegationAccount(0, i.DUMMY[0])
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreatePool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.BOB,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.ALICE,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.BOB,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1_A,
+		})
+
+		s.CommitAfterSeconds(1)
+	})
+
+	AfterEach(func() {
+		CheckAndContinueChainForOneMonth(s)
+	})
+
+	It("Delegate to a pool which does not exist", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  1,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetPoolDelegatorsOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+	})
+
+	It("Delegate to a pool without stakers", func() {
+		// ARRANGE
+		CreatePool(s)
+
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  1,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetPoolDelegatorsOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+	})
+
+	It("Delegate to a pool with two stakers", func() {
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  100*i.KYVE + 1,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(900*i.KYVE - 1))
+
+		poolDelegator, found := s.App().DelegationKeeper.GetPoolDelegator(s.Ctx(), i.DUMMY[0], 0)
+		Expect(found).To(BeTrue())
+		Expect(poolDelegator.Account).To(Equal(account))
+
+		Expect(s.App().DelegationKeeper.GetPoolDelegationAmount(s.Ctx(), i.DUMMY[0], 0)).To(Equal(100*i.KYVE + 1))
+
+		aliceDelegation := s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, account)
+		bobDelegation := s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, account)
+		Expect([]uint64{aliceDelegation, bobDelegation}).To(ConsistOf(50*i.KYVE, 50*i.KYVE+1))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(100*i.KYVE + aliceDelegation))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.BOB)).To(Equal(100*i.KYVE + bobDelegation))
+	})
+
+	It("Delegate to a pool and withdraw rewards", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		PayoutRewards(s, i.ALICE, i.KYVECoins(int64(15*i.KYVE)))
+		PayoutRewards(s, i.BOB, i.KYVECoins(int64(30*i.KYVE)))
+
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawAllRewards{
+			Creator: i.DUMMY[0],
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(900*i.KYVE + 15*i.KYVE))
+		Expect(s.GetBalanceFromAddress(account)).To(BeZero())
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, account)).To(BeEmpty())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.BOB, account)).To(BeEmpty())
+	})
+
+	It("Delegate to a pool and get slashed", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, types.SLASH_TYPE_UPLOAD)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, account)).To(Equal(40 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, account)).To(Equal(50 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetPoolDelegationAmount(s.Ctx(), i.DUMMY[0], 0)).To(Equal(90 * i.KYVE))
+	})
+
+	It("Undelegate more than delegated to the pool", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxDelegatorError(&types.MsgUndelegateFromPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  101 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+	})
+
+	It("Undelegate from a pool and await unbonding", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgUndelegateFromPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  40 * i.KYVE,
+		})
+
+		// ASSERT
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].PoolDelegation).To(BeTrue())
+		Expect(unbondingEntries[0].PoolId).To(Equal(uint64(0)))
+		Expect(unbondingEntries[0].Amount).To(Equal(40 * i.KYVE))
+
+		// ACT
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(940 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, account)).To(Equal(30 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, account)).To(Equal(30 * i.KYVE))
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgUndelegateFromPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  60 * i.KYVE,
+		})
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetPoolDelegationAmount(s.Ctx(), i.DUMMY[0], 0)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetPoolDelegatorsOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+	})
+
+	It("Rebalance the pool delegation after a staker joined", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  90 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.CHARLIE,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.CHARLIE,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_2_A,
+		})
+
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllPoolRebalances(s.Ctx())).To(BeEmpty())
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, account)).To(Equal(30 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, account)).To(Equal(30 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.CHARLIE, account)).To(Equal(30 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetPoolDelegationAmount(s.Ctx(), i.DUMMY[0], 0)).To(Equal(90 * i.KYVE))
+	})
+
+	It("Rebalance the pool delegation after a staker left", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  100 * i.KYVE,
+		})
+
+		PayoutRewards(s, i.BOB, i.KYVECoins(int64(30*i.KYVE)))
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.BOB,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ConsistOf(i.ALICE))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, account)).To(Equal(100 * i.KYVE))
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.BOB, account)).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetPoolDelegationAmount(s.Ctx(), i.DUMMY[0], 0)).To(Equal(100 * i.KYVE))
+
+		// the rewards of the previous position were withdrawn to the delegator
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(900*i.KYVE + 10*i.KYVE))
+	})
+
+	It("Delegate less than the minimum pool delegation to a pool", func() {
+		// ARRANGE
+		minPoolDelegation := s.App().DelegationKeeper.GetMinPoolDelegation(s.Ctx())
+
+		// ACT
+		s.RunTxDelegatorError(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  minPoolDelegation - 1,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetPoolDelegatorsOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  minPoolDelegation,
+		})
+
+		// a pool delegation which already reached the minimum can be increased by any amount
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  1,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetPoolDelegationAmount(s.Ctx(), i.DUMMY[0], 0)).To(Equal(minPoolDelegation + 1))
+	})
+
+	It("Undelegate from a pool and keep less than the minimum pool delegation", func() {
+		// ARRANGE
+		minPoolDelegation := s.App().DelegationKeeper.GetMinPoolDelegation(s.Ctx())
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxDelegatorError(&types.MsgUndelegateFromPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  100*i.KYVE - minPoolDelegation + 1,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(BeEmpty())
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgUndelegateFromPool{
+			Creator: i.DUMMY[0],
+			PoolId:  0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.DUMMY[0])).To(HaveLen(1))
+	})
+
+	It("Rebalance many pool delegators in batches over multiple blocks", func() {
+		// ARRANGE
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.PoolRebalanceBatchSize = 20
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		for _, delegator := range i.DUMMY {
+			s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+				Creator: delegator,
+				PoolId:  0,
+				Amount:  90 * i.KYVE,
+			})
+		}
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.CHARLIE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.CHARLIE,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_2_A,
+		})
+
+		// rebalancedDelegators returns the amount of pool delegators
+		// which have been spread across all three stakers
+		rebalancedDelegators := func() (count int) {
+			for _, delegator := range i.DUMMY {
+				if s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.CHARLIE, types.PoolDelegationAccount(0, delegator)) {
+					count++
+				}
+			}
+			return
+		}
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(rebalancedDelegators()).To(Equal(20))
+
+		poolRebalances := s.App().DelegationKeeper.GetAllPoolRebalances(s.Ctx())
+		Expect(poolRebalances).To(HaveLen(1))
+		Expect(poolRebalances[0].PoolId).To(Equal(uint64(0)))
+		Expect(poolRebalances[0].NextKey).NotTo(BeNil())
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(rebalancedDelegators()).To(Equal(40))
+		Expect(s.App().DelegationKeeper.GetAllPoolRebalances(s.Ctx())).To(HaveLen(1))
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(rebalancedDelegators()).To(Equal(50))
+		Expect(s.App().DelegationKeeper.GetAllPoolRebalances(s.Ctx())).To(BeEmpty())
+
+		for _, delegator := range i.DUMMY {
+			poolAccount := types.PoolDelegationAccount(0, delegator)
+			Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, poolAccount)).To(Equal(30 * i.KYVE))
+			Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, poolAccount)).To(Equal(30 * i.KYVE))
+			Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.CHARLIE, poolAccount)).To(Equal(30 * i.KYVE))
+		}
+	})
+
+	It("Restart the rebalance if the stakers change during a rebalance", func() {
+		// ARRANGE
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.PoolRebalanceBatchSize = 2
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		for _, delegator := range i.DUMMY[:3] {
+			s.RunTxDelegatorSuccess(&types.MsgDelegateToPool{
+				Creator: delegator,
+				PoolId:  0,
+				Amount:  90 * i.KYVE,
+			})
+		}
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.CHARLIE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.CHARLIE,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_2_A,
+		})
+
+		s.CommitAfterSeconds(1)
+
+		poolRebalances := s.App().DelegationKeeper.GetAllPoolRebalances(s.Ctx())
+		Expect(poolRebalances).To(HaveLen(1))
+		Expect(poolRebalances[0].NextKey).NotTo(BeNil())
+
+		// ACT
+		s.App().DelegationKeeper.SchedulePoolRebalance(s.Ctx(), 0)
+
+		// ASSERT
+		poolRebalances = s.App().DelegationKeeper.GetAllPoolRebalances(s.Ctx())
+		Expect(poolRebalances).To(HaveLen(1))
+		Expect(poolRebalances[0].NextKey).To(BeNil())
+
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		Expect(s.App().DelegationKeeper.GetAllPoolRebalances(s.Ctx())).To(BeEmpty())
+
+		for _, delegator := range i.DUMMY[:3] {
+			Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.CHARLIE, types.PoolDelegationAccount(0, delegator))).To(Equal(30 * i.KYVE))
+		}
+	})
+})
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetWithdrawAddress sets the address which receives the delegation rewards
+// and the commission rewards of the creator. Setting the creator itself
+// resets the withdraw address.
+func (k msgServer) SetWithdrawAddress(goCtx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Module accounts can not receive rewards, otherwise payouts would fail
+	if k.bankKeeper.BlockedAddr(sdk.MustAccAddressFromBech32(msg.WithdrawAddress)) {
+		return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrWithdrawAddressBlocked.Error(), msg.WithdrawAddress)
+	}
+
+	if msg.WithdrawAddress == msg.Creator {
+		k.RemoveWithdrawAddress(ctx, msg.Creator)
+	} else {
+		k.Keeper.SetWithdrawAddress(ctx, types.WithdrawAddress{
+			Address:         msg.Creator,
+			WithdrawAddress: msg.WithdrawAddress,
+		})
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventSetWithdrawAddress{
+		Address:         msg.Creator,
+		WithdrawAddress: msg.WithdrawAddress,
+	})
+
+	return &types.MsgSetWithdrawAddressResponse{}, nil
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_set_withdraw_address.go
+
+* Set a withdraw address and withdraw rewards
+* Set a withdraw address and undelegate
+* Reset the withdraw address to the delegator
+* Set a module account as withdraw address
+* Reject duplicated withdraw addresses in the genesis
+
+*/
+
+var _ = Describe("msg_server_set_withdraw_address.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreatePool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  0,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+	})
+
+	AfterEach(func() {
+		CheckAndContinueChainForOneMonth(s)
+	})
+
+	It("Set a withdraw address and withdraw rewards", func() {
+		// ARRANGE
+		initialBalanceDummy1 := s.GetBalanceFromAddress(i.DUMMY[1])
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		PayoutRewards(s, i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(10*i.KYVE))))
+
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawRewards{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetWithdrawAddress(s.Ctx(), i.DUMMY[0])).To(Equal(i.DUMMY[1]))
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(990 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(initialBalanceDummy1 + 10*i.KYVE))
+	})
+
+	It("Set a withdraw address and undelegate", func() {
+		// ARRANGE
+		initialBalanceDummy1 := s.GetBalanceFromAddress(i.DUMMY[1])
+
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		PayoutRewards(s, i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(10*i.KYVE))))
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgUndelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeZero())
+
+		// the undelegated amount is returned to the delegator, the rewards to the withdraw address
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(initialBalanceDummy1 + 10*i.KYVE))
+	})
+
+	It("Reset the withdraw address to the delegator", func() {
+		// ARRANGE
+		initialBalanceDummy1 := s.GetBalanceFromAddress(i.DUMMY[1])
+
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[0],
+		})
+
+		PayoutRewards(s, i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(10*i.KYVE))))
+
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawRewards{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetWithdrawAddress(s.Ctx(), i.DUMMY[0])).To(Equal(i.DUMMY[0]))
+		Expect(s.App().DelegationKeeper.GetAllWithdrawAddresses(s.Ctx())).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(1000 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(initialBalanceDummy1))
+	})
+
+	It("Set a module account as withdraw address", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: authtypes.NewModuleAddress(types.ModuleName).String(),
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetWithdrawAddress(s.Ctx(), i.DUMMY[0])).To(Equal(i.DUMMY[0]))
+	})
+
+	It("Reject duplicated withdraw addresses in the genesis", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		// ACT
+		genState := s.App().DelegationKeeper.GetAllWithdrawAddresses(s.Ctx())
+
+		// ASSERT
+		Expect(genState).To(HaveLen(1))
+		Expect(genState[0].Address).To(Equal(i.DUMMY[0]))
+		Expect(genState[0].WithdrawAddress).To(Equal(i.DUMMY[1]))
+
+		gs := types.DefaultGenesis()
+		gs.WithdrawAddressList = append(genState, genState[0])
+		Expect(gs.Validate()).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UndelegateFromPool handles the transaction of undelegating a given amount from a pool delegation.
+// Like a regular undelegation an unbonding entry is created. When the unbonding timeout is reached
+// the amount is undelegated proportionally from all stakers the pool delegation is spread across.
+func (k msgServer) UndelegateFromPool(goCtx context.Context, msg *types.MsgUndelegateFromPool) (*types.MsgUndelegateFromPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Do not allow to undelegate more than currently delegated
+	delegationAmount := k.GetPoolDelegationAmount(ctx, msg.Creator, msg.PoolId)
+	if msg.Amount > delegationAmount {
+		return nil, types.ErrNotEnoughDelegation.Wrapf("%d > %d", msg.Amount, delegationAmount)
+	}
+
+	// The remaining pool delegation has to be either zero or at least the minimum
+	minPoolDelegation := k.GetMinPoolDelegation(ctx)
+	if remaining := delegationAmount - msg.Amount; remaining > 0 && remaining < minPoolDelegation {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrPoolDelegationTooLow.Error(), remaining, minPoolDelegation)
+	}
+
+	// Create and insert unbonding queue entry.
+	k.StartUnbondingPoolDelegator(ctx, msg.PoolId, msg.Creator, msg.Amount)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventStartUndelegationFromPool{
+		Address:                   msg.Creator,
+		PoolId:                    msg.PoolId,
+		Amount:                    msg.Amount,
+		EstimatedUndelegationDate: uint64(ctx.BlockTime().Unix()) + k.GetUnbondingDelegationTime(ctx),
+	})
+
+	return &types.MsgUndelegateFromPoolResponse{}, nil
+}
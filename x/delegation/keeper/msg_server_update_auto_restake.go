@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdkErrors "cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateAutoRestake enables or disables the automatic restaking of the rewards
+// the delegator receives from the given staker. The restaking itself happens
+// in batches once every auto-restake epoch.
+func (k msgServer) UpdateAutoRestake(
+	goCtx context.Context,
+	msg *types.MsgUpdateAutoRestake,
+) (*types.MsgUpdateAutoRestakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.AutoRestake {
+		// Check if the sender has delegated to the given staker
+		if !k.DoesDelegatorExist(ctx, msg.Staker, msg.Creator) {
+			return nil, sdkErrors.Wrapf(types.ErrNotADelegator, "%s does not delegate to %s", msg.Creator, msg.Staker)
+		}
+
+		k.SetAutoRestake(ctx, types.AutoRestake{
+			Delegator: msg.Creator,
+			Staker:    msg.Staker,
+		})
+	} else {
+		k.RemoveAutoRestake(ctx, msg.Staker, msg.Creator)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateAutoRestake{
+		Address:     msg.Creator,
+		Staker:      msg.Staker,
+		AutoRestake: msg.AutoRestake,
+	})
+
+	return &types.MsgUpdateAutoRestakeResponse{}, nil
+}
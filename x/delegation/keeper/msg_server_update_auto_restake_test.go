@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_update_auto_restake.go
+
+* Enable auto-restake
+* Enable auto-restake without a delegation
+* Disable auto-restake
+* Rewards are not restaked before the next epoch
+* Restake $KYVE rewards at the next epoch
+* Rewards are not restaked if the auto-restake epoch is zero
+* Restake rewards of multiple delegators in batches
+* Auto-restake is removed after undelegating everything
+
+*/
+
+var _ = Describe("msg_server_update_auto_restake.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	const aliceSelfDelegation = 0 * i.KYVE
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreatePool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  aliceSelfDelegation,
+		})
+
+		for k := range 3 {
+			s.RunTxDelegatorSuccess(&types.MsgDelegate{
+				Creator: i.DUMMY[k],
+				Staker:  i.ALICE,
+				Amount:  10 * i.KYVE,
+			})
+		}
+	})
+
+	AfterEach(func() {
+		CheckAndContinueChainForOneMonth(s)
+	})
+
+	It("Enable auto-restake", func() {
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgUpdateAutoRestake{
+			Creator:     i.DUMMY[0],
+			Staker:      i.ALICE,
+			AutoRestake: true,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.DoesAutoRestakeExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeTrue())
+		Expect(s.App().DelegationKeeper.DoesAutoRestakeExist(s.Ctx(), i.ALICE, i.DUMMY[1])).To(BeFalse())
+	})
+
+	It("Enable auto-restake without a delegation", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgUpdateAutoRestake{
+			Creator:     i.DUMMY[3],
+			Staker:      i.ALICE,
+			AutoRestake: true,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.DoesAutoRestakeExist(s.Ctx(), i.ALICE, i.DUMMY[3])).To(BeFalse())
+	})
+
+	It("Disable auto-restake", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgUpdateAutoRestake{
+			Creator:     i.DUMMY[0],
+			Staker:      i.ALICE,
+			AutoRestake: true,
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgUpdateAutoRestake{
+			Creator:     i.DUMMY[0],
+			Staker:      i.ALICE,
+			AutoRestake: false,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.DoesAutoRestakeExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+	})
+
+	It("Rewards are not restaked before the next epoch", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgUpdateAutoRestake{
+			Creator:     i.DUMMY[0],
+			Staker:      i.ALICE,
+			AutoRestake: true,
+		})
+
+		// ACT
+		PayoutRewards(s, i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(30*i.KYVE))))
+		s.CommitAfterSeconds(60)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(globalTypes.Denom).Uint64()).To(Equal(10 * i.KYVE))
+	})
+
+	It("Restake $KYVE rewards at the next epoch", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgUpdateAutoRestake{
+			Creator:     i.DUMMY[0],
+			Staker:      i.ALICE,
+			AutoRestake: true,
+		})
+
+		initialBalanceDummy0 := s.GetCoinsFromAddress(i.DUMMY[0])
+
+		// ACT
+		PayoutRewards(s, i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(30*i.KYVE)), i.ACoin(300)))
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetAutoRestakeEpoch(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(20 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).IsZero()).To(BeTrue())
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(40 * i.KYVE))
+
+		// other denoms are transferred to the delegator
+		Expect(s.GetCoinsFromAddress(i.DUMMY[0]).String()).To(Equal(initialBalanceDummy0.Add(i.ACoin(100)).String()))
+
+		// delegators without auto-restake are not affected
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(10 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1]).AmountOf(globalTypes.Denom).Uint64()).To(Equal(10 * i.KYVE))
+	})
+
+	It("Rewards are not restaked if the auto-restake epoch is zero", func() {
+		// ARRANGE
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.AutoRestakeEpoch = 0
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxDelegatorSuccess(&types.MsgUpdateAutoRestake{
+			Creator:     i.DUMMY[0],
+			Staker:      i.ALICE,
+			AutoRestake: true,
+		})
+
+		// ACT
+		PayoutRewards(s, i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(30*i.KYVE))))
+		s.CommitAfterSeconds(types.DefaultAutoRestakeEpoch)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(globalTypes.Denom).Uint64()).To(Equal(10 * i.KYVE))
+	})
+
+	It("Restake rewards of multiple delegators in batches", func() {
+		// ARRANGE
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.AutoRestakeBatchSize = 2
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		for k := range 3 {
+			s.RunTxDelegatorSuccess(&types.MsgUpdateAutoRestake{
+				Creator:     i.DUMMY[k],
+				Staker:      i.ALICE,
+				AutoRestake: true,
+			})
+		}
+
+		PayoutRewards(s, i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(30*i.KYVE))))
+
+		restaked := func() (count int) {
+			for k := range 3 {
+				if s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[k]) == 20*i.KYVE {
+					count++
+				}
+			}
+			return
+		}
+
+		// ACT
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetAutoRestakeEpoch(s.Ctx()))
+
+		// ASSERT
+		Expect(restaked()).To(Equal(2))
+		Expect(s.App().DelegationKeeper.GetAutoRestakeState(s.Ctx()).InProgress).To(BeTrue())
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(restaked()).To(Equal(3))
+		Expect(s.App().DelegationKeeper.GetAutoRestakeState(s.Ctx()).InProgress).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(60 * i.KYVE))
+	})
+
+	It("Auto-restake is removed after undelegating everything", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgUpdateAutoRestake{
+			Creator:     i.DUMMY[0],
+			Staker:      i.ALICE,
+			AutoRestake: true,
+		})
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgUndelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.GetUnbondingDelegationTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.DoesDelegatorExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+		Expect(s.App().DelegationKeeper.DoesAutoRestakeExist(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeFalse())
+	})
+})
@@ -79,6 +79,10 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(params.VoteSlash).To(Equal(types.DefaultVoteSlash))
 		Expect(params.UploadSlash).To(Equal(types.DefaultUploadSlash))
 		Expect(params.TimeoutSlash).To(Equal(types.DefaultTimeoutSlash))
+		Expect(params.AutoRestakeEpoch).To(Equal(types.DefaultAutoRestakeEpoch))
+		Expect(params.AutoRestakeBatchSize).To(Equal(types.DefaultAutoRestakeBatchSize))
+		Expect(params.PoolRebalanceBatchSize).To(Equal(types.DefaultPoolRebalanceBatchSize))
+		Expect(params.MinPoolDelegation).To(Equal(types.DefaultMinPoolDelegation))
 	})
 
 	It("Invalid authority (transaction)", func() {
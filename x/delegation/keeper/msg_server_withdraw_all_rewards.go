@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdkErrors "cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawAllRewards withdraws the rewards of all stakers the delegator has delegated to,
+// including the stakers of its pool delegations, and transfers the balance to the
+// delegator's withdraw address. A withdraw event is emitted for every staker.
+func (k msgServer) WithdrawAllRewards(
+	goCtx context.Context,
+	msg *types.MsgWithdrawAllRewards,
+) (*types.MsgWithdrawAllRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stakers := k.GetStakersByDelegator(ctx, msg.Creator)
+	poolDelegators := k.GetPoolDelegatorsOfDelegator(ctx, msg.Creator)
+
+	// Check if the sender has delegated to any staker
+	if len(stakers) == 0 && len(poolDelegators) == 0 {
+		return nil, sdkErrors.Wrapf(types.ErrNotADelegator, "%s does not delegate to any staker", msg.Creator)
+	}
+
+	// Withdraw the rewards of every staker and send them back
+	for _, staker := range stakers {
+		if _, err := k.performWithdrawal(ctx, staker, msg.Creator); err != nil {
+			return nil, err
+		}
+	}
+
+	// Withdraw the rewards of the pool delegations, which are held by the pool delegation accounts
+	for _, poolDelegator := range poolDelegators {
+		for _, staker := range k.GetStakersByDelegator(ctx, poolDelegator.Account) {
+			if _, err := k.performWithdrawal(ctx, staker, poolDelegator.Account); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &types.MsgWithdrawAllRewardsResponse{}, nil
+}
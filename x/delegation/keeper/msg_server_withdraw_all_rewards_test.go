@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_withdraw_all_rewards.go
+
+* Withdraw all rewards without any delegation
+* Withdraw all rewards from multiple stakers
+* Withdraw all rewards with multiple denoms
+* Withdraw all rewards to a withdraw address
+
+*/
+
+var _ = Describe("msg_server_withdraw_all_rewards.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	var initialBalanceDummy0 sdk.Coins
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreatePool(s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  0,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.BOB,
+			Amount:  0,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.BOB,
+			Amount:  10 * i.KYVE,
+		})
+
+		initialBalanceDummy0 = s.GetCoinsFromAddress(i.DUMMY[0])
+	})
+
+	AfterEach(func() {
+		CheckAndContinueChainForOneMonth(s)
+	})
+
+	It("Withdraw all rewards without any delegation", func() {
+		// ACT
+		s.RunTxDelegatorError(&types.MsgWithdrawAllRewards{
+			Creator: i.DUMMY[1],
+		})
+	})
+
+	It("Withdraw all rewards from multiple stakers", func() {
+		// ARRANGE
+		PayoutRewards(s, i.ALICE, i.KYVECoins(int64(5*i.KYVE)))
+		PayoutRewards(s, i.BOB, i.KYVECoins(int64(7*i.KYVE)))
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawAllRewards{
+			Creator: i.DUMMY[0],
+		})
+
+		// ASSERT
+		Expect(s.GetCoinsFromAddress(i.DUMMY[0]).String()).To(Equal(initialBalanceDummy0.Add(i.KYVECoin(int64(12 * i.KYVE))).String()))
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeEmpty())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.BOB, i.DUMMY[0])).To(BeEmpty())
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.BOB, i.DUMMY[0])).To(Equal(10 * i.KYVE))
+	})
+
+	It("Withdraw all rewards with multiple denoms", func() {
+		// ARRANGE
+		PayoutRewards(s, i.ALICE, i.KYVECoins(int64(5*i.KYVE)))
+		PayoutRewards(s, i.BOB, i.ACoins(300))
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawAllRewards{
+			Creator: i.DUMMY[0],
+		})
+
+		// ASSERT
+		Expect(s.GetCoinsFromAddress(i.DUMMY[0]).String()).To(Equal(initialBalanceDummy0.Add(sdk.NewCoins(i.KYVECoin(int64(5*i.KYVE)), i.ACoin(300))...).String()))
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeEmpty())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.BOB, i.DUMMY[0])).To(BeEmpty())
+	})
+
+	It("Withdraw all rewards to a withdraw address", func() {
+		// ARRANGE
+		initialBalanceDummy1 := s.GetCoinsFromAddress(i.DUMMY[1])
+
+		s.RunTxDelegatorSuccess(&types.MsgSetWithdrawAddress{
+			Creator:         i.DUMMY[0],
+			WithdrawAddress: i.DUMMY[1],
+		})
+
+		PayoutRewards(s, i.ALICE, i.KYVECoins(int64(5*i.KYVE)))
+		PayoutRewards(s, i.BOB, i.KYVECoins(int64(7*i.KYVE)))
+
+		// ACT
+		s.RunTxDelegatorSuccess(&types.MsgWithdrawAllRewards{
+			Creator: i.DUMMY[0],
+		})
+
+		// ASSERT
+		Expect(s.GetCoinsFromAddress(i.DUMMY[0]).String()).To(Equal(initialBalanceDummy0.String()))
+		Expect(s.GetCoinsFromAddress(i.DUMMY[1]).String()).To(Equal(initialBalanceDummy1.Add(i.KYVECoin(int64(12 * i.KYVE))).String()))
+	})
+})
@@ -159,6 +159,8 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	am.keeper.InitMemStore(sdk.UnwrapSDKContext(ctx))
 	am.keeper.ProcessDelegatorUnbondingQueue(sdk.UnwrapSDKContext(ctx))
+	am.keeper.ProcessAutoRestakeQueue(sdk.UnwrapSDKContext(ctx))
+	am.keeper.ProcessPoolRebalances(sdk.UnwrapSDKContext(ctx))
 	return nil
 }
 
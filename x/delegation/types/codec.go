@@ -9,16 +9,30 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegate{}, "kyve/delegation/MsgDelegate", nil)
+	cdc.RegisterConcrete(&MsgDelegateMulti{}, "kyve/delegation/MsgDelegateMulti", nil)
+	cdc.RegisterConcrete(&MsgDelegateToPool{}, "kyve/delegation/MsgDelegateToPool", nil)
+	cdc.RegisterConcrete(&MsgUndelegateFromPool{}, "kyve/delegation/MsgUndelegateFromPool", nil)
 	cdc.RegisterConcrete(&MsgWithdrawRewards{}, "kyve/delegation/MsgWithdrawRewards", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAllRewards{}, "kyve/delegation/MsgWithdrawAllRewards", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "kyve/delegation/MsgUndelegate", nil)
+	cdc.RegisterConcrete(&MsgCancelUnbonding{}, "kyve/delegation/MsgCancelUnbonding", nil)
 	cdc.RegisterConcrete(&MsgRedelegate{}, "kyve/delegation/MsgRedelegate", nil)
+	cdc.RegisterConcrete(&MsgUpdateAutoRestake{}, "kyve/delegation/MsgUpdateAutoRestake", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "kyve/delegation/MsgSetWithdrawAddress", nil)
 }
 
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDelegate{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDelegateMulti{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDelegateToPool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUndelegateFromPool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawRewards{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawAllRewards{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUndelegate{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelUnbonding{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRedelegate{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateAutoRestake{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetWithdrawAddress{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
 }
 
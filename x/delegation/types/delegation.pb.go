@@ -366,6 +366,10 @@ type UndelegationQueueEntry struct {
 	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	// creation_time ...
 	CreationTime uint64 `protobuf:"varint,5,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// pool_delegation is true if the entry undelegates from a pool delegation
+	PoolDelegation bool `protobuf:"varint,6,opt,name=pool_delegation,json=poolDelegation,proto3" json:"pool_delegation,omitempty"`
+	// pool_id of the pool delegation, only set if pool_delegation is true
+	PoolId uint64 `protobuf:"varint,7,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
 func (m *UndelegationQueueEntry) Reset()         { *m = UndelegationQueueEntry{} }
@@ -436,6 +440,20 @@ func (m *UndelegationQueueEntry) GetCreationTime() uint64 {
 	return 0
 }
 
+func (m *UndelegationQueueEntry) GetPoolDelegation() bool {
+	if m != nil {
+		return m.PoolDelegation
+	}
+	return false
+}
+
+func (m *UndelegationQueueEntry) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 // QueueState ...
 type QueueState struct {
 	// low_index ...
@@ -546,6 +564,306 @@ func (m *RedelegationCooldown) GetCreationDate() uint64 {
 	return 0
 }
 
+// AutoRestake marks that the delegator has enabled the automatic
+// restaking of its rewards from the given staker.
+type AutoRestake struct {
+	// delegator ...
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+}
+
+func (m *AutoRestake) Reset()         { *m = AutoRestake{} }
+func (m *AutoRestake) String() string { return proto.CompactTextString(m) }
+func (*AutoRestake) ProtoMessage()    {}
+func (*AutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{7}
+}
+func (m *AutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRestake.Merge(m, src)
+}
+func (m *AutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRestake proto.InternalMessageInfo
+
+func (m *AutoRestake) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *AutoRestake) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+// AutoRestakeState stores the progress of the auto-restake epochs,
+// which are processed in batches over multiple blocks.
+type AutoRestakeState struct {
+	// next_epoch is the UNIX-timestamp in seconds when the next epoch starts
+	NextEpoch uint64 `protobuf:"varint,1,opt,name=next_epoch,json=nextEpoch,proto3" json:"next_epoch,omitempty"`
+	// in_progress is true while the entries of the current epoch are processed
+	InProgress bool `protobuf:"varint,2,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	// next_key is the store key of the next entry which gets processed
+	NextKey []byte `protobuf:"bytes,3,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *AutoRestakeState) Reset()         { *m = AutoRestakeState{} }
+func (m *AutoRestakeState) String() string { return proto.CompactTextString(m) }
+func (*AutoRestakeState) ProtoMessage()    {}
+func (*AutoRestakeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{8}
+}
+func (m *AutoRestakeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRestakeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRestakeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRestakeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRestakeState.Merge(m, src)
+}
+func (m *AutoRestakeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRestakeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRestakeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRestakeState proto.InternalMessageInfo
+
+func (m *AutoRestakeState) GetNextEpoch() uint64 {
+	if m != nil {
+		return m.NextEpoch
+	}
+	return 0
+}
+
+func (m *AutoRestakeState) GetInProgress() bool {
+	if m != nil {
+		return m.InProgress
+	}
+	return false
+}
+
+func (m *AutoRestakeState) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+// WithdrawAddress stores the address which receives the
+// rewards of a delegator or the commission rewards of a staker.
+type WithdrawAddress struct {
+	// address of the delegator or staker
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// withdraw_address which receives the rewards
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *WithdrawAddress) Reset()         { *m = WithdrawAddress{} }
+func (m *WithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*WithdrawAddress) ProtoMessage()    {}
+func (*WithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{9}
+}
+func (m *WithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawAddress.Merge(m, src)
+}
+func (m *WithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawAddress proto.InternalMessageInfo
+
+func (m *WithdrawAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// PoolDelegator stores a delegation to a pool. The delegated $KYVE is held by
+// a derived account which delegates it in equal parts to the current stakers
+// of the pool.
+type PoolDelegator struct {
+	// delegator ...
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// account is the derived address which holds the delegations to the stakers
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *PoolDelegator) Reset()         { *m = PoolDelegator{} }
+func (m *PoolDelegator) String() string { return proto.CompactTextString(m) }
+func (*PoolDelegator) ProtoMessage()    {}
+func (*PoolDelegator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{10}
+}
+func (m *PoolDelegator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDelegator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDelegator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDelegator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDelegator.Merge(m, src)
+}
+func (m *PoolDelegator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDelegator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDelegator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDelegator proto.InternalMessageInfo
+
+func (m *PoolDelegator) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *PoolDelegator) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolDelegator) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// PoolRebalance marks the pool delegations of a pool to be spread again across
+// the current stakers. The pool delegators are processed in batches over
+// multiple blocks.
+type PoolRebalance struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// next_key is the store key of the next pool delegator which gets rebalanced
+	NextKey []byte `protobuf:"bytes,2,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *PoolRebalance) Reset()         { *m = PoolRebalance{} }
+func (m *PoolRebalance) String() string { return proto.CompactTextString(m) }
+func (*PoolRebalance) ProtoMessage()    {}
+func (*PoolRebalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{11}
+}
+func (m *PoolRebalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRebalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRebalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRebalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRebalance.Merge(m, src)
+}
+func (m *PoolRebalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRebalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRebalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRebalance proto.InternalMessageInfo
+
+func (m *PoolRebalance) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolRebalance) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("kyve.delegation.v1beta1.SlashType", SlashType_name, SlashType_value)
 	proto.RegisterType((*Delegator)(nil), "kyve.delegation.v1beta1.Delegator")
@@ -555,6 +873,11 @@ func init() {
 	proto.RegisterType((*UndelegationQueueEntry)(nil), "kyve.delegation.v1beta1.UndelegationQueueEntry")
 	proto.RegisterType((*QueueState)(nil), "kyve.delegation.v1beta1.QueueState")
 	proto.RegisterType((*RedelegationCooldown)(nil), "kyve.delegation.v1beta1.RedelegationCooldown")
+	proto.RegisterType((*AutoRestake)(nil), "kyve.delegation.v1beta1.AutoRestake")
+	proto.RegisterType((*AutoRestakeState)(nil), "kyve.delegation.v1beta1.AutoRestakeState")
+	proto.RegisterType((*WithdrawAddress)(nil), "kyve.delegation.v1beta1.WithdrawAddress")
+	proto.RegisterType((*PoolDelegator)(nil), "kyve.delegation.v1beta1.PoolDelegator")
+	proto.RegisterType((*PoolRebalance)(nil), "kyve.delegation.v1beta1.PoolRebalance")
 }
 
 func init() {
@@ -562,55 +885,66 @@ func init() {
 }
 
 var fileDescriptor_e07f10cb3da486ac = []byte{
-	// 764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0x8e, 0x13, 0x08, 0xe4, 0x2d, 0x24, 0x61, 0x96, 0x0d, 0xd9, 0x00, 0x26, 0x0a, 0xbb, 0xda,
-	0x2c, 0xab, 0xb5, 0xc5, 0xae, 0x56, 0xda, 0x5b, 0x15, 0xe2, 0x54, 0x44, 0x50, 0xa0, 0xf9, 0x41,
-	0x45, 0x2f, 0xd6, 0xc4, 0x9e, 0x26, 0x96, 0x1d, 0x0f, 0xb5, 0x27, 0x84, 0x1c, 0xab, 0x5e, 0x7a,
-	0xec, 0xbf, 0x50, 0xf5, 0x52, 0xf5, 0xc4, 0x9f, 0xc1, 0x91, 0x63, 0xd5, 0x03, 0x45, 0x70, 0xe8,
-	0xbf, 0x51, 0x65, 0xec, 0x24, 0x93, 0xaa, 0x48, 0xed, 0x25, 0xf1, 0xfb, 0xe6, 0xf9, 0x7d, 0xdf,
-	0x7c, 0xef, 0x3d, 0x43, 0xd1, 0x1e, 0x9c, 0x11, 0xd5, 0x24, 0x0e, 0x69, 0x63, 0x66, 0x51, 0x57,
-	0x3d, 0xdb, 0x6e, 0x11, 0x86, 0xb7, 0x05, 0x48, 0x39, 0xf5, 0x28, 0xa3, 0x68, 0x65, 0x98, 0xa9,
-	0x08, 0x70, 0x98, 0x99, 0x5b, 0xc2, 0x5d, 0xcb, 0xa5, 0x2a, 0xff, 0x0d, 0x72, 0x73, 0xb2, 0x41,
-	0xfd, 0x2e, 0xf5, 0xd5, 0x16, 0xf6, 0xc9, 0xb8, 0xa2, 0x41, 0xad, 0xb0, 0x56, 0x6e, 0xb9, 0x4d,
-	0xdb, 0x94, 0x3f, 0xaa, 0xc3, 0xa7, 0x00, 0x2d, 0xbc, 0x90, 0x20, 0xa1, 0x05, 0xf5, 0xa9, 0x87,
-	0x32, 0x10, 0xf7, 0x19, 0xb6, 0x89, 0x97, 0x95, 0xf2, 0x52, 0x31, 0x51, 0x0b, 0x23, 0xb4, 0x06,
-	0x09, 0x73, 0x94, 0x94, 0x8d, 0xf2, 0xa3, 0x09, 0x80, 0x56, 0x60, 0xce, 0xd6, 0x2d, 0xd7, 0x24,
-	0xe7, 0xd9, 0x58, 0x5e, 0x2a, 0xce, 0xd4, 0xe2, 0x76, 0x75, 0x18, 0xa1, 0xdf, 0x21, 0x69, 0xb9,
-	0x16, 0xb3, 0xb0, 0xa3, 0xe3, 0x2e, 0xed, 0xb9, 0x2c, 0x3b, 0xc3, 0xcf, 0x17, 0x43, 0xb4, 0xc4,
-	0xc1, 0xc2, 0x85, 0x04, 0x29, 0x6d, 0x7c, 0xc7, 0x8a, 0xcb, 0xbc, 0xc1, 0xbd, 0x4a, 0x04, 0xae,
-	0xe8, 0x14, 0x97, 0x03, 0xb3, 0x67, 0xd8, 0xe9, 0x91, 0x6c, 0x2c, 0x1f, 0x2b, 0xfe, 0xf4, 0xcf,
-	0x9a, 0x12, 0xd8, 0xa1, 0x0c, 0xed, 0x18, 0xd9, 0xa6, 0x68, 0xc4, 0x28, 0x53, 0xcb, 0xdd, 0xf9,
-	0xff, 0xf2, 0x7a, 0x23, 0xf2, 0xfe, 0xd3, 0xc6, 0x5f, 0x6d, 0x8b, 0x75, 0x7a, 0x2d, 0xc5, 0xa0,
-	0x5d, 0x35, 0xb4, 0x2f, 0xf8, 0xfb, 0xdb, 0x37, 0x6d, 0x95, 0x0d, 0x4e, 0x89, 0x3f, 0x7a, 0xc7,
-	0x7f, 0xf7, 0xf9, 0x62, 0x4b, 0xaa, 0x05, 0x24, 0x85, 0x9b, 0x28, 0x24, 0x27, 0x92, 0x35, 0xcc,
-	0xf0, 0xbd, 0x8a, 0x07, 0x90, 0x32, 0x7a, 0x9e, 0x47, 0x5c, 0xa6, 0x7b, 0xa4, 0x8f, 0x3d, 0xd3,
-	0xcf, 0x46, 0xb9, 0xc4, 0x5f, 0xbf, 0x29, 0x91, 0xeb, 0xfb, 0x2f, 0xd4, 0x57, 0xfc, 0x0e, 0x7d,
-	0x82, 0xb8, 0x64, 0x48, 0x54, 0x0b, 0x78, 0xd0, 0x9f, 0x90, 0x66, 0x94, 0x61, 0x47, 0x9f, 0x4c,
-	0x50, 0xd8, 0xa1, 0x14, 0xc7, 0x27, 0x37, 0x40, 0xbf, 0x41, 0xd2, 0xc1, 0x8c, 0xf8, 0x2c, 0x30,
-	0x57, 0xb7, 0xc3, 0x56, 0x2d, 0x04, 0x28, 0xf7, 0x78, 0x0f, 0xfd, 0x01, 0xa9, 0x71, 0xdb, 0x75,
-	0x83, 0x77, 0x74, 0x96, 0xa7, 0x25, 0xc7, 0x70, 0x79, 0x88, 0xa2, 0x12, 0xac, 0x4f, 0x95, 0xeb,
-	0x63, 0x5f, 0xef, 0xb9, 0x82, 0x8c, 0x78, 0x5e, 0x2a, 0xce, 0xd7, 0x72, 0x42, 0xf5, 0x27, 0xd8,
-	0x6f, 0x0a, 0x19, 0x85, 0x97, 0x53, 0x53, 0x51, 0x77, 0xb0, 0xdf, 0xf9, 0xf1, 0xa9, 0x78, 0x00,
-	0xf3, 0xcf, 0x3c, 0x6c, 0x8c, 0x6f, 0x9e, 0xd8, 0xd9, 0x1c, 0x5a, 0xfb, 0xf1, 0x7a, 0x63, 0x35,
-	0x30, 0xd2, 0x37, 0x6d, 0xc5, 0xa2, 0x6a, 0x17, 0xb3, 0x8e, 0xb2, 0x4f, 0xda, 0xd8, 0x18, 0x68,
-	0xc4, 0xa8, 0x8d, 0x5f, 0x2a, 0xbc, 0x91, 0x20, 0x23, 0xca, 0x7a, 0xdc, 0x23, 0x3d, 0x12, 0x8c,
-	0xe8, 0x32, 0xcc, 0x06, 0x94, 0x12, 0xa7, 0x0c, 0x02, 0x41, 0x62, 0xf4, 0xfe, 0x15, 0x8a, 0x7d,
-	0xbd, 0x42, 0x19, 0x88, 0x4f, 0x6d, 0x48, 0x18, 0xa1, 0x4d, 0x58, 0x34, 0x3c, 0xc2, 0x99, 0x75,
-	0x66, 0x75, 0x49, 0x68, 0xf7, 0xc2, 0x08, 0x6c, 0x58, 0x5d, 0x52, 0xd8, 0x05, 0xe0, 0xb2, 0xea,
-	0x0c, 0x33, 0x82, 0x56, 0x21, 0xe1, 0xd0, 0xbe, 0x2e, 0x4a, 0x9b, 0x77, 0x68, 0x3f, 0xf0, 0x63,
-	0x1d, 0xa0, 0x63, 0xb5, 0x3b, 0x53, 0x5e, 0x25, 0x86, 0x08, 0x3f, 0x2e, 0x34, 0x61, 0xb9, 0x46,
-	0x26, 0x97, 0x2d, 0x53, 0xea, 0x98, 0xb4, 0xef, 0xa2, 0x2c, 0xcc, 0x61, 0xd3, 0xf4, 0x88, 0xef,
-	0x87, 0xc6, 0x8f, 0xc2, 0x29, 0x81, 0x26, 0x66, 0x24, 0xac, 0x39, 0x16, 0xa8, 0x61, 0x46, 0xb6,
-	0x9e, 0x43, 0x82, 0xf7, 0xaf, 0x31, 0x38, 0x25, 0x28, 0x07, 0x99, 0xfa, 0x7e, 0xa9, 0xbe, 0xab,
-	0x37, 0x4e, 0x8e, 0x2a, 0x7a, 0xf3, 0xa0, 0x7e, 0x54, 0x29, 0x57, 0x1f, 0x56, 0x2b, 0x5a, 0x3a,
-	0x82, 0x32, 0x80, 0x84, 0xb3, 0x46, 0xf5, 0x51, 0xe5, 0xb0, 0xd9, 0x48, 0x4b, 0xe8, 0x67, 0x48,
-	0x09, 0xf8, 0xf1, 0x61, 0xa3, 0x92, 0x8e, 0xa2, 0x5f, 0x60, 0x49, 0x2c, 0x74, 0xb4, 0x7f, 0x58,
-	0xd2, 0xd2, 0xb1, 0xdc, 0xcc, 0xab, 0xb7, 0x72, 0x64, 0xa7, 0x7a, 0x79, 0x2b, 0x4b, 0x57, 0xb7,
-	0xb2, 0x74, 0x73, 0x2b, 0x4b, 0xaf, 0xef, 0xe4, 0xc8, 0xd5, 0x9d, 0x1c, 0xf9, 0x70, 0x27, 0x47,
-	0x9e, 0xaa, 0xc2, 0x4e, 0xed, 0x9d, 0x1c, 0x57, 0x0e, 0x08, 0xeb, 0x53, 0xcf, 0x56, 0x8d, 0x0e,
-	0xb6, 0x5c, 0xf5, 0x5c, 0xfc, 0x2e, 0xf3, 0x05, 0x6b, 0xc5, 0xf9, 0x97, 0xf2, 0xdf, 0x2f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x33, 0x14, 0xb7, 0x80, 0xb7, 0x05, 0x00, 0x00,
+	// 934 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x73, 0x22, 0x45,
+	0x14, 0x66, 0x86, 0x0d, 0x84, 0x97, 0x04, 0xd8, 0x36, 0x12, 0x96, 0xdd, 0x90, 0xd4, 0xac, 0x96,
+	0xec, 0x5a, 0x32, 0xb5, 0x5a, 0x56, 0x79, 0xb3, 0x08, 0x60, 0x2d, 0x95, 0xb8, 0xc1, 0x81, 0x64,
+	0x6b, 0xbd, 0x8c, 0xcd, 0x4c, 0x0b, 0x5d, 0x0c, 0xd3, 0x38, 0xd3, 0x84, 0x70, 0xb4, 0xbc, 0x78,
+	0xf4, 0x3f, 0x78, 0xb1, 0x3c, 0xed, 0xcf, 0xd8, 0xe3, 0x1e, 0x2d, 0x0f, 0xeb, 0x56, 0x72, 0xf0,
+	0x6f, 0x58, 0xd3, 0x3d, 0x40, 0x8f, 0x26, 0xa5, 0x5e, 0x60, 0xde, 0xd7, 0xaf, 0xdf, 0xfb, 0xde,
+	0xf7, 0x75, 0xcf, 0x40, 0x6d, 0xbc, 0xb8, 0x20, 0xa6, 0x4b, 0x3c, 0x32, 0xc4, 0x9c, 0x32, 0xdf,
+	0xbc, 0x78, 0x32, 0x20, 0x1c, 0x3f, 0x51, 0xa0, 0xfa, 0x34, 0x60, 0x9c, 0xa1, 0xbd, 0x28, 0xb3,
+	0xae, 0xc0, 0x71, 0x66, 0xe5, 0x2e, 0x9e, 0x50, 0x9f, 0x99, 0xe2, 0x57, 0xe6, 0x56, 0xaa, 0x0e,
+	0x0b, 0x27, 0x2c, 0x34, 0x07, 0x38, 0x24, 0xab, 0x8a, 0x0e, 0xa3, 0x71, 0xad, 0xca, 0xee, 0x90,
+	0x0d, 0x99, 0x78, 0x34, 0xa3, 0x27, 0x89, 0x1a, 0xdf, 0x6b, 0x90, 0x6b, 0xc9, 0xfa, 0x2c, 0x40,
+	0x25, 0xc8, 0x84, 0x1c, 0x8f, 0x49, 0x50, 0xd6, 0x0e, 0xb5, 0x5a, 0xce, 0x8a, 0x23, 0xf4, 0x00,
+	0x72, 0xee, 0x32, 0xa9, 0xac, 0x8b, 0xa5, 0x35, 0x80, 0xf6, 0x20, 0x3b, 0xb6, 0xa9, 0xef, 0x92,
+	0xcb, 0x72, 0xfa, 0x50, 0xab, 0xdd, 0xb1, 0x32, 0xe3, 0x4e, 0x14, 0xa1, 0xf7, 0x21, 0x4f, 0x7d,
+	0xca, 0x29, 0xf6, 0x6c, 0x3c, 0x61, 0x33, 0x9f, 0x97, 0xef, 0x88, 0xf5, 0x9d, 0x18, 0x6d, 0x08,
+	0xd0, 0x78, 0xa9, 0x41, 0xa1, 0xb5, 0x9a, 0xb1, 0xed, 0xf3, 0x60, 0x71, 0x2b, 0x13, 0xa5, 0x97,
+	0x9e, 0xe8, 0xe5, 0xc1, 0xc6, 0x05, 0xf6, 0x66, 0xa4, 0x9c, 0x3e, 0x4c, 0xd7, 0xb6, 0x3e, 0x7e,
+	0x50, 0x97, 0x72, 0xd4, 0x23, 0x39, 0x96, 0xb2, 0xd5, 0x5b, 0xc4, 0x69, 0x32, 0xea, 0x1f, 0x7d,
+	0xf6, 0xea, 0xcd, 0x41, 0xea, 0xd7, 0x3f, 0x0e, 0x3e, 0x1c, 0x52, 0x3e, 0x9a, 0x0d, 0xea, 0x0e,
+	0x9b, 0x98, 0xb1, 0x7c, 0xf2, 0xef, 0xa3, 0xd0, 0x1d, 0x9b, 0x7c, 0x31, 0x25, 0xe1, 0x72, 0x4f,
+	0xf8, 0xcb, 0x9f, 0x2f, 0x1f, 0x6b, 0x96, 0x6c, 0x62, 0xbc, 0xd5, 0x21, 0xbf, 0xa6, 0xdc, 0xc2,
+	0x1c, 0xdf, 0xca, 0x78, 0x01, 0x05, 0x67, 0x16, 0x04, 0xc4, 0xe7, 0x76, 0x40, 0xe6, 0x38, 0x70,
+	0xc3, 0xb2, 0x2e, 0x28, 0xde, 0xbb, 0x91, 0xa2, 0xe0, 0xf7, 0x69, 0xcc, 0xaf, 0xf6, 0x1f, 0xf8,
+	0x29, 0xe4, 0xf2, 0x71, 0x23, 0x4b, 0xf6, 0x41, 0x8f, 0xa0, 0xc8, 0x19, 0xc7, 0x9e, 0xbd, 0x3e,
+	0x41, 0xb1, 0x43, 0x05, 0x81, 0xaf, 0x27, 0x40, 0xef, 0x41, 0xde, 0xc3, 0x9c, 0x84, 0x5c, 0x8a,
+	0x6b, 0x8f, 0x63, 0xab, 0xb6, 0x25, 0x2a, 0x34, 0x3e, 0x46, 0x1f, 0x40, 0x61, 0x65, 0xbb, 0xed,
+	0x08, 0x47, 0x37, 0x44, 0x5a, 0x7e, 0x05, 0x37, 0x23, 0x14, 0x35, 0x60, 0x3f, 0x51, 0x6e, 0x8e,
+	0x43, 0x7b, 0xe6, 0x2b, 0x34, 0x32, 0x87, 0x5a, 0x6d, 0xd3, 0xaa, 0x28, 0xd5, 0x9f, 0xe3, 0xf0,
+	0x4c, 0xc9, 0x30, 0x7e, 0x48, 0x9c, 0x8a, 0x9e, 0x87, 0xc3, 0xd1, 0xff, 0x3f, 0x15, 0x9f, 0xc3,
+	0xe6, 0xb7, 0x01, 0x76, 0x56, 0x93, 0xe7, 0x8e, 0x1e, 0x46, 0xd2, 0xfe, 0xfe, 0xe6, 0xe0, 0xbe,
+	0x14, 0x32, 0x74, 0xc7, 0x75, 0xca, 0xcc, 0x09, 0xe6, 0xa3, 0xfa, 0x09, 0x19, 0x62, 0x67, 0xd1,
+	0x22, 0x8e, 0xb5, 0xda, 0x64, 0x5c, 0x6b, 0x50, 0x52, 0x69, 0x7d, 0x35, 0x23, 0x33, 0x22, 0x8f,
+	0xe8, 0x2e, 0x6c, 0xc8, 0x96, 0x9a, 0x68, 0x29, 0x03, 0x85, 0xa2, 0x7e, 0xfb, 0x15, 0x4a, 0xff,
+	0xfd, 0x0a, 0x95, 0x20, 0x93, 0xb8, 0x21, 0x71, 0x84, 0x1e, 0xc2, 0x8e, 0x13, 0x10, 0xd1, 0xd9,
+	0xe6, 0x74, 0x42, 0x62, 0xb9, 0xb7, 0x97, 0x60, 0x9f, 0x4e, 0x48, 0xe4, 0xca, 0x94, 0x31, 0xcf,
+	0xfe, 0x87, 0xbc, 0xf9, 0x08, 0x56, 0x4c, 0xde, 0x83, 0xac, 0x48, 0xa4, 0x6e, 0x39, 0x2b, 0xdb,
+	0x44, 0x61, 0xc7, 0x35, 0x9e, 0x02, 0x88, 0xc1, 0x7a, 0x1c, 0x73, 0x82, 0xee, 0x43, 0xce, 0x63,
+	0x73, 0x5b, 0x1d, 0x6e, 0xd3, 0x63, 0x73, 0xa9, 0xe8, 0x3e, 0xc0, 0x88, 0x0e, 0x47, 0x09, 0xb5,
+	0x73, 0x11, 0x22, 0x96, 0x8d, 0x33, 0xd8, 0xb5, 0xc8, 0x9a, 0x48, 0x93, 0x31, 0xcf, 0x65, 0x73,
+	0x1f, 0x95, 0x21, 0x8b, 0x5d, 0x37, 0x20, 0x61, 0x18, 0x5b, 0xb7, 0x0c, 0x13, 0x23, 0xba, 0x98,
+	0x93, 0xb2, 0x9e, 0x1c, 0xb1, 0x85, 0x39, 0x31, 0x9a, 0xb0, 0xd5, 0x98, 0x71, 0x66, 0x11, 0xa1,
+	0x66, 0x52, 0x4c, 0xed, 0x06, 0x31, 0x6f, 0xb2, 0xc0, 0x98, 0x40, 0x51, 0x29, 0x22, 0x67, 0xdd,
+	0x07, 0xf0, 0xc9, 0x25, 0xb7, 0xc9, 0x94, 0x39, 0xa3, 0x78, 0xd8, 0x5c, 0x84, 0xb4, 0x23, 0x00,
+	0x1d, 0xc0, 0x16, 0xf5, 0xed, 0x69, 0xc0, 0x86, 0x82, 0xba, 0x2e, 0x64, 0x05, 0xea, 0x77, 0x63,
+	0x04, 0xdd, 0x83, 0x4d, 0xb1, 0x7f, 0x4c, 0x16, 0xc2, 0xd5, 0x6d, 0x2b, 0x1b, 0xc5, 0xc7, 0x64,
+	0x61, 0x9c, 0x43, 0xe1, 0x39, 0xe5, 0x23, 0x37, 0xc0, 0xf3, 0x46, 0x3c, 0xeb, 0xed, 0x2a, 0x3c,
+	0x82, 0xe2, 0x3c, 0x4e, 0xb6, 0x97, 0x29, 0x92, 0x7d, 0x61, 0x9e, 0x2c, 0x62, 0x7c, 0x03, 0x3b,
+	0xdd, 0xb5, 0xaf, 0x2c, 0xf8, 0x17, 0x35, 0x14, 0xd3, 0x75, 0xd5, 0x74, 0x41, 0xc6, 0x91, 0x97,
+	0x38, 0x1d, 0x93, 0x91, 0xa1, 0xd1, 0x94, 0x1d, 0x2c, 0x32, 0xc0, 0x1e, 0xf6, 0x1d, 0xa2, 0xd6,
+	0xd0, 0x12, 0x35, 0xd4, 0xf1, 0xf5, 0xc4, 0xf8, 0x8f, 0xbf, 0x83, 0x9c, 0xb8, 0xb4, 0xfd, 0xc5,
+	0x94, 0xa0, 0x0a, 0x94, 0x7a, 0x27, 0x8d, 0xde, 0x53, 0xbb, 0xff, 0xa2, 0xdb, 0xb6, 0xcf, 0x9e,
+	0xf5, 0xba, 0xed, 0x66, 0xe7, 0x8b, 0x4e, 0xbb, 0x55, 0x4c, 0xa1, 0x12, 0x20, 0x65, 0xad, 0xdf,
+	0xf9, 0xb2, 0x7d, 0x7a, 0xd6, 0x2f, 0x6a, 0xe8, 0x1d, 0x28, 0x28, 0xf8, 0xf9, 0x69, 0xbf, 0x5d,
+	0xd4, 0xd1, 0xbb, 0x70, 0x57, 0x2d, 0xd4, 0x3d, 0x39, 0x6d, 0xb4, 0x8a, 0xe9, 0xca, 0x9d, 0x1f,
+	0x7f, 0xae, 0xa6, 0x8e, 0x3a, 0xaf, 0xae, 0xaa, 0xda, 0xeb, 0xab, 0xaa, 0xf6, 0xf6, 0xaa, 0xaa,
+	0xfd, 0x74, 0x5d, 0x4d, 0xbd, 0xbe, 0xae, 0xa6, 0x7e, 0xbb, 0xae, 0xa6, 0xbe, 0x36, 0x95, 0x17,
+	0xe9, 0xf1, 0x8b, 0xf3, 0xf6, 0x33, 0xc2, 0xe7, 0x2c, 0x18, 0x9b, 0xce, 0x08, 0x53, 0xdf, 0xbc,
+	0x54, 0x3f, 0xc6, 0xe2, 0xad, 0x3a, 0xc8, 0x88, 0xcf, 0xe3, 0x27, 0x7f, 0x0d, 0x00, 0x15, 0x4b,
+	0xc8, 0x05, 0xac, 0x07, 0x00, 0x00,
 }
 
 func (m *Delegator) Marshal() (dAtA []byte, err error) {
@@ -843,6 +1177,21 @@ func (m *UndelegationQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PoolDelegation {
+		i--
+		if m.PoolDelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.CreationTime != 0 {
 		i = encodeVarintDelegation(dAtA, i, uint64(m.CreationTime))
 		i--
@@ -943,46 +1292,242 @@ func (m *RedelegationCooldown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDelegation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Delegator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovDelegation(uint64(l))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovDelegation(uint64(l))
-	}
-	if m.KIndex != 0 {
-		n += 1 + sovDelegation(uint64(m.KIndex))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.InitialAmount != 0 {
-		n += 1 + sovDelegation(uint64(m.InitialAmount))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AutoRestakeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *AutoRestakeState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRestakeState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InProgress {
+		i--
+		if m.InProgress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NextEpoch != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.NextEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolDelegator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDelegator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolRebalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRebalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRebalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Delegator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.KIndex != 0 {
+		n += 1 + sovDelegation(uint64(m.KIndex))
+	}
+	if m.InitialAmount != 0 {
+		n += 1 + sovDelegation(uint64(m.InitialAmount))
+	}
+	return n
+}
+
+func (m *DelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Staker)
 	if l > 0 {
 		n += 1 + l + sovDelegation(uint64(l))
@@ -1071,6 +1616,12 @@ func (m *UndelegationQueueEntry) Size() (n int) {
 	if m.CreationTime != 0 {
 		n += 1 + sovDelegation(uint64(m.CreationTime))
 	}
+	if m.PoolDelegation {
+		n += 2
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovDelegation(uint64(m.PoolId))
+	}
 	return n
 }
 
@@ -1105,6 +1656,95 @@ func (m *RedelegationCooldown) Size() (n int) {
 	return n
 }
 
+func (m *AutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoRestakeState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextEpoch != 0 {
+		n += 1 + sovDelegation(uint64(m.NextEpoch))
+	}
+	if m.InProgress {
+		n += 2
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolDelegator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovDelegation(uint64(m.PoolId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolRebalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovDelegation(uint64(m.PoolId))
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
 func sovDelegation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1876,14 +2516,53 @@ func (m *UndelegationQueueEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDelegation(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDelegation", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDelegation
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PoolDelegation = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -2086,6 +2765,593 @@ func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoRestake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRestakeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRestakeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRestakeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpoch", wireType)
+			}
+			m.NextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InProgress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InProgress = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolDelegator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolDelegator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolDelegator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRebalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRebalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRebalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDelegation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,4 +11,9 @@ var (
 	ErrMultipleRedelegationInSameBlock = sdkErrors.Register(ModuleName, 1003, "only one redelegation per delegator per block")
 	ErrStakerDoesNotExist              = sdkErrors.Register(ModuleName, 1004, "staker does not exist")
 	ErrRedelegationToInactiveStaker    = sdkErrors.Register(ModuleName, 1005, "redelegation to inactive staker not allowed")
+	ErrUnbondingEntryNotFound          = sdkErrors.Register(ModuleName, 1006, "unbonding entry with index %d not found")
+	ErrNotEnoughUnbonding              = sdkErrors.Register(ModuleName, 1007, "cancel-amount is larger than current unbonding")
+	ErrWithdrawAddressBlocked          = sdkErrors.Register(ModuleName, 1008, "%s is not allowed to receive rewards")
+	ErrPoolHasNoStakers                = sdkErrors.Register(ModuleName, 1009, "pool %d has no stakers to delegate to")
+	ErrPoolDelegationTooLow            = sdkErrors.Register(ModuleName, 1010, "pool delegation of %d is below the minimum pool delegation of %d")
 )
@@ -228,6 +228,80 @@ func (m *EventStartUndelegation) GetEstimatedUndelegationDate() uint64 {
 	return 0
 }
 
+// EventCancelUnbonding is an event emitted when someone cancels an unbonding delegation.
+// emitted_by: MsgCancelUnbonding
+type EventCancelUnbonding struct {
+	// address is the address of the delegator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// staker is the address of the protocol node.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// index is the index of the undelegation queue entry.
+	Index uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// amount is the amount which is no longer being undelegated.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventCancelUnbonding) Reset()         { *m = EventCancelUnbonding{} }
+func (m *EventCancelUnbonding) String() string { return proto.CompactTextString(m) }
+func (*EventCancelUnbonding) ProtoMessage()    {}
+func (*EventCancelUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01988a9108a2e89, []int{3}
+}
+func (m *EventCancelUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelUnbonding.Merge(m, src)
+}
+func (m *EventCancelUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelUnbonding proto.InternalMessageInfo
+
+func (m *EventCancelUnbonding) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventCancelUnbonding) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventCancelUnbonding) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *EventCancelUnbonding) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 // EventUndelegate is an event emitted when someone undelegates from a protocol node.
 // emitted_by: EndBlock
 type EventUndelegate struct {
@@ -243,7 +317,7 @@ func (m *EventUndelegate) Reset()         { *m = EventUndelegate{} }
 func (m *EventUndelegate) String() string { return proto.CompactTextString(m) }
 func (*EventUndelegate) ProtoMessage()    {}
 func (*EventUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d01988a9108a2e89, []int{3}
+	return fileDescriptor_d01988a9108a2e89, []int{4}
 }
 func (m *EventUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,7 +384,7 @@ func (m *EventRedelegate) Reset()         { *m = EventRedelegate{} }
 func (m *EventRedelegate) String() string { return proto.CompactTextString(m) }
 func (*EventRedelegate) ProtoMessage()    {}
 func (*EventRedelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d01988a9108a2e89, []int{4}
+	return fileDescriptor_d01988a9108a2e89, []int{5}
 }
 func (m *EventRedelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +456,7 @@ func (m *EventWithdrawRewards) Reset()         { *m = EventWithdrawRewards{} }
 func (m *EventWithdrawRewards) String() string { return proto.CompactTextString(m) }
 func (*EventWithdrawRewards) ProtoMessage()    {}
 func (*EventWithdrawRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d01988a9108a2e89, []int{5}
+	return fileDescriptor_d01988a9108a2e89, []int{6}
 }
 func (m *EventWithdrawRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,31 +506,30 @@ func (m *EventWithdrawRewards) GetAmounts() string {
 	return ""
 }
 
-// EventSlash is an event emitted when a protocol node is slashed.
-// emitted_by: MsgSubmitBundleProposal, EndBlock
-type EventSlash struct {
-	// pool_id is the unique ID of the pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+// EventUpdateAutoRestake is an event emitted when a delegator enables or
+// disables the automatic restaking of its rewards.
+// emitted_by: MsgUpdateAutoRestake
+type EventUpdateAutoRestake struct {
+	// address is the account address of the delegator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// staker is the account address of the protocol node.
 	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
-	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// slash_type
-	SlashType SlashType `protobuf:"varint,4,opt,name=slash_type,json=slashType,proto3,enum=kyve.delegation.v1beta1.SlashType" json:"slash_type,omitempty"`
+	// auto_restake is the new auto-restake setting
+	AutoRestake bool `protobuf:"varint,3,opt,name=auto_restake,json=autoRestake,proto3" json:"auto_restake,omitempty"`
 }
 
-func (m *EventSlash) Reset()         { *m = EventSlash{} }
-func (m *EventSlash) String() string { return proto.CompactTextString(m) }
-func (*EventSlash) ProtoMessage()    {}
-func (*EventSlash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d01988a9108a2e89, []int{6}
+func (m *EventUpdateAutoRestake) Reset()         { *m = EventUpdateAutoRestake{} }
+func (m *EventUpdateAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateAutoRestake) ProtoMessage()    {}
+func (*EventUpdateAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01988a9108a2e89, []int{7}
 }
-func (m *EventSlash) XXX_Unmarshal(b []byte) error {
+func (m *EventUpdateAutoRestake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventUpdateAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSlash.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventUpdateAutoRestake.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -466,579 +539,2459 @@ func (m *EventSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EventSlash) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSlash.Merge(m, src)
+func (m *EventUpdateAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateAutoRestake.Merge(m, src)
 }
-func (m *EventSlash) XXX_Size() int {
+func (m *EventUpdateAutoRestake) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSlash) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSlash.DiscardUnknown(m)
+func (m *EventUpdateAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateAutoRestake.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSlash proto.InternalMessageInfo
+var xxx_messageInfo_EventUpdateAutoRestake proto.InternalMessageInfo
 
-func (m *EventSlash) GetPoolId() uint64 {
+func (m *EventUpdateAutoRestake) GetAddress() string {
 	if m != nil {
-		return m.PoolId
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *EventSlash) GetStaker() string {
+func (m *EventUpdateAutoRestake) GetStaker() string {
 	if m != nil {
 		return m.Staker
 	}
 	return ""
 }
 
-func (m *EventSlash) GetAmount() uint64 {
+func (m *EventUpdateAutoRestake) GetAutoRestake() bool {
 	if m != nil {
-		return m.Amount
+		return m.AutoRestake
 	}
-	return 0
+	return false
 }
 
-func (m *EventSlash) GetSlashType() SlashType {
-	if m != nil {
-		return m.SlashType
-	}
-	return SLASH_TYPE_UNSPECIFIED
+// EventDelegateToPool is an event emitted when someone delegates to a pool.
+// emitted_by: MsgDelegateToPool
+type EventDelegateToPool struct {
+	// address is the account address of the delegator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pool_id is the id of the pool.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// amount is the amount which got delegated to the stakers of the pool.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*EventUpdateParams)(nil), "kyve.delegation.v1beta1.EventUpdateParams")
-	proto.RegisterType((*EventDelegate)(nil), "kyve.delegation.v1beta1.EventDelegate")
-	proto.RegisterType((*EventStartUndelegation)(nil), "kyve.delegation.v1beta1.EventStartUndelegation")
-	proto.RegisterType((*EventUndelegate)(nil), "kyve.delegation.v1beta1.EventUndelegate")
-	proto.RegisterType((*EventRedelegate)(nil), "kyve.delegation.v1beta1.EventRedelegate")
-	proto.RegisterType((*EventWithdrawRewards)(nil), "kyve.delegation.v1beta1.EventWithdrawRewards")
-	proto.RegisterType((*EventSlash)(nil), "kyve.delegation.v1beta1.EventSlash")
+func (m *EventDelegateToPool) Reset()         { *m = EventDelegateToPool{} }
+func (m *EventDelegateToPool) String() string { return proto.CompactTextString(m) }
+func (*EventDelegateToPool) ProtoMessage()    {}
+func (*EventDelegateToPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01988a9108a2e89, []int{8}
+}
+func (m *EventDelegateToPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegateToPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegateToPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelegateToPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegateToPool.Merge(m, src)
+}
+func (m *EventDelegateToPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegateToPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegateToPool.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterFile("kyve/delegation/v1beta1/events.proto", fileDescriptor_d01988a9108a2e89)
+var xxx_messageInfo_EventDelegateToPool proto.InternalMessageInfo
+
+func (m *EventDelegateToPool) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-var fileDescriptor_d01988a9108a2e89 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xeb, 0xff, 0x3f, 0xea, 0xc8, 0x3b, 0x01, 0x22, 0x9a, 0xb6, 0xb2, 0x49, 0xe9, 0x14,
-	0x71, 0xe8, 0x29, 0xd1, 0xc6, 0x1d, 0x89, 0xa9, 0x3b, 0x4c, 0x48, 0x08, 0xa5, 0x0c, 0x34, 0x38,
-	0x44, 0xee, 0xfc, 0xd2, 0x46, 0x4d, 0xe2, 0xc8, 0x76, 0x1b, 0x7a, 0xe4, 0x1b, 0x70, 0xe4, 0xcc,
-	0xb7, 0xe0, 0x1b, 0xec, 0xb8, 0x23, 0x27, 0x84, 0xda, 0x2f, 0x82, 0x6c, 0x27, 0xb4, 0x43, 0xaa,
-	0xc4, 0xd0, 0x6e, 0x7e, 0xfd, 0x3e, 0xfe, 0x3d, 0x4f, 0xf2, 0xda, 0xf0, 0x64, 0x32, 0x9f, 0x61,
-	0xc4, 0x30, 0xc3, 0x11, 0x55, 0x29, 0x2f, 0xa2, 0xd9, 0xd1, 0x10, 0x15, 0x3d, 0x8a, 0x70, 0x86,
-	0x85, 0x92, 0x61, 0x29, 0xb8, 0xe2, 0xde, 0x9e, 0x56, 0x85, 0x2b, 0x55, 0x58, 0xab, 0xf6, 0x77,
-	0x46, 0x7c, 0xc4, 0x8d, 0x26, 0xd2, 0x2b, 0x2b, 0xdf, 0xef, 0x6d, 0x82, 0xae, 0x11, 0xac, 0x72,
-	0xa3, 0x7d, 0x49, 0x05, 0xcd, 0x6b, 0xfb, 0xe0, 0x1b, 0x81, 0x47, 0xa7, 0x3a, 0xcf, 0x79, 0xc9,
-	0xa8, 0xc2, 0x57, 0xa6, 0xe7, 0xf5, 0x01, 0x78, 0xc6, 0x12, 0xab, 0xec, 0x90, 0x43, 0xd2, 0xdb,
-	0x3e, 0xee, 0x86, 0x1b, 0x92, 0x86, 0xf6, 0xd0, 0x89, 0x73, 0xf5, 0xa3, 0xdb, 0x8a, 0x5d, 0x9e,
-	0xb1, 0x15, 0xa5, 0xc0, 0xaa, 0xa1, 0xfc, 0x77, 0x2b, 0x4a, 0x81, 0x55, 0x4d, 0xe9, 0xc0, 0x56,
-	0x49, 0xe7, 0x19, 0xa7, 0xac, 0xf3, 0xff, 0x21, 0xe9, 0xb9, 0x71, 0x53, 0x06, 0x17, 0x70, 0xdf,
-	0x44, 0xef, 0x5b, 0x18, 0x6a, 0x29, 0x65, 0x4c, 0xa0, 0xb4, 0x99, 0xdd, 0xb8, 0x29, 0xbd, 0x5d,
-	0x68, 0x4b, 0x45, 0x27, 0x28, 0x4c, 0x0c, 0x37, 0xae, 0x2b, 0xbd, 0x4f, 0x73, 0x3e, 0x2d, 0x94,
-	0x61, 0x3b, 0x71, 0x5d, 0x05, 0x5f, 0x09, 0xec, 0x1a, 0xf6, 0x40, 0x51, 0xa1, 0xce, 0x8b, 0x55,
-	0xde, 0xbb, 0x33, 0xf1, 0x9e, 0xc1, 0x01, 0x4a, 0x95, 0xe6, 0x54, 0x21, 0x4b, 0xa6, 0x6b, 0x1e,
-	0x89, 0x1e, 0x45, 0xc7, 0x31, 0xe2, 0xc7, 0xbf, 0x25, 0xeb, 0x29, 0xfa, 0x54, 0x61, 0xf0, 0x1e,
-	0x1e, 0xda, 0xd1, 0x35, 0x8d, 0xbb, 0xfc, 0x03, 0x9f, 0x48, 0x4d, 0x8f, 0xf1, 0x2f, 0xe8, 0x5d,
-	0xd8, 0xfe, 0x20, 0x78, 0x9e, 0xdc, 0xb0, 0x00, 0xbd, 0x35, 0xb0, 0x36, 0x07, 0xe0, 0x2a, 0xde,
-	0xb4, 0xed, 0x1c, 0xef, 0x29, 0x3e, 0xf8, 0x33, 0x83, 0x73, 0x23, 0xc3, 0x10, 0x76, 0x4c, 0x84,
-	0xb7, 0xa9, 0x1a, 0x33, 0x41, 0xab, 0x18, 0x2b, 0x2a, 0x98, 0xfc, 0x87, 0xaf, 0xd4, 0x27, 0x0c,
-	0x53, 0x36, 0x97, 0xa8, 0x2e, 0x83, 0x2f, 0x04, 0xc0, 0x4e, 0x3a, 0xa3, 0x72, 0xec, 0xed, 0xc1,
-	0x56, 0xc9, 0x79, 0x96, 0xa4, 0xcc, 0xa0, 0x9d, 0xb8, 0xad, 0xcb, 0x33, 0x76, 0xeb, 0xe1, 0x3e,
-	0x07, 0x90, 0x9a, 0x98, 0xa8, 0x79, 0x69, 0x67, 0xf9, 0xe0, 0x38, 0xd8, 0x78, 0xf9, 0x8d, 0xf9,
-	0xeb, 0x79, 0x89, 0xb1, 0x2b, 0x9b, 0xe5, 0xc9, 0xd9, 0xd5, 0xc2, 0x27, 0xd7, 0x0b, 0x9f, 0xfc,
-	0x5c, 0xf8, 0xe4, 0xf3, 0xd2, 0x6f, 0x5d, 0x2f, 0xfd, 0xd6, 0xf7, 0xa5, 0xdf, 0x7a, 0x17, 0x8d,
-	0x52, 0x35, 0x9e, 0x0e, 0xc3, 0x4b, 0x9e, 0x47, 0x2f, 0x2e, 0xde, 0x9c, 0xbe, 0x44, 0x55, 0x71,
-	0x31, 0x89, 0x2e, 0xc7, 0x34, 0x2d, 0xa2, 0x8f, 0xeb, 0xaf, 0x5e, 0xdb, 0xcb, 0x61, 0xdb, 0xbc,
-	0xf6, 0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6a, 0xe5, 0x56, 0xd8, 0x94, 0x04, 0x00, 0x00,
+func (m *EventDelegateToPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDelegateToPool) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventStartUndelegationFromPool is an event emitted when someone starts an undelegation from a pool.
+// emitted_by: MsgUndelegateFromPool
+type EventStartUndelegationFromPool struct {
+	// address is the account address of the delegator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pool_id is the id of the pool.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// amount is the amount to be undelegated from the pool.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// estimated_undelegation_date is the date in UNIX seconds on when the undelegation will be performed.
+	EstimatedUndelegationDate uint64 `protobuf:"varint,4,opt,name=estimated_undelegation_date,json=estimatedUndelegationDate,proto3" json:"estimated_undelegation_date,omitempty"`
 }
 
-func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventStartUndelegationFromPool) Reset()         { *m = EventStartUndelegationFromPool{} }
+func (m *EventStartUndelegationFromPool) String() string { return proto.CompactTextString(m) }
+func (*EventStartUndelegationFromPool) ProtoMessage()    {}
+func (*EventStartUndelegationFromPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01988a9108a2e89, []int{9}
+}
+func (m *EventStartUndelegationFromPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStartUndelegationFromPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStartUndelegationFromPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *EventDelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventStartUndelegationFromPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStartUndelegationFromPool.Merge(m, src)
 }
-
-func (m *EventDelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventStartUndelegationFromPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStartUndelegationFromPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStartUndelegationFromPool.DiscardUnknown(m)
 }
 
-func (m *EventDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EventStartUndelegationFromPool proto.InternalMessageInfo
+
+func (m *EventStartUndelegationFromPool) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventStartUndelegationFromPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventStartUndelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventStartUndelegationFromPool) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventStartUndelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventStartUndelegationFromPool) GetEstimatedUndelegationDate() uint64 {
+	if m != nil {
+		return m.EstimatedUndelegationDate
+	}
+	return 0
 }
 
-func (m *EventStartUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EstimatedUndelegationDate != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.EstimatedUndelegationDate))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// EventUndelegateFromPool is an event emitted when someone undelegates from a pool.
+// emitted_by: EndBlock
+type EventUndelegateFromPool struct {
+	// address is the account address of the delegator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pool_id is the id of the pool.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// amount is the amount which got returned to the delegator.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *EventUndelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUndelegateFromPool) Reset()         { *m = EventUndelegateFromPool{} }
+func (m *EventUndelegateFromPool) String() string { return proto.CompactTextString(m) }
+func (*EventUndelegateFromPool) ProtoMessage()    {}
+func (*EventUndelegateFromPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01988a9108a2e89, []int{10}
+}
+func (m *EventUndelegateFromPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUndelegateFromPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUndelegateFromPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventUndelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventUndelegateFromPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUndelegateFromPool.Merge(m, src)
+}
+func (m *EventUndelegateFromPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUndelegateFromPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUndelegateFromPool.DiscardUnknown(m)
 }
 
-func (m *EventUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EventUndelegateFromPool proto.InternalMessageInfo
+
+func (m *EventUndelegateFromPool) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventUndelegateFromPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventRedelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUndelegateFromPool) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventRedelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRebalancePoolDelegations is an event emitted when a batch of pool
+// delegations got spread again across the current stakers of the pool.
+// emitted_by: BeginBlock
+type EventRebalancePoolDelegations struct {
+	// pool_id is the id of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// stakers is the amount of stakers the delegations are spread across.
+	Stakers uint64 `protobuf:"varint,2,opt,name=stakers,proto3" json:"stakers,omitempty"`
+	// delegators is the amount of pool delegators which got rebalanced in this batch.
+	Delegators uint64 `protobuf:"varint,3,opt,name=delegators,proto3" json:"delegators,omitempty"`
+	// completed is true if all pool delegators of the pool have been rebalanced.
+	Completed bool `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
 }
 
-func (m *EventRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
+func (m *EventRebalancePoolDelegations) Reset()         { *m = EventRebalancePoolDelegations{} }
+func (m *EventRebalancePoolDelegations) String() string { return proto.CompactTextString(m) }
+func (*EventRebalancePoolDelegations) ProtoMessage()    {}
+func (*EventRebalancePoolDelegations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01988a9108a2e89, []int{11}
+}
+func (m *EventRebalancePoolDelegations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRebalancePoolDelegations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRebalancePoolDelegations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ToStaker) > 0 {
-		i -= len(m.ToStaker)
-		copy(dAtA[i:], m.ToStaker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToStaker)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventRebalancePoolDelegations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRebalancePoolDelegations.Merge(m, src)
+}
+func (m *EventRebalancePoolDelegations) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRebalancePoolDelegations) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRebalancePoolDelegations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRebalancePoolDelegations proto.InternalMessageInfo
+
+func (m *EventRebalancePoolDelegations) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.FromStaker) > 0 {
-		i -= len(m.FromStaker)
-		copy(dAtA[i:], m.FromStaker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromStaker)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EventRebalancePoolDelegations) GetStakers() uint64 {
+	if m != nil {
+		return m.Stakers
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventRebalancePoolDelegations) GetDelegators() uint64 {
+	if m != nil {
+		return m.Delegators
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventWithdrawRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRebalancePoolDelegations) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSetWithdrawAddress is an event emitted when a delegator or staker
+// sets the address which receives its rewards.
+// emitted_by: MsgSetWithdrawAddress
+type EventSetWithdrawAddress struct {
+	// address is the account address of the delegator or staker.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// withdraw_address is the address which receives the rewards.
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *EventWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		i -= len(m.Amounts)
-		copy(dAtA[i:], m.Amounts)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amounts)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventSetWithdrawAddress) Reset()         { *m = EventSetWithdrawAddress{} }
+func (m *EventSetWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*EventSetWithdrawAddress) ProtoMessage()    {}
+func (*EventSetWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01988a9108a2e89, []int{12}
+}
+func (m *EventSetWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventSetWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetWithdrawAddress.Merge(m, src)
+}
+func (m *EventSetWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetWithdrawAddress proto.InternalMessageInfo
+
+func (m *EventSetWithdrawAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSlash) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSlash) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAutoRestake is an event emitted when the $KYVE rewards of a delegator
+// got delegated to the staker again.
+// emitted_by: BeginBlock
+type EventAutoRestake struct {
+	// address is the account address of the delegator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// staker is the account address of the protocol node.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// amount is the restaked amount in ukyve
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *EventSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SlashType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SlashType))
-		i--
-		dAtA[i] = 0x20
+func (m *EventAutoRestake) Reset()         { *m = EventAutoRestake{} }
+func (m *EventAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*EventAutoRestake) ProtoMessage()    {}
+func (*EventAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01988a9108a2e89, []int{13}
+}
+func (m *EventAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoRestake.Merge(m, src)
+}
+func (m *EventAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoRestake proto.InternalMessageInfo
+
+func (m *EventAutoRestake) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventAutoRestake) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EventUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventAutoRestake) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = m.OldParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.NewParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+// EventSlash is an event emitted when a protocol node is slashed.
+// emitted_by: MsgSubmitBundleProposal, EndBlock
+type EventSlash struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the account address of the protocol node.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// amount ...
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// slash_type
+	SlashType SlashType `protobuf:"varint,4,opt,name=slash_type,json=slashType,proto3,enum=kyve.delegation.v1beta1.SlashType" json:"slash_type,omitempty"`
+}
+
+func (m *EventSlash) Reset()         { *m = EventSlash{} }
+func (m *EventSlash) String() string { return proto.CompactTextString(m) }
+func (*EventSlash) ProtoMessage()    {}
+func (*EventSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01988a9108a2e89, []int{14}
+}
+func (m *EventSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlash.Merge(m, src)
+}
+func (m *EventSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlash.DiscardUnknown(m)
 }
 
-func (m *EventDelegate) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventSlash proto.InternalMessageInfo
+
+func (m *EventSlash) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventSlash) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventSlash) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+	return 0
+}
+
+func (m *EventSlash) GetSlashType() SlashType {
+	if m != nil {
+		return m.SlashType
 	}
-	return n
+	return SLASH_TYPE_UNSPECIFIED
 }
 
-func (m *EventStartUndelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*EventUpdateParams)(nil), "kyve.delegation.v1beta1.EventUpdateParams")
+	proto.RegisterType((*EventDelegate)(nil), "kyve.delegation.v1beta1.EventDelegate")
+	proto.RegisterType((*EventStartUndelegation)(nil), "kyve.delegation.v1beta1.EventStartUndelegation")
+	proto.RegisterType((*EventCancelUnbonding)(nil), "kyve.delegation.v1beta1.EventCancelUnbonding")
+	proto.RegisterType((*EventUndelegate)(nil), "kyve.delegation.v1beta1.EventUndelegate")
+	proto.RegisterType((*EventRedelegate)(nil), "kyve.delegation.v1beta1.EventRedelegate")
+	proto.RegisterType((*EventWithdrawRewards)(nil), "kyve.delegation.v1beta1.EventWithdrawRewards")
+	proto.RegisterType((*EventUpdateAutoRestake)(nil), "kyve.delegation.v1beta1.EventUpdateAutoRestake")
+	proto.RegisterType((*EventDelegateToPool)(nil), "kyve.delegation.v1beta1.EventDelegateToPool")
+	proto.RegisterType((*EventStartUndelegationFromPool)(nil), "kyve.delegation.v1beta1.EventStartUndelegationFromPool")
+	proto.RegisterType((*EventUndelegateFromPool)(nil), "kyve.delegation.v1beta1.EventUndelegateFromPool")
+	proto.RegisterType((*EventRebalancePoolDelegations)(nil), "kyve.delegation.v1beta1.EventRebalancePoolDelegations")
+	proto.RegisterType((*EventSetWithdrawAddress)(nil), "kyve.delegation.v1beta1.EventSetWithdrawAddress")
+	proto.RegisterType((*EventAutoRestake)(nil), "kyve.delegation.v1beta1.EventAutoRestake")
+	proto.RegisterType((*EventSlash)(nil), "kyve.delegation.v1beta1.EventSlash")
+}
+
+func init() {
+	proto.RegisterFile("kyve/delegation/v1beta1/events.proto", fileDescriptor_d01988a9108a2e89)
+}
+
+var fileDescriptor_d01988a9108a2e89 = []byte{
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0xfe, 0x7e, 0x2b, 0xd0, 0x17, 0x15, 0x5c, 0x09, 0xac, 0xa0, 0x05, 0x1b, 0x0f, 0x78,
+	0xd9, 0x06, 0xbc, 0x9b, 0x80, 0x8b, 0x09, 0x31, 0x31, 0xa4, 0x0b, 0x1a, 0xd4, 0x58, 0x67, 0x77,
+	0xc6, 0xdd, 0x86, 0x76, 0xa6, 0x69, 0x67, 0xb7, 0xec, 0xd1, 0x6f, 0xc0, 0xd1, 0xb3, 0x57, 0x3f,
+	0x81, 0xdf, 0x80, 0x23, 0x47, 0x4f, 0xc6, 0xc0, 0x17, 0x31, 0xf3, 0xa7, 0x6c, 0x4b, 0xac, 0x11,
+	0x58, 0x6f, 0x7d, 0x67, 0x9e, 0x79, 0x9e, 0xe7, 0x7d, 0xe7, 0x7d, 0x3b, 0xf0, 0xe8, 0x60, 0x38,
+	0x20, 0x0e, 0x26, 0x01, 0xe9, 0x22, 0xee, 0x33, 0xea, 0x0c, 0xd6, 0xda, 0x84, 0xa3, 0x35, 0x87,
+	0x0c, 0x08, 0xe5, 0x49, 0x23, 0x8a, 0x19, 0x67, 0xb5, 0x05, 0x81, 0x6a, 0x8c, 0x50, 0x0d, 0x8d,
+	0x5a, 0x9c, 0xeb, 0xb2, 0x2e, 0x93, 0x18, 0x47, 0x7c, 0x29, 0xf8, 0xe2, 0x6a, 0x19, 0x69, 0x8e,
+	0x41, 0x21, 0x4b, 0xe5, 0x23, 0x14, 0xa3, 0x50, 0xcb, 0xdb, 0xdf, 0x0c, 0xb8, 0xb3, 0x25, 0xfc,
+	0xec, 0x45, 0x18, 0x71, 0xb2, 0x23, 0xf7, 0x6a, 0x4d, 0x00, 0x16, 0x60, 0x4f, 0x21, 0xeb, 0xc6,
+	0x8a, 0xb1, 0x3a, 0xbd, 0xbe, 0xdc, 0x28, 0x71, 0xda, 0x50, 0x87, 0x36, 0xab, 0xc7, 0x3f, 0x96,
+	0x2b, 0xae, 0xc9, 0x02, 0x3c, 0x62, 0xa1, 0x24, 0xcd, 0x58, 0xfe, 0xbb, 0x14, 0x0b, 0x25, 0xa9,
+	0x66, 0xa9, 0xc3, 0x64, 0x84, 0x86, 0x01, 0x43, 0xb8, 0xfe, 0xff, 0x8a, 0xb1, 0x6a, 0xba, 0x59,
+	0x68, 0xef, 0xc3, 0x2d, 0x69, 0xbd, 0xa9, 0xc8, 0x88, 0x80, 0x22, 0x8c, 0x63, 0x92, 0x28, 0xcf,
+	0xa6, 0x9b, 0x85, 0xb5, 0x79, 0x98, 0x48, 0x38, 0x3a, 0x20, 0xb1, 0xb4, 0x61, 0xba, 0x3a, 0x12,
+	0xeb, 0x28, 0x64, 0x7d, 0xca, 0x25, 0x77, 0xd5, 0xd5, 0x91, 0xfd, 0xc5, 0x80, 0x79, 0xc9, 0xdd,
+	0xe2, 0x28, 0xe6, 0x7b, 0x74, 0xe4, 0x77, 0x7c, 0x22, 0xb5, 0xa7, 0xb0, 0x44, 0x12, 0xee, 0x87,
+	0x88, 0x13, 0xec, 0xf5, 0x73, 0x1a, 0x9e, 0xb8, 0x8a, 0x7a, 0x55, 0x82, 0xef, 0x9d, 0x43, 0xf2,
+	0x2e, 0x9a, 0x88, 0x13, 0x7b, 0x00, 0x73, 0xd2, 0xe3, 0x33, 0x44, 0x3b, 0x24, 0xd8, 0xa3, 0x6d,
+	0x46, 0xb1, 0x4f, 0xbb, 0x57, 0x70, 0x38, 0x07, 0x37, 0x7c, 0x8a, 0xc9, 0xa1, 0x36, 0xa8, 0x82,
+	0x9c, 0xef, 0x6a, 0xa1, 0x38, 0x6f, 0x61, 0x46, 0xb5, 0x0c, 0xc5, 0xe3, 0xaf, 0xfc, 0x27, 0x43,
+	0xb3, 0xbb, 0xe4, 0x2f, 0xd8, 0x97, 0x61, 0xfa, 0x63, 0xcc, 0x42, 0xaf, 0x20, 0x01, 0x62, 0xa9,
+	0xa5, 0x64, 0x96, 0xc0, 0xe4, 0x2c, 0xdb, 0x56, 0xfd, 0x33, 0xc5, 0x59, 0xeb, 0xa2, 0x87, 0x62,
+	0x82, 0x6d, 0x5d, 0xd8, 0xd7, 0x3e, 0xef, 0xe1, 0x18, 0xa5, 0x2e, 0x49, 0x51, 0x8c, 0x93, 0x2b,
+	0x64, 0x29, 0x4e, 0x48, 0xce, 0x24, 0x6b, 0x5e, 0x1d, 0xda, 0x21, 0xcc, 0xe7, 0xe6, 0x6e, 0xa3,
+	0xcf, 0x99, 0x4b, 0xe4, 0xa1, 0x2b, 0xa8, 0x3c, 0x84, 0x9b, 0xa8, 0xcf, 0x99, 0x17, 0x2b, 0x06,
+	0x29, 0x35, 0xe5, 0x4e, 0xa3, 0x11, 0xa9, 0xfd, 0x01, 0xee, 0x16, 0x66, 0x65, 0x97, 0xed, 0x30,
+	0x16, 0xfc, 0x41, 0x6b, 0x01, 0x26, 0x23, 0xc6, 0x02, 0xcf, 0xc7, 0x52, 0xac, 0xea, 0x4e, 0x88,
+	0x70, 0x1b, 0x97, 0x5e, 0xdc, 0x57, 0x03, 0xac, 0xdf, 0x8f, 0xcc, 0xf3, 0x98, 0x85, 0x63, 0x56,
+	0xbb, 0xf6, 0xec, 0x60, 0x58, 0xb8, 0xd0, 0xc3, 0xff, 0xc0, 0xa5, 0x7d, 0x64, 0xc0, 0x03, 0xdd,
+	0xcc, 0x6d, 0x14, 0x88, 0x31, 0x15, 0x0a, 0xcd, 0x73, 0x2b, 0x05, 0x4a, 0xa3, 0x40, 0x59, 0x87,
+	0x49, 0x75, 0xbb, 0x89, 0xd6, 0xca, 0xc2, 0x9a, 0x05, 0xa0, 0x3d, 0xb3, 0x38, 0xd1, 0x82, 0xb9,
+	0x95, 0xda, 0x7d, 0x30, 0x3b, 0x2c, 0x8c, 0x02, 0xc2, 0x09, 0x96, 0x85, 0x98, 0x72, 0x47, 0x0b,
+	0xf6, 0x7b, 0x9d, 0x78, 0x8b, 0x9c, 0xb7, 0xf7, 0x86, 0x4e, 0xaf, 0x3c, 0xf1, 0xc7, 0x30, 0x9b,
+	0x6a, 0xb0, 0x97, 0x41, 0x54, 0x0b, 0xce, 0xa4, 0x45, 0x12, 0xfb, 0x1d, 0xcc, 0x4a, 0xfe, 0xeb,
+	0x75, 0x74, 0x59, 0x41, 0x3f, 0x1b, 0x00, 0xca, 0x7e, 0x80, 0x92, 0x5e, 0x79, 0xf5, 0x2e, 0xfb,
+	0x2b, 0xde, 0x00, 0x48, 0x04, 0xa3, 0xc7, 0x87, 0x91, 0xea, 0x9e, 0xdb, 0xeb, 0x76, 0xe9, 0x53,
+	0x25, 0xc5, 0x77, 0x87, 0x11, 0x71, 0xcd, 0x24, 0xfb, 0xdc, 0xdc, 0x3e, 0x3e, 0xb5, 0x8c, 0x93,
+	0x53, 0xcb, 0xf8, 0x79, 0x6a, 0x19, 0x47, 0x67, 0x56, 0xe5, 0xe4, 0xcc, 0xaa, 0x7c, 0x3f, 0xb3,
+	0x2a, 0x6f, 0x9c, 0xae, 0xcf, 0x7b, 0xfd, 0x76, 0xa3, 0xc3, 0x42, 0xe7, 0xc5, 0xfe, 0xab, 0xad,
+	0x97, 0x84, 0xa7, 0x2c, 0x3e, 0x70, 0x3a, 0x3d, 0xe4, 0x53, 0xe7, 0x30, 0xff, 0x46, 0x0b, 0xf9,
+	0xa4, 0x3d, 0x21, 0xdf, 0xe6, 0x27, 0xbf, 0x06, 0x00, 0x9e, 0x3d, 0x3d, 0xa1, 0x42, 0x08, 0x00,
+	0x00,
+}
+
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.EstimatedUndelegationDate != 0 {
-		n += 1 + sovEvents(uint64(m.EstimatedUndelegationDate))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRedelegate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventStartUndelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStartUndelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStartUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.EstimatedUndelegationDate != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EstimatedUndelegationDate))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.FromStaker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ToStaker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.Index != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventWithdrawRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Amounts)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ToStaker) > 0 {
+		i -= len(m.ToStaker)
+		copy(dAtA[i:], m.ToStaker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToStaker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromStaker) > 0 {
+		i -= len(m.FromStaker)
+		copy(dAtA[i:], m.FromStaker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromStaker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdrawRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		i -= len(m.Amounts)
+		copy(dAtA[i:], m.Amounts)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amounts)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoRestake {
+		i--
+		if m.AutoRestake {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegateToPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegateToPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegateToPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStartUndelegationFromPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStartUndelegationFromPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStartUndelegationFromPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedUndelegationDate != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EstimatedUndelegationDate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUndelegateFromPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUndelegateFromPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUndelegateFromPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRebalancePoolDelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRebalancePoolDelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRebalancePoolDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Delegators != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Delegators))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Stakers != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Stakers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SlashType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.NewParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventStartUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	if m.EstimatedUndelegationDate != 0 {
+		n += 1 + sovEvents(uint64(m.EstimatedUndelegationDate))
+	}
+	return n
+}
+
+func (m *EventCancelUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEvents(uint64(m.Index))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FromStaker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToStaker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventWithdrawRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amounts)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AutoRestake {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDelegateToPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventStartUndelegationFromPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	if m.EstimatedUndelegationDate != 0 {
+		n += 1 + sovEvents(uint64(m.EstimatedUndelegationDate))
+	}
+	return n
+}
+
+func (m *EventUndelegateFromPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventRebalancePoolDelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.Stakers != 0 {
+		n += 1 + sovEvents(uint64(m.Stakers))
+	}
+	if m.Delegators != 0 {
+		n += 1 + sovEvents(uint64(m.Delegators))
+	}
+	if m.Completed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	if m.SlashType != 0 {
+		n += 1 + sovEvents(uint64(m.SlashType))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStartUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStartUndelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStartUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedUndelegationDate", wireType)
+			}
+			m.EstimatedUndelegationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedUndelegationDate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventSlash) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
-	}
-	if m.SlashType != 0 {
-		n += 1 + sovEvents(uint64(m.SlashType))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *EventWithdrawRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1061,17 +3014,17 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWithdrawRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1081,30 +3034,29 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OldParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1114,28 +3066,141 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateAutoRestake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateAutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1163,8 +3228,28 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRestake = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1186,7 +3271,7 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDelegate) Unmarshal(dAtA []byte) error {
+func (m *EventDelegateToPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1209,10 +3294,10 @@ func (m *EventDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelegateToPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelegateToPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1248,10 +3333,10 @@ func (m *EventDelegate) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1261,24 +3346,11 @@ func (m *EventDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
@@ -1319,7 +3391,7 @@ func (m *EventDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStartUndelegation) Unmarshal(dAtA []byte) error {
+func (m *EventStartUndelegationFromPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1342,10 +3414,10 @@ func (m *EventStartUndelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStartUndelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventStartUndelegationFromPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStartUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventStartUndelegationFromPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1381,10 +3453,10 @@ func (m *EventStartUndelegation) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1394,24 +3466,11 @@ func (m *EventStartUndelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
@@ -1471,7 +3530,7 @@ func (m *EventStartUndelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUndelegate) Unmarshal(dAtA []byte) error {
+func (m *EventUndelegateFromPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1494,10 +3553,10 @@ func (m *EventUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUndelegateFromPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUndelegateFromPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1533,10 +3592,10 @@ func (m *EventUndelegate) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1546,24 +3605,11 @@ func (m *EventUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
@@ -1604,7 +3650,7 @@ func (m *EventUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRedelegate) Unmarshal(dAtA []byte) error {
+func (m *EventRebalancePoolDelegations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1627,17 +3673,17 @@ func (m *EventRedelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRedelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRebalancePoolDelegations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRebalancePoolDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1647,29 +3693,54 @@ func (m *EventRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.Stakers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stakers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromStaker", wireType)
+			m.Delegators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delegators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1679,27 +3750,65 @@ func (m *EventRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+			m.Completed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromStaker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToStaker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1727,13 +3836,13 @@ func (m *EventRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToStaker = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1743,11 +3852,24 @@ func (m *EventRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1769,7 +3891,7 @@ func (m *EventRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdrawRewards) Unmarshal(dAtA []byte) error {
+func (m *EventAutoRestake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1792,10 +3914,10 @@ func (m *EventWithdrawRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAutoRestake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1863,10 +3985,10 @@ func (m *EventWithdrawRewards) Unmarshal(dAtA []byte) error {
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1876,24 +3998,11 @@ func (m *EventWithdrawRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amounts = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -17,6 +17,11 @@ func DefaultGenesis() *GenesisState {
 		UndelegationQueueEntryList: []UndelegationQueueEntry{},
 		QueueStateUndelegation:     QueueState{},
 		RedelegationCooldownList:   []RedelegationCooldown{},
+		AutoRestakeList:            []AutoRestake{},
+		AutoRestakeState:           AutoRestakeState{},
+		WithdrawAddressList:        []WithdrawAddress{},
+		PoolDelegatorList:          []PoolDelegator{},
+		PoolRebalanceList:          []PoolRebalance{},
 	}
 }
 
@@ -34,6 +39,18 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.validateAutoRestake(); err != nil {
+		return err
+	}
+
+	if err := gs.validateWithdrawAddresses(); err != nil {
+		return err
+	}
+
+	if err := gs.validatePoolDelegators(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -133,3 +150,63 @@ func (gs *GenesisState) validateRedelegation() error {
 	}
 	return nil
 }
+
+func (gs *GenesisState) validateAutoRestake() error {
+	// Check auto-restake entries
+	autoRestakeMap := make(map[string]struct{})
+
+	for _, elem := range gs.AutoRestakeList {
+		index := string(AutoRestakeKey(elem.Delegator, elem.Staker))
+		if _, ok := autoRestakeMap[index]; ok {
+			return fmt.Errorf("duplicated index for auto-restake entry %v", elem)
+		}
+
+		autoRestakeMap[index] = struct{}{}
+	}
+	return nil
+}
+
+func (gs *GenesisState) validateWithdrawAddresses() error {
+	// Check withdraw address entries
+	withdrawAddressMap := make(map[string]struct{})
+
+	for _, elem := range gs.WithdrawAddressList {
+		index := string(WithdrawAddressKey(elem.Address))
+		if _, ok := withdrawAddressMap[index]; ok {
+			return fmt.Errorf("duplicated index for withdraw address %v", elem)
+		}
+
+		withdrawAddressMap[index] = struct{}{}
+	}
+	return nil
+}
+
+func (gs *GenesisState) validatePoolDelegators() error {
+	// Check pool delegator entries
+	poolDelegatorMap := make(map[string]struct{})
+
+	for _, elem := range gs.PoolDelegatorList {
+		index := string(PoolDelegatorKey(elem.Delegator, elem.PoolId))
+		if _, ok := poolDelegatorMap[index]; ok {
+			return fmt.Errorf("duplicated index for pool delegator %v", elem)
+		}
+
+		if elem.Account != PoolDelegationAccount(elem.PoolId, elem.Delegator) {
+			return fmt.Errorf("invalid account for pool delegator %v", elem)
+		}
+
+		poolDelegatorMap[index] = struct{}{}
+	}
+
+	// Check pool rebalance entries
+	poolRebalanceMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.PoolRebalanceList {
+		if _, ok := poolRebalanceMap[elem.PoolId]; ok {
+			return fmt.Errorf("duplicated pool rebalance entry %v", elem)
+		}
+
+		poolRebalanceMap[elem.PoolId] = struct{}{}
+	}
+	return nil
+}
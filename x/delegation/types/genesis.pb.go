@@ -41,6 +41,16 @@ type GenesisState struct {
 	QueueStateUndelegation QueueState `protobuf:"bytes,7,opt,name=queue_state_undelegation,json=queueStateUndelegation,proto3" json:"queue_state_undelegation"`
 	// redelegation_cooldown_list ...
 	RedelegationCooldownList []RedelegationCooldown `protobuf:"bytes,8,rep,name=redelegation_cooldown_list,json=redelegationCooldownList,proto3" json:"redelegation_cooldown_list"`
+	// auto_restake_list ...
+	AutoRestakeList []AutoRestake `protobuf:"bytes,9,rep,name=auto_restake_list,json=autoRestakeList,proto3" json:"auto_restake_list"`
+	// auto_restake_state ...
+	AutoRestakeState AutoRestakeState `protobuf:"bytes,10,opt,name=auto_restake_state,json=autoRestakeState,proto3" json:"auto_restake_state"`
+	// withdraw_address_list ...
+	WithdrawAddressList []WithdrawAddress `protobuf:"bytes,11,rep,name=withdraw_address_list,json=withdrawAddressList,proto3" json:"withdraw_address_list"`
+	// pool_delegator_list ...
+	PoolDelegatorList []PoolDelegator `protobuf:"bytes,12,rep,name=pool_delegator_list,json=poolDelegatorList,proto3" json:"pool_delegator_list"`
+	// pool_rebalance_list ...
+	PoolRebalanceList []PoolRebalance `protobuf:"bytes,13,rep,name=pool_rebalance_list,json=poolRebalanceList,proto3" json:"pool_rebalance_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +142,41 @@ func (m *GenesisState) GetRedelegationCooldownList() []RedelegationCooldown {
 	return nil
 }
 
+func (m *GenesisState) GetAutoRestakeList() []AutoRestake {
+	if m != nil {
+		return m.AutoRestakeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoRestakeState() AutoRestakeState {
+	if m != nil {
+		return m.AutoRestakeState
+	}
+	return AutoRestakeState{}
+}
+
+func (m *GenesisState) GetWithdrawAddressList() []WithdrawAddress {
+	if m != nil {
+		return m.WithdrawAddressList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolDelegatorList() []PoolDelegator {
+	if m != nil {
+		return m.PoolDelegatorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolRebalanceList() []PoolRebalance {
+	if m != nil {
+		return m.PoolRebalanceList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.delegation.v1beta1.GenesisState")
 }
@@ -141,35 +186,43 @@ func init() {
 }
 
 var fileDescriptor_0bd28fed64b7905b = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0xef, 0xd2, 0x30,
-	0x1c, 0xc6, 0x37, 0x7f, 0x38, 0x4d, 0x51, 0x0f, 0x13, 0x75, 0x59, 0xe2, 0x20, 0xa8, 0x71, 0x17,
-	0xd7, 0x80, 0x67, 0x2f, 0x08, 0x31, 0x46, 0xe3, 0x1f, 0x88, 0x26, 0x7a, 0x59, 0xba, 0xad, 0x19,
-	0x0b, 0x63, 0x85, 0xb5, 0xe3, 0xcf, 0xbb, 0xf0, 0xea, 0x3b, 0xe2, 0xc8, 0xd1, 0x93, 0x31, 0xf0,
-	0x46, 0xcc, 0xda, 0x0a, 0x25, 0xb2, 0xfc, 0xb8, 0x2d, 0xdf, 0x3d, 0xcf, 0xf3, 0xe9, 0xd3, 0x7c,
-	0x0b, 0x9e, 0x4d, 0xd6, 0x0b, 0x0c, 0x23, 0x9c, 0xe2, 0x18, 0xb1, 0x84, 0x64, 0x70, 0xd1, 0x09,
-	0x30, 0x43, 0x1d, 0x18, 0xe3, 0x0c, 0xd3, 0x84, 0x7a, 0xb3, 0x9c, 0x30, 0x62, 0x3e, 0x2a, 0x65,
-	0xde, 0x51, 0xe6, 0x49, 0x99, 0xdd, 0x88, 0x49, 0x4c, 0xb8, 0x06, 0x96, 0x5f, 0x42, 0x6e, 0xbb,
-	0x55, 0xa9, 0x4a, 0x82, 0x50, 0x3e, 0xad, 0x52, 0xce, 0x50, 0x8e, 0xa6, 0x12, 0xdf, 0xfe, 0x69,
-	0x80, 0x3b, 0x6f, 0xc4, 0x81, 0x46, 0x0c, 0x31, 0x6c, 0xbe, 0x02, 0x86, 0x10, 0x58, 0x7a, 0x4b,
-	0x77, 0xeb, 0xdd, 0xa6, 0x57, 0x71, 0x40, 0xef, 0x13, 0x97, 0xf5, 0x6a, 0x9b, 0xdf, 0x4d, 0x6d,
-	0x28, 0x4d, 0xe6, 0x47, 0x70, 0x4f, 0x4a, 0x49, 0xee, 0xa7, 0x09, 0x65, 0xd6, 0x8d, 0xd6, 0x95,
-	0x5b, 0xef, 0xb6, 0x2b, 0x63, 0xfa, 0xff, 0xe4, 0x32, 0xe9, 0xee, 0xc1, 0xff, 0x3e, 0xa1, 0xcc,
-	0x0c, 0xc0, 0x83, 0xa3, 0xc9, 0xc7, 0x19, 0xcb, 0xd7, 0x22, 0xf7, 0x8a, 0xe7, 0xba, 0xd7, 0xe5,
-	0x26, 0x24, 0x1b, 0x94, 0x26, 0x99, 0x7e, 0x3f, 0x3a, 0x1d, 0x73, 0x86, 0x0f, 0x1a, 0x0a, 0x23,
-	0x42, 0x0c, 0x09, 0x44, 0x8d, 0x23, 0x9e, 0x5f, 0x80, 0xe8, 0x23, 0x86, 0x24, 0xc1, 0x8c, 0x4e,
-	0xa6, 0x67, 0x4a, 0xd0, 0x14, 0xd1, 0xb1, 0x20, 0xdc, 0xbc, 0xb8, 0xc4, 0xa8, 0x34, 0xfd, 0x5f,
-	0x82, 0x8f, 0x39, 0x63, 0x05, 0x1e, 0x17, 0x99, 0x42, 0x99, 0x17, 0xb8, 0xc0, 0xea, 0x85, 0x19,
-	0x9c, 0x05, 0x2b, 0x59, 0x5f, 0x14, 0xf7, 0xe7, 0xd2, 0xac, 0xde, 0x9b, 0x5d, 0x9c, 0xfd, 0xcb,
-	0xc9, 0x21, 0xb0, 0x04, 0x8c, 0x96, 0x1b, 0xe4, 0xab, 0x4a, 0xeb, 0x16, 0x5f, 0xa2, 0x27, 0x95,
-	0x50, 0x1e, 0xc5, 0x37, 0x4f, 0x82, 0x1e, 0xce, 0x0f, 0x13, 0xf5, 0x40, 0xe6, 0x1c, 0xd8, 0x39,
-	0x56, 0xea, 0x85, 0x84, 0xa4, 0x11, 0x59, 0x66, 0xa2, 0xdb, 0x6d, 0xde, 0xed, 0x45, 0x25, 0x66,
-	0xa8, 0x58, 0x5f, 0x4b, 0xa7, 0x04, 0x5a, 0xf9, 0x99, 0x7f, 0x65, 0xaf, 0xde, 0xdb, 0xcd, 0xce,
-	0xd1, 0xb7, 0x3b, 0x47, 0xff, 0xb3, 0x73, 0xf4, 0x1f, 0x7b, 0x47, 0xdb, 0xee, 0x1d, 0xed, 0xd7,
-	0xde, 0xd1, 0xbe, 0xc3, 0x38, 0x61, 0xe3, 0x22, 0xf0, 0x42, 0x32, 0x85, 0xef, 0xbe, 0x7d, 0x1d,
-	0x7c, 0xc0, 0x6c, 0x49, 0xf2, 0x09, 0x0c, 0xc7, 0x28, 0xc9, 0xe0, 0x4a, 0x7d, 0x75, 0x6c, 0x3d,
-	0xc3, 0x34, 0x30, 0xf8, 0x6b, 0x7b, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xa4, 0xfe, 0x14,
-	0x15, 0x04, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0x93, 0xaf, 0xfd, 0x02, 0x9d, 0xb4, 0x40, 0xdd, 0x02, 0x56, 0x24, 0xdc, 0xaa, 0x14,
+	0x08, 0x0b, 0x6c, 0xb5, 0xac, 0x59, 0xb4, 0xb4, 0x42, 0x08, 0xc4, 0x9f, 0x54, 0x14, 0x81, 0x40,
+	0xd6, 0x4d, 0x3c, 0x4a, 0xac, 0xb8, 0x9e, 0x64, 0x66, 0x5c, 0x37, 0x6f, 0xc1, 0x63, 0x75, 0xd9,
+	0x25, 0x2b, 0x84, 0x92, 0x17, 0xe0, 0x11, 0x90, 0xef, 0x4c, 0x92, 0x71, 0x88, 0xd5, 0xec, 0xac,
+	0xeb, 0x73, 0xce, 0x6f, 0xee, 0xd5, 0x9d, 0x21, 0x8f, 0xba, 0x83, 0x73, 0xea, 0x05, 0x34, 0xa2,
+	0x6d, 0x90, 0x21, 0x8b, 0xbd, 0xf3, 0xbd, 0x26, 0x95, 0xb0, 0xe7, 0xb5, 0x69, 0x4c, 0x45, 0x28,
+	0xdc, 0x1e, 0x67, 0x92, 0x59, 0xf7, 0x33, 0x99, 0x3b, 0x95, 0xb9, 0x5a, 0x56, 0xdb, 0x6c, 0xb3,
+	0x36, 0x43, 0x8d, 0x97, 0x7d, 0x29, 0x79, 0xad, 0x5e, 0x94, 0x6a, 0x24, 0x28, 0xe5, 0x6e, 0x91,
+	0xb2, 0x07, 0x1c, 0xce, 0x34, 0x7e, 0xe7, 0xcf, 0x0a, 0x59, 0x7d, 0xa5, 0x0e, 0x74, 0x22, 0x41,
+	0x52, 0xeb, 0x05, 0xa9, 0x28, 0x81, 0x5d, 0xde, 0x2e, 0xd7, 0xab, 0xfb, 0x5b, 0x6e, 0xc1, 0x01,
+	0xdd, 0x0f, 0x28, 0x3b, 0x5c, 0xbe, 0xfc, 0xb5, 0x55, 0x6a, 0x68, 0x93, 0xf5, 0x9e, 0xdc, 0xd2,
+	0x52, 0xc6, 0xfd, 0x28, 0x14, 0xd2, 0xfe, 0x6f, 0x7b, 0xa9, 0x5e, 0xdd, 0xdf, 0x29, 0x8c, 0x39,
+	0x1a, 0xcb, 0x75, 0xd2, 0xda, 0xc4, 0xff, 0x36, 0x14, 0xd2, 0x6a, 0x92, 0xbb, 0x53, 0x93, 0x4f,
+	0x63, 0xc9, 0x07, 0x2a, 0x77, 0x09, 0x73, 0xeb, 0xd7, 0xe5, 0x86, 0x2c, 0x3e, 0xce, 0x4c, 0x3a,
+	0x7d, 0x23, 0xc8, 0x97, 0x91, 0xe1, 0x93, 0x4d, 0x83, 0x11, 0x80, 0x04, 0x85, 0x58, 0x46, 0xc4,
+	0x93, 0x05, 0x10, 0x47, 0x20, 0x41, 0x13, 0xac, 0x20, 0x57, 0x9d, 0xd3, 0x84, 0x88, 0x40, 0x74,
+	0x14, 0xe1, 0xff, 0x85, 0x9b, 0x38, 0xc9, 0x4c, 0xff, 0x36, 0x81, 0x65, 0x64, 0x5c, 0x90, 0x07,
+	0x49, 0x6c, 0x50, 0xfa, 0x09, 0x4d, 0xa8, 0x39, 0xb0, 0x0a, 0xb2, 0xbc, 0x42, 0xd6, 0x27, 0xc3,
+	0xfd, 0x31, 0x33, 0x9b, 0x73, 0xab, 0x25, 0x73, 0xff, 0x22, 0xb9, 0x45, 0x6c, 0x05, 0x13, 0x12,
+	0x24, 0xf5, 0x4d, 0xa5, 0x7d, 0x03, 0x97, 0xe8, 0x61, 0x21, 0x14, 0xa3, 0x70, 0xf3, 0x34, 0xe8,
+	0x5e, 0x7f, 0x52, 0x31, 0x0f, 0x64, 0xf5, 0x49, 0x8d, 0x53, 0xa3, 0xbd, 0x16, 0x63, 0x51, 0xc0,
+	0xd2, 0x58, 0xf5, 0x76, 0x13, 0x7b, 0x7b, 0x56, 0x88, 0x69, 0x18, 0xd6, 0x97, 0xda, 0xa9, 0x81,
+	0x36, 0x9f, 0xf3, 0x0f, 0xfb, 0x3a, 0x25, 0xeb, 0x90, 0x48, 0xe6, 0x73, 0x2a, 0x24, 0x74, 0xa9,
+	0x22, 0xad, 0x20, 0x69, 0xb7, 0x90, 0x74, 0x90, 0x48, 0xd6, 0x50, 0x06, 0x0d, 0xb8, 0x0d, 0xd3,
+	0x12, 0xe6, 0x7e, 0x27, 0x56, 0x2e, 0x17, 0xc7, 0x66, 0x13, 0x9c, 0xd4, 0xd3, 0x45, 0x82, 0xcd,
+	0x79, 0xdd, 0x81, 0x99, 0x7a, 0xb6, 0x6c, 0x69, 0x28, 0x3b, 0x01, 0x87, 0xd4, 0x87, 0x20, 0xe0,
+	0x54, 0x08, 0x75, 0xf4, 0xea, 0x35, 0xcb, 0xf6, 0x59, 0xbb, 0x0e, 0x94, 0x69, 0xbc, 0x6c, 0x69,
+	0xbe, 0x8c, 0x2d, 0x7c, 0x23, 0x1b, 0x3d, 0xc6, 0x22, 0x7f, 0xe6, 0xae, 0xaf, 0x22, 0xe1, 0x71,
+	0xf1, 0x93, 0xc1, 0x58, 0x34, 0x7b, 0xdf, 0xd7, 0x7b, 0x66, 0x31, 0x97, 0xce, 0x69, 0x13, 0x22,
+	0x88, 0x5b, 0x7a, 0xf4, 0x6b, 0x0b, 0xa4, 0x37, 0xc6, 0x16, 0x33, 0x7d, 0x52, 0xcc, 0xd2, 0x0f,
+	0x5f, 0x5f, 0x0e, 0x9d, 0xf2, 0xd5, 0xd0, 0x29, 0xff, 0x1e, 0x3a, 0xe5, 0x1f, 0x23, 0xa7, 0x74,
+	0x35, 0x72, 0x4a, 0x3f, 0x47, 0x4e, 0xe9, 0xab, 0xd7, 0x0e, 0x65, 0x27, 0x69, 0xba, 0x2d, 0x76,
+	0xe6, 0xbd, 0xf9, 0x72, 0x7a, 0xfc, 0x8e, 0xca, 0x94, 0xf1, 0xae, 0xd7, 0xea, 0x40, 0x18, 0x7b,
+	0x17, 0xe6, 0x63, 0x2a, 0x07, 0x3d, 0x2a, 0x9a, 0x15, 0x7c, 0x44, 0x9f, 0xff, 0x1d, 0x00, 0x77,
+	0xf8, 0xce, 0xdf, 0xec, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -192,6 +245,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolRebalanceList) > 0 {
+		for iNdEx := len(m.PoolRebalanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRebalanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PoolDelegatorList) > 0 {
+		for iNdEx := len(m.PoolDelegatorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolDelegatorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.WithdrawAddressList) > 0 {
+		for iNdEx := len(m.WithdrawAddressList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawAddressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.AutoRestakeState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.AutoRestakeList) > 0 {
+		for iNdEx := len(m.AutoRestakeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRestakeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.RedelegationCooldownList) > 0 {
 		for iNdEx := len(m.RedelegationCooldownList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -356,6 +475,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoRestakeList) > 0 {
+		for _, e := range m.AutoRestakeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AutoRestakeState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.WithdrawAddressList) > 0 {
+		for _, e := range m.WithdrawAddressList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolDelegatorList) > 0 {
+		for _, e := range m.PoolDelegatorList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolRebalanceList) > 0 {
+		for _, e := range m.PoolRebalanceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -664,6 +809,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakeList = append(m.AutoRestakeList, AutoRestake{})
+			if err := m.AutoRestakeList[len(m.AutoRestakeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoRestakeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddressList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddressList = append(m.WithdrawAddressList, WithdrawAddress{})
+			if err := m.WithdrawAddressList[len(m.WithdrawAddressList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDelegatorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDelegatorList = append(m.PoolDelegatorList, PoolDelegator{})
+			if err := m.PoolDelegatorList[len(m.PoolDelegatorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRebalanceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRebalanceList = append(m.PoolRebalanceList, PoolRebalance{})
+			if err := m.PoolRebalanceList[len(m.PoolRebalanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
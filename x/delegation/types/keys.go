@@ -1,7 +1,10 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/KYVENetwork/chain/util"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 const (
@@ -49,6 +52,27 @@ var (
 
 	// RedelegationCooldownPrefix ...
 	RedelegationCooldownPrefix = []byte{7}
+
+	// AutoRestakeKeyPrefix ...
+	AutoRestakeKeyPrefix = []byte{8}
+
+	// AutoRestakeStateKey ...
+	AutoRestakeStateKey = []byte{9}
+
+	// WithdrawAddressKeyPrefix ...
+	WithdrawAddressKeyPrefix = []byte{10}
+
+	// PoolDelegatorKeyPrefix is the prefix to retrieve all PoolDelegator entries
+	PoolDelegatorKeyPrefix = []byte{11, 0}
+
+	// PoolDelegatorKeyPrefixIndex2 is the prefix to retrieve all PoolDelegator entries of a pool
+	PoolDelegatorKeyPrefixIndex2 = []byte{11, 1}
+
+	// PoolDelegatorKeyPrefixIndex3 is the prefix to retrieve a PoolDelegator by its account
+	PoolDelegatorKeyPrefixIndex3 = []byte{11, 2}
+
+	// PoolRebalanceKeyPrefix is the prefix to retrieve all PoolRebalance entries
+	PoolRebalanceKeyPrefix = []byte{12}
 )
 
 // DelegatorKey returns the store Key to retrieve a Delegator from the index fields
@@ -83,6 +107,36 @@ func RedelegationCooldownKey(delegator string, block uint64) []byte {
 	return util.GetByteKey(delegator, block)
 }
 
+func AutoRestakeKey(delegatorAddress string, stakerAddress string) []byte {
+	return util.GetByteKey(delegatorAddress, stakerAddress)
+}
+
+func WithdrawAddressKey(address string) []byte {
+	return util.GetByteKey(address)
+}
+
+func PoolDelegatorKey(delegatorAddress string, poolId uint64) []byte {
+	return util.GetByteKey(delegatorAddress, poolId)
+}
+
+func PoolDelegatorKeyIndex2(poolId uint64, delegatorAddress string) []byte {
+	return util.GetByteKey(poolId, delegatorAddress)
+}
+
+func PoolDelegatorKeyIndex3(account string) []byte {
+	return util.GetByteKey(account)
+}
+
+func PoolRebalanceKey(poolId uint64) []byte {
+	return util.GetByteKey(poolId)
+}
+
+// PoolDelegationAccount returns the derived address which holds the
+// delegations of the given delegator to the stakers of the given pool.
+func PoolDelegationAccount(poolId uint64, delegatorAddress string) string {
+	return authTypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/%s", ModuleName, poolId, delegatorAddress)).String()
+}
+
 func DelegationSlashEntriesKey(stakerAddress string, kIndex uint64) []byte {
 	return util.GetByteKey(stakerAddress, kIndex)
 }
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgCancelUnbonding{}
+	_ sdk.Msg            = &MsgCancelUnbonding{}
+)
+
+func (msg *MsgCancelUnbonding) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelUnbonding) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelUnbonding) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelUnbonding) Type() string {
+	return "kyve/delegation/MsgCancelUnbonding"
+}
+
+func (msg *MsgCancelUnbonding) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Amount == 0 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "amount must be greater than zero")
+	}
+
+	return nil
+}
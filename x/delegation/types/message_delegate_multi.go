@@ -0,0 +1,98 @@
+package types
+
+import (
+	"math"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgDelegateMulti{}
+	_ sdk.Msg            = &MsgDelegateMulti{}
+)
+
+func (msg *MsgDelegateMulti) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDelegateMulti) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDelegateMulti) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDelegateMulti) Type() string {
+	return "kyve/delegation/MsgDelegateMulti"
+}
+
+func (msg *MsgDelegateMulti) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Entries) == 0 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "no stakers to delegate to")
+	}
+
+	// Either the amounts of all entries or the weights of all entries are used
+	useWeights := msg.Amount > 0
+	totalWeight := uint64(0)
+	totalAmount := uint64(0)
+	stakers := make(map[string]struct{})
+
+	for _, entry := range msg.Entries {
+		if _, err := sdk.AccAddressFromBech32(entry.Staker); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid staker address (%s)", err)
+		}
+
+		if _, ok := stakers[entry.Staker]; ok {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "duplicated staker %s", entry.Staker)
+		}
+		stakers[entry.Staker] = struct{}{}
+
+		if useWeights && entry.Amount > 0 {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "amount of staker %s must be zero if a total amount is given", entry.Staker)
+		}
+
+		if !useWeights && entry.Weight > 0 {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "weight of staker %s requires a total amount", entry.Staker)
+		}
+
+		if !useWeights && entry.Amount == 0 {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "amount of staker %s must be greater than zero", entry.Staker)
+		}
+
+		if totalWeight+entry.Weight < totalWeight {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "total weight overflows")
+		}
+		totalWeight += entry.Weight
+
+		// All amounts are transferred as int64, therefore the total must fit into it
+		if totalAmount+entry.Amount < totalAmount || totalAmount+entry.Amount > math.MaxInt64 {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "total amount exceeds %d", uint64(math.MaxInt64))
+		}
+		totalAmount += entry.Amount
+	}
+
+	if msg.Amount > math.MaxInt64 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "total amount exceeds %d", uint64(math.MaxInt64))
+	}
+
+	if useWeights && totalWeight == 0 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "total weight must be greater than zero")
+	}
+
+	return nil
+}
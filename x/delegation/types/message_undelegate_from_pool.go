@@ -0,0 +1,44 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgUndelegateFromPool{}
+	_ sdk.Msg            = &MsgUndelegateFromPool{}
+)
+
+func (msg *MsgUndelegateFromPool) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUndelegateFromPool) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUndelegateFromPool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUndelegateFromPool) Type() string {
+	return "kyve/delegation/MsgUndelegateFromPool"
+}
+
+func (msg *MsgUndelegateFromPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
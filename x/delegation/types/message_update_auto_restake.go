@@ -0,0 +1,49 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgUpdateAutoRestake{}
+	_ sdk.Msg            = &MsgUpdateAutoRestake{}
+)
+
+func (msg *MsgUpdateAutoRestake) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateAutoRestake) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateAutoRestake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateAutoRestake) Type() string {
+	return "kyve/delegation/MsgUpdateAutoRestake"
+}
+
+func (msg *MsgUpdateAutoRestake) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Staker)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid staker address (%s)", err)
+	}
+
+	return nil
+}
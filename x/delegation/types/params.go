@@ -23,6 +23,18 @@ var DefaultUploadSlash = math.LegacyMustNewDecFromStr("0.2")
 // DefaultTimeoutSlash ...
 var DefaultTimeoutSlash = math.LegacyMustNewDecFromStr("0.02")
 
+// DefaultAutoRestakeEpoch ...
+var DefaultAutoRestakeEpoch = uint64(60 * 60 * 24)
+
+// DefaultAutoRestakeBatchSize ...
+var DefaultAutoRestakeBatchSize = uint64(100)
+
+// DefaultPoolRebalanceBatchSize ...
+var DefaultPoolRebalanceBatchSize = uint64(100)
+
+// DefaultMinPoolDelegation ...
+var DefaultMinPoolDelegation = uint64(100_000_000) // 100 $KYVE
+
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingDelegationTime uint64,
@@ -31,6 +43,10 @@ func NewParams(
 	voteSlash math.LegacyDec,
 	uploadSlash math.LegacyDec,
 	timeoutSlash math.LegacyDec,
+	autoRestakeEpoch uint64,
+	autoRestakeBatchSize uint64,
+	poolRebalanceBatchSize uint64,
+	minPoolDelegation uint64,
 ) Params {
 	return Params{
 		UnbondingDelegationTime: unbondingDelegationTime,
@@ -39,6 +55,10 @@ func NewParams(
 		VoteSlash:               voteSlash,
 		UploadSlash:             uploadSlash,
 		TimeoutSlash:            timeoutSlash,
+		AutoRestakeEpoch:        autoRestakeEpoch,
+		AutoRestakeBatchSize:    autoRestakeBatchSize,
+		PoolRebalanceBatchSize:  poolRebalanceBatchSize,
+		MinPoolDelegation:       minPoolDelegation,
 	}
 }
 
@@ -51,6 +71,10 @@ func DefaultParams() Params {
 		DefaultVoteSlash,
 		DefaultUploadSlash,
 		DefaultTimeoutSlash,
+		DefaultAutoRestakeEpoch,
+		DefaultAutoRestakeBatchSize,
+		DefaultPoolRebalanceBatchSize,
+		DefaultMinPoolDelegation,
 	)
 }
 
@@ -80,5 +104,21 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidateNumber(p.AutoRestakeEpoch); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.AutoRestakeBatchSize); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.PoolRebalanceBatchSize); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.MinPoolDelegation); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -38,6 +38,18 @@ type Params struct {
 	UploadSlash cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=upload_slash,json=uploadSlash,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"upload_slash"`
 	// timeout_slash ...
 	TimeoutSlash cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=timeout_slash,json=timeoutSlash,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"timeout_slash"`
+	// auto_restake_epoch is the time in seconds between two auto-restakes,
+	// zero disables the auto-restaking
+	AutoRestakeEpoch uint64 `protobuf:"varint,7,opt,name=auto_restake_epoch,json=autoRestakeEpoch,proto3" json:"auto_restake_epoch,omitempty"`
+	// auto_restake_batch_size is the maximum amount of delegators
+	// which get restaked in a single block, zero disables the auto-restaking
+	AutoRestakeBatchSize uint64 `protobuf:"varint,8,opt,name=auto_restake_batch_size,json=autoRestakeBatchSize,proto3" json:"auto_restake_batch_size,omitempty"`
+	// pool_rebalance_batch_size is the maximum amount of pool delegators
+	// which get rebalanced in a single block, zero disables the rebalancing
+	PoolRebalanceBatchSize uint64 `protobuf:"varint,9,opt,name=pool_rebalance_batch_size,json=poolRebalanceBatchSize,proto3" json:"pool_rebalance_batch_size,omitempty"`
+	// min_pool_delegation is the minimum amount of $KYVE a delegator
+	// needs to have delegated to a pool
+	MinPoolDelegation uint64 `protobuf:"varint,10,opt,name=min_pool_delegation,json=minPoolDelegation,proto3" json:"min_pool_delegation,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -94,6 +106,34 @@ func (m *Params) GetRedelegationMaxAmount() uint64 {
 	return 0
 }
 
+func (m *Params) GetAutoRestakeEpoch() uint64 {
+	if m != nil {
+		return m.AutoRestakeEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetAutoRestakeBatchSize() uint64 {
+	if m != nil {
+		return m.AutoRestakeBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetPoolRebalanceBatchSize() uint64 {
+	if m != nil {
+		return m.PoolRebalanceBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetMinPoolDelegation() uint64 {
+	if m != nil {
+		return m.MinPoolDelegation
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.delegation.v1beta1.Params")
 }
@@ -103,29 +143,35 @@ func init() {
 }
 
 var fileDescriptor_17019e1d49c878a9 = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcb, 0x4a, 0xf3, 0x40,
-	0x1c, 0x47, 0x93, 0xaf, 0xfd, 0x0a, 0x1d, 0xeb, 0x26, 0x54, 0x1a, 0x15, 0xd2, 0xa2, 0x2e, 0xba,
-	0xca, 0x50, 0x0a, 0x2e, 0xdc, 0x59, 0xab, 0x28, 0x5e, 0x90, 0x2a, 0x82, 0x6e, 0xc2, 0x24, 0x19,
-	0x92, 0xd0, 0x4c, 0xfe, 0x21, 0x33, 0xe9, 0xe5, 0x09, 0xdc, 0xfa, 0x58, 0x5d, 0x76, 0x29, 0x2e,
-	0x8a, 0xb4, 0x2f, 0x22, 0x99, 0x86, 0x5e, 0x76, 0xdd, 0x0d, 0xfc, 0xce, 0x39, 0x8b, 0x99, 0x41,
-	0x67, 0xfd, 0xf1, 0x80, 0x62, 0x97, 0x86, 0xd4, 0x23, 0x22, 0x80, 0x08, 0x0f, 0x5a, 0x36, 0x15,
-	0xa4, 0x85, 0x63, 0x92, 0x10, 0xc6, 0xcd, 0x38, 0x01, 0x01, 0x5a, 0x2d, 0xa3, 0xcc, 0x35, 0x65,
-	0xe6, 0xd4, 0x51, 0xd5, 0x03, 0x0f, 0x24, 0x83, 0xb3, 0xd3, 0x12, 0x3f, 0xf9, 0x2c, 0xa0, 0xd2,
-	0xb3, 0xf4, 0xb5, 0x0b, 0x74, 0x98, 0x46, 0x36, 0x44, 0x6e, 0x10, 0x79, 0xd6, 0x3a, 0x60, 0x89,
-	0x80, 0x51, 0x5d, 0x6d, 0xa8, 0xcd, 0x62, 0xaf, 0xb6, 0x02, 0xba, 0xab, 0xfd, 0x35, 0x60, 0x54,
-	0x6b, 0xa3, 0x83, 0x84, 0x6e, 0x38, 0x0e, 0x40, 0xe8, 0xc2, 0x30, 0xd2, 0xff, 0x49, 0xaf, 0xba,
-	0x39, 0x5e, 0xe5, 0x9b, 0x76, 0x8e, 0x6a, 0x5b, 0x12, 0x23, 0x23, 0x8b, 0x30, 0x48, 0x23, 0xa1,
-	0x17, 0xa4, 0xb6, 0xd5, 0x7c, 0x24, 0xa3, 0x4b, 0x39, 0x6a, 0x1d, 0x84, 0x06, 0x20, 0xa8, 0xc5,
-	0x43, 0xc2, 0x7d, 0xbd, 0xd8, 0x50, 0x9b, 0xe5, 0xce, 0xe9, 0x64, 0x56, 0x57, 0x7e, 0x66, 0xf5,
-	0x63, 0x07, 0x38, 0x03, 0xce, 0xdd, 0xbe, 0x19, 0x00, 0x66, 0x44, 0xf8, 0xe6, 0x03, 0xf5, 0x88,
-	0x33, 0xee, 0x52, 0xa7, 0x57, 0xce, 0xb4, 0x97, 0xcc, 0xd2, 0x6e, 0x50, 0x25, 0x8d, 0x43, 0x20,
-	0x6e, 0x5e, 0xf9, 0xbf, 0x7b, 0x65, 0x6f, 0x29, 0x2e, 0x3b, 0xb7, 0x68, 0x3f, 0xbb, 0x1f, 0x48,
-	0x45, 0x1e, 0x2a, 0xed, 0x1e, 0xaa, 0xe4, 0xa6, 0x2c, 0x75, 0xee, 0x26, 0x73, 0x43, 0x9d, 0xce,
-	0x0d, 0xf5, 0x77, 0x6e, 0xa8, 0x5f, 0x0b, 0x43, 0x99, 0x2e, 0x0c, 0xe5, 0x7b, 0x61, 0x28, 0x1f,
-	0xd8, 0x0b, 0x84, 0x9f, 0xda, 0xa6, 0x03, 0x0c, 0xdf, 0xbf, 0xbf, 0x5d, 0x3f, 0x51, 0x31, 0x84,
-	0xa4, 0x8f, 0x1d, 0x9f, 0x04, 0x11, 0x1e, 0x6d, 0x7e, 0x09, 0x31, 0x8e, 0x29, 0xb7, 0x4b, 0xf2,
-	0x6d, 0xdb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x22, 0xbc, 0x7d, 0xc4, 0x32, 0x02, 0x00, 0x00,
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x28, 0x85, 0x9a, 0x21, 0x41, 0x18, 0x34, 0x03, 0x29, 0x9b, 0x80, 0xc3, 0x0e,
+	0x28, 0xd1, 0x34, 0x81, 0x04, 0x37, 0xca, 0x86, 0x40, 0xfc, 0xd1, 0xd4, 0x21, 0x24, 0xb8, 0x58,
+	0x8e, 0xfb, 0x2a, 0xb1, 0x1a, 0xfb, 0x8d, 0x62, 0xa7, 0x6b, 0xf7, 0x29, 0xf8, 0x58, 0x3b, 0xee,
+	0x06, 0xe2, 0x30, 0xa1, 0xf6, 0x8b, 0x20, 0x3b, 0x61, 0x69, 0x6f, 0xbb, 0x45, 0x79, 0x9e, 0xdf,
+	0xef, 0xf0, 0xfa, 0x21, 0xcf, 0x26, 0xf3, 0x29, 0xc4, 0x63, 0xc8, 0x21, 0x65, 0x46, 0xa0, 0x8a,
+	0xa7, 0x7b, 0x09, 0x18, 0xb6, 0x17, 0x17, 0xac, 0x64, 0x52, 0x47, 0x45, 0x89, 0x06, 0xfd, 0x81,
+	0x6d, 0x45, 0x6d, 0x2b, 0x6a, 0x5a, 0x8f, 0x36, 0x53, 0x4c, 0xd1, 0x75, 0x62, 0xfb, 0x55, 0xd7,
+	0x9f, 0xfc, 0xea, 0x92, 0xde, 0x91, 0xe3, 0xfd, 0xd7, 0x64, 0xab, 0x52, 0x09, 0xaa, 0xb1, 0x50,
+	0x29, 0x6d, 0x05, 0xd4, 0x08, 0x09, 0x81, 0xb7, 0xe3, 0xed, 0x76, 0x47, 0x83, 0xcb, 0xc2, 0xc1,
+	0x65, 0xfe, 0x55, 0x48, 0xf0, 0xf7, 0xc9, 0x83, 0x12, 0x56, 0x18, 0x8e, 0x98, 0x8f, 0xf1, 0x44,
+	0x05, 0xd7, 0x1c, 0xb7, 0xb9, 0x1a, 0xbe, 0x6d, 0x32, 0xff, 0x25, 0x19, 0xac, 0x41, 0x92, 0xcd,
+	0x28, 0x93, 0x58, 0x29, 0x13, 0x5c, 0x77, 0xd8, 0x9a, 0xf3, 0x33, 0x9b, 0xbd, 0x71, 0xa1, 0x3f,
+	0x24, 0x64, 0x8a, 0x06, 0xa8, 0xce, 0x99, 0xce, 0x82, 0xee, 0x8e, 0xb7, 0xdb, 0x1f, 0x3e, 0x3d,
+	0xbb, 0xd8, 0xee, 0xfc, 0xb9, 0xd8, 0x7e, 0xcc, 0x51, 0x4b, 0xd4, 0x7a, 0x3c, 0x89, 0x04, 0xc6,
+	0x92, 0x99, 0x2c, 0xfa, 0x04, 0x29, 0xe3, 0xf3, 0x03, 0xe0, 0xa3, 0xbe, 0xc5, 0x8e, 0x2d, 0xe5,
+	0xbf, 0x23, 0x1b, 0x55, 0x91, 0x23, 0x1b, 0x37, 0x96, 0x1b, 0x57, 0xb7, 0xdc, 0xae, 0xc1, 0xda,
+	0xf3, 0x9e, 0xdc, 0xb1, 0xf7, 0xc1, 0xca, 0x34, 0xa2, 0xde, 0xd5, 0x45, 0x1b, 0x0d, 0x59, 0x9b,
+	0x9e, 0x13, 0x9f, 0x55, 0x06, 0x69, 0x09, 0xda, 0xb0, 0x09, 0x50, 0x28, 0x90, 0x67, 0xc1, 0x4d,
+	0x77, 0x88, 0xbb, 0x36, 0x19, 0xd5, 0xc1, 0xa1, 0xfd, 0xef, 0xbf, 0x20, 0x83, 0xb5, 0x76, 0xc2,
+	0x0c, 0xcf, 0xa8, 0x16, 0xa7, 0x10, 0xdc, 0xaa, 0x4f, 0xbe, 0x82, 0x0c, 0x6d, 0x78, 0x2c, 0x4e,
+	0xc1, 0x7f, 0x45, 0xb6, 0x0a, 0xc4, 0x9c, 0x96, 0x90, 0xb0, 0x9c, 0x29, 0xbe, 0x06, 0xf6, 0x1d,
+	0xf8, 0xd0, 0x16, 0x46, 0xff, 0xf3, 0x16, 0x8d, 0xc8, 0x7d, 0x29, 0x14, 0x75, 0x78, 0xfb, 0x2a,
+	0x01, 0x71, 0xd0, 0x3d, 0x29, 0xd4, 0x11, 0x62, 0xde, 0xce, 0x62, 0xf8, 0xe1, 0x6c, 0x11, 0x7a,
+	0xe7, 0x8b, 0xd0, 0xfb, 0xbb, 0x08, 0xbd, 0x9f, 0xcb, 0xb0, 0x73, 0xbe, 0x0c, 0x3b, 0xbf, 0x97,
+	0x61, 0xe7, 0x47, 0x9c, 0x0a, 0x93, 0x55, 0x49, 0xc4, 0x51, 0xc6, 0x1f, 0xbf, 0x7f, 0x3b, 0xfc,
+	0x02, 0xe6, 0x04, 0xcb, 0x49, 0xcc, 0x33, 0x26, 0x54, 0x3c, 0x5b, 0x9d, 0xb8, 0x99, 0x17, 0xa0,
+	0x93, 0x9e, 0xdb, 0xea, 0xfe, 0xbf, 0x01, 0x00, 0xa8, 0x15, 0x95, 0x62, 0x02, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -148,6 +194,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinPoolDelegation != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPoolDelegation))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PoolRebalanceBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PoolRebalanceBatchSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AutoRestakeBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoRestakeBatchSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AutoRestakeEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoRestakeEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
 	{
 		size := m.TimeoutSlash.Size()
 		i -= size
@@ -228,6 +294,18 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.TimeoutSlash.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.AutoRestakeEpoch != 0 {
+		n += 1 + sovParams(uint64(m.AutoRestakeEpoch))
+	}
+	if m.AutoRestakeBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.AutoRestakeBatchSize))
+	}
+	if m.PoolRebalanceBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.PoolRebalanceBatchSize))
+	}
+	if m.MinPoolDelegation != 0 {
+		n += 1 + sovParams(uint64(m.MinPoolDelegation))
+	}
 	return n
 }
 
@@ -425,6 +503,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeEpoch", wireType)
+			}
+			m.AutoRestakeEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRestakeEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeBatchSize", wireType)
+			}
+			m.AutoRestakeBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRestakeBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRebalanceBatchSize", wireType)
+			}
+			m.PoolRebalanceBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolRebalanceBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolDelegation", wireType)
+			}
+			m.MinPoolDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPoolDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -105,6 +105,22 @@ func (k Keeper) SetFunding(ctx sdk.Context, funding *types.Funding) {
 	), b)
 }
 
+// RemoveFunding removes a specific funding from the store
+func (k Keeper) RemoveFunding(ctx sdk.Context, funding *types.Funding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storeByFunder := prefix.NewStore(storeAdapter, types.FundingKeyPrefixByFunder)
+	storeByFunder.Delete(types.FundingKeyByFunder(
+		funding.FunderAddress,
+		funding.PoolId,
+	))
+
+	storeByPool := prefix.NewStore(storeAdapter, types.FundingKeyPrefixByPool)
+	storeByPool.Delete(types.FundingKeyByPool(
+		funding.FunderAddress,
+		funding.PoolId,
+	))
+}
+
 // GetPaginatedFundingQuery performs a full search on all fundings with the given parameters.
 // Requires either funderAddress or poolId to be provided.
 func (k Keeper) GetPaginatedFundingQuery(
@@ -58,6 +58,13 @@ func (k Keeper) SetFundingState(ctx sdk.Context, fundingState *types.FundingStat
 	), b)
 }
 
+// RemoveFundingState removes the FundingState of a pool
+func (k Keeper) RemoveFundingState(ctx sdk.Context, poolId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FundingStateKeyPrefix)
+	store.Delete(types.FundingStateKey(poolId))
+}
+
 func (k Keeper) GetActiveFundings(ctx sdk.Context, fundingState types.FundingState) (fundings []types.Funding) {
 	for _, funder := range fundingState.ActiveFunderAddresses {
 		funding, found := k.GetFunding(ctx, funder, fundingState.PoolId)
@@ -19,6 +19,82 @@ func (k Keeper) CreateFundingState(ctx sdk.Context, poolId uint64) {
 	k.SetFundingState(ctx, &fundingState)
 }
 
+// MigrateFundings moves all active fundings of a pool to another pool. The funds
+// remain in the funders module, only the pool of the fundings changes. It returns
+// the amount of migrated fundings.
+func (k Keeper) MigrateFundings(ctx sdk.Context, fromPoolId uint64, toPoolId uint64) (migrated uint64) {
+	fromFundingState, _ := k.GetFundingState(ctx, fromPoolId)
+	toFundingState, _ := k.GetFundingState(ctx, toPoolId)
+
+	for _, funding := range k.GetActiveFundings(ctx, fromFundingState) {
+		migratedFunding, found := k.GetFunding(ctx, funding.FunderAddress, toPoolId)
+		if !found {
+			migratedFunding = types.Funding{
+				FunderAddress: funding.FunderAddress,
+				PoolId:        toPoolId,
+				Amounts:       sdk.NewCoins(),
+				TotalFunded:   sdk.NewCoins(),
+			}
+		}
+
+		migratedFunding.Amounts = migratedFunding.Amounts.Add(funding.Amounts...)
+		migratedFunding.AmountsPerBundle = funding.AmountsPerBundle
+		toFundingState.SetActive(&migratedFunding)
+		k.SetFunding(ctx, &migratedFunding)
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
+			PoolId:  fromPoolId,
+			Address: funding.FunderAddress,
+			Amounts: funding.Amounts.String(),
+		})
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventFundPool{
+			PoolId:           toPoolId,
+			Address:          funding.FunderAddress,
+			Amounts:          funding.Amounts.String(),
+			AmountsPerBundle: funding.AmountsPerBundle.String(),
+		})
+
+		funding.Amounts = sdk.NewCoins()
+		fromFundingState.SetInactive(&funding)
+		k.SetFunding(ctx, &funding)
+
+		migrated++
+	}
+
+	k.SetFundingState(ctx, &fromFundingState)
+	k.SetFundingState(ctx, &toFundingState)
+
+	return migrated
+}
+
+// RemoveFundingsOfPool transfers the remaining amounts of all fundings of a pool
+// back to their funders and removes the fundings together with the funding state
+// of the pool. It returns the amount of refunded fundings.
+func (k Keeper) RemoveFundingsOfPool(ctx sdk.Context, poolId uint64) (refunded uint64, err error) {
+	for _, funding := range k.GetFundingsOfPool(ctx, poolId) {
+		if !funding.Amounts.IsZero() {
+			recipient := sdk.MustAccAddressFromBech32(funding.FunderAddress)
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, funding.Amounts); err != nil {
+				return refunded, err
+			}
+
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
+				PoolId:  poolId,
+				Address: funding.FunderAddress,
+				Amounts: funding.Amounts.String(),
+			})
+
+			refunded++
+		}
+
+		k.RemoveFunding(ctx, &funding)
+	}
+
+	k.RemoveFundingState(ctx, poolId)
+
+	return refunded, nil
+}
+
 func (k Keeper) GetTotalActiveFunding(ctx sdk.Context, poolId uint64) (amounts sdk.Coins) {
 	state, found := k.GetFundingState(ctx, poolId)
 	if !found {
@@ -6,7 +6,13 @@ import (
 )
 
 func (f *Funding) GetScore(whitelist map[string]WhitelistCoinEntry) (score uint64) {
-	for _, coin := range f.Amounts {
+	return GetCoinsScore(f.Amounts, whitelist)
+}
+
+// GetCoinsScore returns the USD value of the given coins based on the
+// coin weights of the whitelist. Coins which are not whitelisted are ignored.
+func GetCoinsScore(coins sdk.Coins, whitelist map[string]WhitelistCoinEntry) (score uint64) {
+	for _, coin := range coins {
 		if entry, found := whitelist[coin.Denom]; found {
 			// we first multiply the coin weight which is the USD value per currency unit and then times the amount,
 			// because dividing with the amount of base denoms (10^coin_decimals) first could exceed the decimal
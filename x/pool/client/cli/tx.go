@@ -18,5 +18,7 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdSignalUpgradeReady())
+
 	return cmd
 }
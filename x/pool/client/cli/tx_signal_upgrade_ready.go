@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSignalUpgradeReady() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-upgrade-ready [staker] [pool_id] [version]",
+		Short: "Broadcast message signal-upgrade-ready",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStaker := args[0]
+
+			argPoolId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argVersion := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSignalUpgradeReady{
+				Creator: clientCtx.GetFromAddress().String(),
+				Staker:  argStaker,
+				PoolId:  argPoolId,
+				Version: argVersion,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,6 +15,22 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	}
 
 	k.SetPoolCount(ctx, genState.PoolCount)
+
+	for _, elem := range genState.PoolConfigChangeList {
+		k.SetPoolConfigChange(ctx, elem)
+	}
+
+	for _, elem := range genState.UpgradeReadinessList {
+		k.SetUpgradeReadiness(ctx, elem)
+	}
+
+	for _, elem := range genState.FunderPayoutsList {
+		k.SetFunderPayouts(ctx, elem)
+	}
+
+	for _, elem := range genState.InflationLedgerList {
+		k.SetInflationLedger(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the pool module's exported genesis.
@@ -24,6 +40,10 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.PoolList = k.GetAllPools(ctx)
 	genesis.PoolCount = k.GetPoolCount(ctx)
+	genesis.PoolConfigChangeList = k.GetAllPoolConfigChanges(ctx)
+	genesis.UpgradeReadinessList = k.GetAllUpgradeReadiness(ctx)
+	genesis.FunderPayoutsList = k.GetAllFunderPayouts(ctx)
+	genesis.InflationLedgerList = k.GetAllInflationLedgers(ctx)
 
 	return genesis
 }
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetFunderPayouts sets the recent funder payouts of a pool in the store
+func (k Keeper) SetFunderPayouts(ctx sdk.Context, funderPayouts types.FunderPayouts) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderPayoutsKey)
+	b := k.cdc.MustMarshal(&funderPayouts)
+	store.Set(types.FunderPayoutsKeyPrefix(funderPayouts.PoolId), b)
+}
+
+// GetFunderPayouts returns the recent funder payouts of a pool
+func (k Keeper) GetFunderPayouts(ctx sdk.Context, poolId uint64) (val types.FunderPayouts, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderPayoutsKey)
+	b := store.Get(types.FunderPayoutsKeyPrefix(poolId))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveFunderPayouts removes the recent funder payouts of a pool from the store
+func (k Keeper) RemoveFunderPayouts(ctx sdk.Context, poolId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderPayoutsKey)
+	store.Delete(types.FunderPayoutsKeyPrefix(poolId))
+}
+
+// GetAllFunderPayouts returns the recent funder payouts of all pools
+func (k Keeper) GetAllFunderPayouts(ctx sdk.Context) (list []types.FunderPayouts) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderPayoutsKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FunderPayouts
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetInflationLedger sets the inflation ledger of a pool in the store
+func (k Keeper) SetInflationLedger(ctx sdk.Context, inflationLedger types.InflationLedger) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.InflationLedgerKey)
+	b := k.cdc.MustMarshal(&inflationLedger)
+	store.Set(types.InflationLedgerKeyPrefix(inflationLedger.PoolId), b)
+}
+
+// GetInflationLedger returns the inflation ledger of a pool
+func (k Keeper) GetInflationLedger(ctx sdk.Context, poolId uint64) (val types.InflationLedger, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.InflationLedgerKey)
+	b := store.Get(types.InflationLedgerKeyPrefix(poolId))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllInflationLedgers returns the inflation ledgers of all pools
+func (k Keeper) GetAllInflationLedgers(ctx sdk.Context) (list []types.InflationLedger) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.InflationLedgerKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.InflationLedger
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
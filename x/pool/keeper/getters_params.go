@@ -33,6 +33,31 @@ func (k Keeper) GetMaxVotingPowerPerPool(ctx sdk.Context) (res math.LegacyDec) {
 	return k.GetParams(ctx).MaxVotingPowerPerPool
 }
 
+// GetDynamicInflationShare returns the DynamicInflationShare param
+func (k Keeper) GetDynamicInflationShare(ctx sdk.Context) (res math.LegacyDec) {
+	return k.GetParams(ctx).DynamicInflationShare
+}
+
+// GetDynamicInflationMode returns the DynamicInflationMode param
+func (k Keeper) GetDynamicInflationMode(ctx sdk.Context) (res types.DynamicInflationMode) {
+	return k.GetParams(ctx).DynamicInflationMode
+}
+
+// GetFunderPayoutsWindow returns the FunderPayoutsWindow param
+func (k Keeper) GetFunderPayoutsWindow(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).FunderPayoutsWindow
+}
+
+// GetMaxStakersPerPool returns the MaxStakersPerPool param
+func (k Keeper) GetMaxStakersPerPool(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MaxStakersPerPool
+}
+
+// GetMaxStakersPerPoolLimit returns the MaxStakersPerPoolLimit param
+func (k Keeper) GetMaxStakersPerPoolLimit(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MaxStakersPerPoolLimit
+}
+
 // SetParams stores the x/pool params in state.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
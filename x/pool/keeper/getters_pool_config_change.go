@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetPoolConfigChange sets a specific pool config change in the store
+func (k Keeper) SetPoolConfigChange(ctx sdk.Context, change types.PoolConfigChange) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolConfigChangeKey)
+	b := k.cdc.MustMarshal(&change)
+	store.Set(types.PoolConfigChangeKeyPrefix(change.PoolId, change.Index), b)
+}
+
+// GetPoolConfigChange returns a pool config change by its pool id and index
+func (k Keeper) GetPoolConfigChange(ctx sdk.Context, poolId, index uint64) (val types.PoolConfigChange, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolConfigChangeKey)
+	b := store.Get(types.PoolConfigChangeKeyPrefix(poolId, index))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetPoolConfigChangeCount returns the number of config changes recorded for the given pool
+func (k Keeper) GetPoolConfigChangeCount(ctx sdk.Context, poolId uint64) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, util.GetByteKey(types.PoolConfigChangeKey, poolId))
+
+	// The index of the last change equals the total amount of changes - 1
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+	return binary.BigEndian.Uint64(iterator.Key()) + 1
+}
+
+// GetAllPoolConfigChanges returns all config changes of all pools
+func (k Keeper) GetAllPoolConfigChanges(ctx sdk.Context) (list []types.PoolConfigChange) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolConfigChangeKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PoolConfigChange
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPaginatedPoolConfigChangesQuery returns the config changes of the given pool ordered by their index.
+func (k Keeper) GetPaginatedPoolConfigChangesQuery(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+	poolId uint64,
+) ([]types.PoolConfigChange, *query.PageResponse, error) {
+	var changes []types.PoolConfigChange
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, util.GetByteKey(types.PoolConfigChangeKey, poolId))
+
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		var change types.PoolConfigChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return changes, pageRes, nil
+}
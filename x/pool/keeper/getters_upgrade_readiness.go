@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetUpgradeReadiness sets the upgrade readiness signal of a staker in the store
+func (k Keeper) SetUpgradeReadiness(ctx sdk.Context, readiness types.UpgradeReadiness) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.UpgradeReadinessKey)
+	b := k.cdc.MustMarshal(&readiness)
+	store.Set(types.UpgradeReadinessKeyPrefix(readiness.PoolId, readiness.Staker), b)
+}
+
+// GetUpgradeReadiness returns the upgrade readiness signal of a staker in a pool
+func (k Keeper) GetUpgradeReadiness(ctx sdk.Context, poolId uint64, staker string) (val types.UpgradeReadiness, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.UpgradeReadinessKey)
+	b := store.Get(types.UpgradeReadinessKeyPrefix(poolId, staker))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAllUpgradeReadinessOfPool removes all upgrade readiness signals of a pool
+func (k Keeper) RemoveAllUpgradeReadinessOfPool(ctx sdk.Context, poolId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, util.GetByteKey(types.UpgradeReadinessKey, poolId))
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	_ = iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllUpgradeReadiness returns all upgrade readiness signals of all pools
+func (k Keeper) GetAllUpgradeReadiness(ctx sdk.Context) (list []types.UpgradeReadiness) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.UpgradeReadinessKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UpgradeReadiness
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -26,12 +26,14 @@ type (
 
 		authority string
 
-		stakersKeeper types.StakersKeeper
-		accountKeeper types.AccountKeeper
-		bankKeeper    types.BankKeeper
-		distrkeeper   util.DistributionKeeper
-		upgradeKeeper util.UpgradeKeeper
-		fundersKeeper types.FundersKeeper
+		stakersKeeper    types.StakersKeeper
+		delegationKeeper types.DelegationKeeper
+		accountKeeper    types.AccountKeeper
+		bankKeeper       types.BankKeeper
+		distrkeeper      util.DistributionKeeper
+		upgradeKeeper    util.UpgradeKeeper
+		fundersKeeper    types.FundersKeeper
+		bundlesKeeper    types.BundlesKeeper
 	}
 )
 
@@ -87,10 +89,18 @@ func SetStakersKeeper(k *Keeper, stakersKeeper types.StakersKeeper) {
 	k.stakersKeeper = stakersKeeper
 }
 
+func SetDelegationKeeper(k *Keeper, delegationKeeper types.DelegationKeeper) {
+	k.delegationKeeper = delegationKeeper
+}
+
 func SetFundersKeeper(k *Keeper, fundersKeeper types.FundersKeeper) {
 	k.fundersKeeper = fundersKeeper
 }
 
+func SetBundlesKeeper(k *Keeper, bundlesKeeper types.BundlesKeeper) {
+	k.bundlesKeeper = bundlesKeeper
+}
+
 func (k Keeper) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
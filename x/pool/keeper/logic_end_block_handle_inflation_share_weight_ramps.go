@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HandleInflationShareWeightRamps handles the end-block logic for completing
+// scheduled inflation share weight ramps which reached their end.
+func (k Keeper) HandleInflationShareWeightRamps(ctx sdk.Context) {
+	for _, pool := range k.GetAllPools(ctx) {
+		// Once the ramp is over the target weight becomes the fixed weight of the pool
+		if ramp := pool.InflationShareWeightRamp; ramp != nil && uint64(ctx.BlockTime().Unix()) >= ramp.EndAt {
+			pool.InflationShareWeight = ramp.ToWeight
+			pool.InflationShareWeightRamp = nil
+
+			k.RecordPoolConfigChange(ctx, "", pool)
+			k.SetPool(ctx, pool)
+
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventInflationShareWeightRampCompleted{
+				Id:                   pool.Id,
+				InflationShareWeight: pool.InflationShareWeight,
+			})
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HandlePoolPauses handles the end-block logic for resuming pools whose scheduled pause ended.
+func (k Keeper) HandlePoolPauses(ctx sdk.Context) {
+	for _, pool := range k.GetAllPools(ctx) {
+		// Pool is paused if `PauseUntil` is not zero, resume it once the pause time is over
+		if pool.PauseUntil > 0 && uint64(ctx.BlockTime().Unix()) >= pool.PauseUntil {
+			pool.PauseFrom = 0
+			pool.PauseUntil = 0
+
+			k.RecordPoolConfigChange(ctx, "", pool)
+			k.SetPool(ctx, pool)
+
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolResumed{Id: pool.Id})
+		}
+	}
+}
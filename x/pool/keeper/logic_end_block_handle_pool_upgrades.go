@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"slices"
+
 	"github.com/KYVENetwork/chain/x/pool/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -8,14 +10,24 @@ import (
 // HandlePoolUpgrades handles to end-block logic for detecting and performing schedules pool-upgrades.
 func (k Keeper) HandlePoolUpgrades(ctx sdk.Context) {
 	for _, pool := range k.GetAllPools(ctx) {
+		// Upgrades which require a readiness threshold only get activated once
+		// enough delegation signaled readiness and get aborted after the deadline
+		if pool.UpgradePlan.IsAwaitingReadiness() {
+			if !k.handleUpgradeReadiness(ctx, &pool) {
+				continue
+			}
+		}
+
 		// PoolUpgrade is scheduled if `ScheduledAt` is not zero and smaller than the current block-time
 		if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
 
 			// Check if pool upgrade already has been applied
-			if pool.Protocol.Version != pool.UpgradePlan.Version || pool.Protocol.Binaries != pool.UpgradePlan.Binaries {
+			if pool.Protocol.Version != pool.UpgradePlan.Version || pool.Protocol.Binaries != pool.UpgradePlan.Binaries ||
+				!slices.Equal(pool.Protocol.RuntimeBinaries, pool.UpgradePlan.RuntimeBinaries) {
 				// perform pool upgrade
 				pool.Protocol.Version = pool.UpgradePlan.Version
 				pool.Protocol.Binaries = pool.UpgradePlan.Binaries
+				pool.Protocol.RuntimeBinaries = pool.UpgradePlan.RuntimeBinaries
 				pool.Protocol.LastUpgrade = pool.UpgradePlan.ScheduledAt
 			}
 
@@ -23,9 +35,54 @@ func (k Keeper) HandlePoolUpgrades(ctx sdk.Context) {
 			if uint64(ctx.BlockTime().Unix()) >= (pool.UpgradePlan.ScheduledAt + pool.UpgradePlan.Duration) {
 				// reset upgrade plan to default values
 				pool.UpgradePlan = &types.UpgradePlan{}
+				k.RemoveAllUpgradeReadinessOfPool(ctx, pool.Id)
 			}
 
+			k.RecordPoolConfigChange(ctx, "", pool)
 			k.SetPool(ctx, pool)
 		}
 	}
 }
+
+// handleUpgradeReadiness activates the upgrade plan of the given pool if the readiness
+// threshold is reached or aborts it if the deadline passed. The threshold is checked
+// first, so an upgrade which gets ready in the same block as its deadline is still
+// applied. It returns true if the upgrade got activated and should be performed in
+// this block.
+func (k Keeper) handleUpgradeReadiness(ctx sdk.Context, pool *types.Pool) bool {
+	now := uint64(ctx.BlockTime().Unix())
+	_, readyDelegation, totalDelegation := k.GetUpgradeReadinessTally(ctx, *pool)
+
+	if now >= pool.UpgradePlan.ScheduledAt && isUpgradeReadinessThresholdReached(pool.UpgradePlan, readyDelegation, totalDelegation) {
+		// The upgrade starts now, therefore the pool halts for the upgrade duration from this point on
+		pool.UpgradePlan.ReadinessReached = true
+		pool.UpgradePlan.ScheduledAt = now
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventRuntimeUpgradeActivated{
+			PoolId:          pool.Id,
+			Version:         pool.UpgradePlan.Version,
+			ReadyDelegation: readyDelegation,
+			TotalDelegation: totalDelegation,
+		})
+
+		return true
+	}
+
+	if now >= pool.UpgradePlan.ReadinessDeadline {
+		version := pool.UpgradePlan.Version
+
+		pool.UpgradePlan = &types.UpgradePlan{}
+		k.RecordPoolConfigChange(ctx, "", *pool)
+		k.SetPool(ctx, *pool)
+		k.RemoveAllUpgradeReadinessOfPool(ctx, pool.Id)
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventRuntimeUpgradeAborted{
+			PoolId:          pool.Id,
+			Version:         version,
+			ReadyDelegation: readyDelegation,
+			TotalDelegation: totalDelegation,
+		})
+	}
+
+	return false
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecordFunderPayouts adds the given value of funder payouts to the current
+// funder payouts window of the pool
+func (k Keeper) RecordFunderPayouts(ctx sdk.Context, poolId uint64, value uint64) {
+	funderPayouts, found := k.GetFunderPayouts(ctx, poolId)
+	if !found {
+		funderPayouts.PoolId = poolId
+	}
+
+	funderPayouts.Roll(uint64(ctx.BlockTime().Unix()), k.GetFunderPayoutsWindow(ctx))
+	funderPayouts.Current += value
+
+	k.SetFunderPayouts(ctx, funderPayouts)
+}
+
+// RecordInflationReceived adds the protocol inflation a pool received in the
+// current block to its inflation ledger. The remainder is the part of the
+// amount which the pool received as remainder of the truncated inflation split.
+func (k Keeper) RecordInflationReceived(ctx sdk.Context, poolId uint64, amount uint64, remainder uint64) {
+	inflationLedger, found := k.GetInflationLedger(ctx, poolId)
+	if !found {
+		inflationLedger.PoolId = poolId
+	}
+
+	inflationLedger.TotalReceived += amount
+	inflationLedger.TotalRemainder += remainder
+	inflationLedger.LastReceived = amount
+	inflationLedger.LastHeight = ctx.BlockHeight()
+
+	k.SetInflationLedger(ctx, inflationLedger)
+}
+
+// GetRecentFunderPayouts returns the estimated value of all funder payouts
+// of the pool within the last funder payouts window
+func (k Keeper) GetRecentFunderPayouts(ctx sdk.Context, poolId uint64) uint64 {
+	funderPayouts, found := k.GetFunderPayouts(ctx, poolId)
+	if !found {
+		return 0
+	}
+
+	return funderPayouts.GetRecent(uint64(ctx.BlockTime().Unix()), k.GetFunderPayoutsWindow(ctx))
+}
+
+// GetPoolDemand returns the demand of a pool which is used to distribute the
+// dynamic share of the protocol inflation
+func (k Keeper) GetPoolDemand(ctx sdk.Context, poolId uint64) uint64 {
+	switch k.GetDynamicInflationMode(ctx) {
+	case types.DYNAMIC_INFLATION_MODE_STAKE:
+		return k.delegationKeeper.GetDelegationOfPool(ctx, poolId)
+	default:
+		return k.GetRecentFunderPayouts(ctx, poolId)
+	}
+}
+
+// GetInflationAllocations returns the share of the protocol inflation each of
+// the given pools receives. The static part is split by the inflation share
+// weights while the dynamic part is split by the demand of the pools. If there
+// is no demand at all the whole protocol inflation is split by the weights.
+func (k Keeper) GetInflationAllocations(ctx sdk.Context, pools []types.Pool) (allocations []types.PoolInflationAllocation) {
+	// scheduled weight ramps are evaluated at the current block time
+	now := uint64(ctx.BlockTime().Unix())
+
+	totalInflationShareWeight := math.LegacyZeroDec()
+	totalDemand := math.ZeroInt()
+
+	for _, pool := range pools {
+		allocation := types.PoolInflationAllocation{
+			PoolId:               pool.Id,
+			InflationShareWeight: pool.GetInflationShareWeightAt(now),
+			Demand:               k.GetPoolDemand(ctx, pool.Id),
+		}
+
+		totalInflationShareWeight = totalInflationShareWeight.Add(allocation.InflationShareWeight)
+		totalDemand = totalDemand.Add(math.NewIntFromUint64(allocation.Demand))
+
+		allocations = append(allocations, allocation)
+	}
+
+	dynamicInflationShare := k.GetDynamicInflationShare(ctx)
+	if totalDemand.IsZero() {
+		dynamicInflationShare = math.LegacyZeroDec()
+	}
+	staticInflationShare := math.LegacyOneDec().Sub(dynamicInflationShare)
+
+	for i := range allocations {
+		allocations[i].StaticShare = math.LegacyZeroDec()
+		allocations[i].DynamicShare = math.LegacyZeroDec()
+
+		if !totalInflationShareWeight.IsZero() {
+			allocations[i].StaticShare = allocations[i].InflationShareWeight.
+				Quo(totalInflationShareWeight).
+				Mul(staticInflationShare)
+		}
+
+		if dynamicInflationShare.IsPositive() {
+			allocations[i].DynamicShare = math.LegacyNewDecFromInt(math.NewIntFromUint64(allocations[i].Demand)).
+				QuoInt(totalDemand).
+				Mul(dynamicInflationShare)
+		}
+
+		allocations[i].Share = allocations[i].StaticShare.Add(allocations[i].DynamicShare)
+	}
+
+	return
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_inflation_allocation.go
+
+* Split by inflation share weights if dynamic inflation share is zero
+* Fall back to inflation share weights if there is no demand
+* Split dynamic inflation share by recent funder payouts
+* Split dynamic inflation share by stake
+* Recent funder payouts decay over the window
+* Drop funder payouts older than two windows
+
+*/
+
+var _ = Describe("logic_inflation_allocation.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var gov string
+
+	setParams := func(dynamicInflationShare string, mode types.DynamicInflationMode) {
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.DynamicInflationShare = math.LegacyMustNewDecFromStr(dynamicInflationShare)
+		params.DynamicInflationMode = mode
+		params.FunderPayoutsWindow = 100
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		gov = s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+		for _, weight := range []int64{1_000_000, 3_000_000} {
+			s.RunTxPoolSuccess(&types.MsgCreatePool{
+				Authority:            gov,
+				UploadInterval:       60,
+				InflationShareWeight: math.LegacyNewDec(weight),
+				MinDelegation:        100 * i.KYVE,
+				MaxBundleSize:        100,
+				Binaries:             "{}",
+			})
+		}
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Split by inflation share weights if dynamic inflation share is zero", func() {
+		// ARRANGE
+		s.App().PoolKeeper.RecordFunderPayouts(s.Ctx(), 1, 1000)
+
+		// ACT
+		allocations := s.App().PoolKeeper.GetInflationAllocations(s.Ctx(), s.App().PoolKeeper.GetAllPools(s.Ctx()))
+
+		// ASSERT
+		Expect(allocations).To(HaveLen(2))
+
+		Expect(allocations[0].PoolId).To(Equal(uint64(0)))
+		Expect(allocations[0].StaticShare).To(Equal(math.LegacyMustNewDecFromStr("0.25")))
+		Expect(allocations[0].DynamicShare.IsZero()).To(BeTrue())
+		Expect(allocations[0].Share).To(Equal(math.LegacyMustNewDecFromStr("0.25")))
+
+		Expect(allocations[1].PoolId).To(Equal(uint64(1)))
+		Expect(allocations[1].Demand).To(Equal(uint64(1000)))
+		Expect(allocations[1].Share).To(Equal(math.LegacyMustNewDecFromStr("0.75")))
+	})
+
+	It("Fall back to inflation share weights if there is no demand", func() {
+		// ARRANGE
+		setParams("0.5", types.DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS)
+
+		// ACT
+		allocations := s.App().PoolKeeper.GetInflationAllocations(s.Ctx(), s.App().PoolKeeper.GetAllPools(s.Ctx()))
+
+		// ASSERT
+		Expect(allocations[0].Demand).To(BeZero())
+		Expect(allocations[0].DynamicShare.IsZero()).To(BeTrue())
+		Expect(allocations[0].Share).To(Equal(math.LegacyMustNewDecFromStr("0.25")))
+
+		Expect(allocations[1].Demand).To(BeZero())
+		Expect(allocations[1].DynamicShare.IsZero()).To(BeTrue())
+		Expect(allocations[1].Share).To(Equal(math.LegacyMustNewDecFromStr("0.75")))
+	})
+
+	It("Split dynamic inflation share by recent funder payouts", func() {
+		// ARRANGE
+		setParams("0.5", types.DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS)
+
+		s.App().PoolKeeper.RecordFunderPayouts(s.Ctx(), 0, 600)
+		s.App().PoolKeeper.RecordFunderPayouts(s.Ctx(), 0, 300)
+		s.App().PoolKeeper.RecordFunderPayouts(s.Ctx(), 1, 100)
+
+		// ACT
+		allocations := s.App().PoolKeeper.GetInflationAllocations(s.Ctx(), s.App().PoolKeeper.GetAllPools(s.Ctx()))
+
+		// ASSERT
+		Expect(allocations[0].Demand).To(Equal(uint64(900)))
+		Expect(allocations[0].StaticShare).To(Equal(math.LegacyMustNewDecFromStr("0.125")))
+		Expect(allocations[0].DynamicShare).To(Equal(math.LegacyMustNewDecFromStr("0.45")))
+		Expect(allocations[0].Share).To(Equal(math.LegacyMustNewDecFromStr("0.575")))
+
+		Expect(allocations[1].Demand).To(Equal(uint64(100)))
+		Expect(allocations[1].StaticShare).To(Equal(math.LegacyMustNewDecFromStr("0.375")))
+		Expect(allocations[1].DynamicShare).To(Equal(math.LegacyMustNewDecFromStr("0.05")))
+		Expect(allocations[1].Share).To(Equal(math.LegacyMustNewDecFromStr("0.425")))
+	})
+
+	It("Split dynamic inflation share by stake", func() {
+		// ARRANGE
+		setParams("1", types.DYNAMIC_INFLATION_MODE_STAKE)
+
+		s.App().PoolKeeper.RecordFunderPayouts(s.Ctx(), 1, 1000)
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  300 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     1,
+			Valaddress: i.VALADDRESS_1_B,
+		})
+
+		// ACT
+		allocations := s.App().PoolKeeper.GetInflationAllocations(s.Ctx(), s.App().PoolKeeper.GetAllPools(s.Ctx()))
+
+		// ASSERT
+		Expect(allocations[0].Demand).To(Equal(300 * i.KYVE))
+		Expect(allocations[0].StaticShare.IsZero()).To(BeTrue())
+		Expect(allocations[0].Share).To(Equal(math.LegacyMustNewDecFromStr("0.75")))
+
+		Expect(allocations[1].Demand).To(Equal(100 * i.KYVE))
+		Expect(allocations[1].StaticShare.IsZero()).To(BeTrue())
+		Expect(allocations[1].Share).To(Equal(math.LegacyMustNewDecFromStr("0.25")))
+	})
+
+	It("Recent funder payouts decay over the window", func() {
+		// ARRANGE
+		setParams("0.5", types.DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS)
+
+		recordedAt := uint64(s.Ctx().BlockTime().Unix())
+		s.App().PoolKeeper.RecordFunderPayouts(s.Ctx(), 0, 1000)
+
+		// ACT
+		s.CommitAfterSeconds(50)
+		s.Commit()
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetRecentFunderPayouts(s.Ctx(), 0)).To(Equal(uint64(1000)))
+
+		// ACT
+		s.CommitAfterSeconds(80)
+		s.Commit()
+
+		// ASSERT
+		elapsed := uint64(s.Ctx().BlockTime().Unix()) - recordedAt
+		Expect(elapsed).To(BeNumerically(">=", 100))
+		Expect(elapsed).To(BeNumerically("<", 200))
+		Expect(s.App().PoolKeeper.GetRecentFunderPayouts(s.Ctx(), 0)).To(Equal(1000 * (200 - elapsed) / 100))
+	})
+
+	It("Drop funder payouts older than two windows", func() {
+		// ARRANGE
+		setParams("0.5", types.DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS)
+
+		s.App().PoolKeeper.RecordFunderPayouts(s.Ctx(), 0, 1000)
+
+		// ACT
+		s.CommitAfterSeconds(200)
+		s.Commit()
+
+		s.App().PoolKeeper.RecordFunderPayouts(s.Ctx(), 0, 10)
+
+		// ASSERT
+		funderPayouts, found := s.App().PoolKeeper.GetFunderPayouts(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(funderPayouts.Previous).To(BeZero())
+		Expect(funderPayouts.Current).To(Equal(uint64(10)))
+
+		Expect(s.App().PoolKeeper.GetRecentFunderPayouts(s.Ctx(), 0)).To(Equal(uint64(10)))
+	})
+})
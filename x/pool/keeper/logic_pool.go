@@ -54,6 +54,28 @@ func (k Keeper) ChargeInflationPool(ctx sdk.Context, poolId uint64) (payout uint
 	return payout, nil
 }
 
+// GetMaxStakersOfPool returns the maximum amount of stakers which can join the
+// pool. Pools without their own max stakers fall back to the MaxStakersPerPool
+// param and no pool can exceed the MaxStakersPerPoolLimit param.
+func (k Keeper) GetMaxStakersOfPool(ctx sdk.Context, poolId uint64) uint64 {
+	maxStakers := k.GetMaxStakersPerPool(ctx)
+	if pool, found := k.GetPool(ctx, poolId); found && pool.MaxStakers > 0 {
+		maxStakers = pool.MaxStakers
+	}
+
+	if limit := k.GetMaxStakersPerPoolLimit(ctx); maxStakers > limit {
+		maxStakers = limit
+	}
+
+	return maxStakers
+}
+
+// enforceMaxStakersOfPool evicts the stakers with the lowest delegation if the
+// pool has more stakers than it is currently allowed to have.
+func (k Keeper) enforceMaxStakersOfPool(ctx sdk.Context, poolId uint64) {
+	k.stakersKeeper.EnforceMaxStakers(ctx, poolId, k.GetMaxStakersOfPool(ctx, poolId))
+}
+
 // IncrementBundleInformation updates the latest finalized bundle of a pool
 func (k Keeper) IncrementBundleInformation(
 	ctx sdk.Context,
@@ -71,3 +93,62 @@ func (k Keeper) IncrementBundleInformation(
 		k.SetPool(ctx, pool)
 	}
 }
+
+// RecordPoolConfigChange compares the given updated pool with the pool which is
+// currently stored and appends a new entry to the config change history of the
+// pool if any configurable field differs. It has to be called before the updated
+// pool is written to the store.
+func (k Keeper) RecordPoolConfigChange(ctx sdk.Context, authority string, updated types.Pool) {
+	old, found := k.GetPool(ctx, updated.Id)
+	if !found {
+		return
+	}
+
+	changedFields := old.GetChangedFields(&updated)
+	if len(changedFields) == 0 {
+		return
+	}
+
+	k.SetPoolConfigChange(ctx, types.PoolConfigChange{
+		PoolId:        updated.Id,
+		Index:         k.GetPoolConfigChangeCount(ctx, updated.Id),
+		Height:        ctx.BlockHeight(),
+		Timestamp:     uint64(ctx.BlockTime().Unix()),
+		Authority:     authority,
+		ChangedFields: changedFields,
+	})
+}
+
+// GetUpgradeReadinessTally returns all stakers of the pool which signaled readiness
+// for the version of the scheduled upgrade together with their total delegation
+// and the total delegation of the pool.
+func (k Keeper) GetUpgradeReadinessTally(ctx sdk.Context, pool types.Pool) (readyStakers []string, readyDelegation uint64, totalDelegation uint64) {
+	readyStakers = make([]string, 0)
+
+	if pool.UpgradePlan.GetVersion() == "" {
+		return readyStakers, 0, k.delegationKeeper.GetDelegationOfPool(ctx, pool.Id)
+	}
+
+	for _, staker := range k.stakersKeeper.GetAllStakerAddressesOfPool(ctx, pool.Id) {
+		readiness, found := k.GetUpgradeReadiness(ctx, pool.Id, staker)
+		if !found || readiness.Version != pool.UpgradePlan.Version {
+			continue
+		}
+
+		readyStakers = append(readyStakers, staker)
+		readyDelegation += k.delegationKeeper.GetDelegationAmount(ctx, staker)
+	}
+
+	return readyStakers, readyDelegation, k.delegationKeeper.GetDelegationOfPool(ctx, pool.Id)
+}
+
+// isUpgradeReadinessThresholdReached checks whether the ready delegation reached
+// the readiness threshold of the scheduled upgrade
+func isUpgradeReadinessThresholdReached(plan *types.UpgradePlan, readyDelegation, totalDelegation uint64) bool {
+	if totalDelegation == 0 {
+		return false
+	}
+
+	readyFraction := math.LegacyNewDec(int64(readyDelegation)).QuoInt64(int64(totalDelegation))
+	return readyFraction.GTE(*plan.ReadinessThreshold)
+}
@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - pool config changes
+
+* Create pool without any config change
+* Update pool records changed fields
+* Update pool with unchanged values does not record a change
+* Disable and enable pool records consecutive changes
+* Schedule runtime upgrade records upgrade plan and performed upgrade
+* Cancel runtime upgrade records reset upgrade plan
+* Query config changes with pagination
+
+*/
+
+var _ = Describe("pool config changes", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		msg := &types.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		}
+		s.RunTxPoolSuccess(msg)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Create pool without any config change", func() {
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetPoolConfigChangeCount(s.Ctx(), 0)).To(BeZero())
+		Expect(s.App().PoolKeeper.GetAllPoolConfigChanges(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Update pool records changed fields", func() {
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"Name\":\"TestPool2\",\"UploadInterval\":120,\"MaxBundleSize\":100}",
+		})
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetPoolConfigChangeCount(s.Ctx(), 0)).To(Equal(uint64(1)))
+
+		change, found := s.App().PoolKeeper.GetPoolConfigChange(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+
+		Expect(change.PoolId).To(Equal(uint64(0)))
+		Expect(change.Index).To(Equal(uint64(0)))
+		Expect(change.Height).To(Equal(s.Ctx().BlockHeight()))
+		Expect(change.Timestamp).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+		Expect(change.Authority).To(Equal(gov))
+		Expect(change.ChangedFields).To(Equal([]types.PoolChangedField{
+			{Field: "name", OldValue: "PoolTest", NewValue: "TestPool2"},
+			{Field: "upload_interval", OldValue: "60", NewValue: "120"},
+		}))
+	})
+
+	It("Update pool with unchanged values does not record a change", func() {
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"Name\":\"PoolTest\",\"UploadInterval\":60}",
+		})
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetPoolConfigChangeCount(s.Ctx(), 0)).To(BeZero())
+	})
+
+	It("Disable and enable pool records consecutive changes", func() {
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgDisablePool{
+			Authority: gov,
+			Id:        0,
+		})
+		s.CommitAfterSeconds(60)
+		s.RunTxPoolSuccess(&types.MsgEnablePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetPoolConfigChangeCount(s.Ctx(), 0)).To(Equal(uint64(2)))
+
+		disabled, _ := s.App().PoolKeeper.GetPoolConfigChange(s.Ctx(), 0, 0)
+		Expect(disabled.ChangedFields).To(Equal([]types.PoolChangedField{
+			{Field: "disabled", OldValue: "false", NewValue: "true"},
+		}))
+
+		enabled, _ := s.App().PoolKeeper.GetPoolConfigChange(s.Ctx(), 0, 1)
+		Expect(enabled.ChangedFields).To(Equal([]types.PoolChangedField{
+			{Field: "disabled", OldValue: "true", NewValue: "false"},
+		}))
+		Expect(enabled.Height).To(BeNumerically(">", disabled.Height))
+	})
+
+	It("Schedule runtime upgrade records upgrade plan and performed upgrade", func() {
+		// ARRANGE
+		scheduledAt := uint64(s.Ctx().BlockTime().Unix()) + 60
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgScheduleRuntimeUpgrade{
+			Authority:   gov,
+			Runtime:     "@kyve/test",
+			Version:     "1.0.0",
+			ScheduledAt: scheduledAt,
+			Duration:    60,
+			Binaries:    "{\"linux\":\"test\"}",
+		})
+
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetPoolConfigChangeCount(s.Ctx(), 0)).To(Equal(uint64(2)))
+
+		scheduled, _ := s.App().PoolKeeper.GetPoolConfigChange(s.Ctx(), 0, 0)
+		Expect(scheduled.Authority).To(Equal(gov))
+		Expect(scheduled.ChangedFields).To(Equal([]types.PoolChangedField{
+			{Field: "upgrade_plan.version", OldValue: "", NewValue: "1.0.0"},
+			{Field: "upgrade_plan.binaries", OldValue: "", NewValue: "{\"linux\":\"test\"}"},
+			{Field: "upgrade_plan.scheduled_at", OldValue: "0", NewValue: strconv.FormatUint(scheduledAt, 10)},
+			{Field: "upgrade_plan.duration", OldValue: "0", NewValue: "60"},
+		}))
+
+		performed, _ := s.App().PoolKeeper.GetPoolConfigChange(s.Ctx(), 0, 1)
+		Expect(performed.Authority).To(BeEmpty())
+		Expect(performed.ChangedFields).To(Equal([]types.PoolChangedField{
+			{Field: "protocol.version", OldValue: "0.0.0", NewValue: "1.0.0"},
+			{Field: "protocol.binaries", OldValue: "{}", NewValue: "{\"linux\":\"test\"}"},
+		}))
+	})
+
+	It("Cancel runtime upgrade records reset upgrade plan", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgScheduleRuntimeUpgrade{
+			Authority:   gov,
+			Runtime:     "@kyve/test",
+			Version:     "1.0.0",
+			ScheduledAt: uint64(s.Ctx().BlockTime().Unix()) + 3600,
+			Duration:    60,
+			Binaries:    "{\"linux\":\"test\"}",
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgCancelRuntimeUpgrade{
+			Authority: gov,
+			Runtime:   "@kyve/test",
+		})
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetPoolConfigChangeCount(s.Ctx(), 0)).To(Equal(uint64(2)))
+
+		cancelled, _ := s.App().PoolKeeper.GetPoolConfigChange(s.Ctx(), 0, 1)
+		Expect(cancelled.Authority).To(Equal(gov))
+		Expect(cancelled.ChangedFields).To(HaveLen(4))
+		Expect(cancelled.ChangedFields[0]).To(Equal(types.PoolChangedField{
+			Field: "upgrade_plan.version", OldValue: "1.0.0", NewValue: "",
+		}))
+	})
+
+	It("Query config changes with pagination", func() {
+		// ARRANGE
+		for _, name := range []string{"A", "B", "C"} {
+			s.RunTxPoolSuccess(&types.MsgUpdatePool{
+				Authority: gov,
+				Id:        0,
+				Payload:   "{\"Name\":\"" + name + "\"}",
+			})
+		}
+
+		// ACT
+		res, err := s.App().QueryKeeper.PoolConfigChanges(s.Ctx(), &querytypes.QueryPoolConfigChangesRequest{
+			PoolId:     0,
+			Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(res.Pagination.Total).To(Equal(uint64(3)))
+		Expect(res.ConfigChanges).To(HaveLen(1))
+		Expect(res.ConfigChanges[0].Index).To(Equal(uint64(1)))
+		Expect(res.ConfigChanges[0].ChangedFields).To(Equal([]types.PoolChangedField{
+			{Field: "name", OldValue: "A", NewValue: "B"},
+		}))
+
+		_, err = s.App().QueryKeeper.PoolConfigChanges(s.Ctx(), &querytypes.QueryPoolConfigChangesRequest{
+			PoolId: 1,
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -33,7 +33,10 @@ func (k msgServer) CancelRuntimeUpgrade(
 		affectedPools = append(affectedPools, pool.Id)
 
 		pool.UpgradePlan = &types.UpgradePlan{}
+		k.RecordPoolConfigChange(ctx, req.Authority, pool)
 		k.SetPool(ctx, pool)
+
+		k.RemoveAllUpgradeReadinessOfPool(ctx, pool.Id)
 	}
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventRuntimeUpgradeCancelled{
@@ -24,8 +24,16 @@ func (k msgServer) CreatePool(goCtx context.Context, req *types.MsgCreatePool) (
 		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrInvalidJson.Error(), req.Binaries)
 	}
 
+	if err := types.ValidateRuntimeBinaries(req.RuntimeBinaries); err != nil {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrInvalidRuntimeBinaries.Error(), err)
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if limit := k.GetMaxStakersPerPoolLimit(ctx); req.MaxStakers > limit {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrMaxStakersTooHigh.Error(), req.MaxStakers, limit)
+	}
+
 	id := k.AppendPool(ctx, types.Pool{
 		Name:                 req.Name,
 		Runtime:              req.Runtime,
@@ -37,10 +45,12 @@ func (k msgServer) CreatePool(goCtx context.Context, req *types.MsgCreatePool) (
 		InflationShareWeight: req.InflationShareWeight,
 		MinDelegation:        req.MinDelegation,
 		MaxBundleSize:        req.MaxBundleSize,
+		MaxStakers:           req.MaxStakers,
 		Protocol: &types.Protocol{
-			Version:     req.Version,
-			Binaries:    req.Binaries,
-			LastUpgrade: uint64(ctx.BlockTime().Unix()),
+			Version:         req.Version,
+			Binaries:        req.Binaries,
+			RuntimeBinaries: req.RuntimeBinaries,
+			LastUpgrade:     uint64(ctx.BlockTime().Unix()),
 		},
 		UpgradePlan:              &types.UpgradePlan{},
 		CurrentStorageProviderId: req.StorageProviderId,
@@ -66,6 +76,8 @@ func (k msgServer) CreatePool(goCtx context.Context, req *types.MsgCreatePool) (
 		Binaries:             req.Binaries,
 		StorageProviderId:    req.StorageProviderId,
 		CompressionId:        req.CompressionId,
+		RuntimeBinaries:      req.RuntimeBinaries,
+		MaxStakers:           req.MaxStakers,
 	})
 
 	return &types.MsgCreatePoolResponse{}, nil
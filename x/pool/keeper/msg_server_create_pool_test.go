@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/math"
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	. "github.com/onsi/ginkgo/v2"
@@ -22,6 +23,8 @@ TEST CASES - msg_server_create_pool.go
 * Create first pool
 * Create another pool
 * Create pool with invalid binaries
+* Create pool with checksummed runtime binaries
+* Create pool with invalid runtime binaries
 
 */
 
@@ -321,4 +324,94 @@ var _ = Describe("msg_server_create_pool.go", Ordered, func() {
 		_, found = s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
 		Expect(found).To(BeFalse())
 	})
+
+	It("Create pool with checksummed runtime binaries", func() {
+		// ARRANGE
+		binaries := []types.RuntimeBinary{
+			{
+				Platform: "linux-x64",
+				Url:      "https://github.com/KYVENetwork/node/releases/download/v1.0.0/kyve-linux-x64.zip",
+				Sha256:   "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			},
+			{
+				Platform: "macos-x64",
+				Url:      "https://github.com/KYVENetwork/node/releases/download/v1.0.0/kyve-macos-x64.zip",
+				Sha256:   "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752",
+			},
+		}
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "TestPool",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "1.0.0",
+			Binaries:             "{}",
+			RuntimeBinaries:      binaries,
+			StorageProviderId:    2,
+			CompressionId:        1,
+		})
+
+		// ASSERT
+		pool, found := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(pool.Protocol.RuntimeBinaries).To(Equal(binaries))
+
+		res, err := s.App().QueryKeeper.PoolRuntimeBinaries(s.Ctx(), &querytypes.QueryPoolRuntimeBinariesRequest{PoolId: 0})
+		Expect(err).To(BeNil())
+		Expect(res.Version).To(Equal("1.0.0"))
+		Expect(res.RuntimeBinaries).To(Equal(binaries))
+		Expect(res.UpgradeVersion).To(BeEmpty())
+		Expect(res.UpgradeRuntimeBinaries).To(BeEmpty())
+	})
+
+	It("Create pool with invalid runtime binaries", func() {
+		// ARRANGE
+		msg := &types.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "TestPool",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "1.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		}
+
+		// ACT & ASSERT
+		msg.RuntimeBinaries = []types.RuntimeBinary{
+			{Platform: "linux-x64", Url: "https://example.com/kyve-linux-x64.zip", Sha256: "invalid"},
+		}
+		s.RunTxPoolError(msg)
+		Expect(msg.ValidateBasic()).To(HaveOccurred())
+
+		msg.RuntimeBinaries = []types.RuntimeBinary{
+			{Platform: "linux-x64", Url: "kyve-linux-x64.zip", Sha256: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"},
+		}
+		s.RunTxPoolError(msg)
+		Expect(msg.ValidateBasic()).To(HaveOccurred())
+
+		msg.RuntimeBinaries = []types.RuntimeBinary{
+			{Platform: "linux-x64", Url: "https://example.com/a.zip", Sha256: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"},
+			{Platform: "linux-x64", Url: "https://example.com/b.zip", Sha256: "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"},
+		}
+		s.RunTxPoolError(msg)
+		Expect(msg.ValidateBasic()).To(HaveOccurred())
+
+		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(found).To(BeFalse())
+	})
 })
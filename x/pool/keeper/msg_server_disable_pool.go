@@ -36,6 +36,7 @@ func (k msgServer) DisablePool(
 	}
 
 	pool.Disabled = true
+	k.RecordPoolConfigChange(ctx, req.Authority, pool)
 	k.SetPool(ctx, pool)
 
 	// remove all stakers from pool in order to "reset" it
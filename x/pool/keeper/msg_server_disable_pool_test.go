@@ -215,10 +215,10 @@ var _ = Describe("msg_server_disable_pool.go", Ordered, func() {
 		// ARRANGE
 		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 
-		s.App().PoolKeeper.SetParams(s.Ctx(), types.Params{
-			ProtocolInflationShare:  math.LegacyMustNewDecFromStr("0.1"),
-			PoolInflationPayoutRate: math.LegacyMustNewDecFromStr("0.05"),
-		})
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.ProtocolInflationShare = math.LegacyMustNewDecFromStr("0.1")
+		params.PoolInflationPayoutRate = math.LegacyMustNewDecFromStr("0.05")
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
 
 		for i := 0; i < 100; i++ {
 			s.Commit()
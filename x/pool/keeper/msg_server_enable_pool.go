@@ -31,6 +31,7 @@ func (k msgServer) EnablePool(goCtx context.Context, req *types.MsgEnablePool) (
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolEnabled{Id: req.Id})
 
 	pool.Disabled = false
+	k.RecordPoolConfigChange(ctx, req.Authority, pool)
 	k.SetPool(ctx, pool)
 
 	return &types.MsgEnablePoolResponse{}, nil
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// Gov
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+// ForkPool creates a successor pool from the current state of an existing pool.
+// The successor copies the config of the pool and continues at its current index
+// and key. Optionally, all valaccounts and active fundings are moved to the successor.
+func (k msgServer) ForkPool(goCtx context.Context, req *types.MsgForkPool) (*types.MsgForkPoolResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govTypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, found := k.GetPool(ctx, req.Id)
+	if !found {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrPoolNotFound.Error(), req.Id)
+	}
+
+	if pool.Successor != nil {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrPoolAlreadyForked.Error(), pool.Id, pool.Successor.PoolId)
+	}
+
+	if req.Binaries != "" && !json.Valid([]byte(req.Binaries)) {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrInvalidJson.Error(), req.Binaries)
+	}
+
+	if err := types.ValidateRuntimeBinaries(req.RuntimeBinaries); err != nil {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrInvalidRuntimeBinaries.Error(), err)
+	}
+
+	// the successor starts where the predecessor currently is
+	startKey := pool.StartKey
+	if pool.CurrentKey != "" {
+		startKey = pool.CurrentKey
+	}
+
+	link := types.PoolLink{
+		PoolId:   pool.Id,
+		Index:    pool.CurrentIndex,
+		Key:      pool.CurrentKey,
+		ForkedAt: uint64(ctx.BlockTime().Unix()),
+	}
+
+	successor := types.Pool{
+		Name:                 pool.Name,
+		Runtime:              pool.Runtime,
+		Logo:                 pool.Logo,
+		Config:               pool.Config,
+		StartKey:             startKey,
+		CurrentKey:           pool.CurrentKey,
+		CurrentSummary:       pool.CurrentSummary,
+		CurrentIndex:         pool.CurrentIndex,
+		EndKey:               pool.EndKey,
+		UploadInterval:       pool.UploadInterval,
+		InflationShareWeight: pool.InflationShareWeight,
+		MinDelegation:        pool.MinDelegation,
+		MaxBundleSize:        pool.MaxBundleSize,
+		MaxStakers:           pool.MaxStakers,
+		// a scheduled weight ramp continues on the successor
+		InflationShareWeightRamp: pool.InflationShareWeightRamp,
+		Protocol: &types.Protocol{
+			Version:         pool.Protocol.GetVersion(),
+			Binaries:        pool.Protocol.GetBinaries(),
+			RuntimeBinaries: pool.Protocol.GetRuntimeBinaries(),
+			LastUpgrade:     uint64(ctx.BlockTime().Unix()),
+		},
+		UpgradePlan:              &types.UpgradePlan{},
+		CurrentStorageProviderId: pool.CurrentStorageProviderId,
+		CurrentCompressionId:     pool.CurrentCompressionId,
+		Predecessor:              &link,
+	}
+
+	if req.Payload != "" {
+		var update types.PoolUpdate
+		if err := json.Unmarshal([]byte(req.Payload), &update); err != nil {
+			return nil, err
+		}
+		if limit := k.GetMaxStakersPerPoolLimit(ctx); update.MaxStakers != nil && *update.MaxStakers > limit {
+			return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrMaxStakersTooHigh.Error(), *update.MaxStakers, limit)
+		}
+		update.Apply(&successor)
+	}
+
+	if req.Version != "" {
+		successor.Protocol.Version = req.Version
+	}
+	if req.Binaries != "" {
+		successor.Protocol.Binaries = req.Binaries
+	}
+	if len(req.RuntimeBinaries) > 0 {
+		successor.Protocol.RuntimeBinaries = req.RuntimeBinaries
+	}
+
+	id := k.AppendPool(ctx, successor)
+
+	k.EnsurePoolAccount(ctx, id)
+	k.fundersKeeper.CreateFundingState(ctx, id)
+
+	pool.Successor = &types.PoolLink{
+		PoolId:   id,
+		Index:    link.Index,
+		Key:      link.Key,
+		ForkedAt: link.ForkedAt,
+	}
+	k.SetPool(ctx, pool)
+
+	var migratedValaccounts, migratedFundings uint64
+	if req.MigrateValaccounts {
+		migratedValaccounts = k.stakersKeeper.MigrateValaccounts(ctx, pool.Id, id)
+		// the successor can have a lower max stakers than the predecessor
+		k.enforceMaxStakersOfPool(ctx, id)
+	}
+	if req.MigrateFundings {
+		migratedFundings = k.fundersKeeper.MigrateFundings(ctx, pool.Id, id)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolForked{
+		Id:                  id,
+		PredecessorId:       pool.Id,
+		Index:               link.Index,
+		Key:                 link.Key,
+		MigratedValaccounts: migratedValaccounts,
+		MigratedFundings:    migratedFundings,
+	})
+
+	return &types.MsgForkPoolResponse{Id: id}, nil
+}
@@ -0,0 +1,309 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - msg_server_fork_pool.go
+
+* Invalid authority
+* Fork a non-existing pool
+* Fork pool copies config and continues at current index and key
+* Fork pool with payload and new protocol version
+* Fork pool with invalid payload
+* Fork pool with migrated valaccounts
+* Fork pool with migrated valaccounts keeps the secondary valaddress
+* Fork pool with migrated fundings
+* Fork pool twice
+
+*/
+
+var _ = Describe("msg_server_fork_pool.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		s.RunTxPoolSuccess(&types.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		})
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.CurrentKey = "99"
+		pool.CurrentIndex = 100
+		pool.CurrentSummary = "summary"
+		pool.TotalBundles = 10
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.App().FundersKeeper.SetParams(s.Ctx(), funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}, 20))
+
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.KYVECoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.KYVECoins(1 * i.T_KYVE),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Invalid authority", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgForkPool{
+			Authority: i.DUMMY[0],
+			Id:        0,
+		})
+
+		// ASSERT
+		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Fork a non-existing pool", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgForkPool{
+			Authority: gov,
+			Id:        1,
+		})
+
+		// ASSERT
+		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Fork pool copies config and continues at current index and key", func() {
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgForkPool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		predecessor, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		successor, found := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(found).To(BeTrue())
+
+		link := types.PoolLink{
+			PoolId:   0,
+			Index:    100,
+			Key:      "99",
+			ForkedAt: uint64(s.Ctx().BlockTime().Unix()),
+		}
+
+		Expect(successor.Name).To(Equal(predecessor.Name))
+		Expect(successor.Runtime).To(Equal(predecessor.Runtime))
+		Expect(successor.Config).To(Equal(predecessor.Config))
+		Expect(successor.UploadInterval).To(Equal(predecessor.UploadInterval))
+		Expect(successor.InflationShareWeight).To(Equal(predecessor.InflationShareWeight))
+		Expect(successor.StartKey).To(Equal("99"))
+		Expect(successor.CurrentKey).To(Equal("99"))
+		Expect(successor.CurrentIndex).To(Equal(uint64(100)))
+		Expect(successor.CurrentSummary).To(Equal("summary"))
+		Expect(successor.TotalBundles).To(BeZero())
+		Expect(successor.Protocol.Version).To(Equal("0.0.0"))
+		Expect(successor.UpgradePlan).To(Equal(&types.UpgradePlan{}))
+		Expect(successor.Predecessor).To(Equal(&link))
+		Expect(successor.Successor).To(BeNil())
+
+		link.PoolId = 1
+		Expect(predecessor.Successor).To(Equal(&link))
+
+		_, found = s.App().FundersKeeper.GetFundingState(s.Ctx(), 1)
+		Expect(found).To(BeTrue())
+
+		// nothing got migrated
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(HaveLen(1))
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 1)).To(BeEmpty())
+
+		fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
+		Expect(fundingState.ActiveFunderAddresses).To(Equal([]string{i.ALICE}))
+	})
+
+	It("Fork pool with payload and new protocol version", func() {
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgForkPool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"Name\":\"PoolTestV2\",\"UploadInterval\":120}",
+			Version:   "1.0.0",
+			Binaries:  "{\"linux\":\"test\"}",
+		})
+
+		// ASSERT
+		successor, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+
+		Expect(successor.Name).To(Equal("PoolTestV2"))
+		Expect(successor.UploadInterval).To(Equal(uint64(120)))
+		Expect(successor.Protocol.Version).To(Equal("1.0.0"))
+		Expect(successor.Protocol.Binaries).To(Equal("{\"linux\":\"test\"}"))
+
+		predecessor, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(predecessor.Name).To(Equal("PoolTest"))
+		Expect(predecessor.Protocol.Version).To(Equal("0.0.0"))
+	})
+
+	It("Fork pool with invalid payload", func() {
+		// ARRANGE
+		msg := &types.MsgForkPool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"UploadInterval\":0}",
+		}
+
+		// ACT
+		s.RunTxPoolError(msg)
+
+		// ASSERT
+		Expect(msg.ValidateBasic()).To(HaveOccurred())
+
+		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Fork pool with migrated valaccounts", func() {
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgForkPool{
+			Authority:          gov,
+			Id:                 0,
+			MigrateValaccounts: true,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(BeEmpty())
+
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_0)
+		Expect(found).To(BeTrue())
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_A))
+
+		Expect(s.App().DelegationKeeper.GetDelegationOfPool(s.Ctx(), 0)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetDelegationOfPool(s.Ctx(), 1)).To(Equal(100 * i.KYVE))
+	})
+
+	It("Fork pool with migrated valaccounts keeps the secondary valaddress", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_0,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_0_B,
+		})
+
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgForkPool{
+			Authority:          gov,
+			Id:                 0,
+			MigrateValaccounts: true,
+		})
+
+		// ASSERT
+		migratedValaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_0)
+		Expect(found).To(BeTrue())
+		Expect(migratedValaccount.SecondaryValaddress).To(Equal(i.VALADDRESS_0_B))
+
+		valaccount.PoolId = 1
+		Expect(migratedValaccount).To(Equal(valaccount))
+	})
+
+	It("Fork pool with migrated fundings", func() {
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgForkPool{
+			Authority:       gov,
+			Id:              0,
+			MigrateFundings: true,
+		})
+
+		// ASSERT
+		fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
+		Expect(fundingState.ActiveFunderAddresses).To(BeEmpty())
+
+		fundingState, _ = s.App().FundersKeeper.GetFundingState(s.Ctx(), 1)
+		Expect(fundingState.ActiveFunderAddresses).To(Equal([]string{i.ALICE}))
+
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.IsZero()).To(BeTrue())
+
+		funding, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 1)
+		Expect(found).To(BeTrue())
+		Expect(funding.Amounts).To(Equal(i.KYVECoins(100 * i.T_KYVE)))
+		Expect(funding.AmountsPerBundle).To(Equal(i.KYVECoins(1 * i.T_KYVE)))
+	})
+
+	It("Fork pool twice", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgForkPool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ACT
+		s.RunTxPoolError(&types.MsgForkPool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 2)
+		Expect(found).To(BeFalse())
+
+		// the successor itself can be forked again
+		s.RunTxPoolSuccess(&types.MsgForkPool{
+			Authority: gov,
+			Id:        1,
+		})
+
+		successor, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 2)
+		Expect(successor.Predecessor.PoolId).To(Equal(uint64(1)))
+	})
+})
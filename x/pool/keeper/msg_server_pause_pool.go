@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// Gov
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+func (k msgServer) PausePool(goCtx context.Context, req *types.MsgPausePool) (*types.MsgPausePoolResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govTypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, found := k.GetPool(ctx, req.Id)
+
+	if !found {
+		return nil, errors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), req.Id)
+	}
+
+	if pool.Disabled {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, "Pool is disabled.")
+	}
+
+	// if the pause was scheduled in the past we pause the pool now
+	pauseFrom := req.PauseFrom
+	if now := uint64(ctx.BlockTime().Unix()); pauseFrom < now {
+		pauseFrom = now
+	}
+
+	if req.PauseUntil <= pauseFrom {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidPauseRange.Error(), req.PauseUntil, pauseFrom)
+	}
+
+	pool.PauseFrom = pauseFrom
+	pool.PauseUntil = req.PauseUntil
+	k.RecordPoolConfigChange(ctx, req.Authority, pool)
+	k.SetPool(ctx, pool)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolPaused{
+		Id:         req.Id,
+		PauseFrom:  pauseFrom,
+		PauseUntil: req.PauseUntil,
+	})
+
+	return &types.MsgPausePoolResponse{}, nil
+}
@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - msg_server_pause_pool.go
+
+* Invalid authority
+* Pause a non-existing pool
+* Pause pool with pause until before pause from
+* Pause pool immediately
+* Pause pool in the future
+* Pause ends automatically
+* Resume pool early
+* Resume pool which is not paused
+* Timed out uploader does not receive points during pause
+
+*/
+
+var _ = Describe("msg_server_pause_pool.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		s.RunTxPoolSuccess(&types.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1_A,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Invalid authority", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgPausePool{
+			Authority:  i.DUMMY[0],
+			Id:         0,
+			PauseUntil: uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.PauseUntil).To(BeZero())
+	})
+
+	It("Pause a non-existing pool", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgPausePool{
+			Authority:  gov,
+			Id:         1,
+			PauseUntil: uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+	})
+
+	It("Pause pool with pause until before pause from", func() {
+		// ARRANGE
+		msg := &types.MsgPausePool{
+			Authority:  gov,
+			Id:         0,
+			PauseFrom:  uint64(s.Ctx().BlockTime().Unix()) + 3600,
+			PauseUntil: uint64(s.Ctx().BlockTime().Unix()) + 60,
+		}
+
+		// ACT
+		s.RunTxPoolError(msg)
+
+		// ASSERT
+		Expect(msg.ValidateBasic()).To(HaveOccurred())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.PauseUntil).To(BeZero())
+	})
+
+	It("Pause pool immediately", func() {
+		// ARRANGE
+		pauseUntil := uint64(s.Ctx().BlockTime().Unix()) + 3600
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgPausePool{
+			Authority:  gov,
+			Id:         0,
+			PauseUntil: pauseUntil,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.PauseFrom).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+		Expect(pool.PauseUntil).To(Equal(pauseUntil))
+		Expect(pool.IsPaused(uint64(s.Ctx().BlockTime().Unix()))).To(BeTrue())
+
+		Expect(s.App().BundlesKeeper.AssertPoolCanRun(s.Ctx(), 0)).To(Equal(bundletypes.ErrPoolPaused))
+
+		res, err := s.App().QueryKeeper.Pool(s.Ctx(), &querytypes.QueryPoolRequest{Id: 0})
+		Expect(err).To(BeNil())
+		Expect(res.Pool.Status).To(Equal(types.POOL_STATUS_PAUSED))
+
+		s.RunTxBundlesError(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+	})
+
+	It("Pause pool in the future", func() {
+		// ARRANGE
+		pauseFrom := uint64(s.Ctx().BlockTime().Unix()) + 600
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgPausePool{
+			Authority:  gov,
+			Id:         0,
+			PauseFrom:  pauseFrom,
+			PauseUntil: pauseFrom + 3600,
+		})
+
+		// ASSERT
+		Expect(s.App().BundlesKeeper.AssertPoolCanRun(s.Ctx(), 0)).To(BeNil())
+
+		s.CommitAfterSeconds(600)
+		s.CommitAfterSeconds(1)
+
+		Expect(s.App().BundlesKeeper.AssertPoolCanRun(s.Ctx(), 0)).To(Equal(bundletypes.ErrPoolPaused))
+	})
+
+	It("Pause ends automatically", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgPausePool{
+			Authority:  gov,
+			Id:         0,
+			PauseUntil: uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(3600)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.PauseFrom).To(BeZero())
+		Expect(pool.PauseUntil).To(BeZero())
+
+		Expect(s.App().BundlesKeeper.AssertPoolCanRun(s.Ctx(), 0)).To(BeNil())
+
+		resumed, _ := s.App().PoolKeeper.GetPoolConfigChange(s.Ctx(), 0, 1)
+		Expect(resumed.Authority).To(BeEmpty())
+	})
+
+	It("Resume pool early", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgPausePool{
+			Authority:  gov,
+			Id:         0,
+			PauseUntil: uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgResumePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.PauseUntil).To(BeZero())
+
+		Expect(s.App().BundlesKeeper.AssertPoolCanRun(s.Ctx(), 0)).To(BeNil())
+	})
+
+	It("Resume pool which is not paused", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgResumePool{
+			Authority: gov,
+			Id:        0,
+		})
+	})
+
+	It("Timed out uploader does not receive points during pause", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.RunTxPoolSuccess(&types.MsgPausePool{
+			Authority:  gov,
+			Id:         0,
+			PauseUntil: uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(1800)
+		s.CommitAfterSeconds(1799)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Points).To(BeZero())
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(BeEmpty())
+
+		// the upload timeout starts again after the pause
+		s.CommitAfterSeconds(s.App().BundlesKeeper.GetUploadTimeout(s.Ctx()))
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		bundleProposal, _ = s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).NotTo(BeEmpty())
+
+		valaccount, _ = s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Points).To(BeZero())
+	})
+})
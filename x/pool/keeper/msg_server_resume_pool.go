@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// Gov
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+func (k msgServer) ResumePool(goCtx context.Context, req *types.MsgResumePool) (*types.MsgResumePoolResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govTypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, found := k.GetPool(ctx, req.Id)
+
+	if !found {
+		return nil, errors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), req.Id)
+	}
+
+	if pool.PauseUntil == 0 {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, "Pool is not paused.")
+	}
+
+	pool.PauseFrom = 0
+	pool.PauseUntil = 0
+	k.RecordPoolConfigChange(ctx, req.Authority, pool)
+	k.SetPool(ctx, pool)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolResumed{Id: req.Id})
+
+	return &types.MsgResumePoolResponse{}, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/util"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// Gov
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+// RetirePool removes a disabled pool together with all of its state. All
+// fundings are refunded and removed, all valaccounts are forced out of the
+// pool and the remaining balance of the pool account is transferred to the
+// treasury. Finalized bundles and the config change history are kept.
+func (k msgServer) RetirePool(
+	goCtx context.Context,
+	req *types.MsgRetirePool,
+) (*types.MsgRetirePoolResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govTypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, found := k.GetPool(ctx, req.Id)
+
+	if !found {
+		return nil, errors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), req.Id)
+	}
+
+	if !pool.Disabled {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrPoolNotDisabled.Error(), req.Id)
+	}
+
+	refundedFundings, err := k.fundersKeeper.RemoveFundingsOfPool(ctx, pool.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	removedValaccounts := k.stakersKeeper.RemoveAllValaccountsOfPool(ctx, pool.Id)
+
+	k.bundlesKeeper.RemoveBundleProposal(ctx, pool.Id)
+	k.bundlesKeeper.RemoveRoundRobinProgress(ctx, pool.Id)
+	k.RemoveAllUpgradeReadinessOfPool(ctx, pool.Id)
+	k.RemoveFunderPayouts(ctx, pool.Id)
+
+	// send remaining pool assets to treasury
+	balance := k.bankKeeper.GetBalance(ctx, pool.GetPoolAccount(), globalTypes.Denom).Amount.Uint64()
+	if balance > 0 {
+		if err := util.TransferFromAddressToTreasury(k.distrkeeper, ctx, pool.GetPoolAccount().String(), balance); err != nil {
+			return nil, err
+		}
+	}
+
+	k.RemovePool(ctx, pool.Id)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolRetired{
+		Id:                 pool.Id,
+		RefundedFundings:   refundedFundings,
+		RemovedValaccounts: removedValaccounts,
+		SweptAmount:        balance,
+	})
+
+	return &types.MsgRetirePoolResponse{}, nil
+}
@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - msg_server_retire_pool.go
+
+* Invalid authority
+* Retire a non-existing pool
+* Retire an active pool
+* Retire a disabled pool
+* Retire pool refunds all fundings
+* Retire pool removes leaving valaccounts
+* Retire pool sweeps pool account to treasury
+* Retire pool keeps finalized bundles
+
+*/
+
+var _ = Describe("msg_server_retire_pool.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		s.RunTxPoolSuccess(&types.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.App().FundersKeeper.SetParams(s.Ctx(), funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}, 20))
+
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.KYVECoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.KYVECoins(1 * i.T_KYVE),
+		})
+
+		s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundletypes.BundleProposal{
+			PoolId:       0,
+			NextUploader: i.STAKER_0,
+		})
+
+		s.App().BundlesKeeper.SetRoundRobinProgress(s.Ctx(), bundletypes.RoundRobinProgress{
+			PoolId: 0,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Invalid authority", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgDisablePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ACT
+		s.RunTxPoolError(&types.MsgRetirePool{
+			Authority: i.DUMMY[0],
+			Id:        0,
+		})
+
+		// ASSERT
+		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+	})
+
+	It("Retire a non-existing pool", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgRetirePool{
+			Authority: gov,
+			Id:        1,
+		})
+	})
+
+	It("Retire an active pool", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgRetirePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(HaveLen(1))
+	})
+
+	It("Retire a disabled pool", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgDisablePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgRetirePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(found).To(BeFalse())
+		Expect(s.App().PoolKeeper.GetPoolCount(s.Ctx())).To(Equal(uint64(1)))
+
+		Expect(s.App().FundersKeeper.DoesFundingStateExist(s.Ctx(), 0)).To(BeFalse())
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(BeEmpty())
+
+		_, found = s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(found).To(BeFalse())
+
+		_, found = s.App().BundlesKeeper.GetRoundRobinProgress(s.Ctx(), 0)
+		Expect(found).To(BeFalse())
+
+		// the staker itself still exists
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeTrue())
+	})
+
+	It("Retire pool refunds all fundings", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgDisablePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		balanceBefore := s.GetBalanceFromAddress(i.ALICE)
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgRetirePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.ALICE)).To(Equal(balanceBefore + 100*i.KYVE))
+		Expect(s.GetBalanceFromModule(funderstypes.ModuleName)).To(BeZero())
+
+		_, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(found).To(BeFalse())
+
+		// the funder itself still exists
+		_, found = s.App().FundersKeeper.GetFunder(s.Ctx(), i.ALICE)
+		Expect(found).To(BeTrue())
+	})
+
+	It("Retire pool removes leaving valaccounts", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakertypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.RunTxPoolSuccess(&types.MsgDisablePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		Expect(s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())).To(HaveLen(1))
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgRetirePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(BeEmpty())
+	})
+
+	It("Retire pool sweeps pool account to treasury", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgDisablePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		err := s.App().BankKeeper.SendCoins(s.Ctx(), sdk.MustAccAddressFromBech32(i.ALICE), pool.GetPoolAccount(), i.KYVECoins(10*i.T_KYVE))
+		Expect(err).To(BeNil())
+
+		communityPoolBefore := s.GetCoinsFromCommunityPool()
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgRetirePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		balance := s.App().BankKeeper.GetBalance(s.Ctx(), pool.GetPoolAccount(), globaltypes.Denom)
+		Expect(balance.IsZero()).To(BeTrue())
+
+		communityPoolAfter := s.GetCoinsFromCommunityPool()
+		Expect(communityPoolAfter.AmountOf(globaltypes.Denom).Uint64()).To(Equal(communityPoolBefore.AmountOf(globaltypes.Denom).Uint64() + 10*i.KYVE))
+	})
+
+	It("Retire pool keeps finalized bundles", func() {
+		// ARRANGE
+		s.App().BundlesKeeper.SetFinalizedBundle(s.Ctx(), bundletypes.FinalizedBundle{
+			PoolId:        0,
+			Id:            0,
+			StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Uploader:      i.STAKER_0,
+			FromIndex:     0,
+			ToIndex:       100,
+			FromKey:       "0",
+			ToKey:         "99",
+			BundleSummary: "test_value",
+			DataHash:      "test_hash",
+		})
+
+		s.RunTxPoolSuccess(&types.MsgDisablePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgRetirePool{
+			Authority: gov,
+			Id:        0,
+		})
+
+		// ASSERT
+		finalizedBundle, found := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+		Expect(finalizedBundle.ToKey).To(Equal("99"))
+	})
+})
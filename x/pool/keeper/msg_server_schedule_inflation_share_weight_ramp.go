@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// Gov
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+// ScheduleInflationShareWeightRamp schedules a linear change of the inflation
+// share weight of a pool. An already scheduled ramp gets replaced.
+func (k msgServer) ScheduleInflationShareWeightRamp(
+	goCtx context.Context,
+	req *types.MsgScheduleInflationShareWeightRamp,
+) (*types.MsgScheduleInflationShareWeightRampResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govTypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, found := k.GetPool(ctx, req.Id)
+
+	if !found {
+		return nil, errors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), req.Id)
+	}
+
+	now := uint64(ctx.BlockTime().Unix())
+
+	// if the ramp was scheduled in the past we start it now
+	startAt := req.StartAt
+	if startAt < now {
+		startAt = now
+	}
+
+	if req.EndAt <= startAt {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidRampRange.Error(), req.EndAt, startAt)
+	}
+
+	// the ramp has to continue from the weight currently in effect, otherwise the
+	// weight would jump once the ramp starts
+	currentWeight := pool.GetInflationShareWeightAt(now)
+	if !req.FromWeight.Equal(currentWeight) {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidRampFromWeight.Error(), currentWeight, req.FromWeight)
+	}
+
+	// the weight of a replaced ramp stays in effect until the new ramp starts
+	pool.InflationShareWeight = currentWeight
+	pool.InflationShareWeightRamp = &types.InflationShareWeightRamp{
+		FromWeight: req.FromWeight,
+		ToWeight:   req.ToWeight,
+		StartAt:    startAt,
+		EndAt:      req.EndAt,
+	}
+
+	k.RecordPoolConfigChange(ctx, req.Authority, pool)
+	k.SetPool(ctx, pool)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventInflationShareWeightRampScheduled{
+		Id:         pool.Id,
+		FromWeight: req.FromWeight,
+		ToWeight:   req.ToWeight,
+		StartAt:    startAt,
+		EndAt:      req.EndAt,
+	})
+
+	return &types.MsgScheduleInflationShareWeightRampResponse{}, nil
+}
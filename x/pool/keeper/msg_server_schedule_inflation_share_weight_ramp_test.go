@@ -0,0 +1,346 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - msg_server_schedule_inflation_share_weight_ramp.go
+
+* Invalid authority
+* Schedule ramp for a non-existing pool
+* Schedule ramp with end before start
+* Schedule ramp with a from weight different to the current weight
+* Schedule ramp in the past starts immediately
+* Ramp interpolates the inflation share weight linearly
+* Ramp completes automatically
+* Replace a running ramp
+* Update pool inflation share weight cancels the ramp
+* Pool receives inflation according to the ramp
+
+*/
+
+var _ = Describe("msg_server_schedule_inflation_share_weight_ramp.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		s.RunTxPoolSuccess(&types.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Invalid authority", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  i.DUMMY[0],
+			Id:         0,
+			FromWeight: math.LegacyNewDec(10_000),
+			ToWeight:   math.LegacyZeroDec(),
+			StartAt:    uint64(s.Ctx().BlockTime().Unix()),
+			EndAt:      uint64(s.Ctx().BlockTime().Unix()) + 100,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.InflationShareWeightRamp).To(BeNil())
+	})
+
+	It("Schedule ramp for a non-existing pool", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         1,
+			FromWeight: math.LegacyNewDec(10_000),
+			ToWeight:   math.LegacyZeroDec(),
+			StartAt:    uint64(s.Ctx().BlockTime().Unix()),
+			EndAt:      uint64(s.Ctx().BlockTime().Unix()) + 100,
+		})
+	})
+
+	It("Schedule ramp with end before start", func() {
+		// ARRANGE
+		msg := &types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         0,
+			FromWeight: math.LegacyNewDec(10_000),
+			ToWeight:   math.LegacyZeroDec(),
+			StartAt:    uint64(s.Ctx().BlockTime().Unix()) + 100,
+			EndAt:      uint64(s.Ctx().BlockTime().Unix()) + 50,
+		}
+
+		// ACT
+		s.RunTxPoolError(msg)
+
+		// ASSERT
+		Expect(msg.ValidateBasic()).To(HaveOccurred())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.InflationShareWeightRamp).To(BeNil())
+	})
+
+	It("Schedule ramp with a from weight different to the current weight", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         0,
+			FromWeight: math.LegacyNewDec(2_000),
+			ToWeight:   math.LegacyNewDec(6_000),
+			StartAt:    uint64(s.Ctx().BlockTime().Unix()) + 100,
+			EndAt:      uint64(s.Ctx().BlockTime().Unix()) + 300,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.InflationShareWeightRamp).To(BeNil())
+		Expect(pool.InflationShareWeight).To(Equal(math.LegacyNewDec(10_000)))
+	})
+
+	It("Schedule ramp in the past starts immediately", func() {
+		// ARRANGE
+		now := uint64(s.Ctx().BlockTime().Unix())
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         0,
+			FromWeight: math.LegacyNewDec(10_000),
+			ToWeight:   math.LegacyZeroDec(),
+			StartAt:    now - 100,
+			EndAt:      now + 100,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.InflationShareWeightRamp).To(Equal(&types.InflationShareWeightRamp{
+			FromWeight: math.LegacyNewDec(10_000),
+			ToWeight:   math.LegacyZeroDec(),
+			StartAt:    now,
+			EndAt:      now + 100,
+		}))
+
+		change, found := s.App().PoolKeeper.GetPoolConfigChange(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+		Expect(change.Authority).To(Equal(gov))
+	})
+
+	It("Ramp interpolates the inflation share weight linearly", func() {
+		// ARRANGE
+		now := uint64(s.Ctx().BlockTime().Unix())
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         0,
+			FromWeight: math.LegacyNewDec(10_000),
+			ToWeight:   math.LegacyNewDec(2_000),
+			StartAt:    now + 100,
+			EndAt:      now + 300,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.GetInflationShareWeightAt(now)).To(Equal(math.LegacyNewDec(10_000)))
+		Expect(pool.GetInflationShareWeightAt(now + 100)).To(Equal(math.LegacyNewDec(10_000)))
+		Expect(pool.GetInflationShareWeightAt(now + 150)).To(Equal(math.LegacyNewDec(8_000)))
+		Expect(pool.GetInflationShareWeightAt(now + 200)).To(Equal(math.LegacyNewDec(6_000)))
+		Expect(pool.GetInflationShareWeightAt(now + 300)).To(Equal(math.LegacyNewDec(2_000)))
+		Expect(pool.GetInflationShareWeightAt(now + 1000)).To(Equal(math.LegacyNewDec(2_000)))
+	})
+
+	It("Ramp completes automatically", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         0,
+			FromWeight: math.LegacyNewDec(10_000),
+			ToWeight:   math.LegacyNewDec(1_000),
+			StartAt:    uint64(s.Ctx().BlockTime().Unix()),
+			EndAt:      uint64(s.Ctx().BlockTime().Unix()) + 100,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(50)
+		s.CommitAfterSeconds(1)
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.InflationShareWeightRamp).NotTo(BeNil())
+		Expect(pool.InflationShareWeight).To(Equal(math.LegacyNewDec(10_000)))
+
+		s.CommitAfterSeconds(50)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.InflationShareWeightRamp).To(BeNil())
+		Expect(pool.InflationShareWeight).To(Equal(math.LegacyNewDec(1_000)))
+
+		completed, found := s.App().PoolKeeper.GetPoolConfigChange(s.Ctx(), 0, 1)
+		Expect(found).To(BeTrue())
+		Expect(completed.Authority).To(BeEmpty())
+		Expect(completed.ChangedFields[0]).To(Equal(types.PoolChangedField{
+			Field:    "inflation_share_weight",
+			OldValue: math.LegacyNewDec(10_000).String(),
+			NewValue: math.LegacyNewDec(1_000).String(),
+		}))
+	})
+
+	It("Replace a running ramp", func() {
+		// ARRANGE
+		now := uint64(s.Ctx().BlockTime().Unix())
+
+		s.RunTxPoolSuccess(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         0,
+			FromWeight: math.LegacyNewDec(10_000),
+			ToWeight:   math.LegacyZeroDec(),
+			StartAt:    now,
+			EndAt:      now + 100,
+		})
+
+		s.CommitAfterSeconds(50)
+
+		// ACT
+		now = uint64(s.Ctx().BlockTime().Unix())
+
+		s.RunTxPoolSuccess(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         0,
+			FromWeight: math.LegacyNewDec(5_000),
+			ToWeight:   math.LegacyNewDec(10_000),
+			StartAt:    now + 100,
+			EndAt:      now + 200,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		// the weight of the replaced ramp stays in effect until the new ramp starts
+		Expect(pool.InflationShareWeight).To(Equal(math.LegacyNewDec(5_000)))
+		Expect(pool.GetInflationShareWeightAt(now + 50)).To(Equal(math.LegacyNewDec(5_000)))
+		Expect(pool.InflationShareWeightRamp.StartAt).To(Equal(now + 100))
+	})
+
+	It("Update pool inflation share weight cancels the ramp", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         0,
+			FromWeight: math.LegacyNewDec(10_000),
+			ToWeight:   math.LegacyZeroDec(),
+			StartAt:    uint64(s.Ctx().BlockTime().Unix()),
+			EndAt:      uint64(s.Ctx().BlockTime().Unix()) + 100,
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"InflationShareWeight\":\"2500\"}",
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.InflationShareWeightRamp).To(BeNil())
+		Expect(pool.InflationShareWeight).To(Equal(math.LegacyNewDec(2_500)))
+	})
+
+	It("Pool receives inflation according to the ramp", func() {
+		// ARRANGE
+		params := types.DefaultParams()
+		params.ProtocolInflationShare = math.LegacyMustNewDecFromStr("0.1")
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&types.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest 2",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyZeroDec(),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		})
+
+		// both pools need two stakers to not exceed the max voting power
+		for _, staker := range []struct{ address, valaddressA, valaddressB string }{
+			{i.STAKER_0, i.VALADDRESS_0_A, i.VALADDRESS_0_B},
+			{i.STAKER_1, i.VALADDRESS_1_A, i.VALADDRESS_1_B},
+		} {
+			s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+				Creator: staker.address,
+				Amount:  100 * i.KYVE,
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    staker.address,
+				PoolId:     0,
+				Valaddress: staker.valaddressA,
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    staker.address,
+				PoolId:     1,
+				Valaddress: staker.valaddressB,
+			})
+		}
+
+		now := uint64(s.Ctx().BlockTime().Unix())
+
+		s.RunTxPoolSuccess(&types.MsgScheduleInflationShareWeightRamp{
+			Authority:  gov,
+			Id:         1,
+			FromWeight: math.LegacyZeroDec(),
+			ToWeight:   math.LegacyNewDec(10_000),
+			StartAt:    now + 100,
+			EndAt:      now + 200,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(60)
+
+		// ASSERT
+		Expect(s.GetBalanceFromPool(0)).To(BeNumerically(">", uint64(0)))
+		Expect(s.GetBalanceFromPool(1)).To(BeZero())
+
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(60)
+
+		Expect(s.GetBalanceFromPool(1)).To(BeNumerically(">", uint64(0)))
+	})
+})
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	// Gov
 	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -20,10 +21,14 @@ func (k msgServer) ScheduleRuntimeUpgrade(
 		return nil, errors.Wrapf(govTypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
 	}
 
-	if req.Version == "" || req.Binaries == "" {
+	if req.Version == "" || (req.Binaries == "" && len(req.RuntimeBinaries) == 0) {
 		return nil, types.ErrInvalidArgs
 	}
 
+	if err := types.ValidateRuntimeBinaries(req.RuntimeBinaries); err != nil {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrInvalidRuntimeBinaries.Error(), err)
+	}
+
 	var scheduledAt uint64
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -34,6 +39,11 @@ func (k msgServer) ScheduleRuntimeUpgrade(
 		scheduledAt = req.ScheduledAt
 	}
 
+	// the readiness deadline has to be after the time the upgrade is scheduled at
+	if req.ReadinessThreshold != nil && req.ReadinessDeadline <= scheduledAt {
+		return nil, types.ErrInvalidArgs
+	}
+
 	affectedPools := make([]uint64, 0)
 	for _, pool := range k.GetAllPools(ctx) {
 		// only schedule upgrade if the runtime matches
@@ -47,24 +57,31 @@ func (k msgServer) ScheduleRuntimeUpgrade(
 		}
 
 		pool.UpgradePlan = &types.UpgradePlan{
-			Version:     req.Version,
-			Binaries:    req.Binaries,
-			ScheduledAt: scheduledAt,
-			Duration:    req.Duration,
+			Version:            req.Version,
+			Binaries:           req.Binaries,
+			ScheduledAt:        scheduledAt,
+			Duration:           req.Duration,
+			ReadinessThreshold: req.ReadinessThreshold,
+			ReadinessDeadline:  req.ReadinessDeadline,
+			RuntimeBinaries:    req.RuntimeBinaries,
 		}
 
 		affectedPools = append(affectedPools, pool.Id)
 
+		k.RecordPoolConfigChange(ctx, req.Authority, pool)
 		k.SetPool(ctx, pool)
 	}
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventRuntimeUpgradeScheduled{
-		Runtime:       req.Runtime,
-		Version:       req.Version,
-		ScheduledAt:   req.ScheduledAt,
-		Duration:      req.Duration,
-		Binaries:      req.Binaries,
-		AffectedPools: affectedPools,
+		Runtime:            req.Runtime,
+		Version:            req.Version,
+		ScheduledAt:        req.ScheduledAt,
+		Duration:           req.Duration,
+		Binaries:           req.Binaries,
+		AffectedPools:      affectedPools,
+		ReadinessThreshold: req.ReadinessThreshold,
+		ReadinessDeadline:  req.ReadinessDeadline,
+		RuntimeBinaries:    req.RuntimeBinaries,
 	})
 
 	return &types.MsgScheduleRuntimeUpgradeResponse{}, nil
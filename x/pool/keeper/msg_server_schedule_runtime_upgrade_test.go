@@ -24,6 +24,8 @@ TEST CASES - msg_server_schedule_runtime_upgrade.go
 * Schedule runtime upgrade in the past
 * Schedule runtime upgrade in the future
 * Schedule runtime upgrade while another one is ongoing
+* Schedule runtime upgrade with checksummed runtime binaries
+* Schedule runtime upgrade with invalid runtime binaries
 
 */
 
@@ -294,4 +296,59 @@ var _ = Describe("msg_server_schedule_runtime_upgrade.go", Ordered, func() {
 			Duration:    60,
 		}))
 	})
+
+	It("Schedule runtime upgrade with checksummed runtime binaries", func() {
+		// ARRANGE
+		binaries := []types.RuntimeBinary{
+			{
+				Platform: "linux-x64",
+				Url:      "https://github.com/KYVENetwork/node/releases/download/v1.0.0/kyve-linux-x64.zip",
+				Sha256:   "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			},
+		}
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgScheduleRuntimeUpgrade{
+			Authority:       gov,
+			Runtime:         "@kyve/test",
+			Version:         "1.0.0",
+			RuntimeBinaries: binaries,
+			Duration:        60,
+			ScheduledAt:     uint64(s.Ctx().BlockTime().Unix()) + 60,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.UpgradePlan.RuntimeBinaries).To(Equal(binaries))
+
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Protocol.Version).To(Equal("1.0.0"))
+		Expect(pool.Protocol.RuntimeBinaries).To(Equal(binaries))
+	})
+
+	It("Schedule runtime upgrade with invalid runtime binaries", func() {
+		// ARRANGE
+		msg := &types.MsgScheduleRuntimeUpgrade{
+			Authority: gov,
+			Runtime:   "@kyve/test",
+			Version:   "1.0.0",
+			RuntimeBinaries: []types.RuntimeBinary{
+				{Platform: "linux-x64", Url: "https://example.com/kyve-linux-x64.zip", Sha256: "9f86d081"},
+			},
+			Duration:    60,
+			ScheduledAt: currentTime,
+		}
+
+		// ACT
+		s.RunTxPoolError(msg)
+
+		// ASSERT
+		Expect(msg.ValidateBasic()).To(HaveOccurred())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.UpgradePlan).To(Equal(&types.UpgradePlan{}))
+	})
 })
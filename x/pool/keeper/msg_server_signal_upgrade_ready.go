@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+// SignalUpgradeReady allows a valaccount to signal that its protocol node
+// has installed the binaries of the scheduled runtime upgrade.
+func (k msgServer) SignalUpgradeReady(goCtx context.Context, msg *types.MsgSignalUpgradeReady) (*types.MsgSignalUpgradeReadyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, err := k.GetPoolWithError(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.stakersKeeper.AssertValaccountAuthorized(ctx, msg.PoolId, msg.Staker, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if pool.UpgradePlan.GetScheduledAt() == 0 {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrNoUpgradeScheduled.Error(), msg.PoolId)
+	}
+
+	if pool.UpgradePlan.Version != msg.Version {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrUpgradeVersionMismatch.Error(), pool.UpgradePlan.Version, msg.Version)
+	}
+
+	if readiness, found := k.GetUpgradeReadiness(ctx, msg.PoolId, msg.Staker); found && readiness.Version == msg.Version {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrAlreadySignaledReady.Error(), msg.Version)
+	}
+
+	k.SetUpgradeReadiness(ctx, types.UpgradeReadiness{
+		PoolId:     msg.PoolId,
+		Staker:     msg.Staker,
+		Version:    msg.Version,
+		SignaledAt: uint64(ctx.BlockTime().Unix()),
+	})
+
+	_, readyDelegation, totalDelegation := k.GetUpgradeReadinessTally(ctx, pool)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpgradeReadinessSignaled{
+		PoolId:          msg.PoolId,
+		Staker:          msg.Staker,
+		Version:         msg.Version,
+		ReadyDelegation: readyDelegation,
+		TotalDelegation: totalDelegation,
+	})
+
+	return &types.MsgSignalUpgradeReadyResponse{}, nil
+}
@@ -0,0 +1,350 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Pool
+	"github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - msg_server_signal_upgrade_ready.go
+
+* Signal upgrade ready without a scheduled upgrade
+* Signal upgrade ready with an unauthorized valaddress
+* Signal upgrade ready with a wrong version
+* Signal upgrade ready twice
+* Upgrade is not performed before the readiness threshold is reached
+* Upgrade is performed once the readiness threshold is reached
+* Upgrade is aborted once the readiness deadline is reached
+* Upgrade is performed if the readiness threshold is reached at the deadline
+* Upgrade without readiness threshold is performed at the scheduled time
+* Cancel runtime upgrade removes readiness signals
+* Query pool returns upgrade readiness
+
+*/
+
+var _ = Describe("msg_server_signal_upgrade_ready.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	scheduleUpgrade := func(threshold *math.LegacyDec) {
+		now := uint64(s.Ctx().BlockTime().Unix())
+
+		msg := &types.MsgScheduleRuntimeUpgrade{
+			Authority:   gov,
+			Runtime:     "@kyve/test",
+			Version:     "1.0.0",
+			ScheduledAt: now + 60,
+			Duration:    60,
+			Binaries:    "{\"linux\":\"test\"}",
+		}
+
+		if threshold != nil {
+			msg.ReadinessThreshold = threshold
+			msg.ReadinessDeadline = now + 3600
+		}
+
+		s.RunTxPoolSuccess(msg)
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		s.RunTxPoolSuccess(&types.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  300 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1_A,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Signal upgrade ready without a scheduled upgrade", func() {
+		// ACT
+		s.RunTxPoolError(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetAllUpgradeReadiness(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Signal upgrade ready with an unauthorized valaddress", func() {
+		// ARRANGE
+		threshold := math.LegacyMustNewDecFromStr("0.5")
+		scheduleUpgrade(&threshold)
+
+		// ACT
+		s.RunTxPoolError(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_1_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetAllUpgradeReadiness(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Signal upgrade ready with a wrong version", func() {
+		// ARRANGE
+		threshold := math.LegacyMustNewDecFromStr("0.5")
+		scheduleUpgrade(&threshold)
+
+		// ACT
+		s.RunTxPoolError(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+			Version: "2.0.0",
+		})
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetAllUpgradeReadiness(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Signal upgrade ready twice", func() {
+		// ARRANGE
+		threshold := math.LegacyMustNewDecFromStr("0.5")
+		scheduleUpgrade(&threshold)
+
+		s.RunTxPoolSuccess(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		// ACT
+		s.RunTxPoolError(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		// ASSERT
+		readiness, found := s.App().PoolKeeper.GetUpgradeReadiness(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+		Expect(readiness.Version).To(Equal("1.0.0"))
+		Expect(readiness.SignaledAt).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+	})
+
+	It("Upgrade is not performed before the readiness threshold is reached", func() {
+		// ARRANGE
+		threshold := math.LegacyMustNewDecFromStr("0.5")
+		scheduleUpgrade(&threshold)
+
+		// staker 0 only holds 25% of the pool delegation
+		s.RunTxPoolSuccess(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		// ACT
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Protocol.Version).To(Equal("0.0.0"))
+		Expect(pool.UpgradePlan.Version).To(Equal("1.0.0"))
+		Expect(pool.UpgradePlan.ReadinessReached).To(BeFalse())
+		Expect(pool.UpgradePlan.IsUpgrading(uint64(s.Ctx().BlockTime().Unix()))).To(BeFalse())
+	})
+
+	It("Upgrade is performed once the readiness threshold is reached", func() {
+		// ARRANGE
+		threshold := math.LegacyMustNewDecFromStr("0.5")
+		scheduleUpgrade(&threshold)
+
+		s.CommitAfterSeconds(120)
+		s.CommitAfterSeconds(1)
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_1_A,
+			Staker:  i.STAKER_1,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Protocol.Version).To(Equal("1.0.0"))
+		Expect(pool.Protocol.Binaries).To(Equal("{\"linux\":\"test\"}"))
+		Expect(pool.UpgradePlan.ReadinessReached).To(BeTrue())
+		Expect(pool.UpgradePlan.IsUpgrading(uint64(s.Ctx().BlockTime().Unix()))).To(BeTrue())
+
+		// the upgrade duration starts once the threshold got reached
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.UpgradePlan).To(Equal(&types.UpgradePlan{}))
+		Expect(s.App().PoolKeeper.GetAllUpgradeReadiness(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Upgrade is aborted once the readiness deadline is reached", func() {
+		// ARRANGE
+		threshold := math.LegacyMustNewDecFromStr("0.5")
+		scheduleUpgrade(&threshold)
+
+		s.RunTxPoolSuccess(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		// ACT
+		s.CommitAfterSeconds(3600)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Protocol.Version).To(Equal("0.0.0"))
+		Expect(pool.UpgradePlan).To(Equal(&types.UpgradePlan{}))
+		Expect(s.App().PoolKeeper.GetAllUpgradeReadiness(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Upgrade is performed if the readiness threshold is reached at the deadline", func() {
+		// ARRANGE
+		threshold := math.LegacyMustNewDecFromStr("0.5")
+		scheduleUpgrade(&threshold)
+
+		s.CommitAfterSeconds(3599)
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_1_A,
+			Staker:  i.STAKER_1,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		// the readiness deadline is reached in this block
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Protocol.Version).To(Equal("1.0.0"))
+		Expect(pool.UpgradePlan.ReadinessReached).To(BeTrue())
+		Expect(pool.UpgradePlan.IsUpgrading(uint64(s.Ctx().BlockTime().Unix()))).To(BeTrue())
+	})
+
+	It("Upgrade without readiness threshold is performed at the scheduled time", func() {
+		// ARRANGE
+		scheduleUpgrade(nil)
+
+		// ACT
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Protocol.Version).To(Equal("1.0.0"))
+		Expect(pool.UpgradePlan.ReadinessThreshold).To(BeNil())
+		Expect(pool.UpgradePlan.IsUpgrading(uint64(s.Ctx().BlockTime().Unix()))).To(BeTrue())
+	})
+
+	It("Cancel runtime upgrade removes readiness signals", func() {
+		// ARRANGE
+		threshold := math.LegacyMustNewDecFromStr("0.5")
+		scheduleUpgrade(&threshold)
+
+		s.RunTxPoolSuccess(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgCancelRuntimeUpgrade{
+			Authority: gov,
+			Runtime:   "@kyve/test",
+		})
+
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetAllUpgradeReadiness(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Query pool returns upgrade readiness", func() {
+		// ARRANGE
+		threshold := math.LegacyMustNewDecFromStr("0.5")
+		scheduleUpgrade(&threshold)
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgSignalUpgradeReady{
+			Creator: i.VALADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+			Version: "1.0.0",
+		})
+
+		// ASSERT
+		res, err := s.App().QueryKeeper.Pool(s.Ctx(), &querytypes.QueryPoolRequest{Id: 0})
+		Expect(err).To(BeNil())
+
+		Expect(res.Pool.UpgradeReadiness.ReadyStakers).To(Equal([]string{i.STAKER_0}))
+		Expect(res.Pool.UpgradeReadiness.ReadyDelegation).To(Equal(100 * i.KYVE))
+		Expect(res.Pool.UpgradeReadiness.TotalDelegation).To(Equal(400 * i.KYVE))
+	})
+})
@@ -25,6 +25,11 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	_ = json.Unmarshal([]byte(msg.Payload), &newParams)
 	k.SetParams(ctx, newParams)
 
+	// lowered max stakers evict the stakers with the lowest delegation
+	for _, pool := range k.GetAllPools(ctx) {
+		k.enforceMaxStakersOfPool(ctx, pool.Id)
+	}
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateParams{
 		OldParams: oldParams,
 		NewParams: newParams,
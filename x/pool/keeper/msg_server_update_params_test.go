@@ -10,6 +10,8 @@ import (
 
 	// Pool
 	"github.com/KYVENetwork/chain/x/pool/types"
+	// Stakers
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
 	// Gov
 	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -34,6 +36,14 @@ TEST CASES - msg_server_update_params.go
 * Update max voting power per pool
 * Update max voting power per pool with invalid value
 
+* Update dynamic inflation share and mode
+* Update dynamic inflation share with invalid value
+* Update funder payouts window with invalid value
+
+* Update max stakers per pool and evict the lowest stakers
+* Update max stakers per pool above the limit
+* Update max stakers per pool with zero value
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -66,6 +76,11 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(params.ProtocolInflationShare).To(Equal(types.DefaultProtocolInflationShare))
 		Expect(params.PoolInflationPayoutRate).To(Equal(types.DefaultPoolInflationPayoutRate))
 		Expect(params.MaxVotingPowerPerPool).To(Equal(types.DefaultMaxVotingPowerPerPool))
+		Expect(params.DynamicInflationShare).To(Equal(types.DefaultDynamicInflationShare))
+		Expect(params.DynamicInflationMode).To(Equal(types.DefaultDynamicInflationMode))
+		Expect(params.FunderPayoutsWindow).To(Equal(types.DefaultFunderPayoutsWindow))
+		Expect(params.MaxStakersPerPool).To(Equal(types.DefaultMaxStakersPerPool))
+		Expect(params.MaxStakersPerPoolLimit).To(Equal(types.DefaultMaxStakersPerPoolLimit))
 	})
 
 	It("Invalid authority (transaction)", func() {
@@ -407,4 +422,207 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.PoolInflationPayoutRate).To(Equal(types.DefaultPoolInflationPayoutRate))
 		Expect(updatedParams.MaxVotingPowerPerPool).To(Equal(types.DefaultMaxVotingPowerPerPool))
 	})
+
+	It("Update dynamic inflation share and mode", func() {
+		// ARRANGE
+		payload := `{
+			"dynamic_inflation_share": "0.3",
+			"dynamic_inflation_mode": 1,
+			"funder_payouts_window": 86400
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.ProtocolInflationShare).To(Equal(types.DefaultProtocolInflationShare))
+		Expect(updatedParams.DynamicInflationShare).To(Equal(math.LegacyMustNewDecFromStr("0.3")))
+		Expect(updatedParams.DynamicInflationMode).To(Equal(types.DYNAMIC_INFLATION_MODE_STAKE))
+		Expect(updatedParams.FunderPayoutsWindow).To(Equal(uint64(86400)))
+	})
+
+	It("Update dynamic inflation share with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"dynamic_inflation_share": "1.2"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.DynamicInflationShare).To(Equal(types.DefaultDynamicInflationShare))
+	})
+
+	It("Update funder payouts window with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"funder_payouts_window": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.FunderPayoutsWindow).To(Equal(types.DefaultFunderPayoutsWindow))
+	})
+
+	It("Update max stakers per pool and evict the lowest stakers", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&types.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyZeroDec(),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Binaries:             "{}",
+		})
+
+		stakers := []string{i.STAKER_0, i.STAKER_1, i.ALICE}
+		valaddresses := []string{i.VALADDRESS_0_A, i.VALADDRESS_1_A, i.VALADDRESS_2_A}
+
+		for k, staker := range stakers {
+			s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+				Creator: staker,
+				Amount:  uint64(k+1) * 100 * i.KYVE,
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    staker,
+				PoolId:     0,
+				Valaddress: valaddresses[k],
+			})
+		}
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   "{\"max_stakers_per_pool\": 2, \"max_stakers_per_pool_limit\": 10}",
+		})
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(updatedParams.MaxStakersPerPool).To(Equal(uint64(2)))
+		Expect(updatedParams.MaxStakersPerPoolLimit).To(Equal(uint64(10)))
+
+		Expect(s.App().PoolKeeper.GetMaxStakersOfPool(s.Ctx(), 0)).To(Equal(uint64(2)))
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ConsistOf(i.STAKER_1, i.ALICE))
+	})
+
+	It("Update max stakers per pool above the limit", func() {
+		// ARRANGE
+		payload := `{
+			"max_stakers_per_pool": 20,
+			"max_stakers_per_pool_limit": 10
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.MaxStakersPerPool).To(Equal(types.DefaultMaxStakersPerPool))
+		Expect(updatedParams.MaxStakersPerPoolLimit).To(Equal(types.DefaultMaxStakersPerPoolLimit))
+	})
+
+	It("Update max stakers per pool with zero value", func() {
+		// ARRANGE
+		payload := `{
+			"max_stakers_per_pool": 0,
+			"max_stakers_per_pool_limit": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().PoolKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.MaxStakersPerPool).To(Equal(types.DefaultMaxStakersPerPool))
+		Expect(updatedParams.MaxStakersPerPoolLimit).To(Equal(types.DefaultMaxStakersPerPoolLimit))
+	})
 })
@@ -29,42 +29,18 @@ func (k msgServer) UpdatePool(goCtx context.Context, req *types.MsgUpdatePool) (
 		return nil, err
 	}
 
-	if update.Name != nil {
-		pool.Name = *update.Name
-	}
-	if update.Runtime != nil {
-		pool.Runtime = *update.Runtime
-	}
-	if update.Logo != nil {
-		pool.Logo = *update.Logo
-	}
-	if update.Config != nil {
-		pool.Config = *update.Config
-	}
-	if update.UploadInterval != nil {
-		pool.UploadInterval = *update.UploadInterval
-	}
-	if update.InflationShareWeight != nil {
-		pool.InflationShareWeight = *update.InflationShareWeight
-	}
-	if update.MinDelegation != nil {
-		pool.MinDelegation = *update.MinDelegation
-	}
-	if update.MaxBundleSize != nil {
-		pool.MaxBundleSize = *update.MaxBundleSize
-	}
-	if update.StorageProviderId != nil {
-		pool.CurrentStorageProviderId = *update.StorageProviderId
-	}
-	if update.CompressionId != nil {
-		pool.CurrentCompressionId = *update.CompressionId
-	}
-	if update.EndKey != nil {
-		pool.EndKey = *update.EndKey
+	if limit := k.GetMaxStakersPerPoolLimit(ctx); update.MaxStakers != nil && *update.MaxStakers > limit {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrMaxStakersTooHigh.Error(), *update.MaxStakers, limit)
 	}
 
+	update.Apply(&pool)
+
+	k.RecordPoolConfigChange(ctx, req.Authority, pool)
 	k.SetPool(ctx, pool)
 
+	// a lowered max stakers evicts the stakers with the lowest delegation
+	k.enforceMaxStakersOfPool(ctx, pool.Id)
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolUpdated{
 		Id:                   pool.Id,
 		RawUpdateString:      req.Payload,
@@ -78,6 +54,7 @@ func (k msgServer) UpdatePool(goCtx context.Context, req *types.MsgUpdatePool) (
 		MaxBundleSize:        pool.MaxBundleSize,
 		StorageProviderId:    pool.CurrentStorageProviderId,
 		CompressionId:        pool.CurrentCompressionId,
+		MaxStakers:           pool.MaxStakers,
 	})
 
 	return &types.MsgUpdatePoolResponse{}, nil
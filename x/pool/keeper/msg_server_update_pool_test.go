@@ -10,6 +10,8 @@ import (
 
 	// Pool
 	"github.com/KYVENetwork/chain/x/pool/types"
+	// Stakers
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
 )
 
 /*
@@ -22,6 +24,8 @@ TEST CASES - msg_server_update_pool.go
 * Update first pool partially
 * Update another pool
 * Update pool with invalid json payload
+* Lower max stakers of pool to evict the lowest stakers
+* Update pool with max stakers above the limit
 
 */
 
@@ -336,4 +340,72 @@ var _ = Describe("msg_server_update_pool.go", Ordered, func() {
 		Expect(found).To(BeTrue())
 		Expect(pool.Name).To(BeEmpty())
 	})
+
+	It("Lower max stakers of pool to evict the lowest stakers", func() {
+		// ARRANGE
+		stakers := []string{i.STAKER_0, i.STAKER_1, i.ALICE}
+		valaddresses := []string{i.VALADDRESS_0_A, i.VALADDRESS_1_A, i.VALADDRESS_2_A}
+
+		for k, staker := range stakers {
+			s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+				Creator: staker,
+				Amount:  uint64(k+1) * 100 * i.KYVE,
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    staker,
+				PoolId:     0,
+				Valaddress: valaddresses[k],
+			})
+		}
+
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(HaveLen(3))
+
+		// ACT
+		s.RunTxPoolSuccess(&types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"MaxStakers\": 1}",
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.MaxStakers).To(Equal(uint64(1)))
+		Expect(s.App().PoolKeeper.GetMaxStakersOfPool(s.Ctx(), 0)).To(Equal(uint64(1)))
+
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ConsistOf(i.ALICE))
+
+		// a new staker with less delegation can not join the full pool
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.BOB,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersError(&stakertypes.MsgJoinPool{
+			Creator:    i.BOB,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_B,
+		})
+
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ConsistOf(i.ALICE))
+	})
+
+	It("Update pool with max stakers above the limit", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"MaxStakers\": 101}",
+		}
+
+		// ACT
+		_, err := s.RunTx(msg)
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.MaxStakers).To(BeZero())
+		Expect(s.App().PoolKeeper.GetMaxStakersOfPool(s.Ctx(), 0)).To(Equal(types.DefaultMaxStakersPerPool))
+	})
 })
@@ -159,6 +159,8 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 // EndBlock contains the logic that is automatically triggered at the end of each block
 func (am AppModule) EndBlock(ctx context.Context) error {
 	am.keeper.HandlePoolUpgrades(sdk.UnwrapSDKContext(ctx))
+	am.keeper.HandlePoolPauses(sdk.UnwrapSDKContext(ctx))
+	am.keeper.HandleInflationShareWeightRamps(sdk.UnwrapSDKContext(ctx))
 	return nil
 }
 
@@ -176,7 +178,7 @@ func init() {
 	appmodule.Register(
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
-		appmodule.Invoke(InvokeSetStakersKeeper, InvokeSetFundersKeeper),
+		appmodule.Invoke(InvokeSetStakersKeeper, InvokeSetDelegationKeeper, InvokeSetFundersKeeper, InvokeSetBundlesKeeper),
 	)
 }
 
@@ -244,6 +246,20 @@ func InvokeSetStakersKeeper(
 	return nil
 }
 
+func InvokeSetDelegationKeeper(
+	k *keeper.Keeper,
+	delegationKeeper types.DelegationKeeper,
+) error {
+	if k == nil {
+		return fmt.Errorf("keeper is nil")
+	}
+	if delegationKeeper == nil {
+		return fmt.Errorf("delegation keeper is nil")
+	}
+	keeper.SetDelegationKeeper(k, delegationKeeper)
+	return nil
+}
+
 func InvokeSetFundersKeeper(
 	k *keeper.Keeper,
 	fundersKeeper types.FundersKeeper,
@@ -257,3 +273,17 @@ func InvokeSetFundersKeeper(
 	keeper.SetFundersKeeper(k, fundersKeeper)
 	return nil
 }
+
+func InvokeSetBundlesKeeper(
+	k *keeper.Keeper,
+	bundlesKeeper types.BundlesKeeper,
+) error {
+	if k == nil {
+		return fmt.Errorf("keeper is nil")
+	}
+	if bundlesKeeper == nil {
+		return fmt.Errorf("bundles keeper is nil")
+	}
+	keeper.SetBundlesKeeper(k, bundlesKeeper)
+	return nil
+}
@@ -7,7 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSignalUpgradeReady{}, "kyve/pool/MsgSignalUpgradeReady", nil)
 }
 
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
@@ -15,8 +16,14 @@ func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdatePool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDisablePool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgEnablePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgForkPool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRetirePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgPausePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgResumePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgScheduleInflationShareWeightRamp{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgScheduleRuntimeUpgrade{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelRuntimeUpgrade{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSignalUpgradeReady{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
 }
 
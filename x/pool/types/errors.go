@@ -9,4 +9,15 @@ var (
 	ErrPoolNotFound = errors.Register(ModuleName, 1100, "pool with id %v does not exist")
 	ErrInvalidJson  = errors.Register(ModuleName, 1101, "invalid json object: %v")
 	ErrInvalidArgs  = errors.Register(ModuleName, 1102, "invalid args")
+
+	ErrNoUpgradeScheduled     = errors.Register(ModuleName, 1103, "pool %v has no scheduled upgrade")
+	ErrUpgradeVersionMismatch = errors.Register(ModuleName, 1104, "scheduled upgrade version is %v, got %v")
+	ErrAlreadySignaledReady   = errors.Register(ModuleName, 1105, "staker already signaled readiness for version %v")
+	ErrInvalidRuntimeBinaries = errors.Register(ModuleName, 1106, "invalid runtime binaries: %v")
+	ErrInvalidPauseRange      = errors.Register(ModuleName, 1107, "pause until %v has to be after pause from %v")
+	ErrPoolAlreadyForked      = errors.Register(ModuleName, 1108, "pool %v was already forked into pool %v")
+	ErrPoolNotDisabled        = errors.Register(ModuleName, 1109, "pool %v has to be disabled")
+	ErrInvalidRampRange       = errors.Register(ModuleName, 1110, "ramp end %v has to be after ramp start %v")
+	ErrMaxStakersTooHigh      = errors.Register(ModuleName, 1111, "max stakers %v exceeds the limit of %v")
+	ErrInvalidRampFromWeight  = errors.Register(ModuleName, 1112, "ramp has to start from the current inflation share weight %v, got %v")
 )
@@ -131,6 +131,10 @@ type EventCreatePool struct {
 	// end_key is the last key before the pool should stop indexing, it is
 	// inclusive
 	EndKey string `protobuf:"bytes,15,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// runtime_binaries contains the checksummed binaries of the protocol node
+	RuntimeBinaries []RuntimeBinary `protobuf:"bytes,16,rep,name=runtime_binaries,json=runtimeBinaries,proto3" json:"runtime_binaries"`
+	// max_stakers is the maximum amount of stakers which can join the pool
+	MaxStakers uint64 `protobuf:"varint,17,opt,name=max_stakers,json=maxStakers,proto3" json:"max_stakers,omitempty"`
 }
 
 func (m *EventCreatePool) Reset()         { *m = EventCreatePool{} }
@@ -264,6 +268,20 @@ func (m *EventCreatePool) GetEndKey() string {
 	return ""
 }
 
+func (m *EventCreatePool) GetRuntimeBinaries() []RuntimeBinary {
+	if m != nil {
+		return m.RuntimeBinaries
+	}
+	return nil
+}
+
+func (m *EventCreatePool) GetMaxStakers() uint64 {
+	if m != nil {
+		return m.MaxStakers
+	}
+	return 0
+}
+
 // EventPoolEnabled ...
 // emitted_by: EndBlock(gov)
 type EventPoolEnabled struct {
@@ -358,37 +376,36 @@ func (m *EventPoolDisabled) GetId() uint64 {
 	return 0
 }
 
-// EventRuntimeUpgradeScheduled ...
+// EventPoolForked is an event emitted when a successor pool was forked
+// from an existing pool.
 // emitted_by: EndBlock(gov)
-type EventRuntimeUpgradeScheduled struct {
-	// runtime is the name of the runtime that will be upgraded.
-	Runtime string `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	// version is the new version that the runtime will be upgraded to.
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// scheduled_at is the time in UNIX seconds when the upgrade will occur.
-	ScheduledAt uint64 `protobuf:"varint,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
-	// duration is the amount of seconds the pool will be paused after the
-	// scheduled time is reached. This will give node operators time to upgrade
-	// their node.
-	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
-	// binaries contain download links for prebuilt binaries (in JSON format).
-	Binaries string `protobuf:"bytes,5,opt,name=binaries,proto3" json:"binaries,omitempty"`
-	// affected_pools contains all IDs of pools that will be affected by this runtime upgrade.
-	AffectedPools []uint64 `protobuf:"varint,6,rep,packed,name=affected_pools,json=affectedPools,proto3" json:"affected_pools,omitempty"`
+type EventPoolForked struct {
+	// id is the unique ID of the successor pool.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// predecessor_id is the unique ID of the forked pool.
+	PredecessorId uint64 `protobuf:"varint,2,opt,name=predecessor_id,json=predecessorId,proto3" json:"predecessor_id,omitempty"`
+	// index is the current index the successor starts at.
+	Index uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// key is the current key the successor starts at.
+	Key string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// migrated_valaccounts is the amount of valaccounts moved to the successor.
+	MigratedValaccounts uint64 `protobuf:"varint,5,opt,name=migrated_valaccounts,json=migratedValaccounts,proto3" json:"migrated_valaccounts,omitempty"`
+	// migrated_fundings is the amount of fundings moved to the successor.
+	MigratedFundings uint64 `protobuf:"varint,6,opt,name=migrated_fundings,json=migratedFundings,proto3" json:"migrated_fundings,omitempty"`
 }
 
-func (m *EventRuntimeUpgradeScheduled) Reset()         { *m = EventRuntimeUpgradeScheduled{} }
-func (m *EventRuntimeUpgradeScheduled) String() string { return proto.CompactTextString(m) }
-func (*EventRuntimeUpgradeScheduled) ProtoMessage()    {}
-func (*EventRuntimeUpgradeScheduled) Descriptor() ([]byte, []int) {
+func (m *EventPoolForked) Reset()         { *m = EventPoolForked{} }
+func (m *EventPoolForked) String() string { return proto.CompactTextString(m) }
+func (*EventPoolForked) ProtoMessage()    {}
+func (*EventPoolForked) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1828a100d789238, []int{4}
 }
-func (m *EventRuntimeUpgradeScheduled) XXX_Unmarshal(b []byte) error {
+func (m *EventPoolForked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRuntimeUpgradeScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventPoolForked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRuntimeUpgradeScheduled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventPoolForked.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -398,82 +415,86 @@ func (m *EventRuntimeUpgradeScheduled) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventRuntimeUpgradeScheduled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRuntimeUpgradeScheduled.Merge(m, src)
+func (m *EventPoolForked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolForked.Merge(m, src)
 }
-func (m *EventRuntimeUpgradeScheduled) XXX_Size() int {
+func (m *EventPoolForked) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRuntimeUpgradeScheduled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRuntimeUpgradeScheduled.DiscardUnknown(m)
+func (m *EventPoolForked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolForked.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRuntimeUpgradeScheduled proto.InternalMessageInfo
+var xxx_messageInfo_EventPoolForked proto.InternalMessageInfo
 
-func (m *EventRuntimeUpgradeScheduled) GetRuntime() string {
+func (m *EventPoolForked) GetId() uint64 {
 	if m != nil {
-		return m.Runtime
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *EventRuntimeUpgradeScheduled) GetVersion() string {
+func (m *EventPoolForked) GetPredecessorId() uint64 {
 	if m != nil {
-		return m.Version
+		return m.PredecessorId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventRuntimeUpgradeScheduled) GetScheduledAt() uint64 {
+func (m *EventPoolForked) GetIndex() uint64 {
 	if m != nil {
-		return m.ScheduledAt
+		return m.Index
 	}
 	return 0
 }
 
-func (m *EventRuntimeUpgradeScheduled) GetDuration() uint64 {
+func (m *EventPoolForked) GetKey() string {
 	if m != nil {
-		return m.Duration
+		return m.Key
 	}
-	return 0
+	return ""
 }
 
-func (m *EventRuntimeUpgradeScheduled) GetBinaries() string {
+func (m *EventPoolForked) GetMigratedValaccounts() uint64 {
 	if m != nil {
-		return m.Binaries
+		return m.MigratedValaccounts
 	}
-	return ""
+	return 0
 }
 
-func (m *EventRuntimeUpgradeScheduled) GetAffectedPools() []uint64 {
+func (m *EventPoolForked) GetMigratedFundings() uint64 {
 	if m != nil {
-		return m.AffectedPools
+		return m.MigratedFundings
 	}
-	return nil
+	return 0
 }
 
-// EventRuntimeUpgradeCancelled ...
+// EventPoolRetired is an event emitted when a disabled pool was retired.
 // emitted_by: EndBlock(gov)
-type EventRuntimeUpgradeCancelled struct {
-	// runtime is the name of the runtime that will be upgraded.
-	Runtime string `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	// affected_pools contains all IDs of pools that are affected by the
-	// cancellation of this runtime upgrade.
-	AffectedPools []uint64 `protobuf:"varint,2,rep,packed,name=affected_pools,json=affectedPools,proto3" json:"affected_pools,omitempty"`
+type EventPoolRetired struct {
+	// id is the unique ID of the retired pool.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// refunded_fundings is the amount of fundings which got refunded.
+	RefundedFundings uint64 `protobuf:"varint,2,opt,name=refunded_fundings,json=refundedFundings,proto3" json:"refunded_fundings,omitempty"`
+	// removed_valaccounts is the amount of valaccounts which got removed.
+	RemovedValaccounts uint64 `protobuf:"varint,3,opt,name=removed_valaccounts,json=removedValaccounts,proto3" json:"removed_valaccounts,omitempty"`
+	// swept_amount is the remaining balance of the pool account which got
+	// transferred to the treasury.
+	SweptAmount uint64 `protobuf:"varint,4,opt,name=swept_amount,json=sweptAmount,proto3" json:"swept_amount,omitempty"`
 }
 
-func (m *EventRuntimeUpgradeCancelled) Reset()         { *m = EventRuntimeUpgradeCancelled{} }
-func (m *EventRuntimeUpgradeCancelled) String() string { return proto.CompactTextString(m) }
-func (*EventRuntimeUpgradeCancelled) ProtoMessage()    {}
-func (*EventRuntimeUpgradeCancelled) Descriptor() ([]byte, []int) {
+func (m *EventPoolRetired) Reset()         { *m = EventPoolRetired{} }
+func (m *EventPoolRetired) String() string { return proto.CompactTextString(m) }
+func (*EventPoolRetired) ProtoMessage()    {}
+func (*EventPoolRetired) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1828a100d789238, []int{5}
 }
-func (m *EventRuntimeUpgradeCancelled) XXX_Unmarshal(b []byte) error {
+func (m *EventPoolRetired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRuntimeUpgradeCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventPoolRetired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRuntimeUpgradeCancelled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventPoolRetired.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -483,80 +504,76 @@ func (m *EventRuntimeUpgradeCancelled) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventRuntimeUpgradeCancelled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRuntimeUpgradeCancelled.Merge(m, src)
+func (m *EventPoolRetired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolRetired.Merge(m, src)
 }
-func (m *EventRuntimeUpgradeCancelled) XXX_Size() int {
+func (m *EventPoolRetired) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRuntimeUpgradeCancelled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRuntimeUpgradeCancelled.DiscardUnknown(m)
+func (m *EventPoolRetired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolRetired.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRuntimeUpgradeCancelled proto.InternalMessageInfo
+var xxx_messageInfo_EventPoolRetired proto.InternalMessageInfo
 
-func (m *EventRuntimeUpgradeCancelled) GetRuntime() string {
+func (m *EventPoolRetired) GetId() uint64 {
 	if m != nil {
-		return m.Runtime
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *EventRuntimeUpgradeCancelled) GetAffectedPools() []uint64 {
+func (m *EventPoolRetired) GetRefundedFundings() uint64 {
 	if m != nil {
-		return m.AffectedPools
+		return m.RefundedFundings
 	}
-	return nil
+	return 0
 }
 
-// EventPoolUpdated ...
+func (m *EventPoolRetired) GetRemovedValaccounts() uint64 {
+	if m != nil {
+		return m.RemovedValaccounts
+	}
+	return 0
+}
+
+func (m *EventPoolRetired) GetSweptAmount() uint64 {
+	if m != nil {
+		return m.SweptAmount
+	}
+	return 0
+}
+
+// EventInflationShareWeightRampScheduled is an event emitted when a linear change
+// of the inflation share weight of a pool was scheduled.
 // emitted_by: EndBlock(gov)
-type EventPoolUpdated struct {
-	// id is the unique ID of the pool.
+type EventInflationShareWeightRampScheduled struct {
+	// id is the unique ID of the affected pool.
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// raw update string
-	RawUpdateString string `protobuf:"bytes,2,opt,name=raw_update_string,json=rawUpdateString,proto3" json:"raw_update_string,omitempty"`
-	// name is the human readable name of the pool
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// runtime is the runtime name of the pool
-	Runtime string `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	// logo is the logo url of the pool
-	Logo string `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
-	// config is either a json stringified config or an
-	// external link pointing to the config
-	Config string `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
-	// upload_interval is the interval the pool should validate
-	// bundles with
-	UploadInterval uint64 `protobuf:"varint,7,opt,name=upload_interval,json=uploadInterval,proto3" json:"upload_interval,omitempty"`
-	// inflation_share_weight is the fixed cost which gets paid out
-	// to every successful uploader
-	InflationShareWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=inflation_share_weight,json=inflationShareWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_share_weight"`
-	// min_delegation is the minimum amount of $KYVE the pool has
-	// to have in order to produce bundles
-	MinDelegation uint64 `protobuf:"varint,9,opt,name=min_delegation,json=minDelegation,proto3" json:"min_delegation,omitempty"`
-	// max_bundle_size is the max size a data bundle can have
-	// (amount of data items)
-	MaxBundleSize uint64 `protobuf:"varint,10,opt,name=max_bundle_size,json=maxBundleSize,proto3" json:"max_bundle_size,omitempty"`
-	// storage_provider_id is the unique id of the storage provider
-	// the pool is archiving the data on
-	StorageProviderId uint32 `protobuf:"varint,11,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
-	// compression_id is the unique id of the compression type the bundles
-	// get compressed with
-	CompressionId uint32 `protobuf:"varint,12,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
+	// from_weight is the inflation share weight at the start of the ramp.
+	FromWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=from_weight,json=fromWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"from_weight"`
+	// to_weight is the inflation share weight at the end of the ramp.
+	ToWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=to_weight,json=toWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"to_weight"`
+	// start_at is the unix time the ramp starts.
+	StartAt uint64 `protobuf:"varint,4,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// end_at is the unix time the ramp ends.
+	EndAt uint64 `protobuf:"varint,5,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
 }
 
-func (m *EventPoolUpdated) Reset()         { *m = EventPoolUpdated{} }
-func (m *EventPoolUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventPoolUpdated) ProtoMessage()    {}
-func (*EventPoolUpdated) Descriptor() ([]byte, []int) {
+func (m *EventInflationShareWeightRampScheduled) Reset() {
+	*m = EventInflationShareWeightRampScheduled{}
+}
+func (m *EventInflationShareWeightRampScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventInflationShareWeightRampScheduled) ProtoMessage()    {}
+func (*EventInflationShareWeightRampScheduled) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1828a100d789238, []int{6}
 }
-func (m *EventPoolUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventInflationShareWeightRampScheduled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPoolUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventInflationShareWeightRampScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPoolUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventInflationShareWeightRampScheduled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -566,118 +583,175 @@ func (m *EventPoolUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *EventPoolUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPoolUpdated.Merge(m, src)
+func (m *EventInflationShareWeightRampScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInflationShareWeightRampScheduled.Merge(m, src)
 }
-func (m *EventPoolUpdated) XXX_Size() int {
+func (m *EventInflationShareWeightRampScheduled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPoolUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPoolUpdated.DiscardUnknown(m)
+func (m *EventInflationShareWeightRampScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInflationShareWeightRampScheduled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPoolUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventInflationShareWeightRampScheduled proto.InternalMessageInfo
 
-func (m *EventPoolUpdated) GetId() uint64 {
+func (m *EventInflationShareWeightRampScheduled) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-func (m *EventPoolUpdated) GetRawUpdateString() string {
+func (m *EventInflationShareWeightRampScheduled) GetStartAt() uint64 {
 	if m != nil {
-		return m.RawUpdateString
+		return m.StartAt
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPoolUpdated) GetName() string {
+func (m *EventInflationShareWeightRampScheduled) GetEndAt() uint64 {
 	if m != nil {
-		return m.Name
+		return m.EndAt
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPoolUpdated) GetRuntime() string {
-	if m != nil {
-		return m.Runtime
-	}
-	return ""
+// EventInflationShareWeightRampCompleted is an event emitted when a scheduled
+// change of the inflation share weight of a pool reached its end.
+// emitted_by: EndBlock
+type EventInflationShareWeightRampCompleted struct {
+	// id is the unique ID of the affected pool.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// inflation_share_weight is the new inflation share weight of the pool.
+	InflationShareWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_share_weight,json=inflationShareWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_share_weight"`
 }
 
-func (m *EventPoolUpdated) GetLogo() string {
-	if m != nil {
-		return m.Logo
-	}
-	return ""
+func (m *EventInflationShareWeightRampCompleted) Reset() {
+	*m = EventInflationShareWeightRampCompleted{}
 }
-
-func (m *EventPoolUpdated) GetConfig() string {
-	if m != nil {
-		return m.Config
+func (m *EventInflationShareWeightRampCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventInflationShareWeightRampCompleted) ProtoMessage()    {}
+func (*EventInflationShareWeightRampCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{7}
+}
+func (m *EventInflationShareWeightRampCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventInflationShareWeightRampCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventInflationShareWeightRampCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *EventInflationShareWeightRampCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInflationShareWeightRampCompleted.Merge(m, src)
+}
+func (m *EventInflationShareWeightRampCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventInflationShareWeightRampCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInflationShareWeightRampCompleted.DiscardUnknown(m)
 }
 
-func (m *EventPoolUpdated) GetUploadInterval() uint64 {
+var xxx_messageInfo_EventInflationShareWeightRampCompleted proto.InternalMessageInfo
+
+func (m *EventInflationShareWeightRampCompleted) GetId() uint64 {
 	if m != nil {
-		return m.UploadInterval
+		return m.Id
 	}
 	return 0
 }
 
-func (m *EventPoolUpdated) GetMinDelegation() uint64 {
-	if m != nil {
-		return m.MinDelegation
+// EventPoolPaused is an event emitted when a pause of a pool was scheduled.
+// emitted_by: EndBlock(gov)
+type EventPoolPaused struct {
+	// id is the unique ID of the affected pool.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pause_from is the unix time the pause starts.
+	PauseFrom uint64 `protobuf:"varint,2,opt,name=pause_from,json=pauseFrom,proto3" json:"pause_from,omitempty"`
+	// pause_until is the unix time the pause ends.
+	PauseUntil uint64 `protobuf:"varint,3,opt,name=pause_until,json=pauseUntil,proto3" json:"pause_until,omitempty"`
+}
+
+func (m *EventPoolPaused) Reset()         { *m = EventPoolPaused{} }
+func (m *EventPoolPaused) String() string { return proto.CompactTextString(m) }
+func (*EventPoolPaused) ProtoMessage()    {}
+func (*EventPoolPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{8}
+}
+func (m *EventPoolPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *EventPoolPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolPaused.Merge(m, src)
+}
+func (m *EventPoolPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolPaused.DiscardUnknown(m)
 }
 
-func (m *EventPoolUpdated) GetMaxBundleSize() uint64 {
+var xxx_messageInfo_EventPoolPaused proto.InternalMessageInfo
+
+func (m *EventPoolPaused) GetId() uint64 {
 	if m != nil {
-		return m.MaxBundleSize
+		return m.Id
 	}
 	return 0
 }
 
-func (m *EventPoolUpdated) GetStorageProviderId() uint32 {
+func (m *EventPoolPaused) GetPauseFrom() uint64 {
 	if m != nil {
-		return m.StorageProviderId
+		return m.PauseFrom
 	}
 	return 0
 }
 
-func (m *EventPoolUpdated) GetCompressionId() uint32 {
+func (m *EventPoolPaused) GetPauseUntil() uint64 {
 	if m != nil {
-		return m.CompressionId
+		return m.PauseUntil
 	}
 	return 0
 }
 
-// EventDefundPool is an event emitted when a pool is defunded.
-// emitted_by: MsgSubmitBundleProposal
-type EventPoolFundsSlashed struct {
-	// pool_id is the unique ID of the pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// address is the account address of the pool funder.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// amount is the amount in ukyve the validator has lost due to the slash
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+// EventPoolResumed is an event emitted when the pause of a pool ended.
+// emitted_by: EndBlock, EndBlock(gov)
+type EventPoolResumed struct {
+	// id is the unique ID of the affected pool.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *EventPoolFundsSlashed) Reset()         { *m = EventPoolFundsSlashed{} }
-func (m *EventPoolFundsSlashed) String() string { return proto.CompactTextString(m) }
-func (*EventPoolFundsSlashed) ProtoMessage()    {}
-func (*EventPoolFundsSlashed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1828a100d789238, []int{7}
+func (m *EventPoolResumed) Reset()         { *m = EventPoolResumed{} }
+func (m *EventPoolResumed) String() string { return proto.CompactTextString(m) }
+func (*EventPoolResumed) ProtoMessage()    {}
+func (*EventPoolResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{9}
 }
-func (m *EventPoolFundsSlashed) XXX_Unmarshal(b []byte) error {
+func (m *EventPoolResumed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPoolFundsSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventPoolResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPoolFundsSlashed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventPoolResumed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -687,832 +761,3254 @@ func (m *EventPoolFundsSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventPoolFundsSlashed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPoolFundsSlashed.Merge(m, src)
+func (m *EventPoolResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolResumed.Merge(m, src)
 }
-func (m *EventPoolFundsSlashed) XXX_Size() int {
+func (m *EventPoolResumed) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPoolFundsSlashed) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPoolFundsSlashed.DiscardUnknown(m)
+func (m *EventPoolResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolResumed.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPoolFundsSlashed proto.InternalMessageInfo
+var xxx_messageInfo_EventPoolResumed proto.InternalMessageInfo
 
-func (m *EventPoolFundsSlashed) GetPoolId() uint64 {
+func (m *EventPoolResumed) GetId() uint64 {
 	if m != nil {
-		return m.PoolId
+		return m.Id
 	}
 	return 0
 }
 
-func (m *EventPoolFundsSlashed) GetAddress() string {
+// EventRuntimeUpgradeScheduled ...
+// emitted_by: EndBlock(gov)
+type EventRuntimeUpgradeScheduled struct {
+	// runtime is the name of the runtime that will be upgraded.
+	Runtime string `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// version is the new version that the runtime will be upgraded to.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// scheduled_at is the time in UNIX seconds when the upgrade will occur.
+	ScheduledAt uint64 `protobuf:"varint,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// duration is the amount of seconds the pool will be paused after the
+	// scheduled time is reached. This will give node operators time to upgrade
+	// their node.
+	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// binaries contain download links for prebuilt binaries (in JSON format).
+	Binaries string `protobuf:"bytes,5,opt,name=binaries,proto3" json:"binaries,omitempty"`
+	// affected_pools contains all IDs of pools that will be affected by this runtime upgrade.
+	AffectedPools []uint64 `protobuf:"varint,6,rep,packed,name=affected_pools,json=affectedPools,proto3" json:"affected_pools,omitempty"`
+	// readiness_threshold is the optional fraction of delegation which has to
+	// signal readiness before the upgrade gets activated.
+	ReadinessThreshold *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=readiness_threshold,json=readinessThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"readiness_threshold,omitempty"`
+	// readiness_deadline is the time in UNIX seconds when the upgrade gets
+	// aborted if the readiness threshold was not reached.
+	ReadinessDeadline uint64 `protobuf:"varint,8,opt,name=readiness_deadline,json=readinessDeadline,proto3" json:"readiness_deadline,omitempty"`
+	// runtime_binaries contains the checksummed prebuilt binaries.
+	RuntimeBinaries []RuntimeBinary `protobuf:"bytes,9,rep,name=runtime_binaries,json=runtimeBinaries,proto3" json:"runtime_binaries"`
+}
+
+func (m *EventRuntimeUpgradeScheduled) Reset()         { *m = EventRuntimeUpgradeScheduled{} }
+func (m *EventRuntimeUpgradeScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventRuntimeUpgradeScheduled) ProtoMessage()    {}
+func (*EventRuntimeUpgradeScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{10}
+}
+func (m *EventRuntimeUpgradeScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRuntimeUpgradeScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRuntimeUpgradeScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRuntimeUpgradeScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRuntimeUpgradeScheduled.Merge(m, src)
+}
+func (m *EventRuntimeUpgradeScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRuntimeUpgradeScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRuntimeUpgradeScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRuntimeUpgradeScheduled proto.InternalMessageInfo
+
+func (m *EventRuntimeUpgradeScheduled) GetRuntime() string {
 	if m != nil {
-		return m.Address
+		return m.Runtime
 	}
 	return ""
 }
 
-func (m *EventPoolFundsSlashed) GetAmount() uint64 {
+func (m *EventRuntimeUpgradeScheduled) GetVersion() string {
 	if m != nil {
-		return m.Amount
+		return m.Version
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventUpdateParams)(nil), "kyve.pool.v1beta1.EventUpdateParams")
-	proto.RegisterType((*EventCreatePool)(nil), "kyve.pool.v1beta1.EventCreatePool")
-	proto.RegisterType((*EventPoolEnabled)(nil), "kyve.pool.v1beta1.EventPoolEnabled")
-	proto.RegisterType((*EventPoolDisabled)(nil), "kyve.pool.v1beta1.EventPoolDisabled")
-	proto.RegisterType((*EventRuntimeUpgradeScheduled)(nil), "kyve.pool.v1beta1.EventRuntimeUpgradeScheduled")
-	proto.RegisterType((*EventRuntimeUpgradeCancelled)(nil), "kyve.pool.v1beta1.EventRuntimeUpgradeCancelled")
-	proto.RegisterType((*EventPoolUpdated)(nil), "kyve.pool.v1beta1.EventPoolUpdated")
-	proto.RegisterType((*EventPoolFundsSlashed)(nil), "kyve.pool.v1beta1.EventPoolFundsSlashed")
+func (m *EventRuntimeUpgradeScheduled) GetScheduledAt() uint64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("kyve/pool/v1beta1/events.proto", fileDescriptor_c1828a100d789238) }
+func (m *EventRuntimeUpgradeScheduled) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
 
-var fileDescriptor_c1828a100d789238 = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xae, 0x53, 0x37, 0x6d, 0xa6, 0x6d, 0x42, 0xcd, 0xb2, 0x98, 0x2e, 0xca, 0x96, 0xac, 0x16,
-	0x0a, 0x17, 0xb6, 0x16, 0xee, 0x91, 0xe8, 0x0f, 0x52, 0x54, 0x84, 0x56, 0x8e, 0x16, 0xb4, 0xdc,
-	0x8c, 0x26, 0x9e, 0x13, 0x67, 0x14, 0x7b, 0xc6, 0x9a, 0x19, 0x27, 0xcd, 0x3e, 0x05, 0x3c, 0x08,
-	0xef, 0xb1, 0x97, 0x2b, 0xc4, 0x05, 0xe2, 0x62, 0x85, 0xda, 0x17, 0x41, 0x33, 0xb6, 0x43, 0xb2,
-	0x35, 0xa8, 0xd7, 0xdc, 0xcd, 0xf9, 0xfb, 0xe6, 0xcc, 0x77, 0xce, 0x67, 0xa3, 0xfe, 0x6c, 0x39,
-	0x87, 0x30, 0x17, 0x22, 0x0d, 0xe7, 0xcf, 0xc6, 0xa0, 0xc9, 0xb3, 0x10, 0xe6, 0xc0, 0xb5, 0x0a,
-	0x72, 0x29, 0xb4, 0xf0, 0x8e, 0x4c, 0x3c, 0x30, 0xf1, 0xa0, 0x8a, 0x1f, 0x3f, 0x48, 0x44, 0x22,
-	0x6c, 0x34, 0x34, 0xa7, 0x32, 0xf1, 0xb8, 0x01, 0x28, 0x27, 0x92, 0x64, 0x15, 0xd0, 0xe0, 0x57,
-	0x07, 0x1d, 0x5d, 0x1a, 0xe4, 0x17, 0x39, 0x25, 0x1a, 0x9e, 0xdb, 0x98, 0xf7, 0x35, 0x42, 0x22,
-	0xa5, 0xb8, 0xcc, 0xf4, 0x9d, 0x13, 0xe7, 0x74, 0xff, 0xcb, 0x8f, 0x82, 0x3b, 0x77, 0x06, 0x65,
-	0xfa, 0x99, 0xfb, 0xfa, 0xed, 0xe3, 0xad, 0xa8, 0x23, 0x52, 0xfa, 0x4f, 0x3d, 0x87, 0x45, 0x5d,
-	0xdf, 0xba, 0x67, 0x3d, 0x87, 0x45, 0x55, 0xef, 0xa3, 0xdd, 0x9c, 0x2c, 0x53, 0x41, 0xa8, 0xbf,
-	0x7d, 0xe2, 0x9c, 0x76, 0xa2, 0xda, 0x1c, 0xfc, 0xe2, 0xa2, 0x9e, 0xed, 0xf7, 0x5c, 0x82, 0xe9,
-	0x57, 0x88, 0xd4, 0xeb, 0xa2, 0x16, 0xa3, 0xb6, 0x4b, 0x37, 0x6a, 0x31, 0xea, 0x79, 0xc8, 0xe5,
-	0x24, 0x03, 0x7b, 0x6f, 0x27, 0xb2, 0x67, 0x83, 0x28, 0x0b, 0xae, 0x59, 0x06, 0x35, 0x62, 0x65,
-	0x9a, 0xec, 0x54, 0x24, 0xc2, 0x77, 0xcb, 0x6c, 0x73, 0xf6, 0x1e, 0xa2, 0x76, 0x2c, 0xf8, 0x84,
-	0x25, 0xfe, 0x8e, 0xf5, 0x56, 0x96, 0xf7, 0x08, 0x75, 0x94, 0x26, 0x52, 0xe3, 0x19, 0x2c, 0xfd,
-	0xb6, 0x0d, 0xed, 0x59, 0xc7, 0x15, 0x2c, 0xbd, 0xcf, 0x50, 0xaf, 0xc8, 0x4d, 0x93, 0x98, 0x71,
-	0x0d, 0x72, 0x4e, 0x52, 0x7f, 0xd7, 0xf6, 0xd4, 0x2d, 0xdd, 0xc3, 0xca, 0xeb, 0xbd, 0x44, 0x0f,
-	0x19, 0x9f, 0xa4, 0x44, 0x33, 0xc1, 0xb1, 0x9a, 0x12, 0x09, 0x78, 0x01, 0x2c, 0x99, 0x6a, 0x7f,
-	0xcf, 0x40, 0x9e, 0x3d, 0x31, 0x74, 0xfc, 0xf9, 0xf6, 0xf1, 0xa3, 0x58, 0xa8, 0x4c, 0x28, 0x45,
-	0x67, 0x01, 0x13, 0x61, 0x46, 0xf4, 0x34, 0xf8, 0x0e, 0x12, 0x12, 0x2f, 0x2f, 0x20, 0x8e, 0x1e,
-	0xac, 0x20, 0x46, 0x06, 0xe1, 0x47, 0x0b, 0xe0, 0x3d, 0x45, 0xdd, 0x8c, 0x71, 0x4c, 0x21, 0x85,
-	0xc4, 0x06, 0xfd, 0x8e, 0x6d, 0xe1, 0x30, 0x63, 0xfc, 0x62, 0xe5, 0xf4, 0x3e, 0x45, 0xbd, 0x8c,
-	0x5c, 0xe3, 0x71, 0xc1, 0x69, 0x0a, 0x58, 0xb1, 0x57, 0xe0, 0xa3, 0x2a, 0x8f, 0x5c, 0x9f, 0x59,
-	0xef, 0x88, 0xbd, 0xb2, 0xac, 0xcd, 0x41, 0x2a, 0x83, 0xb3, 0x5f, 0xb2, 0x56, 0x99, 0xde, 0x31,
-	0xda, 0x1b, 0x33, 0x4e, 0x24, 0x03, 0xe5, 0x1f, 0x94, 0x44, 0xd4, 0xb6, 0x17, 0xa0, 0xf7, 0x95,
-	0x16, 0x92, 0x24, 0x80, 0x73, 0x29, 0xe6, 0x8c, 0x82, 0xc4, 0x8c, 0xfa, 0x87, 0x27, 0xce, 0xe9,
-	0x61, 0x74, 0x54, 0x85, 0x9e, 0x57, 0x91, 0x21, 0x35, 0x4d, 0xc7, 0x22, 0xcb, 0x25, 0x28, 0x03,
-	0x6d, 0x52, 0xbb, 0x36, 0xf5, 0x70, 0xcd, 0x3b, 0xa4, 0xde, 0x87, 0x68, 0x17, 0x38, 0xb5, 0xd4,
-	0xf7, 0xca, 0xa9, 0x00, 0xa7, 0x57, 0xb0, 0x1c, 0x0c, 0xd0, 0x7b, 0x76, 0x25, 0xcc, 0x32, 0x5c,
-	0x72, 0x32, 0x4e, 0x81, 0xbe, 0xbb, 0x13, 0x83, 0x27, 0xd5, 0x9a, 0x9b, 0x9c, 0x0b, 0xa6, 0x9a,
-	0x93, 0x7e, 0x77, 0xd0, 0xc7, 0x36, 0x2b, 0x2a, 0x77, 0xe3, 0x45, 0x9e, 0x48, 0x42, 0x61, 0x14,
-	0x4f, 0x81, 0x16, 0xa6, 0x60, 0x6d, 0x8b, 0x9c, 0xcd, 0x2d, 0x5a, 0x63, 0xaa, 0xb5, 0xc9, 0xd4,
-	0x27, 0xe8, 0x40, 0xd5, 0x00, 0x98, 0x68, 0xbb, 0x7e, 0x6e, 0xb4, 0xbf, 0xf2, 0x7d, 0xa3, 0x0d,
-	0x99, 0xb4, 0x90, 0xe5, 0xbc, 0x5c, 0x1b, 0x5e, 0xd9, 0x1b, 0x44, 0xef, 0xbc, 0x43, 0xf4, 0x53,
-	0xd4, 0x25, 0x93, 0x09, 0xc4, 0x1a, 0x28, 0x36, 0xba, 0x52, 0x7e, 0xfb, 0x64, 0xdb, 0x4c, 0xb1,
-	0xf6, 0x9a, 0xd7, 0xaa, 0x01, 0x6e, 0x7c, 0xd5, 0x39, 0xe1, 0x31, 0xa4, 0xff, 0xfd, 0xaa, 0xbb,
-	0x17, 0xb4, 0x9a, 0x2e, 0xf8, 0x6d, 0x7b, 0x6d, 0x02, 0xe5, 0x87, 0xe4, 0x0e, 0xb9, 0xde, 0x17,
-	0xe8, 0x48, 0x92, 0x05, 0x2e, 0x6c, 0x18, 0x2b, 0x2d, 0x19, 0x4f, 0x2a, 0xae, 0x7a, 0x92, 0x2c,
-	0xca, 0xb2, 0x91, 0x75, 0xaf, 0x14, 0xbc, 0xdd, 0xac, 0x60, 0xb7, 0x59, 0xc1, 0x3b, 0x8d, 0x0a,
-	0x6e, 0x6f, 0x28, 0xf8, 0x7f, 0x28, 0xd2, 0x7f, 0x91, 0xdb, 0xfe, 0xfd, 0xe5, 0x76, 0xd0, 0x20,
-	0xb7, 0xc1, 0x18, 0x7d, 0xb0, 0x9a, 0xe9, 0xb7, 0x05, 0xa7, 0x6a, 0x94, 0x12, 0x35, 0x05, 0xab,
-	0x43, 0xb3, 0x0b, 0x78, 0x35, 0xdd, 0xb6, 0x31, 0x87, 0x76, 0x8f, 0x08, 0xa5, 0x06, 0xa1, 0xd6,
-	0x40, 0x65, 0x9a, 0x69, 0x90, 0x4c, 0x14, 0xbc, 0xde, 0xfe, 0xca, 0x3a, 0x3b, 0x7f, 0x7d, 0xd3,
-	0x77, 0xde, 0xdc, 0xf4, 0x9d, 0xbf, 0x6e, 0xfa, 0xce, 0xcf, 0xb7, 0xfd, 0xad, 0x37, 0xb7, 0xfd,
-	0xad, 0x3f, 0x6e, 0xfb, 0x5b, 0x3f, 0x7d, 0x9e, 0x30, 0x3d, 0x2d, 0xc6, 0x41, 0x2c, 0xb2, 0xf0,
-	0xea, 0xe5, 0x0f, 0x97, 0xdf, 0x83, 0x5e, 0x08, 0x39, 0x0b, 0xe3, 0x29, 0x61, 0x3c, 0xbc, 0x2e,
-	0xff, 0x68, 0x7a, 0x99, 0x83, 0x1a, 0xb7, 0xed, 0x9f, 0xec, 0xab, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x29, 0x41, 0xad, 0xe9, 0x34, 0x07, 0x00, 0x00,
+func (m *EventRuntimeUpgradeScheduled) GetBinaries() string {
+	if m != nil {
+		return m.Binaries
+	}
+	return ""
 }
 
-func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRuntimeUpgradeScheduled) GetAffectedPools() []uint64 {
+	if m != nil {
+		return m.AffectedPools
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRuntimeUpgradeScheduled) GetReadinessDeadline() uint64 {
+	if m != nil {
+		return m.ReadinessDeadline
+	}
+	return 0
 }
 
-func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventRuntimeUpgradeScheduled) GetRuntimeBinaries() []RuntimeBinary {
+	if m != nil {
+		return m.RuntimeBinaries
 	}
-	{
-		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// EventRuntimeUpgradeCancelled ...
+// emitted_by: EndBlock(gov)
+type EventRuntimeUpgradeCancelled struct {
+	// runtime is the name of the runtime that will be upgraded.
+	Runtime string `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// affected_pools contains all IDs of pools that are affected by the
+	// cancellation of this runtime upgrade.
+	AffectedPools []uint64 `protobuf:"varint,2,rep,packed,name=affected_pools,json=affectedPools,proto3" json:"affected_pools,omitempty"`
+}
+
+func (m *EventRuntimeUpgradeCancelled) Reset()         { *m = EventRuntimeUpgradeCancelled{} }
+func (m *EventRuntimeUpgradeCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventRuntimeUpgradeCancelled) ProtoMessage()    {}
+func (*EventRuntimeUpgradeCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{11}
+}
+func (m *EventRuntimeUpgradeCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRuntimeUpgradeCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRuntimeUpgradeCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *EventRuntimeUpgradeCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRuntimeUpgradeCancelled.Merge(m, src)
+}
+func (m *EventRuntimeUpgradeCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRuntimeUpgradeCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRuntimeUpgradeCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRuntimeUpgradeCancelled proto.InternalMessageInfo
+
+func (m *EventRuntimeUpgradeCancelled) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
+	}
+	return ""
+}
+
+func (m *EventRuntimeUpgradeCancelled) GetAffectedPools() []uint64 {
+	if m != nil {
+		return m.AffectedPools
+	}
+	return nil
+}
+
+// EventUpgradeReadinessSignaled is an event emitted when a staker signals
+// readiness for a scheduled runtime upgrade.
+// emitted_by: MsgSignalUpgradeReady
+type EventUpgradeReadinessSignaled struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the address of the staker.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// version is the upgrade version the staker is ready for.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// ready_delegation is the total delegation of all ready stakers
+	// after the signal.
+	ReadyDelegation uint64 `protobuf:"varint,4,opt,name=ready_delegation,json=readyDelegation,proto3" json:"ready_delegation,omitempty"`
+	// total_delegation is the total delegation of the pool.
+	TotalDelegation uint64 `protobuf:"varint,5,opt,name=total_delegation,json=totalDelegation,proto3" json:"total_delegation,omitempty"`
+}
+
+func (m *EventUpgradeReadinessSignaled) Reset()         { *m = EventUpgradeReadinessSignaled{} }
+func (m *EventUpgradeReadinessSignaled) String() string { return proto.CompactTextString(m) }
+func (*EventUpgradeReadinessSignaled) ProtoMessage()    {}
+func (*EventUpgradeReadinessSignaled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{12}
+}
+func (m *EventUpgradeReadinessSignaled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpgradeReadinessSignaled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpgradeReadinessSignaled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventUpgradeReadinessSignaled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpgradeReadinessSignaled.Merge(m, src)
+}
+func (m *EventUpgradeReadinessSignaled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpgradeReadinessSignaled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpgradeReadinessSignaled.DiscardUnknown(m)
 }
 
-func (m *EventCreatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventUpgradeReadinessSignaled proto.InternalMessageInfo
+
+func (m *EventUpgradeReadinessSignaled) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventCreatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventUpgradeReadinessSignaled) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
 }
 
-func (m *EventCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EndKey) > 0 {
-		i -= len(m.EndKey)
-		copy(dAtA[i:], m.EndKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.EndKey)))
-		i--
-		dAtA[i] = 0x7a
+func (m *EventUpgradeReadinessSignaled) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if m.CompressionId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CompressionId))
-		i--
-		dAtA[i] = 0x70
+	return ""
+}
+
+func (m *EventUpgradeReadinessSignaled) GetReadyDelegation() uint64 {
+	if m != nil {
+		return m.ReadyDelegation
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
-		i--
-		dAtA[i] = 0x68
+	return 0
+}
+
+func (m *EventUpgradeReadinessSignaled) GetTotalDelegation() uint64 {
+	if m != nil {
+		return m.TotalDelegation
 	}
-	if len(m.Binaries) > 0 {
-		i -= len(m.Binaries)
-		copy(dAtA[i:], m.Binaries)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Binaries)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.MaxBundleSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MaxBundleSize))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.MinDelegation != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MinDelegation))
-		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size := m.InflationShareWeight.Size()
-		i -= size
-		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if m.UploadInterval != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.UploadInterval))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.StartKey) > 0 {
-		i -= len(m.StartKey)
-		copy(dAtA[i:], m.StartKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StartKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Config) > 0 {
-		i -= len(m.Config)
-		copy(dAtA[i:], m.Config)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Config)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Logo)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventPoolEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EventRuntimeUpgradeActivated is an event emitted when the readiness threshold
+// of a scheduled runtime upgrade was reached.
+// emitted_by: EndBlock
+type EventRuntimeUpgradeActivated struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// version is the version the pool gets upgraded to.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// ready_delegation is the total delegation of all ready stakers.
+	ReadyDelegation uint64 `protobuf:"varint,3,opt,name=ready_delegation,json=readyDelegation,proto3" json:"ready_delegation,omitempty"`
+	// total_delegation is the total delegation of the pool.
+	TotalDelegation uint64 `protobuf:"varint,4,opt,name=total_delegation,json=totalDelegation,proto3" json:"total_delegation,omitempty"`
 }
 
-func (m *EventPoolEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRuntimeUpgradeActivated) Reset()         { *m = EventRuntimeUpgradeActivated{} }
+func (m *EventRuntimeUpgradeActivated) String() string { return proto.CompactTextString(m) }
+func (*EventRuntimeUpgradeActivated) ProtoMessage()    {}
+func (*EventRuntimeUpgradeActivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{13}
 }
-
-func (m *EventPoolEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *EventRuntimeUpgradeActivated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRuntimeUpgradeActivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRuntimeUpgradeActivated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventRuntimeUpgradeActivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRuntimeUpgradeActivated.Merge(m, src)
+}
+func (m *EventRuntimeUpgradeActivated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRuntimeUpgradeActivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRuntimeUpgradeActivated.DiscardUnknown(m)
 }
 
-func (m *EventPoolDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventRuntimeUpgradeActivated proto.InternalMessageInfo
+
+func (m *EventRuntimeUpgradeActivated) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventPoolDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRuntimeUpgradeActivated) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
 }
 
-func (m *EventPoolDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *EventRuntimeUpgradeActivated) GetReadyDelegation() uint64 {
+	if m != nil {
+		return m.ReadyDelegation
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventRuntimeUpgradeScheduled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRuntimeUpgradeActivated) GetTotalDelegation() uint64 {
+	if m != nil {
+		return m.TotalDelegation
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventRuntimeUpgradeScheduled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRuntimeUpgradeAborted is an event emitted when the readiness threshold
+// of a scheduled runtime upgrade was not reached until the deadline.
+// emitted_by: EndBlock
+type EventRuntimeUpgradeAborted struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// version is the version the pool would have been upgraded to.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// ready_delegation is the total delegation of all ready stakers.
+	ReadyDelegation uint64 `protobuf:"varint,3,opt,name=ready_delegation,json=readyDelegation,proto3" json:"ready_delegation,omitempty"`
+	// total_delegation is the total delegation of the pool.
+	TotalDelegation uint64 `protobuf:"varint,4,opt,name=total_delegation,json=totalDelegation,proto3" json:"total_delegation,omitempty"`
 }
 
-func (m *EventRuntimeUpgradeScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AffectedPools) > 0 {
-		dAtA4 := make([]byte, len(m.AffectedPools)*10)
-		var j3 int
-		for _, num := range m.AffectedPools {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+func (m *EventRuntimeUpgradeAborted) Reset()         { *m = EventRuntimeUpgradeAborted{} }
+func (m *EventRuntimeUpgradeAborted) String() string { return proto.CompactTextString(m) }
+func (*EventRuntimeUpgradeAborted) ProtoMessage()    {}
+func (*EventRuntimeUpgradeAborted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{14}
+}
+func (m *EventRuntimeUpgradeAborted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRuntimeUpgradeAborted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRuntimeUpgradeAborted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintEvents(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Binaries) > 0 {
-		i -= len(m.Binaries)
-		copy(dAtA[i:], m.Binaries)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Binaries)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Duration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ScheduledAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ScheduledAt))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventRuntimeUpgradeAborted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRuntimeUpgradeAborted.Merge(m, src)
+}
+func (m *EventRuntimeUpgradeAborted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRuntimeUpgradeAborted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRuntimeUpgradeAborted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRuntimeUpgradeAborted proto.InternalMessageInfo
+
+func (m *EventRuntimeUpgradeAborted) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventRuntimeUpgradeAborted) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRuntimeUpgradeCancelled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRuntimeUpgradeAborted) GetReadyDelegation() uint64 {
+	if m != nil {
+		return m.ReadyDelegation
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventRuntimeUpgradeCancelled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRuntimeUpgradeAborted) GetTotalDelegation() uint64 {
+	if m != nil {
+		return m.TotalDelegation
+	}
+	return 0
 }
 
-func (m *EventRuntimeUpgradeCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AffectedPools) > 0 {
-		dAtA6 := make([]byte, len(m.AffectedPools)*10)
-		var j5 int
-		for _, num := range m.AffectedPools {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
+// EventPoolUpdated ...
+// emitted_by: EndBlock(gov)
+type EventPoolUpdated struct {
+	// id is the unique ID of the pool.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// raw update string
+	RawUpdateString string `protobuf:"bytes,2,opt,name=raw_update_string,json=rawUpdateString,proto3" json:"raw_update_string,omitempty"`
+	// name is the human readable name of the pool
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// runtime is the runtime name of the pool
+	Runtime string `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// logo is the logo url of the pool
+	Logo string `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
+	// config is either a json stringified config or an
+	// external link pointing to the config
+	Config string `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
+	// upload_interval is the interval the pool should validate
+	// bundles with
+	UploadInterval uint64 `protobuf:"varint,7,opt,name=upload_interval,json=uploadInterval,proto3" json:"upload_interval,omitempty"`
+	// inflation_share_weight is the fixed cost which gets paid out
+	// to every successful uploader
+	InflationShareWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=inflation_share_weight,json=inflationShareWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_share_weight"`
+	// min_delegation is the minimum amount of $KYVE the pool has
+	// to have in order to produce bundles
+	MinDelegation uint64 `protobuf:"varint,9,opt,name=min_delegation,json=minDelegation,proto3" json:"min_delegation,omitempty"`
+	// max_bundle_size is the max size a data bundle can have
+	// (amount of data items)
+	MaxBundleSize uint64 `protobuf:"varint,10,opt,name=max_bundle_size,json=maxBundleSize,proto3" json:"max_bundle_size,omitempty"`
+	// storage_provider_id is the unique id of the storage provider
+	// the pool is archiving the data on
+	StorageProviderId uint32 `protobuf:"varint,11,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// compression_id is the unique id of the compression type the bundles
+	// get compressed with
+	CompressionId uint32 `protobuf:"varint,12,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
+	// max_stakers is the maximum amount of stakers which can join the pool
+	MaxStakers uint64 `protobuf:"varint,13,opt,name=max_stakers,json=maxStakers,proto3" json:"max_stakers,omitempty"`
+}
+
+func (m *EventPoolUpdated) Reset()         { *m = EventPoolUpdated{} }
+func (m *EventPoolUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPoolUpdated) ProtoMessage()    {}
+func (*EventPoolUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{15}
+}
+func (m *EventPoolUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintEvents(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventPoolUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolUpdated.Merge(m, src)
+}
+func (m *EventPoolUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolUpdated.DiscardUnknown(m)
 }
 
-func (m *EventPoolUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventPoolUpdated proto.InternalMessageInfo
+
+func (m *EventPoolUpdated) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventPoolUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPoolUpdated) GetRawUpdateString() string {
+	if m != nil {
+		return m.RawUpdateString
+	}
+	return ""
 }
 
-func (m *EventPoolUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CompressionId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CompressionId))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.MaxBundleSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MaxBundleSize))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.MinDelegation != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MinDelegation))
-		i--
-		dAtA[i] = 0x48
+func (m *EventPoolUpdated) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	{
-		size := m.InflationShareWeight.Size()
-		i -= size
-		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EventPoolUpdated) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
 	}
-	i--
-	dAtA[i] = 0x42
-	if m.UploadInterval != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.UploadInterval))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *EventPoolUpdated) GetLogo() string {
+	if m != nil {
+		return m.Logo
 	}
-	if len(m.Config) > 0 {
-		i -= len(m.Config)
-		copy(dAtA[i:], m.Config)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Config)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *EventPoolUpdated) GetConfig() string {
+	if m != nil {
+		return m.Config
 	}
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Logo)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EventPoolUpdated) GetUploadInterval() uint64 {
+	if m != nil {
+		return m.UploadInterval
 	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *EventPoolUpdated) GetMinDelegation() uint64 {
+	if m != nil {
+		return m.MinDelegation
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *EventPoolUpdated) GetMaxBundleSize() uint64 {
+	if m != nil {
+		return m.MaxBundleSize
 	}
-	if len(m.RawUpdateString) > 0 {
-		i -= len(m.RawUpdateString)
-		copy(dAtA[i:], m.RawUpdateString)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RawUpdateString)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EventPoolUpdated) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventPoolUpdated) GetCompressionId() uint32 {
+	if m != nil {
+		return m.CompressionId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventPoolFundsSlashed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPoolUpdated) GetMaxStakers() uint64 {
+	if m != nil {
+		return m.MaxStakers
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventPoolFundsSlashed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventDefundPool is an event emitted when a pool is defunded.
+// emitted_by: MsgSubmitBundleProposal
+type EventPoolFundsSlashed struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// address is the account address of the pool funder.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount in ukyve the validator has lost due to the slash
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *EventPoolFundsSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+func (m *EventPoolFundsSlashed) Reset()         { *m = EventPoolFundsSlashed{} }
+func (m *EventPoolFundsSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventPoolFundsSlashed) ProtoMessage()    {}
+func (*EventPoolFundsSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{16}
+}
+func (m *EventPoolFundsSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolFundsSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolFundsSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventPoolFundsSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolFundsSlashed.Merge(m, src)
+}
+func (m *EventPoolFundsSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolFundsSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolFundsSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolFundsSlashed proto.InternalMessageInfo
+
+func (m *EventPoolFundsSlashed) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventPoolFundsSlashed) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventPoolFundsSlashed) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *EventUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+
+func init() {
+	proto.RegisterType((*EventUpdateParams)(nil), "kyve.pool.v1beta1.EventUpdateParams")
+	proto.RegisterType((*EventCreatePool)(nil), "kyve.pool.v1beta1.EventCreatePool")
+	proto.RegisterType((*EventPoolEnabled)(nil), "kyve.pool.v1beta1.EventPoolEnabled")
+	proto.RegisterType((*EventPoolDisabled)(nil), "kyve.pool.v1beta1.EventPoolDisabled")
+	proto.RegisterType((*EventPoolForked)(nil), "kyve.pool.v1beta1.EventPoolForked")
+	proto.RegisterType((*EventPoolRetired)(nil), "kyve.pool.v1beta1.EventPoolRetired")
+	proto.RegisterType((*EventInflationShareWeightRampScheduled)(nil), "kyve.pool.v1beta1.EventInflationShareWeightRampScheduled")
+	proto.RegisterType((*EventInflationShareWeightRampCompleted)(nil), "kyve.pool.v1beta1.EventInflationShareWeightRampCompleted")
+	proto.RegisterType((*EventPoolPaused)(nil), "kyve.pool.v1beta1.EventPoolPaused")
+	proto.RegisterType((*EventPoolResumed)(nil), "kyve.pool.v1beta1.EventPoolResumed")
+	proto.RegisterType((*EventRuntimeUpgradeScheduled)(nil), "kyve.pool.v1beta1.EventRuntimeUpgradeScheduled")
+	proto.RegisterType((*EventRuntimeUpgradeCancelled)(nil), "kyve.pool.v1beta1.EventRuntimeUpgradeCancelled")
+	proto.RegisterType((*EventUpgradeReadinessSignaled)(nil), "kyve.pool.v1beta1.EventUpgradeReadinessSignaled")
+	proto.RegisterType((*EventRuntimeUpgradeActivated)(nil), "kyve.pool.v1beta1.EventRuntimeUpgradeActivated")
+	proto.RegisterType((*EventRuntimeUpgradeAborted)(nil), "kyve.pool.v1beta1.EventRuntimeUpgradeAborted")
+	proto.RegisterType((*EventPoolUpdated)(nil), "kyve.pool.v1beta1.EventPoolUpdated")
+	proto.RegisterType((*EventPoolFundsSlashed)(nil), "kyve.pool.v1beta1.EventPoolFundsSlashed")
+}
+
+func init() { proto.RegisterFile("kyve/pool/v1beta1/events.proto", fileDescriptor_c1828a100d789238) }
+
+var fileDescriptor_c1828a100d789238 = []byte{
+	// 1323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4b, 0x8f, 0x13, 0xc7,
+	0x16, 0x9e, 0xb6, 0x3d, 0x0f, 0x97, 0x99, 0xb1, 0x5d, 0x0c, 0xd0, 0x0c, 0x60, 0xe6, 0x1a, 0xc1,
+	0x1d, 0x2e, 0xba, 0xb6, 0xe0, 0xee, 0xaf, 0x32, 0x0f, 0x90, 0x46, 0x44, 0x11, 0x69, 0x03, 0x11,
+	0xd9, 0xb4, 0xca, 0x5d, 0x35, 0xed, 0xd2, 0x74, 0x57, 0xb5, 0xaa, 0xca, 0xf6, 0x98, 0x7d, 0xf6,
+	0x91, 0xf2, 0x13, 0xa2, 0x28, 0x9b, 0xe4, 0x3f, 0x64, 0xc9, 0x92, 0x65, 0x94, 0x05, 0x8a, 0xe0,
+	0x1f, 0x24, 0x7f, 0x20, 0xaa, 0x47, 0x7b, 0x7a, 0x66, 0x1a, 0x18, 0x89, 0x4d, 0x94, 0x9d, 0xcf,
+	0xb3, 0xcf, 0xe3, 0x3b, 0xa7, 0x8e, 0x41, 0xe7, 0x70, 0x36, 0x21, 0xfd, 0x8c, 0xf3, 0xa4, 0x3f,
+	0xb9, 0x3f, 0x24, 0x0a, 0xdd, 0xef, 0x93, 0x09, 0x61, 0x4a, 0xf6, 0x32, 0xc1, 0x15, 0x87, 0x6d,
+	0x2d, 0xef, 0x69, 0x79, 0xcf, 0xc9, 0x37, 0xd6, 0x63, 0x1e, 0x73, 0x23, 0xed, 0xeb, 0x5f, 0x56,
+	0x71, 0xa3, 0xc4, 0x51, 0x86, 0x04, 0x4a, 0x9d, 0xa3, 0x8d, 0xeb, 0x25, 0x72, 0xed, 0xd5, 0x48,
+	0xbb, 0x3f, 0x7b, 0xa0, 0xfd, 0x50, 0x7f, 0xf7, 0x59, 0x86, 0x91, 0x22, 0x4f, 0x8c, 0x25, 0xfc,
+	0x3f, 0x00, 0x3c, 0xc1, 0xa1, 0xf5, 0xe3, 0x7b, 0x9b, 0xde, 0x56, 0xe3, 0xc1, 0xd5, 0xde, 0x99,
+	0x88, 0x7a, 0x56, 0x7d, 0xa7, 0xf6, 0xea, 0xcd, 0xcd, 0x85, 0xa0, 0xce, 0x13, 0x7c, 0x6c, 0xcf,
+	0xc8, 0x34, 0xb7, 0xaf, 0x9c, 0xd3, 0x9e, 0x91, 0xa9, 0xb3, 0xf7, 0xc1, 0x72, 0x86, 0x66, 0x09,
+	0x47, 0xd8, 0xaf, 0x6e, 0x7a, 0x5b, 0xf5, 0x20, 0x27, 0xbb, 0xdf, 0x2c, 0x82, 0xa6, 0x89, 0x77,
+	0x57, 0x10, 0x1d, 0x2f, 0xe7, 0x09, 0x5c, 0x03, 0x15, 0x8a, 0x4d, 0x94, 0xb5, 0xa0, 0x42, 0x31,
+	0x84, 0xa0, 0xc6, 0x50, 0x4a, 0xcc, 0x77, 0xeb, 0x81, 0xf9, 0xad, 0x3d, 0x8a, 0x31, 0x53, 0x34,
+	0x25, 0xb9, 0x47, 0x47, 0x6a, 0xed, 0x84, 0xc7, 0xdc, 0xaf, 0x59, 0x6d, 0xfd, 0x1b, 0x5e, 0x06,
+	0x4b, 0x11, 0x67, 0x07, 0x34, 0xf6, 0x17, 0x0d, 0xd7, 0x51, 0xf0, 0x1a, 0xa8, 0x4b, 0x85, 0x84,
+	0x0a, 0x0f, 0xc9, 0xcc, 0x5f, 0x32, 0xa2, 0x15, 0xc3, 0x78, 0x4c, 0x66, 0xf0, 0xdf, 0xa0, 0x39,
+	0xce, 0x74, 0x90, 0x21, 0x65, 0x8a, 0x88, 0x09, 0x4a, 0xfc, 0x65, 0x13, 0xd3, 0x9a, 0x65, 0xef,
+	0x3b, 0x2e, 0x7c, 0x01, 0x2e, 0x53, 0x76, 0x90, 0x20, 0x45, 0x39, 0x0b, 0xe5, 0x08, 0x09, 0x12,
+	0x4e, 0x09, 0x8d, 0x47, 0xca, 0x5f, 0xd1, 0x2e, 0x77, 0x6e, 0xe9, 0x72, 0xfc, 0xf6, 0xe6, 0xe6,
+	0xb5, 0x88, 0xcb, 0x94, 0x4b, 0x89, 0x0f, 0x7b, 0x94, 0xf7, 0x53, 0xa4, 0x46, 0xbd, 0xcf, 0x49,
+	0x8c, 0xa2, 0xd9, 0x1e, 0x89, 0x82, 0xf5, 0xb9, 0x8b, 0x81, 0xf6, 0xf0, 0x95, 0x71, 0x00, 0x6f,
+	0x83, 0xb5, 0x94, 0xb2, 0x10, 0x93, 0x84, 0xc4, 0x46, 0xe8, 0xd7, 0x4d, 0x08, 0xab, 0x29, 0x65,
+	0x7b, 0x73, 0x26, 0xbc, 0x03, 0x9a, 0x29, 0x3a, 0x0a, 0x87, 0x63, 0x86, 0x13, 0x12, 0x4a, 0xfa,
+	0x92, 0xf8, 0xc0, 0xe9, 0xa1, 0xa3, 0x1d, 0xc3, 0x1d, 0xd0, 0x97, 0xa6, 0x6a, 0x13, 0x22, 0xa4,
+	0xf6, 0xd3, 0xb0, 0x55, 0x73, 0x24, 0xdc, 0x00, 0x2b, 0x43, 0xca, 0x90, 0xa0, 0x44, 0xfa, 0x17,
+	0x6c, 0x21, 0x72, 0x1a, 0xf6, 0xc0, 0x45, 0xa9, 0xb8, 0x40, 0x31, 0x09, 0x33, 0xc1, 0x27, 0x14,
+	0x13, 0x11, 0x52, 0xec, 0xaf, 0x6e, 0x7a, 0x5b, 0xab, 0x41, 0xdb, 0x89, 0x9e, 0x38, 0xc9, 0x3e,
+	0xd6, 0x41, 0x47, 0x3c, 0xcd, 0x04, 0x91, 0xda, 0xb5, 0x56, 0x5d, 0x33, 0xaa, 0xab, 0x05, 0xee,
+	0x3e, 0x86, 0x57, 0xc0, 0x32, 0x61, 0xd8, 0x94, 0xbe, 0x69, 0xbb, 0x42, 0x18, 0xd6, 0x85, 0xff,
+	0x12, 0xb4, 0x5c, 0x33, 0xc3, 0x79, 0x4c, 0xad, 0xcd, 0xea, 0x56, 0xe3, 0xc1, 0x66, 0x09, 0xe6,
+	0x02, 0xab, 0xba, 0xa3, 0x35, 0x67, 0x0e, 0x7a, 0x4d, 0x51, 0x60, 0xea, 0x14, 0x6e, 0x82, 0x86,
+	0x2e, 0x90, 0x54, 0xe8, 0x90, 0x08, 0xe9, 0xb7, 0x4d, 0x71, 0x40, 0x8a, 0x8e, 0x06, 0x96, 0xd3,
+	0xed, 0x82, 0x96, 0x81, 0xa1, 0x06, 0xe0, 0x43, 0x86, 0x86, 0x09, 0xc1, 0xa7, 0x71, 0xd8, 0xbd,
+	0x05, 0xda, 0x73, 0x9d, 0x3d, 0x2a, 0xcb, 0x95, 0x5e, 0x7b, 0xa0, 0x39, 0xd7, 0x7a, 0xc4, 0xc5,
+	0xe1, 0x59, 0x1d, 0x5d, 0xa0, 0x4c, 0x10, 0x4c, 0x22, 0x22, 0x25, 0x37, 0xb5, 0xac, 0xd8, 0x6e,
+	0x15, 0xb8, 0xfb, 0x18, 0xae, 0x83, 0x45, 0xca, 0x30, 0x39, 0x32, 0x08, 0xaf, 0x05, 0x96, 0x80,
+	0x2d, 0x50, 0xd5, 0x25, 0xb3, 0xf0, 0xd6, 0x3f, 0xe1, 0x7d, 0xb0, 0x9e, 0xd2, 0x58, 0x20, 0x45,
+	0x70, 0x38, 0x41, 0x09, 0x8a, 0x22, 0x3e, 0x66, 0x4a, 0x1a, 0xac, 0xd7, 0x82, 0x8b, 0xb9, 0xec,
+	0xf9, 0xb1, 0x08, 0xde, 0x03, 0xed, 0xb9, 0xc9, 0xc1, 0x98, 0x61, 0xca, 0x62, 0x69, 0x06, 0xa0,
+	0x16, 0xb4, 0x72, 0xc1, 0x23, 0xc7, 0xef, 0x7e, 0xef, 0x15, 0x8a, 0x13, 0x10, 0x45, 0x45, 0x49,
+	0x4e, 0xf7, 0x40, 0x5b, 0x10, 0xed, 0xaa, 0xe8, 0xd1, 0xa6, 0xd5, 0xca, 0x05, 0xb9, 0x47, 0xd8,
+	0x07, 0x17, 0x05, 0x49, 0xf9, 0xe4, 0x54, 0xc0, 0x36, 0x4f, 0xe8, 0x44, 0xc5, 0x78, 0xff, 0x05,
+	0x2e, 0xc8, 0x29, 0xc9, 0x54, 0x88, 0x52, 0xcd, 0x30, 0xd9, 0xd7, 0x82, 0x86, 0xe1, 0x6d, 0x1b,
+	0x56, 0xf7, 0x0f, 0x0f, 0xdc, 0x31, 0x51, 0xee, 0x97, 0x0c, 0x52, 0x80, 0xd2, 0x6c, 0x10, 0x8d,
+	0x08, 0x1e, 0x97, 0xf4, 0x0c, 0xee, 0x81, 0xc6, 0x81, 0xe0, 0x69, 0x3e, 0xb5, 0x95, 0xf3, 0x4f,
+	0x2d, 0xd0, 0x76, 0x6e, 0x56, 0x3f, 0x03, 0x75, 0xc5, 0x73, 0x1f, 0xd5, 0xf3, 0xfb, 0x58, 0x51,
+	0xdc, 0x79, 0xb8, 0x0a, 0xec, 0xf6, 0x09, 0x51, 0x9e, 0xe1, 0xb2, 0xa1, 0xb7, 0x15, 0xbc, 0x04,
+	0xf4, 0x74, 0x68, 0x81, 0xed, 0xea, 0x22, 0x61, 0x78, 0x5b, 0x75, 0xbf, 0xfb, 0x58, 0xd2, 0xbb,
+	0x3c, 0xcd, 0x12, 0xa2, 0x4a, 0x92, 0x7e, 0xff, 0xd6, 0xaa, 0x7c, 0xe2, 0xd6, 0xea, 0xa2, 0xc2,
+	0x08, 0x3c, 0x41, 0x63, 0x59, 0xf2, 0xf5, 0x1b, 0x00, 0x64, 0x5a, 0x12, 0xea, 0x02, 0x3a, 0x9c,
+	0xd4, 0x0d, 0xe7, 0x91, 0xe0, 0xa9, 0x9e, 0x57, 0x2b, 0xd6, 0x73, 0x9c, 0x38, 0x60, 0x58, 0x8b,
+	0x67, 0x9a, 0x73, 0x62, 0x5e, 0x03, 0x22, 0xc7, 0x69, 0xc9, 0x28, 0xfe, 0x54, 0x05, 0xd7, 0x8d,
+	0x92, 0x5b, 0x11, 0xcf, 0xb2, 0x58, 0x20, 0x4c, 0x8e, 0x71, 0x50, 0x78, 0x44, 0xbc, 0x93, 0x8f,
+	0x48, 0x61, 0x51, 0x56, 0x4e, 0x2e, 0x4a, 0x8d, 0xc4, 0xdc, 0x81, 0x6e, 0x47, 0xd5, 0x21, 0x31,
+	0xe7, 0x6d, 0x2b, 0xbd, 0x4b, 0xf1, 0x58, 0xd8, 0x75, 0x6d, 0xdb, 0x38, 0xa7, 0x4f, 0xec, 0xd9,
+	0xc5, 0x53, 0x7b, 0xf6, 0x36, 0x58, 0x43, 0x07, 0x07, 0x24, 0xd2, 0x43, 0xa9, 0x57, 0x9c, 0x9e,
+	0xc8, 0xaa, 0x5e, 0x0b, 0x39, 0x57, 0x27, 0x2b, 0xe1, 0x53, 0x3d, 0x3c, 0x08, 0x53, 0x46, 0xa4,
+	0x0c, 0xd5, 0x48, 0x10, 0x39, 0xe2, 0x09, 0xf6, 0x97, 0xe7, 0x5d, 0xf3, 0x3e, 0xd6, 0x35, 0x38,
+	0xb7, 0x7f, 0x9a, 0x9b, 0xc3, 0xff, 0x82, 0x63, 0x6e, 0x88, 0x09, 0xc2, 0x09, 0x65, 0xc4, 0x3c,
+	0x60, 0xb5, 0xa0, 0x3d, 0x97, 0xec, 0x39, 0x41, 0xe9, 0x8e, 0xae, 0x7f, 0xd2, 0x8e, 0xee, 0x86,
+	0xa5, 0xdd, 0xda, 0x45, 0x2c, 0x22, 0xc9, 0x87, 0xbb, 0x75, 0xb6, 0x70, 0x95, 0x92, 0xc2, 0x75,
+	0x7f, 0xf1, 0xc0, 0x0d, 0x77, 0x1b, 0x19, 0xd7, 0x41, 0x9e, 0xd5, 0x80, 0xc6, 0x0c, 0xe9, 0x4f,
+	0x5c, 0x01, 0xcb, 0xda, 0x3e, 0x9c, 0xc3, 0x68, 0x49, 0x93, 0xfb, 0x58, 0x1f, 0x10, 0xf6, 0xed,
+	0x70, 0x70, 0x70, 0x54, 0x11, 0x27, 0xd5, 0x93, 0x38, 0xb9, 0x0b, 0x5a, 0xba, 0x6a, 0xb3, 0xe2,
+	0xdb, 0x6d, 0xc1, 0xd0, 0x34, 0xfc, 0xc2, 0xeb, 0x7d, 0x17, 0xb4, 0x14, 0x57, 0x28, 0x29, 0xaa,
+	0xda, 0x29, 0x6f, 0x1a, 0xfe, 0xb1, 0x6a, 0xf7, 0x47, 0xaf, 0xb4, 0x48, 0xdb, 0x91, 0xa2, 0x13,
+	0xa4, 0x3e, 0x94, 0xc1, 0xfb, 0x11, 0x5d, 0x16, 0x69, 0xf5, 0xfc, 0x91, 0xd6, 0xca, 0x23, 0xfd,
+	0xc1, 0x03, 0x1b, 0x65, 0x91, 0x0e, 0xb9, 0xf8, 0x5b, 0xc5, 0xf9, 0x67, 0xb5, 0xb0, 0x49, 0xec,
+	0xd1, 0x7c, 0x76, 0x5b, 0xfd, 0x07, 0xb4, 0x05, 0x9a, 0x86, 0x63, 0x23, 0x0e, 0xa5, 0x12, 0x94,
+	0xc5, 0x2e, 0xbc, 0xa6, 0x40, 0x53, 0x6b, 0x36, 0x30, 0xec, 0xf9, 0xb5, 0x5a, 0x2d, 0xbf, 0x56,
+	0x6b, 0xe5, 0xd7, 0xea, 0x62, 0xe9, 0xb5, 0xba, 0x74, 0xe2, 0x5a, 0xfd, 0x07, 0x1e, 0xa4, 0xef,
+	0x39, 0x2d, 0x1b, 0xe7, 0x3f, 0x2d, 0x2f, 0x94, 0x9d, 0x96, 0xa7, 0xce, 0xbd, 0xd5, 0x33, 0xe7,
+	0xde, 0x10, 0x5c, 0x3a, 0x3e, 0xd2, 0xc6, 0x0c, 0xcb, 0x41, 0x82, 0xe4, 0xe8, 0x23, 0xb8, 0x44,
+	0x18, 0xeb, 0x4f, 0xe4, 0xb8, 0x74, 0xa4, 0x6e, 0x97, 0xbb, 0x4a, 0x2c, 0x1a, 0x1d, 0xb5, 0xb3,
+	0xfb, 0xea, 0x6d, 0xc7, 0x7b, 0xfd, 0xb6, 0xe3, 0xfd, 0xfe, 0xb6, 0xe3, 0x7d, 0xfb, 0xae, 0xb3,
+	0xf0, 0xfa, 0x5d, 0x67, 0xe1, 0xd7, 0x77, 0x9d, 0x85, 0xaf, 0xef, 0xc6, 0x54, 0x8d, 0xc6, 0xc3,
+	0x5e, 0xc4, 0xd3, 0xfe, 0xe3, 0x17, 0xcf, 0x1f, 0x7e, 0x41, 0xd4, 0x94, 0x8b, 0xc3, 0x7e, 0x34,
+	0x42, 0x94, 0xf5, 0x8f, 0xec, 0x9f, 0x3b, 0x35, 0xcb, 0x88, 0x1c, 0x2e, 0x99, 0xbf, 0x75, 0xff,
+	0xfb, 0x6b, 0x00, 0xbb, 0x97, 0x58, 0x05, 0x5f, 0x0e, 0x00, 0x00,
+}
+
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.OldParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.NewParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+	if m.MaxStakers != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxStakers))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for iNdEx := len(m.RuntimeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.CompressionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CompressionId))
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.UploadInterval != 0 {
-		n += 1 + sovEvents(uint64(m.UploadInterval))
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxBundleSize))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = m.InflationShareWeight.Size()
-	n += 1 + l + sovEvents(uint64(l))
 	if m.MinDelegation != 0 {
-		n += 1 + sovEvents(uint64(m.MinDelegation))
+		i = encodeVarintEvents(dAtA, i, uint64(m.MinDelegation))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.MaxBundleSize != 0 {
-		n += 1 + sovEvents(uint64(m.MaxBundleSize))
+	{
+		size := m.InflationShareWeight.Size()
+		i -= size
+		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	if m.UploadInterval != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UploadInterval))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Binaries)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.CompressionId != 0 {
-		n += 1 + sovEvents(uint64(m.CompressionId))
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPoolEnabled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPoolEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPoolDisabled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPoolDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRuntimeUpgradeScheduled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPoolForked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolForked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolForked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MigratedFundings != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MigratedFundings))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MigratedValaccounts != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MigratedValaccounts))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ScheduledAt != 0 {
-		n += 1 + sovEvents(uint64(m.ScheduledAt))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Duration != 0 {
-		n += 1 + sovEvents(uint64(m.Duration))
+	if m.Index != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Binaries)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PredecessorId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PredecessorId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.AffectedPools) > 0 {
-		l = 0
-		for _, e := range m.AffectedPools {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRuntimeUpgradeCancelled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPoolRetired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolRetired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolRetired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.SweptAmount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SweptAmount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.AffectedPools) > 0 {
-		l = 0
-		for _, e := range m.AffectedPools {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
+	if m.RemovedValaccounts != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RemovedValaccounts))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.RefundedFundings != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RefundedFundings))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPoolUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventInflationShareWeightRampScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInflationShareWeightRampScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInflationShareWeightRampScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+	if m.EndAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.RawUpdateString)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.StartAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartAt))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.ToWeight.Size()
+		i -= size
+		if _, err := m.ToWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FromWeight.Size()
+		i -= size
+		if _, err := m.FromWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventInflationShareWeightRampCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.UploadInterval != 0 {
-		n += 1 + sovEvents(uint64(m.UploadInterval))
+	return dAtA[:n], nil
+}
+
+func (m *EventInflationShareWeightRampCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInflationShareWeightRampCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InflationShareWeight.Size()
+		i -= size
+		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.InflationShareWeight.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.MinDelegation != 0 {
-		n += 1 + sovEvents(uint64(m.MinDelegation))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PauseUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PauseUntil))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PauseFrom != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PauseFrom))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolResumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolResumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRuntimeUpgradeScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRuntimeUpgradeScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRuntimeUpgradeScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RuntimeBinaries) > 0 {
+		for iNdEx := len(m.RuntimeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ReadinessDeadline != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReadinessDeadline))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReadinessThreshold != nil {
+		{
+			size := m.ReadinessThreshold.Size()
+			i -= size
+			if _, err := m.ReadinessThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AffectedPools) > 0 {
+		dAtA4 := make([]byte, len(m.AffectedPools)*10)
+		var j3 int
+		for _, num := range m.AffectedPools {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvents(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRuntimeUpgradeCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRuntimeUpgradeCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRuntimeUpgradeCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AffectedPools) > 0 {
+		dAtA6 := make([]byte, len(m.AffectedPools)*10)
+		var j5 int
+		for _, num := range m.AffectedPools {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintEvents(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpgradeReadinessSignaled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpgradeReadinessSignaled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpgradeReadinessSignaled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalDelegation != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalDelegation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadyDelegation != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReadyDelegation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRuntimeUpgradeActivated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRuntimeUpgradeActivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRuntimeUpgradeActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalDelegation != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalDelegation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadyDelegation != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReadyDelegation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRuntimeUpgradeAborted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRuntimeUpgradeAborted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRuntimeUpgradeAborted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalDelegation != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalDelegation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadyDelegation != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReadyDelegation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxStakers != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxStakers))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CompressionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CompressionId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxBundleSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinDelegation != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MinDelegation))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.InflationShareWeight.Size()
+		i -= size
+		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.UploadInterval != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UploadInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RawUpdateString) > 0 {
+		i -= len(m.RawUpdateString)
+		copy(dAtA[i:], m.RawUpdateString)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RawUpdateString)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolFundsSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolFundsSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolFundsSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.NewParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.UploadInterval != 0 {
+		n += 1 + sovEvents(uint64(m.UploadInterval))
+	}
+	l = m.InflationShareWeight.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.MinDelegation != 0 {
+		n += 1 + sovEvents(uint64(m.MinDelegation))
+	}
+	if m.MaxBundleSize != 0 {
+		n += 1 + sovEvents(uint64(m.MaxBundleSize))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.CompressionId != 0 {
+		n += 1 + sovEvents(uint64(m.CompressionId))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for _, e := range m.RuntimeBinaries {
+			l = e.Size()
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.MaxStakers != 0 {
+		n += 2 + sovEvents(uint64(m.MaxStakers))
+	}
+	return n
+}
+
+func (m *EventPoolEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EventPoolDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EventPoolForked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PredecessorId != 0 {
+		n += 1 + sovEvents(uint64(m.PredecessorId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEvents(uint64(m.Index))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MigratedValaccounts != 0 {
+		n += 1 + sovEvents(uint64(m.MigratedValaccounts))
+	}
+	if m.MigratedFundings != 0 {
+		n += 1 + sovEvents(uint64(m.MigratedFundings))
+	}
+	return n
+}
+
+func (m *EventPoolRetired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.RefundedFundings != 0 {
+		n += 1 + sovEvents(uint64(m.RefundedFundings))
+	}
+	if m.RemovedValaccounts != 0 {
+		n += 1 + sovEvents(uint64(m.RemovedValaccounts))
+	}
+	if m.SweptAmount != 0 {
+		n += 1 + sovEvents(uint64(m.SweptAmount))
+	}
+	return n
+}
+
+func (m *EventInflationShareWeightRampScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = m.FromWeight.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ToWeight.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.StartAt != 0 {
+		n += 1 + sovEvents(uint64(m.StartAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovEvents(uint64(m.EndAt))
+	}
+	return n
+}
+
+func (m *EventInflationShareWeightRampCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = m.InflationShareWeight.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPoolPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PauseFrom != 0 {
+		n += 1 + sovEvents(uint64(m.PauseFrom))
+	}
+	if m.PauseUntil != 0 {
+		n += 1 + sovEvents(uint64(m.PauseUntil))
+	}
+	return n
+}
+
+func (m *EventPoolResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EventRuntimeUpgradeScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovEvents(uint64(m.ScheduledAt))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.AffectedPools) > 0 {
+		l = 0
+		for _, e := range m.AffectedPools {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.ReadinessThreshold != nil {
+		l = m.ReadinessThreshold.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReadinessDeadline != 0 {
+		n += 1 + sovEvents(uint64(m.ReadinessDeadline))
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for _, e := range m.RuntimeBinaries {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRuntimeUpgradeCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.AffectedPools) > 0 {
+		l = 0
+		for _, e := range m.AffectedPools {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventUpgradeReadinessSignaled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReadyDelegation != 0 {
+		n += 1 + sovEvents(uint64(m.ReadyDelegation))
+	}
+	if m.TotalDelegation != 0 {
+		n += 1 + sovEvents(uint64(m.TotalDelegation))
+	}
+	return n
+}
+
+func (m *EventRuntimeUpgradeActivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReadyDelegation != 0 {
+		n += 1 + sovEvents(uint64(m.ReadyDelegation))
+	}
+	if m.TotalDelegation != 0 {
+		n += 1 + sovEvents(uint64(m.TotalDelegation))
+	}
+	return n
+}
+
+func (m *EventRuntimeUpgradeAborted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReadyDelegation != 0 {
+		n += 1 + sovEvents(uint64(m.ReadyDelegation))
+	}
+	if m.TotalDelegation != 0 {
+		n += 1 + sovEvents(uint64(m.TotalDelegation))
+	}
+	return n
+}
+
+func (m *EventPoolUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.RawUpdateString)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.UploadInterval != 0 {
+		n += 1 + sovEvents(uint64(m.UploadInterval))
+	}
+	l = m.InflationShareWeight.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.MinDelegation != 0 {
+		n += 1 + sovEvents(uint64(m.MinDelegation))
+	}
+	if m.MaxBundleSize != 0 {
+		n += 1 + sovEvents(uint64(m.MaxBundleSize))
+	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.CompressionId != 0 {
+		n += 1 + sovEvents(uint64(m.CompressionId))
+	}
+	if m.MaxStakers != 0 {
+		n += 1 + sovEvents(uint64(m.MaxStakers))
+	}
+	return n
+}
+
+func (m *EventPoolFundsSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationShareWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationShareWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			m.MinDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionId", wireType)
+			}
+			m.CompressionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeBinaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeBinaries = append(m.RuntimeBinaries, RuntimeBinary{})
+			if err := m.RuntimeBinaries[len(m.RuntimeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakers", wireType)
+			}
+			m.MaxStakers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.MaxBundleSize != 0 {
-		n += 1 + sovEvents(uint64(m.MaxBundleSize))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	return nil
+}
+func (m *EventPoolForked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolForked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolForked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorId", wireType)
+			}
+			m.PredecessorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PredecessorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedValaccounts", wireType)
+			}
+			m.MigratedValaccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedValaccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedFundings", wireType)
+			}
+			m.MigratedFundings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedFundings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.CompressionId != 0 {
-		n += 1 + sovEvents(uint64(m.CompressionId))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolRetired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolRetired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolRetired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedFundings", wireType)
+			}
+			m.RefundedFundings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundedFundings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedValaccounts", wireType)
+			}
+			m.RemovedValaccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovedValaccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweptAmount", wireType)
+			}
+			m.SweptAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SweptAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventPoolFundsSlashed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *EventInflationShareWeightRampScheduled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1535,17 +4031,17 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventInflationShareWeightRampScheduled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventInflationShareWeightRampScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1555,30 +4051,16 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OldParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1588,28 +4070,29 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FromWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1637,8 +4120,48 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = string(dAtA[iNdEx:postIndex])
+			if err := m.ToWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			}
+			m.StartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			m.EndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1660,7 +4183,7 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
+func (m *EventInflationShareWeightRampCompleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1683,10 +4206,10 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventInflationShareWeightRampCompleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventInflationShareWeightRampCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1710,7 +4233,7 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationShareWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1738,13 +4261,103 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.InflationShareWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseFrom", wireType)
+			}
+			m.PauseFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseFrom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseUntil", wireType)
 			}
-			var stringLen uint64
+			m.PauseUntil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1754,29 +4367,66 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PauseUntil |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolResumed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolResumed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolResumed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1786,59 +4436,64 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRuntimeUpgradeScheduled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRuntimeUpgradeScheduled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRuntimeUpgradeScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1866,30 +4521,11 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = string(dAtA[iNdEx:postIndex])
+			m.Runtime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
-			}
-			m.UploadInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UploadInterval |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationShareWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1917,15 +4553,13 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationShareWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
 			}
-			m.MinDelegation = 0
+			m.ScheduledAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1935,16 +4569,16 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinDelegation |= uint64(b&0x7F) << shift
+				m.ScheduledAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			m.MaxBundleSize = 0
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1954,14 +4588,14 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				m.Duration |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1989,11 +4623,87 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Binaries = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AffectedPools = append(m.AffectedPools, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AffectedPools) == 0 {
+					m.AffectedPools = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AffectedPools = append(m.AffectedPools, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffectedPools", wireType)
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2019,34 +4729,19 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
-			}
-			m.StorageProviderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return io.ErrUnexpectedEOF
 			}
-		case 14:
+			var v cosmossdk_io_math.LegacyDec
+			m.ReadinessThreshold = &v
+			if err := m.ReadinessThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessDeadline", wireType)
 			}
-			m.CompressionId = 0
+			m.ReadinessDeadline = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2056,16 +4751,16 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompressionId |= uint32(b&0x7F) << shift
+				m.ReadinessDeadline |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeBinaries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2075,23 +4770,25 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndKey = string(dAtA[iNdEx:postIndex])
+			m.RuntimeBinaries = append(m.RuntimeBinaries, RuntimeBinary{})
+			if err := m.RuntimeBinaries[len(m.RuntimeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2114,7 +4811,7 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPoolEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventRuntimeUpgradeCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2137,17 +4834,17 @@ func (m *EventPoolEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPoolEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRuntimeUpgradeCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPoolEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRuntimeUpgradeCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2157,11 +4854,100 @@ func (m *EventPoolEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AffectedPools = append(m.AffectedPools, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AffectedPools) == 0 {
+					m.AffectedPools = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AffectedPools = append(m.AffectedPools, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffectedPools", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2183,7 +4969,7 @@ func (m *EventPoolEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPoolDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventUpgradeReadinessSignaled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2206,17 +4992,17 @@ func (m *EventPoolDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPoolDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpgradeReadinessSignaled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPoolDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpgradeReadinessSignaled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2226,7 +5012,109 @@ func (m *EventPoolDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyDelegation", wireType)
+			}
+			m.ReadyDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			}
+			m.TotalDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDelegation |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2252,7 +5140,7 @@ func (m *EventPoolDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRuntimeUpgradeScheduled) Unmarshal(dAtA []byte) error {
+func (m *EventRuntimeUpgradeActivated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2275,17 +5163,17 @@ func (m *EventRuntimeUpgradeScheduled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRuntimeUpgradeScheduled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRuntimeUpgradeActivated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRuntimeUpgradeScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRuntimeUpgradeActivated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2293,26 +5181,13 @@ func (m *EventRuntimeUpgradeScheduled) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
@@ -2347,9 +5222,9 @@ func (m *EventRuntimeUpgradeScheduled) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyDelegation", wireType)
 			}
-			m.ScheduledAt = 0
+			m.ReadyDelegation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2359,35 +5234,16 @@ func (m *EventRuntimeUpgradeScheduled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledAt |= uint64(b&0x7F) << shift
+				m.ReadyDelegation |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			m.Duration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
 			}
-			var stringLen uint64
+			m.TotalDelegation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2397,100 +5253,11 @@ func (m *EventRuntimeUpgradeScheduled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalDelegation |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AffectedPools = append(m.AffectedPools, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.AffectedPools) == 0 {
-					m.AffectedPools = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AffectedPools = append(m.AffectedPools, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AffectedPools", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2512,7 +5279,7 @@ func (m *EventRuntimeUpgradeScheduled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRuntimeUpgradeCancelled) Unmarshal(dAtA []byte) error {
+func (m *EventRuntimeUpgradeAborted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,15 +5302,34 @@ func (m *EventRuntimeUpgradeCancelled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRuntimeUpgradeCancelled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRuntimeUpgradeAborted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRuntimeUpgradeCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRuntimeUpgradeAborted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2571,83 +5357,45 @@ func (m *EventRuntimeUpgradeCancelled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyDelegation", wireType)
+			}
+			m.ReadyDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				m.AffectedPools = append(m.AffectedPools, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			}
+			m.TotalDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.AffectedPools) == 0 {
-					m.AffectedPools = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AffectedPools = append(m.AffectedPools, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AffectedPools", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -3007,6 +5755,25 @@ func (m *EventPoolUpdated) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakers", wireType)
+			}
+			m.MaxStakers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -26,8 +26,24 @@ type UpgradeKeeper interface {
 type StakersKeeper interface {
 	LeavePool(ctx sdk.Context, staker string, poolId uint64)
 	GetAllStakerAddressesOfPool(ctx sdk.Context, poolId uint64) (stakers []string)
+	AssertValaccountAuthorized(ctx sdk.Context, poolId uint64, stakerAddress string, valaddress string) error
+	MigrateValaccounts(ctx sdk.Context, fromPoolId uint64, toPoolId uint64) (migrated uint64)
+	RemoveAllValaccountsOfPool(ctx sdk.Context, poolId uint64) (removed uint64)
+	EnforceMaxStakers(ctx sdk.Context, poolId uint64, maxStakers uint64) (evicted uint64)
+}
+
+type DelegationKeeper interface {
+	GetDelegationAmount(ctx sdk.Context, staker string) uint64
+	GetDelegationOfPool(ctx sdk.Context, poolId uint64) uint64
 }
 
 type FundersKeeper interface {
 	CreateFundingState(ctx sdk.Context, poolId uint64)
+	MigrateFundings(ctx sdk.Context, fromPoolId uint64, toPoolId uint64) (migrated uint64)
+	RemoveFundingsOfPool(ctx sdk.Context, poolId uint64) (refunded uint64, err error)
+}
+
+type BundlesKeeper interface {
+	RemoveBundleProposal(ctx sdk.Context, poolId uint64)
+	RemoveRoundRobinProgress(ctx sdk.Context, poolId uint64)
 }
@@ -28,5 +28,52 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Check for duplicated index in PoolConfigChangeList
+	poolConfigChangeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PoolConfigChangeList {
+		index := string(PoolConfigChangeKeyPrefix(elem.PoolId, elem.Index))
+		if _, ok := poolConfigChangeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated pool config change %v", elem)
+		}
+		poolConfigChangeIndexMap[index] = struct{}{}
+		if elem.PoolId >= gs.PoolCount {
+			return fmt.Errorf("pool config change refers to non-existing pool %v", elem)
+		}
+	}
+
+	// Check for duplicated index in UpgradeReadinessList
+	upgradeReadinessIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.UpgradeReadinessList {
+		index := string(UpgradeReadinessKeyPrefix(elem.PoolId, elem.Staker))
+		if _, ok := upgradeReadinessIndexMap[index]; ok {
+			return fmt.Errorf("duplicated upgrade readiness %v", elem)
+		}
+		upgradeReadinessIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in FunderPayoutsList
+	funderPayoutsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FunderPayoutsList {
+		index := string(FunderPayoutsKeyPrefix(elem.PoolId))
+		if _, ok := funderPayoutsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated funder payouts %v", elem)
+		}
+		funderPayoutsIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in InflationLedgerList
+	inflationLedgerIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.InflationLedgerList {
+		index := string(InflationLedgerKeyPrefix(elem.PoolId))
+		if _, ok := inflationLedgerIndexMap[index]; ok {
+			return fmt.Errorf("duplicated inflation ledger %v", elem)
+		}
+		inflationLedgerIndexMap[index] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -31,6 +31,14 @@ type GenesisState struct {
 	PoolList []Pool `protobuf:"bytes,2,rep,name=pool_list,json=poolList,proto3" json:"pool_list"`
 	// pool_count ...
 	PoolCount uint64 `protobuf:"varint,3,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
+	// pool_config_change_list ...
+	PoolConfigChangeList []PoolConfigChange `protobuf:"bytes,4,rep,name=pool_config_change_list,json=poolConfigChangeList,proto3" json:"pool_config_change_list"`
+	// upgrade_readiness_list ...
+	UpgradeReadinessList []UpgradeReadiness `protobuf:"bytes,5,rep,name=upgrade_readiness_list,json=upgradeReadinessList,proto3" json:"upgrade_readiness_list"`
+	// funder_payouts_list ...
+	FunderPayoutsList []FunderPayouts `protobuf:"bytes,6,rep,name=funder_payouts_list,json=funderPayoutsList,proto3" json:"funder_payouts_list"`
+	// inflation_ledger_list ...
+	InflationLedgerList []InflationLedger `protobuf:"bytes,7,rep,name=inflation_ledger_list,json=inflationLedgerList,proto3" json:"inflation_ledger_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +95,34 @@ func (m *GenesisState) GetPoolCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPoolConfigChangeList() []PoolConfigChange {
+	if m != nil {
+		return m.PoolConfigChangeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUpgradeReadinessList() []UpgradeReadiness {
+	if m != nil {
+		return m.UpgradeReadinessList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFunderPayoutsList() []FunderPayouts {
+	if m != nil {
+		return m.FunderPayoutsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInflationLedgerList() []InflationLedger {
+	if m != nil {
+		return m.InflationLedgerList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.pool.v1beta1.GenesisState")
 }
@@ -94,24 +130,33 @@ func init() {
 func init() { proto.RegisterFile("kyve/pool/v1beta1/genesis.proto", fileDescriptor_ba827ab14a3de899) }
 
 var fileDescriptor_ba827ab14a3de899 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xae, 0x2c, 0x4b,
-	0xd5, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x92, 0x92, 0xc1,
-	0x22, 0x0f, 0x32, 0x15, 0x2c, 0xab, 0xb4, 0x88, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x71, 0x70, 0x49,
-	0x62, 0x49, 0xaa, 0x90, 0x39, 0x17, 0x1b, 0x44, 0xbb, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91,
-	0xa4, 0x1e, 0x86, 0x43, 0xf4, 0x02, 0xc0, 0x0a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82,
-	0x2a, 0x17, 0xb2, 0xe2, 0xe2, 0x04, 0x29, 0x8a, 0xcf, 0xc9, 0x2c, 0x2e, 0x91, 0x60, 0x52, 0x60,
-	0xd6, 0xe0, 0x36, 0x12, 0xc7, 0xa6, 0x37, 0x3f, 0x3f, 0x07, 0xaa, 0x93, 0x03, 0x24, 0xe1, 0x93,
-	0x59, 0x5c, 0x22, 0x24, 0xcb, 0xc5, 0x05, 0xd6, 0x9b, 0x9c, 0x5f, 0x9a, 0x57, 0x22, 0xc1, 0xac,
-	0xc0, 0xa8, 0xc1, 0x12, 0x04, 0x36, 0xcd, 0x19, 0x24, 0xe0, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3,
-	0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9,
-	0xb9, 0xfa, 0xde, 0x91, 0x61, 0xae, 0x7e, 0xa9, 0x25, 0xe5, 0xf9, 0x45, 0xd9, 0xfa, 0xc9, 0x19,
-	0x89, 0x99, 0x79, 0xfa, 0x15, 0x10, 0x6f, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x3d,
-	0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x79, 0x5b, 0xc5, 0x7a, 0x01, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x18, 0x86, 0x13, 0x37, 0x56, 0x9d, 0xf5, 0xb2, 0xd9, 0xd5, 0xad, 0x45, 0xb3, 0x61, 0xbd, 0xd4,
+	0x4b, 0xc2, 0xd6, 0x83, 0xe0, 0xb1, 0x41, 0x45, 0x2c, 0x52, 0x2a, 0x16, 0x14, 0x21, 0x4e, 0x93,
+	0xc9, 0x74, 0x68, 0x3a, 0x13, 0x92, 0x49, 0xb5, 0xff, 0xc2, 0xbb, 0x7f, 0xa8, 0xc7, 0x1e, 0x3d,
+	0x89, 0xb4, 0x7f, 0x44, 0xe6, 0x9b, 0x29, 0x68, 0x9b, 0xde, 0xc2, 0xf7, 0x3e, 0xef, 0xf3, 0xe6,
+	0x30, 0xe8, 0x6a, 0xb6, 0x5c, 0x90, 0xb0, 0x10, 0x22, 0x0f, 0x17, 0x37, 0x13, 0x22, 0xf1, 0x4d,
+	0x48, 0x09, 0x27, 0x15, 0xab, 0x82, 0xa2, 0x14, 0x52, 0xb8, 0x67, 0x0a, 0x08, 0x14, 0x10, 0x18,
+	0xa0, 0x73, 0x41, 0x05, 0x15, 0x90, 0x86, 0xea, 0x4b, 0x83, 0x1d, 0xef, 0xd0, 0x54, 0xe0, 0x12,
+	0xcf, 0x8d, 0xa8, 0xf3, 0xb8, 0x21, 0x57, 0x56, 0x48, 0xaf, 0x7f, 0x3a, 0xe8, 0xfe, 0x1b, 0x3d,
+	0xfc, 0x41, 0x62, 0x49, 0xdc, 0x17, 0xa8, 0xa5, 0xeb, 0x6d, 0xdb, 0xb7, 0xbb, 0xa7, 0xbd, 0x47,
+	0xc1, 0xc1, 0x8f, 0x04, 0x43, 0x00, 0xfa, 0xce, 0xea, 0xf7, 0x95, 0x35, 0x32, 0xb8, 0xfb, 0x12,
+	0xdd, 0x53, 0x50, 0x9c, 0xb3, 0x4a, 0xb6, 0x6f, 0xf9, 0x27, 0xdd, 0xd3, 0xde, 0x65, 0x53, 0x57,
+	0x88, 0xdc, 0x34, 0xef, 0xaa, 0x60, 0xc0, 0x2a, 0xe9, 0x3e, 0x41, 0x08, 0xba, 0x89, 0xa8, 0xb9,
+	0x6c, 0x9f, 0xf8, 0x76, 0xd7, 0x19, 0x81, 0x2d, 0x52, 0x07, 0xf7, 0x2b, 0xba, 0x34, 0x31, 0xcf,
+	0x18, 0x8d, 0x93, 0x29, 0xe6, 0x94, 0xe8, 0x21, 0x07, 0x86, 0x9e, 0x1e, 0x19, 0x8a, 0xa0, 0x10,
+	0x01, 0x6f, 0x46, 0x2f, 0x8a, 0xbd, 0x3b, 0xfc, 0x40, 0x8c, 0x1e, 0xd6, 0x05, 0x2d, 0x71, 0x4a,
+	0xe2, 0x92, 0xe0, 0x94, 0x71, 0x52, 0x55, 0x7a, 0xe0, 0xf6, 0xd1, 0x81, 0x8f, 0xba, 0x30, 0xda,
+	0xf1, 0xbb, 0x81, 0x7a, 0xef, 0x0e, 0x03, 0x63, 0x74, 0x9e, 0xd5, 0x3c, 0x25, 0x65, 0x5c, 0xe0,
+	0xa5, 0xa8, 0xa5, 0xb1, 0xb7, 0xc0, 0xee, 0x37, 0xd8, 0x5f, 0x03, 0x3d, 0xd4, 0xb0, 0x51, 0x9f,
+	0x65, 0xff, 0x1e, 0xc1, 0xfb, 0x05, 0x3d, 0x60, 0x3c, 0xcb, 0xb1, 0x64, 0x82, 0xc7, 0x39, 0x49,
+	0x29, 0x29, 0xb5, 0xf9, 0x0e, 0x98, 0xaf, 0x1b, 0xcc, 0x6f, 0x77, 0xfc, 0x00, 0x70, 0xe3, 0x3e,
+	0x67, 0xff, 0x9f, 0x95, 0xbd, 0x1f, 0xad, 0x36, 0x9e, 0xbd, 0xde, 0x78, 0xf6, 0x9f, 0x8d, 0x67,
+	0xff, 0xd8, 0x7a, 0xd6, 0x7a, 0xeb, 0x59, 0xbf, 0xb6, 0x9e, 0xf5, 0xf9, 0x19, 0x65, 0x72, 0x5a,
+	0x4f, 0x82, 0x44, 0xcc, 0xc3, 0x77, 0x9f, 0xc6, 0xaf, 0xde, 0x13, 0xf9, 0x4d, 0x94, 0xb3, 0x30,
+	0x99, 0x62, 0xc6, 0xc3, 0xef, 0xfa, 0xbd, 0xc9, 0x65, 0x41, 0xaa, 0x49, 0x0b, 0x5e, 0xda, 0xf3,
+	0xbf, 0x03, 0x00, 0x13, 0x4f, 0x01, 0x48, 0xf3, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +179,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InflationLedgerList) > 0 {
+		for iNdEx := len(m.InflationLedgerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationLedgerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FunderPayoutsList) > 0 {
+		for iNdEx := len(m.FunderPayoutsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunderPayoutsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UpgradeReadinessList) > 0 {
+		for iNdEx := len(m.UpgradeReadinessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeReadinessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PoolConfigChangeList) > 0 {
+		for iNdEx := len(m.PoolConfigChangeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolConfigChangeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.PoolCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolCount))
 		i--
@@ -194,6 +295,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.PoolCount != 0 {
 		n += 1 + sovGenesis(uint64(m.PoolCount))
 	}
+	if len(m.PoolConfigChangeList) > 0 {
+		for _, e := range m.PoolConfigChangeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UpgradeReadinessList) > 0 {
+		for _, e := range m.UpgradeReadinessList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FunderPayoutsList) > 0 {
+		for _, e := range m.FunderPayoutsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InflationLedgerList) > 0 {
+		for _, e := range m.InflationLedgerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -318,6 +443,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolConfigChangeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolConfigChangeList = append(m.PoolConfigChangeList, PoolConfigChange{})
+			if err := m.PoolConfigChangeList[len(m.PoolConfigChangeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeReadinessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeReadinessList = append(m.UpgradeReadinessList, UpgradeReadiness{})
+			if err := m.UpgradeReadinessList[len(m.UpgradeReadinessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderPayoutsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderPayoutsList = append(m.FunderPayoutsList, FunderPayouts{})
+			if err := m.FunderPayoutsList[len(m.FunderPayoutsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationLedgerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationLedgerList = append(m.InflationLedgerList, InflationLedger{})
+			if err := m.InflationLedgerList[len(m.InflationLedgerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -27,8 +27,40 @@ var (
 
 	// PoolCountKey is the prefix for the pool counter defined in pool.proto
 	PoolCountKey = []byte{2}
+
+	// PoolConfigChangeKey is the prefix for the config change history of all pools
+	// key -> PoolConfigChangeKey | <poolId> | <index>
+	PoolConfigChangeKey = []byte{3}
+
+	// UpgradeReadinessKey is the prefix for all readiness signals of stakers for scheduled upgrades
+	// key -> UpgradeReadinessKey | <poolId> | <staker>
+	UpgradeReadinessKey = []byte{4}
+
+	// FunderPayoutsKey is the prefix for the recent funder payouts of all pools
+	// key -> FunderPayoutsKey | <poolId>
+	FunderPayoutsKey = []byte{5}
+
+	// InflationLedgerKey is the prefix for the received protocol inflation of all pools
+	// key -> InflationLedgerKey | <poolId>
+	InflationLedgerKey = []byte{6}
 )
 
 func PoolKeyPrefix(poolId uint64) []byte {
 	return util.GetByteKey(poolId)
 }
+
+func PoolConfigChangeKeyPrefix(poolId uint64, index uint64) []byte {
+	return util.GetByteKey(poolId, index)
+}
+
+func FunderPayoutsKeyPrefix(poolId uint64) []byte {
+	return util.GetByteKey(poolId)
+}
+
+func InflationLedgerKeyPrefix(poolId uint64) []byte {
+	return util.GetByteKey(poolId)
+}
+
+func UpgradeReadinessKeyPrefix(poolId uint64, staker string) []byte {
+	return util.GetByteKey(poolId, staker)
+}
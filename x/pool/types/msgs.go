@@ -17,8 +17,14 @@ var (
 	_ sdk.Msg = &MsgUpdatePool{}
 	_ sdk.Msg = &MsgDisablePool{}
 	_ sdk.Msg = &MsgEnablePool{}
+	_ sdk.Msg = &MsgForkPool{}
+	_ sdk.Msg = &MsgRetirePool{}
+	_ sdk.Msg = &MsgPausePool{}
+	_ sdk.Msg = &MsgResumePool{}
+	_ sdk.Msg = &MsgScheduleInflationShareWeightRamp{}
 	_ sdk.Msg = &MsgScheduleRuntimeUpgrade{}
 	_ sdk.Msg = &MsgCancelRuntimeUpgrade{}
+	_ sdk.Msg = &MsgSignalUpgradeReady{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -50,6 +56,10 @@ func (msg *MsgCreatePool) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max bundle size")
 	}
 
+	if err := ValidateRuntimeBinaries(msg.RuntimeBinaries); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidRuntimeBinaries.Error(), err)
+	}
+
 	return nil
 }
 
@@ -72,6 +82,7 @@ type PoolUpdate struct {
 	StorageProviderId    *uint32
 	CompressionId        *uint32
 	EndKey               *string
+	MaxStakers           *uint64
 }
 
 // ValidateBasic does a sanity check on the provided data.
@@ -85,6 +96,11 @@ func (msg *MsgUpdatePool) ValidateBasic() error {
 		return err
 	}
 
+	return payload.ValidateBasic()
+}
+
+// ValidateBasic does a sanity check on all fields of the pool update which are set.
+func (payload *PoolUpdate) ValidateBasic() error {
 	if payload.UploadInterval != nil {
 		if err := util.ValidatePositiveNumber(*payload.UploadInterval); err != nil {
 			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid upload interval")
@@ -112,6 +128,48 @@ func (msg *MsgUpdatePool) ValidateBasic() error {
 	return nil
 }
 
+// Apply overwrites all fields of the given pool which are set in the pool update.
+func (payload *PoolUpdate) Apply(pool *Pool) {
+	if payload.Name != nil {
+		pool.Name = *payload.Name
+	}
+	if payload.Runtime != nil {
+		pool.Runtime = *payload.Runtime
+	}
+	if payload.Logo != nil {
+		pool.Logo = *payload.Logo
+	}
+	if payload.Config != nil {
+		pool.Config = *payload.Config
+	}
+	if payload.UploadInterval != nil {
+		pool.UploadInterval = *payload.UploadInterval
+	}
+	if payload.InflationShareWeight != nil {
+		pool.InflationShareWeight = *payload.InflationShareWeight
+		// a fixed weight replaces any scheduled ramp
+		pool.InflationShareWeightRamp = nil
+	}
+	if payload.MinDelegation != nil {
+		pool.MinDelegation = *payload.MinDelegation
+	}
+	if payload.MaxBundleSize != nil {
+		pool.MaxBundleSize = *payload.MaxBundleSize
+	}
+	if payload.StorageProviderId != nil {
+		pool.CurrentStorageProviderId = *payload.StorageProviderId
+	}
+	if payload.MaxStakers != nil {
+		pool.MaxStakers = *payload.MaxStakers
+	}
+	if payload.CompressionId != nil {
+		pool.CurrentCompressionId = *payload.CompressionId
+	}
+	if payload.EndKey != nil {
+		pool.EndKey = *payload.EndKey
+	}
+}
+
 // GetSigners returns the expected signers for a MsgDisablePool message.
 func (msg *MsgDisablePool) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
@@ -142,6 +200,112 @@ func (msg *MsgEnablePool) ValidateBasic() error {
 	return nil
 }
 
+// GetSigners returns the expected signers for a MsgForkPool message.
+func (msg *MsgForkPool) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgForkPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if msg.Payload != "" {
+		var payload PoolUpdate
+		if err := json.Unmarshal([]byte(msg.Payload), &payload); err != nil {
+			return err
+		}
+
+		if err := payload.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateRuntimeBinaries(msg.RuntimeBinaries); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidRuntimeBinaries.Error(), err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgRetirePool message.
+func (msg *MsgRetirePool) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRetirePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgPausePool message.
+func (msg *MsgPausePool) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgPausePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if msg.PauseUntil <= msg.PauseFrom {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidPauseRange.Error(), msg.PauseUntil, msg.PauseFrom)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgResumePool message.
+func (msg *MsgResumePool) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgResumePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgScheduleInflationShareWeightRamp message.
+func (msg *MsgScheduleInflationShareWeightRamp) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgScheduleInflationShareWeightRamp) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if err := util.ValidateDecimal(msg.FromWeight); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid from weight: %s", err)
+	}
+
+	if err := util.ValidateDecimal(msg.ToWeight); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid to weight: %s", err)
+	}
+
+	if msg.EndAt <= msg.StartAt {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidRampRange.Error(), msg.EndAt, msg.StartAt)
+	}
+
+	return nil
+}
+
 // GetSigners returns the expected signers for a MsgScheduleRuntimeUpgrade message.
 func (msg *MsgScheduleRuntimeUpgrade) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
@@ -154,6 +318,20 @@ func (msg *MsgScheduleRuntimeUpgrade) ValidateBasic() error {
 		return errors.Wrap(err, "invalid authority address")
 	}
 
+	if msg.ReadinessThreshold != nil {
+		if err := util.ValidatePercentage(*msg.ReadinessThreshold); err != nil || msg.ReadinessThreshold.IsZero() {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid readiness threshold")
+		}
+
+		if msg.ReadinessDeadline <= msg.ScheduledAt {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "readiness deadline has to be after scheduled at")
+		}
+	}
+
+	if err := ValidateRuntimeBinaries(msg.RuntimeBinaries); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidRuntimeBinaries.Error(), err)
+	}
+
 	return nil
 }
 
@@ -195,3 +373,30 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// GetSigners returns the expected signers for a MsgSignalUpgradeReady message.
+func (msg *MsgSignalUpgradeReady) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSignalUpgradeReady) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Staker); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid staker address (%s)", err)
+	}
+
+	if msg.Version == "" {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid version")
+	}
+
+	return nil
+}
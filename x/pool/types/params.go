@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	"github.com/KYVENetwork/chain/util"
 )
@@ -14,16 +16,41 @@ var DefaultPoolInflationPayoutRate = math.LegacyMustNewDecFromStr("0.05")
 // DefaultMaxVotingPowerPerPool ...
 var DefaultMaxVotingPowerPerPool = math.LegacyMustNewDecFromStr("0.5")
 
+// DefaultDynamicInflationShare ...
+var DefaultDynamicInflationShare = math.LegacyZeroDec()
+
+// DefaultDynamicInflationMode ...
+var DefaultDynamicInflationMode = DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS
+
+// DefaultFunderPayoutsWindow ...
+var DefaultFunderPayoutsWindow = uint64(7 * 24 * 60 * 60)
+
+// DefaultMaxStakersPerPool ...
+var DefaultMaxStakersPerPool = uint64(50)
+
+// DefaultMaxStakersPerPoolLimit ...
+var DefaultMaxStakersPerPoolLimit = uint64(100)
+
 // NewParams creates a new Params instance
 func NewParams(
 	protocolInflationShare math.LegacyDec,
 	poolInflationPayoutRate math.LegacyDec,
 	maxVotingPowerPerPool math.LegacyDec,
+	dynamicInflationShare math.LegacyDec,
+	dynamicInflationMode DynamicInflationMode,
+	funderPayoutsWindow uint64,
+	maxStakersPerPool uint64,
+	maxStakersPerPoolLimit uint64,
 ) Params {
 	return Params{
 		ProtocolInflationShare:  protocolInflationShare,
 		PoolInflationPayoutRate: poolInflationPayoutRate,
 		MaxVotingPowerPerPool:   maxVotingPowerPerPool,
+		DynamicInflationShare:   dynamicInflationShare,
+		DynamicInflationMode:    dynamicInflationMode,
+		FunderPayoutsWindow:     funderPayoutsWindow,
+		MaxStakersPerPool:       maxStakersPerPool,
+		MaxStakersPerPoolLimit:  maxStakersPerPoolLimit,
 	}
 }
 
@@ -33,6 +60,11 @@ func DefaultParams() Params {
 		DefaultProtocolInflationShare,
 		DefaultPoolInflationPayoutRate,
 		DefaultMaxVotingPowerPerPool,
+		DefaultDynamicInflationShare,
+		DefaultDynamicInflationMode,
+		DefaultFunderPayoutsWindow,
+		DefaultMaxStakersPerPool,
+		DefaultMaxStakersPerPoolLimit,
 	)
 }
 
@@ -50,5 +82,29 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidatePercentage(p.DynamicInflationShare); err != nil {
+		return err
+	}
+
+	if _, ok := DynamicInflationMode_name[int32(p.DynamicInflationMode)]; !ok {
+		return fmt.Errorf("invalid dynamic inflation mode: %v", p.DynamicInflationMode)
+	}
+
+	if err := util.ValidatePositiveNumber(p.FunderPayoutsWindow); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePositiveNumber(p.MaxStakersPerPool); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePositiveNumber(p.MaxStakersPerPoolLimit); err != nil {
+		return err
+	}
+
+	if p.MaxStakersPerPool > p.MaxStakersPerPoolLimit {
+		return fmt.Errorf("max stakers per pool %v exceeds limit %v", p.MaxStakersPerPool, p.MaxStakersPerPoolLimit)
+	}
+
 	return nil
 }
@@ -24,6 +24,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DynamicInflationMode defines how the demand of a pool is measured for
+// the dynamic share of the protocol inflation
+type DynamicInflationMode int32
+
+const (
+	// DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS measures the demand by the
+	// funder payouts of the pool within the funder payouts window
+	DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS DynamicInflationMode = 0
+	// DYNAMIC_INFLATION_MODE_STAKE measures the demand by the total
+	// delegation of all stakers in the pool
+	DYNAMIC_INFLATION_MODE_STAKE DynamicInflationMode = 1
+)
+
+var DynamicInflationMode_name = map[int32]string{
+	0: "DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS",
+	1: "DYNAMIC_INFLATION_MODE_STAKE",
+}
+
+var DynamicInflationMode_value = map[string]int32{
+	"DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS": 0,
+	"DYNAMIC_INFLATION_MODE_STAKE":          1,
+}
+
+func (x DynamicInflationMode) String() string {
+	return proto.EnumName(DynamicInflationMode_name, int32(x))
+}
+
+func (DynamicInflationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d8646dfa6da3b4d, []int{0}
+}
+
 // Params defines the pool module parameters.
 type Params struct {
 	// protocol_inflation_share ...
@@ -32,6 +63,21 @@ type Params struct {
 	PoolInflationPayoutRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=pool_inflation_payout_rate,json=poolInflationPayoutRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pool_inflation_payout_rate"`
 	// max_voting_power_per_pool ...
 	MaxVotingPowerPerPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_voting_power_per_pool,json=maxVotingPowerPerPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_voting_power_per_pool"`
+	// dynamic_inflation_share is the share of the protocol inflation which is
+	// distributed in proportion to the demand of each pool instead of the static
+	// inflation share weights
+	DynamicInflationShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=dynamic_inflation_share,json=dynamicInflationShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dynamic_inflation_share"`
+	// dynamic_inflation_mode defines how the demand of a pool is measured
+	DynamicInflationMode DynamicInflationMode `protobuf:"varint,5,opt,name=dynamic_inflation_mode,json=dynamicInflationMode,proto3,enum=kyve.pool.v1beta1.DynamicInflationMode" json:"dynamic_inflation_mode,omitempty"`
+	// funder_payouts_window is the duration in seconds over which the recent
+	// funder payouts of a pool are tracked, zero falls back to the default window
+	FunderPayoutsWindow uint64 `protobuf:"varint,6,opt,name=funder_payouts_window,json=funderPayoutsWindow,proto3" json:"funder_payouts_window,omitempty"`
+	// max_stakers_per_pool is the maximum amount of stakers of a pool
+	// which did not set its own max stakers
+	MaxStakersPerPool uint64 `protobuf:"varint,7,opt,name=max_stakers_per_pool,json=maxStakersPerPool,proto3" json:"max_stakers_per_pool,omitempty"`
+	// max_stakers_per_pool_limit is the upper bound for the max stakers
+	// of every pool
+	MaxStakersPerPoolLimit uint64 `protobuf:"varint,8,opt,name=max_stakers_per_pool_limit,json=maxStakersPerPoolLimit,proto3" json:"max_stakers_per_pool_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,34 +113,75 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDynamicInflationMode() DynamicInflationMode {
+	if m != nil {
+		return m.DynamicInflationMode
+	}
+	return DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS
+}
+
+func (m *Params) GetFunderPayoutsWindow() uint64 {
+	if m != nil {
+		return m.FunderPayoutsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStakersPerPool() uint64 {
+	if m != nil {
+		return m.MaxStakersPerPool
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStakersPerPoolLimit() uint64 {
+	if m != nil {
+		return m.MaxStakersPerPoolLimit
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("kyve.pool.v1beta1.DynamicInflationMode", DynamicInflationMode_name, DynamicInflationMode_value)
 	proto.RegisterType((*Params)(nil), "kyve.pool.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("kyve/pool/v1beta1/params.proto", fileDescriptor_7d8646dfa6da3b4d) }
 
 var fileDescriptor_7d8646dfa6da3b4d = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd1, 0x4d, 0x4b, 0xfb, 0x30,
-	0x1c, 0x07, 0xf0, 0x76, 0x7f, 0x18, 0xfc, 0x7b, 0xb3, 0xf8, 0x50, 0x27, 0x64, 0xa2, 0x17, 0xbd,
-	0x34, 0x0c, 0xdf, 0xc1, 0xd4, 0x83, 0x28, 0x52, 0x26, 0x0c, 0x14, 0x46, 0xfd, 0xad, 0xc6, 0xb6,
-	0xac, 0xe9, 0x2f, 0x24, 0xd9, 0x43, 0xdf, 0x85, 0x2f, 0xc3, 0x97, 0xb2, 0xe3, 0x8e, 0xe2, 0x61,
-	0xc8, 0xfa, 0x46, 0x24, 0x99, 0x13, 0x8f, 0x3b, 0x04, 0x42, 0xbe, 0xc9, 0xe7, 0x1b, 0x12, 0x8f,
-	0x8c, 0xaa, 0x09, 0xa3, 0x02, 0xb1, 0xa0, 0x93, 0xce, 0x90, 0x69, 0xe8, 0x50, 0x01, 0x12, 0xb8,
-	0x0a, 0x85, 0x44, 0x8d, 0xfe, 0x8e, 0xc9, 0x43, 0x93, 0x87, 0x3f, 0x79, 0x6b, 0x37, 0xc5, 0x14,
-	0x6d, 0x4a, 0xcd, 0x6c, 0xbd, 0xf1, 0xe4, 0xbd, 0xe1, 0x35, 0x23, 0x7b, 0xd2, 0x1f, 0x78, 0x81,
-	0x5d, 0x4b, 0xb0, 0x88, 0xf3, 0xf2, 0xb5, 0x00, 0x9d, 0x63, 0x19, 0xab, 0x0c, 0x24, 0x0b, 0xdc,
-	0x63, 0xf7, 0xec, 0x7f, 0xf7, 0x74, 0xbe, 0x6c, 0x3b, 0x9f, 0xcb, 0xf6, 0x51, 0x82, 0x8a, 0xa3,
-	0x52, 0x2f, 0xa3, 0x30, 0x47, 0xca, 0x41, 0x67, 0xe1, 0x1d, 0x4b, 0x21, 0xa9, 0xae, 0x58, 0xd2,
-	0xdb, 0xdf, 0x20, 0x37, 0x1b, 0xe3, 0xc1, 0x10, 0xfe, 0xb3, 0xd7, 0x32, 0xf7, 0xf9, 0x43, 0x0b,
-	0xa8, 0x70, 0xac, 0x63, 0x09, 0x9a, 0x05, 0x8d, 0xed, 0x0b, 0x0e, 0x0c, 0xf3, 0x8b, 0x47, 0x16,
-	0xe9, 0x81, 0x66, 0xfe, 0xc0, 0x3b, 0xe4, 0x30, 0x8b, 0x27, 0xa8, 0xf3, 0x32, 0x8d, 0x05, 0x4e,
-	0x99, 0x8c, 0x85, 0x19, 0x88, 0x45, 0xf0, 0x6f, 0xfb, 0x82, 0x3d, 0x0e, 0xb3, 0xbe, 0x45, 0x22,
-	0x63, 0x44, 0x4c, 0x46, 0x88, 0x45, 0xf7, 0x72, 0xbe, 0x22, 0xee, 0x62, 0x45, 0xdc, 0xaf, 0x15,
-	0x71, 0xdf, 0x6a, 0xe2, 0x2c, 0x6a, 0xe2, 0x7c, 0xd4, 0xc4, 0x79, 0x3a, 0x4f, 0x73, 0x9d, 0x8d,
-	0x87, 0x61, 0x82, 0x9c, 0xde, 0x3e, 0xf6, 0xaf, 0xef, 0x99, 0x9e, 0xa2, 0x1c, 0xd1, 0x24, 0x83,
-	0xbc, 0xa4, 0xb3, 0xf5, 0x3f, 0xe9, 0x4a, 0x30, 0x35, 0x6c, 0xda, 0xd7, 0xb9, 0xf8, 0x0e, 0x00,
-	0x00, 0xff, 0xff, 0x41, 0x1e, 0x77, 0xf5, 0xc1, 0x01, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x6d, 0x68, 0x03, 0xcc, 0x02, 0xb5, 0x26, 0x4d, 0x4d, 0x40, 0x6e, 0x04, 0x42, 0xa4,
+	0x2c, 0x6c, 0xb5, 0xec, 0xd8, 0xa5, 0x4d, 0x2a, 0x45, 0xcd, 0xc5, 0x4a, 0xd2, 0xa2, 0x80, 0xa2,
+	0x61, 0x62, 0x4f, 0x93, 0x51, 0x3c, 0x3e, 0x96, 0x67, 0x72, 0x7b, 0x00, 0x24, 0x96, 0xbc, 0x03,
+	0x2f, 0xd3, 0x65, 0x97, 0x88, 0x45, 0x85, 0x92, 0x17, 0x41, 0x9e, 0x34, 0x02, 0x9a, 0x20, 0x95,
+	0x85, 0x25, 0x6b, 0xfe, 0xf3, 0x7f, 0x47, 0xfe, 0x6c, 0x23, 0x6b, 0x30, 0x1d, 0x51, 0x27, 0x02,
+	0x08, 0x9c, 0xd1, 0x41, 0x97, 0x4a, 0x72, 0xe0, 0x44, 0x24, 0x26, 0x5c, 0xd8, 0x51, 0x0c, 0x12,
+	0x8c, 0xed, 0x24, 0xb7, 0x93, 0xdc, 0xbe, 0xc9, 0xb3, 0xe9, 0x1e, 0xf4, 0x40, 0xa5, 0x4e, 0x72,
+	0xb7, 0x18, 0x7c, 0xf1, 0x79, 0x13, 0xa5, 0x5c, 0xd5, 0x34, 0x3a, 0xc8, 0x54, 0x67, 0x1e, 0x04,
+	0x98, 0x85, 0x17, 0x01, 0x91, 0x0c, 0x42, 0x2c, 0xfa, 0x24, 0xa6, 0xa6, 0x9e, 0xd3, 0xf3, 0x8f,
+	0x8e, 0x5e, 0x5e, 0x5e, 0xef, 0x69, 0x3f, 0xae, 0xf7, 0x9e, 0x79, 0x20, 0x38, 0x08, 0xe1, 0x0f,
+	0x6c, 0x06, 0x0e, 0x27, 0xb2, 0x6f, 0x57, 0x68, 0x8f, 0x78, 0xd3, 0x22, 0xf5, 0x1a, 0x99, 0x25,
+	0xa4, 0xbc, 0x64, 0x34, 0x13, 0x84, 0xf1, 0x09, 0x65, 0x23, 0xf8, 0x0b, 0x1d, 0x91, 0x29, 0x0c,
+	0x25, 0x8e, 0x89, 0xa4, 0xe6, 0xbd, 0xbb, 0x2f, 0xd8, 0x8d, 0xe0, 0x0f, 0xb8, 0xab, 0x20, 0x0d,
+	0x22, 0xa9, 0xd1, 0x41, 0x4f, 0x39, 0x99, 0xe0, 0x11, 0x48, 0x16, 0xf6, 0x70, 0x04, 0x63, 0x1a,
+	0xe3, 0x28, 0xb9, 0x00, 0x02, 0xf3, 0xfe, 0xdd, 0x17, 0xec, 0x70, 0x32, 0x39, 0x57, 0x10, 0x37,
+	0x61, 0xb8, 0x34, 0x76, 0x01, 0x02, 0xe3, 0x23, 0xda, 0xf5, 0xa7, 0x21, 0xe1, 0xcc, 0x5b, 0xd1,
+	0xb3, 0xf1, 0x1f, 0xf0, 0x1b, 0xc6, 0x2d, 0x3b, 0x1d, 0x94, 0x59, 0x85, 0x73, 0xf0, 0xa9, 0xb9,
+	0x99, 0xd3, 0xf3, 0x8f, 0x0f, 0x5f, 0xdb, 0x2b, 0x6f, 0xd4, 0x2e, 0xde, 0x22, 0x55, 0xc1, 0xa7,
+	0x8d, 0xb4, 0xbf, 0xe6, 0xd4, 0x38, 0x44, 0x3b, 0x17, 0xc3, 0xd0, 0x4f, 0x64, 0x28, 0x5f, 0x02,
+	0x8f, 0x59, 0xe8, 0xc3, 0xd8, 0x4c, 0xe5, 0xf4, 0xfc, 0x46, 0xe3, 0xc9, 0x22, 0x5c, 0xb8, 0x14,
+	0xef, 0x55, 0x64, 0x38, 0x28, 0x9d, 0xe8, 0x14, 0x92, 0x0c, 0x68, 0x2c, 0x7e, 0x9b, 0x7c, 0xa0,
+	0x2a, 0xdb, 0x9c, 0x4c, 0x9a, 0x8b, 0x68, 0x29, 0xe8, 0x1d, 0xca, 0xae, 0x2b, 0xe0, 0x80, 0x71,
+	0x26, 0xcd, 0x87, 0xaa, 0x96, 0x59, 0xa9, 0x55, 0x92, 0xf4, 0x0d, 0x43, 0xe9, 0x75, 0x8f, 0x63,
+	0xec, 0xa3, 0x57, 0xc5, 0x76, 0xad, 0x50, 0x2d, 0x1f, 0xe3, 0x72, 0xed, 0xa4, 0x52, 0x68, 0x95,
+	0xeb, 0x35, 0x5c, 0xad, 0x17, 0x4b, 0xf8, 0xe4, 0xac, 0x56, 0x2c, 0x35, 0xb0, 0x5b, 0x68, 0xd7,
+	0xcf, 0x5a, 0xcd, 0x2d, 0xcd, 0xc8, 0xa1, 0xe7, 0xff, 0x18, 0x6d, 0xb6, 0x0a, 0xa7, 0xa5, 0x2d,
+	0x3d, 0xbb, 0xf1, 0xe5, 0x9b, 0xa5, 0x1d, 0x1d, 0x5f, 0xce, 0x2c, 0xfd, 0x6a, 0x66, 0xe9, 0x3f,
+	0x67, 0x96, 0xfe, 0x75, 0x6e, 0x69, 0x57, 0x73, 0x4b, 0xfb, 0x3e, 0xb7, 0xb4, 0x0f, 0xfb, 0x3d,
+	0x26, 0xfb, 0xc3, 0xae, 0xed, 0x01, 0x77, 0x4e, 0xdb, 0xe7, 0xa5, 0x1a, 0x95, 0x63, 0x88, 0x07,
+	0x8e, 0xd7, 0x27, 0x2c, 0x74, 0x26, 0x8b, 0xff, 0x4d, 0x4e, 0x23, 0x2a, 0xba, 0x29, 0xf5, 0x95,
+	0xbf, 0xfd, 0x35, 0x00, 0x1f, 0xfd, 0xfd, 0x04, 0x89, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -117,6 +204,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxStakersPerPoolLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStakersPerPoolLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxStakersPerPool != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStakersPerPool))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FunderPayoutsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FunderPayoutsWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DynamicInflationMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DynamicInflationMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DynamicInflationShare.Size()
+		i -= size
+		if _, err := m.DynamicInflationShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.MaxVotingPowerPerPool.Size()
 		i -= size
@@ -173,6 +290,20 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MaxVotingPowerPerPool.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.DynamicInflationShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DynamicInflationMode != 0 {
+		n += 1 + sovParams(uint64(m.DynamicInflationMode))
+	}
+	if m.FunderPayoutsWindow != 0 {
+		n += 1 + sovParams(uint64(m.FunderPayoutsWindow))
+	}
+	if m.MaxStakersPerPool != 0 {
+		n += 1 + sovParams(uint64(m.MaxStakersPerPool))
+	}
+	if m.MaxStakersPerPoolLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxStakersPerPoolLimit))
+	}
 	return n
 }
 
@@ -313,6 +444,116 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicInflationShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicInflationShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicInflationMode", wireType)
+			}
+			m.DynamicInflationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DynamicInflationMode |= DynamicInflationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderPayoutsWindow", wireType)
+			}
+			m.FunderPayoutsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FunderPayoutsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakersPerPool", wireType)
+			}
+			m.MaxStakersPerPool = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakersPerPool |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakersPerPoolLimit", wireType)
+			}
+			m.MaxStakersPerPoolLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakersPerPoolLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
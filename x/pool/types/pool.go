@@ -1,8 +1,13 @@
 package types
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -12,3 +17,177 @@ func (m *Pool) GetPoolAccount() sdk.AccAddress {
 
 	return authTypes.NewModuleAddress(name)
 }
+
+// GetChangedFields compares all configurable fields of the pool with the given
+// updated pool and returns every field whose value differs.
+func (m *Pool) GetChangedFields(updated *Pool) []PoolChangedField {
+	fields := []PoolChangedField{
+		{Field: "name", OldValue: m.Name, NewValue: updated.Name},
+		{Field: "runtime", OldValue: m.Runtime, NewValue: updated.Runtime},
+		{Field: "logo", OldValue: m.Logo, NewValue: updated.Logo},
+		{Field: "config", OldValue: m.Config, NewValue: updated.Config},
+		{Field: "end_key", OldValue: m.EndKey, NewValue: updated.EndKey},
+		{Field: "upload_interval", OldValue: strconv.FormatUint(m.UploadInterval, 10), NewValue: strconv.FormatUint(updated.UploadInterval, 10)},
+		{Field: "inflation_share_weight", OldValue: m.InflationShareWeight.String(), NewValue: updated.InflationShareWeight.String()},
+		{Field: "min_delegation", OldValue: strconv.FormatUint(m.MinDelegation, 10), NewValue: strconv.FormatUint(updated.MinDelegation, 10)},
+		{Field: "max_bundle_size", OldValue: strconv.FormatUint(m.MaxBundleSize, 10), NewValue: strconv.FormatUint(updated.MaxBundleSize, 10)},
+		{Field: "max_stakers", OldValue: strconv.FormatUint(m.MaxStakers, 10), NewValue: strconv.FormatUint(updated.MaxStakers, 10)},
+		{Field: "disabled", OldValue: strconv.FormatBool(m.Disabled), NewValue: strconv.FormatBool(updated.Disabled)},
+		{Field: "protocol.version", OldValue: m.Protocol.GetVersion(), NewValue: updated.Protocol.GetVersion()},
+		{Field: "protocol.binaries", OldValue: m.Protocol.GetBinaries(), NewValue: updated.Protocol.GetBinaries()},
+		{Field: "protocol.runtime_binaries", OldValue: runtimeBinariesString(m.Protocol.GetRuntimeBinaries()), NewValue: runtimeBinariesString(updated.Protocol.GetRuntimeBinaries())},
+		{Field: "upgrade_plan.version", OldValue: m.UpgradePlan.GetVersion(), NewValue: updated.UpgradePlan.GetVersion()},
+		{Field: "upgrade_plan.binaries", OldValue: m.UpgradePlan.GetBinaries(), NewValue: updated.UpgradePlan.GetBinaries()},
+		{Field: "upgrade_plan.runtime_binaries", OldValue: runtimeBinariesString(m.UpgradePlan.GetRuntimeBinaries()), NewValue: runtimeBinariesString(updated.UpgradePlan.GetRuntimeBinaries())},
+		{Field: "upgrade_plan.scheduled_at", OldValue: strconv.FormatUint(m.UpgradePlan.GetScheduledAt(), 10), NewValue: strconv.FormatUint(updated.UpgradePlan.GetScheduledAt(), 10)},
+		{Field: "upgrade_plan.duration", OldValue: strconv.FormatUint(m.UpgradePlan.GetDuration(), 10), NewValue: strconv.FormatUint(updated.UpgradePlan.GetDuration(), 10)},
+		{Field: "upgrade_plan.readiness_threshold", OldValue: m.UpgradePlan.getReadinessThresholdString(), NewValue: updated.UpgradePlan.getReadinessThresholdString()},
+		{Field: "upgrade_plan.readiness_deadline", OldValue: strconv.FormatUint(m.UpgradePlan.GetReadinessDeadline(), 10), NewValue: strconv.FormatUint(updated.UpgradePlan.GetReadinessDeadline(), 10)},
+		{Field: "upgrade_plan.readiness_reached", OldValue: strconv.FormatBool(m.UpgradePlan.GetReadinessReached()), NewValue: strconv.FormatBool(updated.UpgradePlan.GetReadinessReached())},
+		{Field: "current_storage_provider_id", OldValue: strconv.FormatUint(uint64(m.CurrentStorageProviderId), 10), NewValue: strconv.FormatUint(uint64(updated.CurrentStorageProviderId), 10)},
+		{Field: "pause_from", OldValue: strconv.FormatUint(m.PauseFrom, 10), NewValue: strconv.FormatUint(updated.PauseFrom, 10)},
+		{Field: "pause_until", OldValue: strconv.FormatUint(m.PauseUntil, 10), NewValue: strconv.FormatUint(updated.PauseUntil, 10)},
+		{Field: "inflation_share_weight_ramp.from_weight", OldValue: m.InflationShareWeightRamp.getFromWeightString(), NewValue: updated.InflationShareWeightRamp.getFromWeightString()},
+		{Field: "inflation_share_weight_ramp.to_weight", OldValue: m.InflationShareWeightRamp.getToWeightString(), NewValue: updated.InflationShareWeightRamp.getToWeightString()},
+		{Field: "inflation_share_weight_ramp.start_at", OldValue: strconv.FormatUint(m.InflationShareWeightRamp.GetStartAt(), 10), NewValue: strconv.FormatUint(updated.InflationShareWeightRamp.GetStartAt(), 10)},
+		{Field: "inflation_share_weight_ramp.end_at", OldValue: strconv.FormatUint(m.InflationShareWeightRamp.GetEndAt(), 10), NewValue: strconv.FormatUint(updated.InflationShareWeightRamp.GetEndAt(), 10)},
+		{Field: "current_compression_id", OldValue: strconv.FormatUint(uint64(m.CurrentCompressionId), 10), NewValue: strconv.FormatUint(uint64(updated.CurrentCompressionId), 10)},
+	}
+
+	changed := make([]PoolChangedField, 0)
+	for _, field := range fields {
+		if field.OldValue != field.NewValue {
+			changed = append(changed, field)
+		}
+	}
+
+	return changed
+}
+
+// IsPaused returns true if the pool is halted because of a scheduled pause
+// at the given unix time.
+func (m *Pool) IsPaused(now uint64) bool {
+	return m.PauseUntil > 0 && now >= m.PauseFrom && now < m.PauseUntil
+}
+
+// GetInflationShareWeightAt returns the inflation share weight of the pool at
+// the given unix time. While a scheduled ramp is running the weight is linearly
+// interpolated between the from and to weight of the ramp.
+func (m *Pool) GetInflationShareWeightAt(now uint64) math.LegacyDec {
+	ramp := m.InflationShareWeightRamp
+	if ramp == nil || now < ramp.StartAt {
+		return m.InflationShareWeight
+	}
+
+	if now >= ramp.EndAt {
+		return ramp.ToWeight
+	}
+
+	progress := math.LegacyNewDec(int64(now - ramp.StartAt)).QuoInt64(int64(ramp.EndAt - ramp.StartAt))
+	return ramp.FromWeight.Add(ramp.ToWeight.Sub(ramp.FromWeight).Mul(progress))
+}
+
+func (m *InflationShareWeightRamp) getFromWeightString() string {
+	if m == nil {
+		return ""
+	}
+	return m.FromWeight.String()
+}
+
+func (m *InflationShareWeightRamp) getToWeightString() string {
+	if m == nil {
+		return ""
+	}
+	return m.ToWeight.String()
+}
+
+// IsAwaitingReadiness returns true if the upgrade plan requires a readiness
+// threshold which has not been reached yet.
+func (m *UpgradePlan) IsAwaitingReadiness() bool {
+	return m.ScheduledAt > 0 && m.ReadinessThreshold != nil && m.ReadinessThreshold.IsPositive() && !m.ReadinessReached
+}
+
+// IsUpgrading returns true if the pool is currently halted because of the
+// upgrade plan at the given unix time.
+func (m *UpgradePlan) IsUpgrading(now uint64) bool {
+	return m.ScheduledAt > 0 && now >= m.ScheduledAt && !m.IsAwaitingReadiness()
+}
+
+func (m *UpgradePlan) getReadinessThresholdString() string {
+	if m == nil || m.ReadinessThreshold == nil {
+		return ""
+	}
+	return m.ReadinessThreshold.String()
+}
+
+// Roll moves the funder payouts window forward so that it contains the given
+// unix time. Payouts of windows which ended more than one window ago are dropped.
+func (m *FunderPayouts) Roll(now uint64, window uint64) {
+	if m.WindowStart == 0 || now < m.WindowStart {
+		m.WindowStart = now
+		return
+	}
+
+	elapsed := now - m.WindowStart
+	if elapsed < window {
+		return
+	}
+
+	if elapsed < 2*window {
+		m.Previous = m.Current
+	} else {
+		m.Previous = 0
+	}
+
+	m.Current = 0
+	m.WindowStart = now - elapsed%window
+}
+
+// GetRecent estimates the funder payouts of the last window at the given unix
+// time by weighting the previous window with the fraction of it which still
+// overlaps with the last window.
+func (m FunderPayouts) GetRecent(now uint64, window uint64) uint64 {
+	m.Roll(now, window)
+
+	remaining := window - (now - m.WindowStart)
+
+	return m.Current + math.LegacyNewDecFromInt(math.NewIntFromUint64(m.Previous)).
+		MulInt(math.NewIntFromUint64(remaining)).
+		QuoInt(math.NewIntFromUint64(window)).
+		TruncateInt().Uint64()
+}
+
+// ValidateRuntimeBinaries checks that every binary has a unique platform, a
+// valid download url and a hex encoded sha256 checksum.
+func ValidateRuntimeBinaries(binaries []RuntimeBinary) error {
+	platforms := make(map[string]bool)
+
+	for _, binary := range binaries {
+		if binary.Platform == "" {
+			return fmt.Errorf("platform can not be empty")
+		}
+
+		if platforms[binary.Platform] {
+			return fmt.Errorf("duplicate platform %s", binary.Platform)
+		}
+		platforms[binary.Platform] = true
+
+		if u, err := url.ParseRequestURI(binary.Url); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid url %s for platform %s", binary.Url, binary.Platform)
+		}
+
+		if checksum, err := hex.DecodeString(binary.Sha256); err != nil || len(checksum) != 32 {
+			return fmt.Errorf("invalid sha256 checksum %s for platform %s", binary.Sha256, binary.Platform)
+		}
+	}
+
+	return nil
+}
+
+func runtimeBinariesString(binaries []RuntimeBinary) string {
+	if len(binaries) == 0 {
+		return ""
+	}
+	raw, _ := json.Marshal(binaries)
+	return string(raw)
+}
@@ -53,6 +53,10 @@ const (
 	// POOL_STATUS_END_KEY_REACHED indicates, that the end key has been
 	// reached and that the pool is halted
 	POOL_STATUS_END_KEY_REACHED PoolStatus = 7
+	// POOL_STATUS_PAUSED indicates, that the pool was paused by the
+	// governance for a maintenance window and that the pool is halted
+	// until the pause ends
+	POOL_STATUS_PAUSED PoolStatus = 8
 )
 
 var PoolStatus_name = map[int32]string{
@@ -64,6 +68,7 @@ var PoolStatus_name = map[int32]string{
 	5: "POOL_STATUS_UPGRADING",
 	6: "POOL_STATUS_VOTING_POWER_TOO_HIGH",
 	7: "POOL_STATUS_END_KEY_REACHED",
+	8: "POOL_STATUS_PAUSED",
 }
 
 var PoolStatus_value = map[string]int32{
@@ -75,6 +80,7 @@ var PoolStatus_value = map[string]int32{
 	"POOL_STATUS_UPGRADING":             5,
 	"POOL_STATUS_VOTING_POWER_TOO_HIGH": 6,
 	"POOL_STATUS_END_KEY_REACHED":       7,
+	"POOL_STATUS_PAUSED":                8,
 }
 
 func (x PoolStatus) String() string {
@@ -95,6 +101,9 @@ type Protocol struct {
 	Binaries string `protobuf:"bytes,2,opt,name=binaries,proto3" json:"binaries,omitempty"`
 	// last_upgrade is the unix time the pool was upgraded the last time
 	LastUpgrade uint64 `protobuf:"varint,3,opt,name=last_upgrade,json=lastUpgrade,proto3" json:"last_upgrade,omitempty"`
+	// runtime_binaries holds the checksummed binaries in the current version
+	// for multiple platforms and architectures
+	RuntimeBinaries []RuntimeBinary `protobuf:"bytes,4,rep,name=runtime_binaries,json=runtimeBinaries,proto3" json:"runtime_binaries"`
 }
 
 func (m *Protocol) Reset()         { *m = Protocol{} }
@@ -151,6 +160,77 @@ func (m *Protocol) GetLastUpgrade() uint64 {
 	return 0
 }
 
+func (m *Protocol) GetRuntimeBinaries() []RuntimeBinary {
+	if m != nil {
+		return m.RuntimeBinaries
+	}
+	return nil
+}
+
+// RuntimeBinary is a prebuilt binary of a runtime for a single platform
+type RuntimeBinary struct {
+	// platform is the platform and architecture of the binary, e.g. linux-x64
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// url is the download link of the binary
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// sha256 is the hex encoded sha256 checksum of the binary
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (m *RuntimeBinary) Reset()         { *m = RuntimeBinary{} }
+func (m *RuntimeBinary) String() string { return proto.CompactTextString(m) }
+func (*RuntimeBinary) ProtoMessage()    {}
+func (*RuntimeBinary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{1}
+}
+func (m *RuntimeBinary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuntimeBinary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuntimeBinary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuntimeBinary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeBinary.Merge(m, src)
+}
+func (m *RuntimeBinary) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuntimeBinary) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeBinary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeBinary proto.InternalMessageInfo
+
+func (m *RuntimeBinary) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *RuntimeBinary) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *RuntimeBinary) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
 // Upgrade holds all info when a pool has a scheduled upgrade
 type UpgradePlan struct {
 	// version is the new software version tag of the upgrade
@@ -164,13 +244,26 @@ type UpgradePlan struct {
 	// during the upgrade to give all validators a chance of switching
 	// to the new binaries
 	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// readiness_threshold is the optional fraction of the total delegation of the
+	// pool which has to signal readiness before the upgrade gets activated. If it
+	// is not set the upgrade gets activated at scheduled_at regardless of readiness
+	ReadinessThreshold *cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=readiness_threshold,json=readinessThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"readiness_threshold,omitempty"`
+	// readiness_deadline is the unix time at which the upgrade gets aborted if
+	// the readiness_threshold has not been reached until then
+	ReadinessDeadline uint64 `protobuf:"varint,6,opt,name=readiness_deadline,json=readinessDeadline,proto3" json:"readiness_deadline,omitempty"`
+	// readiness_reached is true once the readiness_threshold was reached and the
+	// upgrade got activated
+	ReadinessReached bool `protobuf:"varint,7,opt,name=readiness_reached,json=readinessReached,proto3" json:"readiness_reached,omitempty"`
+	// runtime_binaries holds the checksummed binaries in the upgrade version
+	// for multiple platforms and architectures
+	RuntimeBinaries []RuntimeBinary `protobuf:"bytes,8,rep,name=runtime_binaries,json=runtimeBinaries,proto3" json:"runtime_binaries"`
 }
 
 func (m *UpgradePlan) Reset()         { *m = UpgradePlan{} }
 func (m *UpgradePlan) String() string { return proto.CompactTextString(m) }
 func (*UpgradePlan) ProtoMessage()    {}
 func (*UpgradePlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c1730f47ff2ef8, []int{1}
+	return fileDescriptor_40c1730f47ff2ef8, []int{2}
 }
 func (m *UpgradePlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +320,27 @@ func (m *UpgradePlan) GetDuration() uint64 {
 	return 0
 }
 
+func (m *UpgradePlan) GetReadinessDeadline() uint64 {
+	if m != nil {
+		return m.ReadinessDeadline
+	}
+	return 0
+}
+
+func (m *UpgradePlan) GetReadinessReached() bool {
+	if m != nil {
+		return m.ReadinessReached
+	}
+	return false
+}
+
+func (m *UpgradePlan) GetRuntimeBinaries() []RuntimeBinary {
+	if m != nil {
+		return m.RuntimeBinaries
+	}
+	return nil
+}
+
 // Pool ...
 type Pool struct {
 	// id - unique identifier of the pool, can not be changed
@@ -272,13 +386,28 @@ type Pool struct {
 	// end_key is the last key before the pool should stop indexing, it is
 	// inclusive
 	EndKey string `protobuf:"bytes,20,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// pause_from is the unix time the scheduled pause of the pool starts
+	PauseFrom uint64 `protobuf:"varint,21,opt,name=pause_from,json=pauseFrom,proto3" json:"pause_from,omitempty"`
+	// pause_until is the unix time the scheduled pause of the pool ends. The
+	// pool is not paused if it is zero
+	PauseUntil uint64 `protobuf:"varint,22,opt,name=pause_until,json=pauseUntil,proto3" json:"pause_until,omitempty"`
+	// predecessor links to the pool this pool was forked from
+	Predecessor *PoolLink `protobuf:"bytes,23,opt,name=predecessor,proto3" json:"predecessor,omitempty"`
+	// successor links to the pool this pool was forked into
+	Successor *PoolLink `protobuf:"bytes,24,opt,name=successor,proto3" json:"successor,omitempty"`
+	// inflation_share_weight_ramp is the scheduled linear change of the
+	// inflation share weight. No change is scheduled if it is empty
+	InflationShareWeightRamp *InflationShareWeightRamp `protobuf:"bytes,25,opt,name=inflation_share_weight_ramp,json=inflationShareWeightRamp,proto3" json:"inflation_share_weight_ramp,omitempty"`
+	// max_stakers is the maximum amount of stakers which can join the pool,
+	// zero falls back to the max_stakers_per_pool param
+	MaxStakers uint64 `protobuf:"varint,26,opt,name=max_stakers,json=maxStakers,proto3" json:"max_stakers,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c1730f47ff2ef8, []int{2}
+	return fileDescriptor_40c1730f47ff2ef8, []int{3}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,474 +569,3310 @@ func (m *Pool) GetEndKey() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("kyve.pool.v1beta1.PoolStatus", PoolStatus_name, PoolStatus_value)
-	proto.RegisterType((*Protocol)(nil), "kyve.pool.v1beta1.Protocol")
-	proto.RegisterType((*UpgradePlan)(nil), "kyve.pool.v1beta1.UpgradePlan")
-	proto.RegisterType((*Pool)(nil), "kyve.pool.v1beta1.Pool")
+func (m *Pool) GetPauseFrom() uint64 {
+	if m != nil {
+		return m.PauseFrom
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("kyve/pool/v1beta1/pool.proto", fileDescriptor_40c1730f47ff2ef8) }
+func (m *Pool) GetPauseUntil() uint64 {
+	if m != nil {
+		return m.PauseUntil
+	}
+	return 0
+}
 
-var fileDescriptor_40c1730f47ff2ef8 = []byte{
-	// 855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x8f, 0xda, 0x46,
-	0x14, 0xc6, 0x84, 0xb0, 0x30, 0xec, 0xb2, 0x64, 0x4a, 0x37, 0xd3, 0xa5, 0x62, 0xc9, 0x46, 0x69,
-	0x69, 0x0f, 0xa0, 0xb4, 0x95, 0x7a, 0xea, 0x81, 0xc5, 0x0e, 0x6b, 0xed, 0x0a, 0x90, 0x0d, 0x1b,
-	0x6d, 0x2f, 0xa3, 0xc1, 0x33, 0x31, 0xa3, 0xb5, 0x3d, 0x96, 0x3d, 0x26, 0x4b, 0x8e, 0x95, 0x2a,
-	0xf5, 0xd8, 0x73, 0xaf, 0xfd, 0x67, 0x72, 0xcc, 0xb1, 0xea, 0x21, 0xaa, 0x76, 0xff, 0x91, 0xca,
-	0x63, 0x43, 0x49, 0xda, 0x53, 0x6f, 0xef, 0x7d, 0xdf, 0xf7, 0x7e, 0x79, 0xde, 0x33, 0xf8, 0xfc,
-	0x66, 0xbd, 0x62, 0xfd, 0x50, 0x08, 0xaf, 0xbf, 0x7a, 0xbe, 0x60, 0x92, 0x3c, 0x57, 0x4e, 0x2f,
-	0x8c, 0x84, 0x14, 0xf0, 0x51, 0xca, 0xf6, 0x14, 0x90, 0xb3, 0xc7, 0x4d, 0x57, 0xb8, 0x42, 0xb1,
-	0xfd, 0xd4, 0xca, 0x84, 0xa7, 0x0e, 0xa8, 0x4c, 0x53, 0xc3, 0x11, 0x1e, 0x44, 0x60, 0x6f, 0xc5,
-	0xa2, 0x98, 0x8b, 0x00, 0x69, 0x1d, 0xad, 0x5b, 0xb5, 0x36, 0x2e, 0x3c, 0x06, 0x95, 0x05, 0x0f,
-	0x48, 0xc4, 0x59, 0x8c, 0x8a, 0x8a, 0xda, 0xfa, 0xf0, 0x09, 0xd8, 0xf7, 0x48, 0x2c, 0x71, 0x12,
-	0xba, 0x11, 0xa1, 0x0c, 0x3d, 0xe8, 0x68, 0xdd, 0x92, 0x55, 0x4b, 0xb1, 0x79, 0x06, 0x9d, 0xfe,
-	0xa4, 0x81, 0x5a, 0x6e, 0x4f, 0x3d, 0x12, 0xfc, 0xff, 0x42, 0xb1, 0xb3, 0x64, 0x34, 0xf1, 0x18,
-	0xc5, 0x44, 0x6e, 0x0a, 0x6d, 0xb1, 0x81, 0x4c, 0xc3, 0x69, 0x12, 0x11, 0x99, 0x66, 0x2e, 0x29,
-	0x7a, 0xeb, 0x9f, 0xfe, 0x56, 0x06, 0xa5, 0xa9, 0x10, 0x1e, 0xac, 0x83, 0x22, 0xa7, 0xaa, 0x70,
-	0xc9, 0x2a, 0x72, 0x0a, 0x21, 0x28, 0x05, 0xc4, 0x67, 0x79, 0x3d, 0x65, 0xa7, 0x1d, 0x46, 0x49,
-	0x20, 0xb9, 0x9f, 0xcd, 0x53, 0xb5, 0x36, 0x6e, 0xaa, 0xf6, 0x84, 0x2b, 0x54, 0xfa, 0xaa, 0xa5,
-	0x6c, 0x78, 0x04, 0xca, 0x8e, 0x08, 0x5e, 0x71, 0x17, 0x3d, 0x54, 0x68, 0xee, 0xc1, 0x16, 0xa8,
-	0xc6, 0x92, 0x44, 0x12, 0xdf, 0xb0, 0x35, 0x2a, 0x67, 0xe3, 0x28, 0xe0, 0x82, 0xad, 0xe1, 0x09,
-	0xa8, 0x39, 0x49, 0x14, 0xb1, 0x20, 0xa3, 0xf7, 0x14, 0x0d, 0x72, 0x28, 0x15, 0x7c, 0x09, 0x0e,
-	0x37, 0x82, 0x38, 0xf1, 0x7d, 0x12, 0xad, 0x51, 0x45, 0x89, 0xea, 0x39, 0x6c, 0x67, 0x28, 0x7c,
-	0x0a, 0x0e, 0x36, 0x42, 0x1e, 0x50, 0x76, 0x8b, 0xaa, 0x6a, 0xb6, 0xfd, 0x1c, 0x34, 0x53, 0x2c,
-	0x15, 0x49, 0x21, 0x89, 0x87, 0x17, 0x49, 0x40, 0x3d, 0x16, 0x23, 0x90, 0x89, 0x14, 0x78, 0x96,
-	0x61, 0x69, 0xc9, 0x24, 0xf4, 0x04, 0xa1, 0x98, 0x07, 0x92, 0x45, 0x2b, 0xe2, 0xa1, 0x9a, 0x92,
-	0xd5, 0x33, 0xd8, 0xcc, 0x51, 0x78, 0x0d, 0x8e, 0x78, 0xf0, 0xca, 0x53, 0x5f, 0x16, 0xc7, 0x4b,
-	0x12, 0x31, 0xfc, 0x9a, 0x71, 0x77, 0x29, 0xd1, 0x7e, 0xda, 0xe2, 0xd9, 0xd3, 0xb7, 0xef, 0x4f,
-	0x0a, 0x7f, 0xbe, 0x3f, 0x69, 0x39, 0x22, 0xf6, 0x45, 0x1c, 0xd3, 0x9b, 0x1e, 0x17, 0x7d, 0x9f,
-	0xc8, 0x65, 0xef, 0x92, 0xb9, 0xc4, 0x59, 0xeb, 0xcc, 0xb1, 0x9a, 0xdb, 0x14, 0x76, 0x9a, 0xe1,
-	0xa5, 0x4a, 0x00, 0x9f, 0x81, 0xba, 0xcf, 0x03, 0x4c, 0x99, 0xc7, 0xdc, 0xec, 0x25, 0x0f, 0x54,
-	0x0b, 0x07, 0x3e, 0x0f, 0xf4, 0x2d, 0x08, 0xbf, 0x00, 0x87, 0x3e, 0xb9, 0xcd, 0xa7, 0xc1, 0x31,
-	0x7f, 0xc3, 0x50, 0x3d, 0xd7, 0x91, 0xdb, 0x6c, 0x1e, 0x9b, 0xbf, 0x61, 0x6a, 0x25, 0x78, 0x4c,
-	0x16, 0x1e, 0xa3, 0xe8, 0xb0, 0xa3, 0x75, 0x2b, 0xd6, 0xd6, 0x87, 0xdf, 0x83, 0x4a, 0x98, 0x2f,
-	0x3f, 0x6a, 0x74, 0xb4, 0x6e, 0xed, 0x9b, 0x56, 0xef, 0x5f, 0x87, 0xd3, 0xdb, 0xdc, 0x87, 0xb5,
-	0x15, 0xc3, 0x01, 0xd8, 0xcf, 0xd7, 0x1d, 0x87, 0x1e, 0x09, 0xd0, 0x23, 0x15, 0xdc, 0xfe, 0x8f,
-	0xe0, 0x9d, 0xb5, 0xb7, 0x6a, 0xc9, 0xce, 0x0d, 0xfc, 0x00, 0x5a, 0xdb, 0xd7, 0x95, 0x22, 0x22,
-	0x2e, 0xc3, 0x61, 0x24, 0x56, 0x9c, 0xb2, 0x08, 0x73, 0x8a, 0x60, 0x47, 0xeb, 0x1e, 0x58, 0x68,
-	0xf3, 0xd2, 0x99, 0x62, 0x9a, 0x0b, 0x4c, 0x0a, 0xbf, 0x03, 0x47, 0x9b, 0x70, 0x47, 0xf8, 0x61,
-	0xc4, 0xe2, 0xf4, 0x7e, 0xd2, 0xc8, 0x4f, 0x54, 0x64, 0x33, 0x67, 0x87, 0xff, 0x90, 0x26, 0x85,
-	0x8f, 0xc1, 0x1e, 0x0b, 0xa8, 0xda, 0xb7, 0x66, 0xb6, 0xa9, 0x2c, 0xa0, 0x17, 0x6c, 0xfd, 0xf5,
-	0xcf, 0x45, 0x00, 0xd2, 0xe3, 0xb0, 0x25, 0x91, 0x49, 0x0c, 0x5b, 0xe0, 0xf1, 0x74, 0x32, 0xb9,
-	0xc4, 0xf6, 0x6c, 0x30, 0x9b, 0xdb, 0x78, 0x3e, 0xb6, 0xa7, 0xc6, 0xd0, 0x7c, 0x61, 0x1a, 0x7a,
-	0xa3, 0x00, 0x8f, 0x00, 0xdc, 0x25, 0x07, 0xc3, 0x99, 0x79, 0x65, 0x34, 0x34, 0x88, 0x40, 0x73,
-	0x17, 0xd7, 0x4d, 0x7b, 0x70, 0x76, 0x69, 0xe8, 0x8d, 0xe2, 0xc7, 0xcc, 0x78, 0x82, 0x5f, 0xcc,
-	0xc7, 0xba, 0xdd, 0x78, 0x00, 0x9f, 0x81, 0x27, 0x1f, 0x32, 0x33, 0x6c, 0x8c, 0x27, 0xf3, 0xd1,
-	0x39, 0xd6, 0x8d, 0x4b, 0x63, 0x34, 0x98, 0x99, 0x93, 0x71, 0xa3, 0x04, 0x3f, 0x03, 0x9f, 0x7e,
-	0xd0, 0xcf, 0x74, 0x64, 0x0d, 0x74, 0x73, 0x3c, 0x6a, 0x3c, 0xfc, 0x38, 0xc3, 0xd5, 0x64, 0x66,
-	0x8e, 0x47, 0x78, 0x3a, 0x79, 0x69, 0x58, 0x78, 0x36, 0x99, 0xe0, 0x73, 0x73, 0x74, 0xde, 0x28,
-	0xc3, 0x13, 0xd0, 0xda, 0x95, 0x19, 0x63, 0x1d, 0x5f, 0x18, 0xd7, 0xd8, 0x32, 0x06, 0xc3, 0x73,
-	0x43, 0x6f, 0xec, 0x1d, 0x97, 0x7e, 0xf9, 0xbd, 0x5d, 0x38, 0x1b, 0xbe, 0xbd, 0x6b, 0x6b, 0xef,
-	0xee, 0xda, 0xda, 0x5f, 0x77, 0x6d, 0xed, 0xd7, 0xfb, 0x76, 0xe1, 0xdd, 0x7d, 0xbb, 0xf0, 0xc7,
-	0x7d, 0xbb, 0xf0, 0xe3, 0x57, 0x2e, 0x97, 0xcb, 0x64, 0xd1, 0x73, 0x84, 0xdf, 0xbf, 0xb8, 0xbe,
-	0x32, 0xc6, 0x4c, 0xbe, 0x16, 0xd1, 0x4d, 0xdf, 0x59, 0x12, 0x1e, 0xf4, 0x6f, 0xb3, 0x3f, 0xb1,
-	0x5c, 0x87, 0x2c, 0x5e, 0x94, 0xd5, 0x9e, 0x7c, 0xfb, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5,
-	0x7e, 0x8c, 0x21, 0xa3, 0x05, 0x00, 0x00,
+func (m *Pool) GetPredecessor() *PoolLink {
+	if m != nil {
+		return m.Predecessor
+	}
+	return nil
 }
 
-func (m *Protocol) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Pool) GetSuccessor() *PoolLink {
+	if m != nil {
+		return m.Successor
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Protocol) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Pool) GetInflationShareWeightRamp() *InflationShareWeightRamp {
+	if m != nil {
+		return m.InflationShareWeightRamp
+	}
+	return nil
 }
 
-func (m *Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpgrade != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.LastUpgrade))
-		i--
-		dAtA[i] = 0x18
+func (m *Pool) GetMaxStakers() uint64 {
+	if m != nil {
+		return m.MaxStakers
 	}
-	if len(m.Binaries) > 0 {
-		i -= len(m.Binaries)
-		copy(dAtA[i:], m.Binaries)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.Binaries)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+// InflationShareWeightRamp is a linear change of the inflation share weight
+// of a pool over a time window
+type InflationShareWeightRamp struct {
+	// from_weight is the inflation share weight at the start of the ramp
+	FromWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=from_weight,json=fromWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"from_weight"`
+	// to_weight is the inflation share weight at the end of the ramp
+	ToWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=to_weight,json=toWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"to_weight"`
+	// start_at is the unix time the ramp starts
+	StartAt uint64 `protobuf:"varint,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// end_at is the unix time the ramp ends
+	EndAt uint64 `protobuf:"varint,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+}
+
+func (m *InflationShareWeightRamp) Reset()         { *m = InflationShareWeightRamp{} }
+func (m *InflationShareWeightRamp) String() string { return proto.CompactTextString(m) }
+func (*InflationShareWeightRamp) ProtoMessage()    {}
+func (*InflationShareWeightRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{4}
+}
+func (m *InflationShareWeightRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationShareWeightRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationShareWeightRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *InflationShareWeightRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationShareWeightRamp.Merge(m, src)
+}
+func (m *InflationShareWeightRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationShareWeightRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationShareWeightRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationShareWeightRamp proto.InternalMessageInfo
+
+func (m *InflationShareWeightRamp) GetStartAt() uint64 {
+	if m != nil {
+		return m.StartAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UpgradePlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InflationShareWeightRamp) GetEndAt() uint64 {
+	if m != nil {
+		return m.EndAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UpgradePlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PoolLink links a pool to its predecessor or successor pool
+type PoolLink struct {
+	// pool_id is the id of the linked pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// index is the current index of the predecessor at the time of the fork
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// key is the current key of the predecessor at the time of the fork
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// forked_at is the unix time the pool was forked
+	ForkedAt uint64 `protobuf:"varint,4,opt,name=forked_at,json=forkedAt,proto3" json:"forked_at,omitempty"`
 }
 
-func (m *UpgradePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Duration != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x20
+func (m *PoolLink) Reset()         { *m = PoolLink{} }
+func (m *PoolLink) String() string { return proto.CompactTextString(m) }
+func (*PoolLink) ProtoMessage()    {}
+func (*PoolLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{5}
+}
+func (m *PoolLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ScheduledAt != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.ScheduledAt))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *PoolLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolLink.Merge(m, src)
+}
+func (m *PoolLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolLink proto.InternalMessageInfo
+
+func (m *PoolLink) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.Binaries) > 0 {
-		i -= len(m.Binaries)
-		copy(dAtA[i:], m.Binaries)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.Binaries)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *PoolLink) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *PoolLink) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolLink) GetForkedAt() uint64 {
+	if m != nil {
+		return m.ForkedAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpgradeReadiness is the signal of a staker that its protocol node has
+// installed the binaries of the scheduled upgrade of a pool
+type UpgradeReadiness struct {
+	// pool_id is the id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the address of the staker which signaled readiness
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// version is the upgrade version the staker is ready for
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// signaled_at is the unix time at which the readiness was signaled
+	SignaledAt uint64 `protobuf:"varint,4,opt,name=signaled_at,json=signaledAt,proto3" json:"signaled_at,omitempty"`
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EndKey) > 0 {
-		i -= len(m.EndKey)
-		copy(dAtA[i:], m.EndKey)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.EndKey)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+func (m *UpgradeReadiness) Reset()         { *m = UpgradeReadiness{} }
+func (m *UpgradeReadiness) String() string { return proto.CompactTextString(m) }
+func (*UpgradeReadiness) ProtoMessage()    {}
+func (*UpgradeReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{6}
+}
+func (m *UpgradeReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.CurrentCompressionId != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.CurrentCompressionId))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+}
+func (m *UpgradeReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeReadiness.Merge(m, src)
+}
+func (m *UpgradeReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeReadiness proto.InternalMessageInfo
+
+func (m *UpgradeReadiness) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.CurrentStorageProviderId != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.CurrentStorageProviderId))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	return 0
+}
+
+func (m *UpgradeReadiness) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	if m.UpgradePlan != nil {
-		{
-			size, err := m.UpgradePlan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPool(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return ""
+}
+
+func (m *UpgradeReadiness) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if m.Protocol != nil {
-		{
-			size, err := m.Protocol.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPool(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return ""
+}
+
+func (m *UpgradeReadiness) GetSignaledAt() uint64 {
+	if m != nil {
+		return m.SignaledAt
 	}
-	if m.Disabled {
-		i--
-		if m.Disabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+// PoolConfigChange is an append-only history entry which records a single
+// change of the configuration of a pool
+type PoolConfigChange struct {
+	// pool_id is the id of the pool which was changed
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// index is the incrementing index of the change within the pool
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// height is the block height at which the change was applied
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp is the unix time at which the change was applied
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// authority is the address which authorized the change. It is empty
+	// if the change was applied by the chain itself, e.g. when a scheduled
+	// runtime upgrade gets performed
+	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	// changed_fields contains all pool fields which were changed
+	ChangedFields []PoolChangedField `protobuf:"bytes,6,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields"`
+}
+
+func (m *PoolConfigChange) Reset()         { *m = PoolConfigChange{} }
+func (m *PoolConfigChange) String() string { return proto.CompactTextString(m) }
+func (*PoolConfigChange) ProtoMessage()    {}
+func (*PoolConfigChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{7}
+}
+func (m *PoolConfigChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolConfigChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolConfigChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.MaxBundleSize != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.MaxBundleSize))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.MinDelegation != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.MinDelegation))
-		i--
-		dAtA[i] = 0x68
+		return b[:n], nil
 	}
-	{
-		size := m.InflationShareWeight.Size()
-		i -= size
-		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPool(dAtA, i, uint64(size))
+}
+func (m *PoolConfigChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolConfigChange.Merge(m, src)
+}
+func (m *PoolConfigChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolConfigChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolConfigChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolConfigChange proto.InternalMessageInfo
+
+func (m *PoolConfigChange) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	i--
-	dAtA[i] = 0x62
-	if m.UploadInterval != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.UploadInterval))
-		i--
-		dAtA[i] = 0x58
+	return 0
+}
+
+func (m *PoolConfigChange) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if m.TotalBundles != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.TotalBundles))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *PoolConfigChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.CurrentIndex != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.CurrentIndex))
-		i--
-		dAtA[i] = 0x48
+	return 0
+}
+
+func (m *PoolConfigChange) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	if len(m.CurrentSummary) > 0 {
-		i -= len(m.CurrentSummary)
-		copy(dAtA[i:], m.CurrentSummary)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.CurrentSummary)))
-		i--
-		dAtA[i] = 0x42
+	return 0
+}
+
+func (m *PoolConfigChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.CurrentKey) > 0 {
-		i -= len(m.CurrentKey)
-		copy(dAtA[i:], m.CurrentKey)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.CurrentKey)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *PoolConfigChange) GetChangedFields() []PoolChangedField {
+	if m != nil {
+		return m.ChangedFields
 	}
-	if len(m.StartKey) > 0 {
-		i -= len(m.StartKey)
-		copy(dAtA[i:], m.StartKey)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.StartKey)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+// PoolChangedField holds the old and the new value of a changed pool field
+type PoolChangedField struct {
+	// field is the name of the field, nested fields are separated by a dot
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// old_value is the stringified value before the change
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// new_value is the stringified value after the change
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *PoolChangedField) Reset()         { *m = PoolChangedField{} }
+func (m *PoolChangedField) String() string { return proto.CompactTextString(m) }
+func (*PoolChangedField) ProtoMessage()    {}
+func (*PoolChangedField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{8}
+}
+func (m *PoolChangedField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolChangedField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolChangedField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Config) > 0 {
-		i -= len(m.Config)
-		copy(dAtA[i:], m.Config)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.Config)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *PoolChangedField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolChangedField.Merge(m, src)
+}
+func (m *PoolChangedField) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolChangedField) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolChangedField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolChangedField proto.InternalMessageInfo
+
+func (m *PoolChangedField) GetField() string {
+	if m != nil {
+		return m.Field
 	}
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.Logo)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *PoolChangedField) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
 	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *PoolChangedField) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPool(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+// FunderPayouts tracks the value of the funder payouts of a pool within
+// the current and the previous funder payouts window
+type FunderPayouts struct {
+	// pool_id is the id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// window_start is the unix time at which the current window started
+	WindowStart uint64 `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// current is the value of all funder payouts within the current window
+	Current uint64 `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	// previous is the value of all funder payouts within the previous window
+	Previous uint64 `protobuf:"varint,4,opt,name=previous,proto3" json:"previous,omitempty"`
+}
+
+func (m *FunderPayouts) Reset()         { *m = FunderPayouts{} }
+func (m *FunderPayouts) String() string { return proto.CompactTextString(m) }
+func (*FunderPayouts) ProtoMessage()    {}
+func (*FunderPayouts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{9}
+}
+func (m *FunderPayouts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderPayouts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderPayouts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *FunderPayouts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderPayouts.Merge(m, src)
+}
+func (m *FunderPayouts) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderPayouts) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderPayouts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunderPayouts proto.InternalMessageInfo
+
+func (m *FunderPayouts) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPool(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FunderPayouts) GetWindowStart() uint64 {
+	if m != nil {
+		return m.WindowStart
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Protocol) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FunderPayouts) GetCurrent() uint64 {
+	if m != nil {
+		return m.Current
 	}
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
+	return 0
+}
+
+func (m *FunderPayouts) GetPrevious() uint64 {
+	if m != nil {
+		return m.Previous
 	}
-	l = len(m.Binaries)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
+	return 0
+}
+
+// PoolInflationAllocation is the share of the protocol inflation a pool
+// receives, split into its static and its dynamic part
+type PoolInflationAllocation struct {
+	// pool_id is the id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// inflation_share_weight is the effective static inflation share weight of the pool
+	InflationShareWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_share_weight,json=inflationShareWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_share_weight"`
+	// demand is the measured demand of the pool, depending on the dynamic
+	// inflation mode either the recent funder payouts or the total delegation
+	Demand uint64 `protobuf:"varint,3,opt,name=demand,proto3" json:"demand,omitempty"`
+	// static_share is the share of the protocol inflation based on the inflation share weight
+	StaticShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=static_share,json=staticShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"static_share"`
+	// dynamic_share is the share of the protocol inflation based on the demand
+	DynamicShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=dynamic_share,json=dynamicShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dynamic_share"`
+	// share is the total share of the protocol inflation the pool receives
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *PoolInflationAllocation) Reset()         { *m = PoolInflationAllocation{} }
+func (m *PoolInflationAllocation) String() string { return proto.CompactTextString(m) }
+func (*PoolInflationAllocation) ProtoMessage()    {}
+func (*PoolInflationAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{10}
+}
+func (m *PoolInflationAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolInflationAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolInflationAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolInflationAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolInflationAllocation.Merge(m, src)
+}
+func (m *PoolInflationAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolInflationAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolInflationAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolInflationAllocation proto.InternalMessageInfo
+
+func (m *PoolInflationAllocation) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolInflationAllocation) GetDemand() uint64 {
+	if m != nil {
+		return m.Demand
+	}
+	return 0
+}
+
+// InflationLedger tracks the cumulative protocol inflation a pool has received
+type InflationLedger struct {
+	// pool_id is the id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// total_received is the total amount of protocol inflation the pool has received
+	TotalReceived uint64 `protobuf:"varint,2,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
+	// total_remainder is the part of total_received which the pool has received
+	// as remainder of the truncated inflation split
+	TotalRemainder uint64 `protobuf:"varint,3,opt,name=total_remainder,json=totalRemainder,proto3" json:"total_remainder,omitempty"`
+	// last_received is the amount of protocol inflation the pool received at the last height
+	LastReceived uint64 `protobuf:"varint,4,opt,name=last_received,json=lastReceived,proto3" json:"last_received,omitempty"`
+	// last_height is the last block height at which the pool received protocol inflation
+	LastHeight int64 `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *InflationLedger) Reset()         { *m = InflationLedger{} }
+func (m *InflationLedger) String() string { return proto.CompactTextString(m) }
+func (*InflationLedger) ProtoMessage()    {}
+func (*InflationLedger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{11}
+}
+func (m *InflationLedger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationLedger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationLedger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationLedger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationLedger.Merge(m, src)
+}
+func (m *InflationLedger) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationLedger) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationLedger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationLedger proto.InternalMessageInfo
+
+func (m *InflationLedger) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *InflationLedger) GetTotalReceived() uint64 {
+	if m != nil {
+		return m.TotalReceived
+	}
+	return 0
+}
+
+func (m *InflationLedger) GetTotalRemainder() uint64 {
+	if m != nil {
+		return m.TotalRemainder
+	}
+	return 0
+}
+
+func (m *InflationLedger) GetLastReceived() uint64 {
+	if m != nil {
+		return m.LastReceived
+	}
+	return 0
+}
+
+func (m *InflationLedger) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("kyve.pool.v1beta1.PoolStatus", PoolStatus_name, PoolStatus_value)
+	proto.RegisterType((*Protocol)(nil), "kyve.pool.v1beta1.Protocol")
+	proto.RegisterType((*RuntimeBinary)(nil), "kyve.pool.v1beta1.RuntimeBinary")
+	proto.RegisterType((*UpgradePlan)(nil), "kyve.pool.v1beta1.UpgradePlan")
+	proto.RegisterType((*Pool)(nil), "kyve.pool.v1beta1.Pool")
+	proto.RegisterType((*InflationShareWeightRamp)(nil), "kyve.pool.v1beta1.InflationShareWeightRamp")
+	proto.RegisterType((*PoolLink)(nil), "kyve.pool.v1beta1.PoolLink")
+	proto.RegisterType((*UpgradeReadiness)(nil), "kyve.pool.v1beta1.UpgradeReadiness")
+	proto.RegisterType((*PoolConfigChange)(nil), "kyve.pool.v1beta1.PoolConfigChange")
+	proto.RegisterType((*PoolChangedField)(nil), "kyve.pool.v1beta1.PoolChangedField")
+	proto.RegisterType((*FunderPayouts)(nil), "kyve.pool.v1beta1.FunderPayouts")
+	proto.RegisterType((*PoolInflationAllocation)(nil), "kyve.pool.v1beta1.PoolInflationAllocation")
+	proto.RegisterType((*InflationLedger)(nil), "kyve.pool.v1beta1.InflationLedger")
+}
+
+func init() { proto.RegisterFile("kyve/pool/v1beta1/pool.proto", fileDescriptor_40c1730f47ff2ef8) }
+
+var fileDescriptor_40c1730f47ff2ef8 = []byte{
+	// 1621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x73, 0x1b, 0x49,
+	0x15, 0xf7, 0xc8, 0xb2, 0x2c, 0x3d, 0xf9, 0x43, 0xe9, 0x75, 0x9c, 0x49, 0xbc, 0xd8, 0x8e, 0x52,
+	0x01, 0xc3, 0x16, 0x76, 0x6d, 0xf8, 0xaa, 0x3d, 0x6c, 0x15, 0xb2, 0x25, 0xdb, 0xaa, 0xb8, 0x24,
+	0x31, 0x92, 0xb3, 0x15, 0x2e, 0x53, 0xed, 0xe9, 0xb6, 0x34, 0x78, 0x66, 0x5a, 0xd5, 0xdd, 0x63,
+	0x5b, 0x7b, 0xa0, 0x8a, 0x13, 0x1c, 0xf9, 0x07, 0x38, 0xf1, 0x3f, 0x70, 0xe6, 0x98, 0xe3, 0x1e,
+	0x38, 0x50, 0x1c, 0x52, 0x90, 0x1c, 0xb8, 0xf3, 0x17, 0x50, 0xfd, 0x31, 0x63, 0x39, 0x58, 0xac,
+	0x21, 0xb7, 0x79, 0xbf, 0xdf, 0x7b, 0xaf, 0xbb, 0x5f, 0xbf, 0x8f, 0x1e, 0xf8, 0xf4, 0x62, 0x72,
+	0x49, 0xf7, 0xc6, 0x8c, 0x45, 0x7b, 0x97, 0x9f, 0x9f, 0x51, 0x89, 0x3f, 0xd7, 0xc2, 0xee, 0x98,
+	0x33, 0xc9, 0xd0, 0x03, 0xc5, 0xee, 0x6a, 0xc0, 0xb2, 0x4f, 0xd6, 0x86, 0x6c, 0xc8, 0x34, 0xbb,
+	0xa7, 0xbe, 0x8c, 0x62, 0xfd, 0x4f, 0x0e, 0x94, 0x7b, 0xea, 0x2b, 0x60, 0x11, 0x72, 0x61, 0xf1,
+	0x92, 0x72, 0x11, 0xb2, 0xc4, 0x75, 0xb6, 0x9d, 0x9d, 0x8a, 0x97, 0x89, 0xe8, 0x09, 0x94, 0xcf,
+	0xc2, 0x04, 0xf3, 0x90, 0x0a, 0xb7, 0xa0, 0xa9, 0x5c, 0x46, 0x4f, 0x61, 0x29, 0xc2, 0x42, 0xfa,
+	0xe9, 0x78, 0xc8, 0x31, 0xa1, 0xee, 0xfc, 0xb6, 0xb3, 0x53, 0xf4, 0xaa, 0x0a, 0x3b, 0x35, 0x10,
+	0xfa, 0x05, 0xd4, 0x78, 0x9a, 0xc8, 0x30, 0xa6, 0x7e, 0xee, 0xa6, 0xb8, 0x3d, 0xbf, 0x53, 0x7d,
+	0xb1, 0xbd, 0xfb, 0x1f, 0x3b, 0xdd, 0xf5, 0x8c, 0xea, 0xbe, 0xd2, 0x9c, 0xec, 0x17, 0xdf, 0xbc,
+	0xdd, 0x9a, 0xf3, 0x56, 0xf9, 0x14, 0x18, 0x52, 0x51, 0x3f, 0x85, 0xe5, 0x5b, 0x7a, 0x6a, 0x8b,
+	0xe3, 0x08, 0xcb, 0x73, 0xc6, 0x63, 0xbb, 0xfb, 0x5c, 0x46, 0x35, 0x98, 0x4f, 0x79, 0x64, 0x77,
+	0xae, 0x3e, 0xd1, 0x3a, 0x94, 0xc4, 0x08, 0xbf, 0xf8, 0xc9, 0x4f, 0xf5, 0x76, 0x2b, 0x9e, 0x95,
+	0xea, 0xbf, 0x9d, 0x87, 0xaa, 0xdd, 0x75, 0x2f, 0xc2, 0xc9, 0xff, 0x1f, 0x12, 0x11, 0x8c, 0x28,
+	0x49, 0x23, 0x4a, 0x7c, 0x2c, 0xb3, 0x90, 0xe4, 0x58, 0x43, 0x2a, 0x73, 0x92, 0x72, 0x2c, 0x95,
+	0xe7, 0xa2, 0xa6, 0x73, 0x19, 0x0d, 0xe0, 0x13, 0x4e, 0x31, 0x09, 0x13, 0x2a, 0x84, 0x2f, 0x47,
+	0x9c, 0x8a, 0x11, 0x8b, 0x88, 0xbb, 0xa0, 0x56, 0xd9, 0x7f, 0xf6, 0xe6, 0xed, 0x96, 0xf3, 0xb7,
+	0xb7, 0x5b, 0x1b, 0x01, 0x13, 0x31, 0x13, 0x82, 0x5c, 0xec, 0x86, 0x6c, 0x2f, 0xc6, 0x72, 0xb4,
+	0x7b, 0x42, 0x87, 0x38, 0x98, 0x34, 0x69, 0xe0, 0xa1, 0xdc, 0x7e, 0x90, 0x99, 0xa3, 0x1f, 0xc2,
+	0x0d, 0xea, 0x13, 0x8a, 0x49, 0x14, 0x26, 0xd4, 0x2d, 0xe9, 0xb5, 0x1f, 0xe4, 0x4c, 0xd3, 0x12,
+	0xe8, 0x33, 0xb8, 0x01, 0x7d, 0x4e, 0xb1, 0xda, 0xbb, 0xbb, 0xb8, 0xed, 0xec, 0x94, 0xbd, 0x5a,
+	0x4e, 0x78, 0x06, 0xbf, 0xf3, 0x82, 0xcb, 0x1f, 0x77, 0xc1, 0xff, 0x28, 0x43, 0xb1, 0xc7, 0x58,
+	0x84, 0x56, 0xa0, 0x10, 0x12, 0x1d, 0xfd, 0xa2, 0x57, 0x08, 0x09, 0x42, 0x50, 0x4c, 0x70, 0x4c,
+	0x6d, 0xd0, 0xf5, 0xb7, 0xba, 0x26, 0x6b, 0x6f, 0xef, 0x33, 0x13, 0x95, 0x76, 0xc4, 0x86, 0x4c,
+	0xc7, 0xb8, 0xe2, 0xe9, 0x6f, 0x75, 0xf9, 0x01, 0x4b, 0xce, 0xc3, 0xa1, 0x09, 0xa9, 0x67, 0x25,
+	0xb4, 0x01, 0x15, 0x21, 0x31, 0x97, 0xfe, 0x05, 0x9d, 0xe8, 0xc0, 0x54, 0xbc, 0xb2, 0x06, 0x5e,
+	0xd2, 0x09, 0xda, 0x82, 0x6a, 0x90, 0x72, 0x4e, 0x13, 0x43, 0x2f, 0x6a, 0x1a, 0x2c, 0xa4, 0x14,
+	0xbe, 0x07, 0xab, 0x99, 0x82, 0x48, 0xe3, 0x18, 0xf3, 0x89, 0x5b, 0xd6, 0x4a, 0x2b, 0x16, 0xee,
+	0x1b, 0x14, 0x3d, 0x83, 0xe5, 0x4c, 0x31, 0x4c, 0x08, 0xbd, 0x76, 0x2b, 0xfa, 0x6c, 0x4b, 0x16,
+	0x6c, 0x2b, 0x4c, 0x29, 0x49, 0x26, 0x71, 0xe4, 0x9f, 0xa5, 0x09, 0x89, 0xa8, 0x70, 0xc1, 0x28,
+	0x69, 0x70, 0xdf, 0x60, 0x6a, 0xc9, 0x74, 0x1c, 0x31, 0x4c, 0xfc, 0x30, 0x91, 0x94, 0x5f, 0xe2,
+	0xc8, 0xad, 0x6a, 0xb5, 0x15, 0x03, 0xb7, 0x2d, 0x8a, 0x5e, 0xc3, 0x7a, 0x98, 0x9c, 0x47, 0x3a,
+	0xbd, 0x7c, 0x31, 0xc2, 0x9c, 0xfa, 0x57, 0x34, 0x1c, 0x8e, 0xa4, 0xbb, 0x94, 0x27, 0xd5, 0xdc,
+	0xb7, 0x25, 0xd5, 0x5a, 0xee, 0xa2, 0xaf, 0x3c, 0x7c, 0xa5, 0x1d, 0xa0, 0xe7, 0xb0, 0x12, 0x87,
+	0x89, 0x4f, 0x68, 0x44, 0x87, 0x9a, 0x74, 0x97, 0xf5, 0x16, 0x96, 0xe3, 0x30, 0x69, 0xe6, 0x20,
+	0xfa, 0x2e, 0xac, 0xc6, 0xf8, 0xda, 0x9e, 0xc6, 0x17, 0xe1, 0xd7, 0xd4, 0x5d, 0xb1, 0x7a, 0xf8,
+	0xda, 0x9c, 0xa7, 0x1f, 0x7e, 0x4d, 0x75, 0x5d, 0x84, 0x02, 0x9f, 0x45, 0x94, 0xb8, 0xab, 0x3a,
+	0xdb, 0x72, 0x19, 0xfd, 0x0c, 0xca, 0x63, 0xdb, 0xab, 0xdc, 0xda, 0xb6, 0xb3, 0x53, 0x7d, 0xb1,
+	0x71, 0x47, 0x76, 0x65, 0xed, 0xcc, 0xcb, 0x95, 0x51, 0x03, 0x96, 0x6c, 0x77, 0xf2, 0xc7, 0x11,
+	0x4e, 0xdc, 0x07, 0xda, 0x78, 0xf3, 0x0e, 0xe3, 0xa9, 0xda, 0xf7, 0xaa, 0xe9, 0x8d, 0x80, 0xbe,
+	0x84, 0x8d, 0xfc, 0x76, 0x25, 0xe3, 0x78, 0x48, 0xfd, 0x31, 0x67, 0x97, 0x21, 0xa1, 0xdc, 0x0f,
+	0x89, 0x8b, 0xb6, 0x9d, 0x9d, 0x65, 0xcf, 0xcd, 0x6e, 0xda, 0x68, 0xf4, 0xac, 0x42, 0x9b, 0xa0,
+	0x1f, 0xc3, 0x7a, 0x66, 0x1e, 0xb0, 0x78, 0xcc, 0xa9, 0x50, 0x4d, 0x44, 0x59, 0x7e, 0xa2, 0x2d,
+	0xd7, 0x2c, 0x7b, 0x70, 0x43, 0xb6, 0x09, 0x7a, 0x04, 0x8b, 0x34, 0x21, 0x3a, 0xdf, 0xd6, 0x4c,
+	0xa6, 0xd2, 0x84, 0xa8, 0x5c, 0xfb, 0x0e, 0xc0, 0x18, 0xa7, 0x82, 0xfa, 0xe7, 0x9c, 0xc5, 0xee,
+	0x43, 0x1d, 0xc8, 0x8a, 0x46, 0x0e, 0x39, 0x8b, 0x55, 0xae, 0x1a, 0x5a, 0x15, 0x41, 0xe4, 0xae,
+	0x6b, 0xde, 0x58, 0x9c, 0x2a, 0x04, 0x7d, 0x09, 0xd5, 0x31, 0xa7, 0x84, 0x06, 0x54, 0x08, 0xc6,
+	0xdd, 0x47, 0xb3, 0x83, 0xc9, 0x58, 0x74, 0x12, 0x26, 0x17, 0xde, 0xb4, 0x3e, 0xfa, 0x02, 0x2a,
+	0x22, 0x0d, 0xac, 0xb1, 0xfb, 0xed, 0xc6, 0x37, 0xda, 0xe8, 0x57, 0xb0, 0x71, 0x77, 0x26, 0xfa,
+	0x1c, 0xc7, 0x63, 0xf7, 0xb1, 0x76, 0xf6, 0xd9, 0x1d, 0xce, 0xda, 0x77, 0x24, 0x9f, 0x87, 0xe3,
+	0xb1, 0xe7, 0x86, 0x33, 0x18, 0x15, 0x06, 0x95, 0x73, 0x42, 0xe2, 0x0b, 0xca, 0x85, 0xfb, 0xc4,
+	0x84, 0x21, 0xc6, 0xd7, 0x7d, 0x83, 0xd4, 0xff, 0xe2, 0x80, 0x3b, 0xcb, 0x2f, 0x6a, 0x42, 0x55,
+	0x45, 0x37, 0x2b, 0x14, 0xe7, 0xfe, 0x85, 0x02, 0xca, 0xce, 0x96, 0xc7, 0xcf, 0xa1, 0x22, 0x59,
+	0xe6, 0xa3, 0x70, 0x7f, 0x1f, 0x65, 0xc9, 0xac, 0x87, 0xc7, 0x60, 0x9a, 0xd0, 0xcd, 0x20, 0x59,
+	0xd4, 0x72, 0x43, 0xa2, 0x87, 0xa0, 0x12, 0x42, 0x11, 0x66, 0x84, 0x2c, 0xd0, 0x84, 0x34, 0x64,
+	0x7d, 0x04, 0xe5, 0x2c, 0xf4, 0x2a, 0x85, 0x54, 0x18, 0xfd, 0xbc, 0x85, 0x96, 0x94, 0xd8, 0x26,
+	0x68, 0x0d, 0x16, 0x4c, 0xf7, 0x29, 0x18, 0x53, 0x2d, 0xa8, 0x49, 0xa9, 0xb2, 0xcd, 0x34, 0x51,
+	0xf5, 0xa9, 0x9a, 0xe2, 0x39, 0xe3, 0x17, 0x74, 0x6a, 0x99, 0xb2, 0x01, 0x1a, 0xb2, 0xfe, 0x6b,
+	0xa8, 0xd9, 0x8a, 0xf1, 0xb2, 0x91, 0x30, 0x7b, 0x45, 0x35, 0x73, 0x75, 0xe0, 0x6d, 0xeb, 0xb6,
+	0xd2, 0xf4, 0x8c, 0x9d, 0xbf, 0x3d, 0x63, 0xb7, 0xa0, 0x2a, 0xc2, 0x61, 0x82, 0xa3, 0xe9, 0xd5,
+	0x21, 0x83, 0x1a, 0xb2, 0xfe, 0x4f, 0x07, 0x6a, 0xea, 0xa8, 0x07, 0xba, 0x81, 0x1f, 0x8c, 0x70,
+	0x32, 0xa4, 0xff, 0xeb, 0x91, 0xd7, 0xa1, 0x34, 0x32, 0xd7, 0xa3, 0x56, 0x9f, 0xf7, 0xac, 0x84,
+	0x3e, 0x85, 0x8a, 0x9a, 0x20, 0x42, 0xaa, 0xbc, 0x34, 0x4b, 0xdf, 0x00, 0x8a, 0xc5, 0xa9, 0x1c,
+	0x31, 0x1e, 0xca, 0x89, 0x1d, 0x23, 0x37, 0x00, 0xea, 0xc1, 0x4a, 0xa0, 0x37, 0x43, 0xfc, 0xf3,
+	0x90, 0x46, 0x44, 0xb8, 0x25, 0x3d, 0x0d, 0x9f, 0xcd, 0xa8, 0x12, 0xb3, 0x73, 0x72, 0xa8, 0x74,
+	0xed, 0x40, 0x5c, 0x0e, 0xa6, 0x30, 0x51, 0x3f, 0xb3, 0x07, 0x9d, 0x02, 0xd5, 0x79, 0xb4, 0x77,
+	0xfb, 0x34, 0x31, 0x82, 0xba, 0x30, 0x16, 0x11, 0xff, 0x12, 0x47, 0x69, 0x36, 0x24, 0xcb, 0x2c,
+	0x22, 0xaf, 0x94, 0xac, 0xc8, 0x84, 0x5e, 0x59, 0xd2, 0x44, 0xbb, 0x9c, 0xd0, 0x2b, 0x4d, 0xd6,
+	0x7f, 0xe3, 0xc0, 0xf2, 0x61, 0x9a, 0x10, 0xca, 0x7b, 0x78, 0xc2, 0x52, 0xf9, 0x5f, 0xee, 0xf2,
+	0x29, 0x2c, 0x5d, 0x85, 0x09, 0x61, 0x57, 0xbe, 0xce, 0x45, 0x1b, 0xd1, 0xaa, 0xc1, 0xfa, 0x0a,
+	0x52, 0xd7, 0x6a, 0x9b, 0x5a, 0x96, 0xb6, 0x56, 0xd4, 0x4f, 0x35, 0x4e, 0x2f, 0x43, 0x96, 0x8a,
+	0x2c, 0xa3, 0x32, 0xb9, 0xfe, 0xaf, 0x02, 0x3c, 0x52, 0x07, 0xcd, 0xcb, 0xb2, 0x11, 0x45, 0x2c,
+	0xd0, 0x5f, 0xb3, 0x77, 0x33, 0x7b, 0xbc, 0x15, 0x3e, 0x76, 0xbc, 0xad, 0x43, 0x89, 0xd0, 0x18,
+	0x27, 0xc4, 0x1e, 0xc2, 0x4a, 0xe8, 0x10, 0x96, 0x84, 0xc4, 0x32, 0x0c, 0xcc, 0x7a, 0x6e, 0xf1,
+	0xfe, 0x0b, 0x55, 0x8d, 0xa1, 0x5e, 0x05, 0x1d, 0xc3, 0x32, 0x99, 0x24, 0x38, 0xce, 0x1d, 0x2d,
+	0xdc, 0xdf, 0xd1, 0x92, 0xb5, 0x34, 0x9e, 0xbe, 0x80, 0x05, 0xe3, 0xa1, 0x74, 0x7f, 0x0f, 0xc6,
+	0xa2, 0xfe, 0x67, 0x07, 0x56, 0xf3, 0x80, 0x9f, 0x50, 0x32, 0xa4, 0x7c, 0x76, 0xb0, 0x9f, 0xc3,
+	0x8a, 0x79, 0x99, 0x70, 0x1a, 0xd0, 0xf0, 0x92, 0x12, 0x7b, 0xf9, 0xe6, 0xbd, 0xe2, 0x59, 0x50,
+	0xbd, 0x4d, 0x32, 0xb5, 0x18, 0xab, 0x4a, 0xe3, 0x36, 0x82, 0x2b, 0x56, 0xcf, 0xa2, 0xea, 0xa5,
+	0xa3, 0xff, 0x1f, 0x72, 0x77, 0x26, 0x25, 0xf4, 0x4f, 0x45, 0xee, 0x6d, 0x0b, 0xf4, 0x0f, 0x85,
+	0x6f, 0x2b, 0x75, 0x41, 0x57, 0x2a, 0x28, 0xe8, 0x58, 0x23, 0x3f, 0xf8, 0x43, 0x01, 0x40, 0xe5,
+	0x4d, 0x5f, 0x62, 0x99, 0x0a, 0xb4, 0x01, 0x8f, 0x7a, 0xdd, 0xee, 0x89, 0xdf, 0x1f, 0x34, 0x06,
+	0xa7, 0x7d, 0xff, 0xb4, 0xd3, 0xef, 0xb5, 0x0e, 0xda, 0x87, 0xed, 0x56, 0xb3, 0x36, 0x87, 0xd6,
+	0x01, 0x4d, 0x93, 0x8d, 0x83, 0x41, 0xfb, 0x55, 0xab, 0xe6, 0x20, 0x17, 0xd6, 0xa6, 0xf1, 0x66,
+	0xbb, 0xdf, 0xd8, 0x3f, 0x69, 0x35, 0x6b, 0x85, 0x0f, 0x99, 0x4e, 0xd7, 0x3f, 0x3c, 0xed, 0x34,
+	0xfb, 0xb5, 0x79, 0xf4, 0x1c, 0x9e, 0xde, 0x66, 0x06, 0x7e, 0xab, 0xd3, 0x3d, 0x3d, 0x3a, 0xf6,
+	0x9b, 0xad, 0x93, 0xd6, 0x51, 0x63, 0xd0, 0xee, 0x76, 0x6a, 0x45, 0xf4, 0x18, 0x1e, 0xde, 0xda,
+	0x4f, 0xef, 0xc8, 0x6b, 0x34, 0xdb, 0x9d, 0xa3, 0xda, 0xc2, 0x87, 0x1e, 0x5e, 0x75, 0x07, 0xed,
+	0xce, 0x91, 0xdf, 0xeb, 0x7e, 0xd5, 0xf2, 0xfc, 0x41, 0xb7, 0xeb, 0x1f, 0xb7, 0x8f, 0x8e, 0x6b,
+	0x25, 0xb4, 0x05, 0x1b, 0xd3, 0x6a, 0xad, 0x4e, 0xd3, 0x7f, 0xd9, 0x7a, 0xed, 0x7b, 0xad, 0xc6,
+	0xc1, 0x71, 0xab, 0x59, 0x5b, 0xfc, 0xf0, 0x54, 0xbd, 0xc6, 0x69, 0xbf, 0xd5, 0xac, 0x95, 0x9f,
+	0x14, 0x7f, 0xf7, 0xc7, 0xcd, 0xb9, 0xfd, 0x83, 0x37, 0xef, 0x36, 0x9d, 0x6f, 0xde, 0x6d, 0x3a,
+	0x7f, 0x7f, 0xb7, 0xe9, 0xfc, 0xfe, 0xfd, 0xe6, 0xdc, 0x37, 0xef, 0x37, 0xe7, 0xfe, 0xfa, 0x7e,
+	0x73, 0xee, 0x97, 0xdf, 0x1f, 0x86, 0x72, 0x94, 0x9e, 0xed, 0x06, 0x2c, 0xde, 0x7b, 0xf9, 0xfa,
+	0x55, 0xab, 0x43, 0xe5, 0x15, 0xe3, 0x17, 0x7b, 0xc1, 0x08, 0x87, 0xc9, 0xde, 0xb5, 0xf9, 0xc7,
+	0x94, 0x93, 0x31, 0x15, 0x67, 0x25, 0xfd, 0xa2, 0xfa, 0xd1, 0xbf, 0x07, 0x00, 0x66, 0xb9, 0xe4,
+	0xfb, 0x7d, 0x0e, 0x00, 0x00,
+}
+
+func (m *Protocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Protocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RuntimeBinaries) > 0 {
+		for iNdEx := len(m.RuntimeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if m.LastUpgrade != 0 {
-		n += 1 + sovPool(uint64(m.LastUpgrade))
+		i = encodeVarintPool(dAtA, i, uint64(m.LastUpgrade))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpgradePlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RuntimeBinary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RuntimeBinary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuntimeBinary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Binaries)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ScheduledAt != 0 {
-		n += 1 + sovPool(uint64(m.ScheduledAt))
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RuntimeBinaries) > 0 {
+		for iNdEx := len(m.RuntimeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ReadinessReached {
+		i--
+		if m.ReadinessReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReadinessDeadline != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.ReadinessDeadline))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReadinessThreshold != nil {
+		{
+			size := m.ReadinessThreshold.Size()
+			i -= size
+			if _, err := m.ReadinessThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.Duration != 0 {
-		n += 1 + sovPool(uint64(m.Duration))
+		i = encodeVarintPool(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledAt != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxStakers != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MaxStakers))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.InflationShareWeightRamp != nil {
+		{
+			size, err := m.InflationShareWeightRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.Successor != nil {
+		{
+			size, err := m.Successor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.Predecessor != nil {
+		{
+			size, err := m.Predecessor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.PauseUntil != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PauseUntil))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.PauseFrom != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PauseFrom))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.CurrentCompressionId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.CurrentCompressionId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CurrentStorageProviderId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.CurrentStorageProviderId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.UpgradePlan != nil {
+		{
+			size, err := m.UpgradePlan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Protocol != nil {
+		{
+			size, err := m.Protocol.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MaxBundleSize))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MinDelegation != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MinDelegation))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.InflationShareWeight.Size()
+		i -= size
+		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.UploadInterval != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.UploadInterval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TotalBundles != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TotalBundles))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CurrentIndex != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.CurrentIndex))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CurrentSummary) > 0 {
+		i -= len(m.CurrentSummary)
+		copy(dAtA[i:], m.CurrentSummary)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.CurrentSummary)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CurrentKey) > 0 {
+		i -= len(m.CurrentKey)
+		copy(dAtA[i:], m.CurrentKey)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.CurrentKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationShareWeightRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationShareWeightRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationShareWeightRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndAt != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartAt != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.StartAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ToWeight.Size()
+		i -= size
+		if _, err := m.ToWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.FromWeight.Size()
+		i -= size
+		if _, err := m.FromWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForkedAt != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.ForkedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignaledAt != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.SignaledAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolConfigChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolConfigChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolConfigChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangedFields) > 0 {
+		for iNdEx := len(m.ChangedFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangedFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolChangedField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolChangedField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolChangedField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunderPayouts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderPayouts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderPayouts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Previous != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Previous))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Current != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Current))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolInflationAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolInflationAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolInflationAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DynamicShare.Size()
+		i -= size
+		if _, err := m.DynamicShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.StaticShare.Size()
+		i -= size
+		if _, err := m.StaticShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Demand != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Demand))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.InflationShareWeight.Size()
+		i -= size
+		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationLedger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationLedger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationLedger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastReceived != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.LastReceived))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalRemainder != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TotalRemainder))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalReceived != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TotalReceived))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Protocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.LastUpgrade != 0 {
+		n += 1 + sovPool(uint64(m.LastUpgrade))
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for _, e := range m.RuntimeBinaries {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RuntimeBinary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovPool(uint64(m.ScheduledAt))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovPool(uint64(m.Duration))
+	}
+	if m.ReadinessThreshold != nil {
+		l = m.ReadinessThreshold.Size()
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.ReadinessDeadline != 0 {
+		n += 1 + sovPool(uint64(m.ReadinessDeadline))
+	}
+	if m.ReadinessReached {
+		n += 2
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for _, e := range m.RuntimeBinaries {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPool(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.CurrentKey)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.CurrentSummary)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.CurrentIndex != 0 {
+		n += 1 + sovPool(uint64(m.CurrentIndex))
+	}
+	if m.TotalBundles != 0 {
+		n += 1 + sovPool(uint64(m.TotalBundles))
+	}
+	if m.UploadInterval != 0 {
+		n += 1 + sovPool(uint64(m.UploadInterval))
+	}
+	l = m.InflationShareWeight.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.MinDelegation != 0 {
+		n += 1 + sovPool(uint64(m.MinDelegation))
+	}
+	if m.MaxBundleSize != 0 {
+		n += 1 + sovPool(uint64(m.MaxBundleSize))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.Protocol != nil {
+		l = m.Protocol.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.UpgradePlan != nil {
+		l = m.UpgradePlan.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.CurrentStorageProviderId != 0 {
+		n += 2 + sovPool(uint64(m.CurrentStorageProviderId))
+	}
+	if m.CurrentCompressionId != 0 {
+		n += 2 + sovPool(uint64(m.CurrentCompressionId))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.PauseFrom != 0 {
+		n += 2 + sovPool(uint64(m.PauseFrom))
+	}
+	if m.PauseUntil != 0 {
+		n += 2 + sovPool(uint64(m.PauseUntil))
+	}
+	if m.Predecessor != nil {
+		l = m.Predecessor.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.Successor != nil {
+		l = m.Successor.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.InflationShareWeightRamp != nil {
+		l = m.InflationShareWeightRamp.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.MaxStakers != 0 {
+		n += 2 + sovPool(uint64(m.MaxStakers))
+	}
+	return n
+}
+
+func (m *InflationShareWeightRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FromWeight.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.ToWeight.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.StartAt != 0 {
+		n += 1 + sovPool(uint64(m.StartAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovPool(uint64(m.EndAt))
+	}
+	return n
+}
+
+func (m *PoolLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPool(uint64(m.Index))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.ForkedAt != 0 {
+		n += 1 + sovPool(uint64(m.ForkedAt))
+	}
+	return n
+}
+
+func (m *UpgradeReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.SignaledAt != 0 {
+		n += 1 + sovPool(uint64(m.SignaledAt))
+	}
+	return n
+}
+
+func (m *PoolConfigChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPool(uint64(m.Index))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPool(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPool(uint64(m.Timestamp))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, e := range m.ChangedFields {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolChangedField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	return n
+}
+
+func (m *FunderPayouts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovPool(uint64(m.WindowStart))
+	}
+	if m.Current != 0 {
+		n += 1 + sovPool(uint64(m.Current))
+	}
+	if m.Previous != 0 {
+		n += 1 + sovPool(uint64(m.Previous))
+	}
+	return n
+}
+
+func (m *PoolInflationAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	l = m.InflationShareWeight.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.Demand != 0 {
+		n += 1 + sovPool(uint64(m.Demand))
+	}
+	l = m.StaticShare.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.DynamicShare.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.Share.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *InflationLedger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	if m.TotalReceived != 0 {
+		n += 1 + sovPool(uint64(m.TotalReceived))
+	}
+	if m.TotalRemainder != 0 {
+		n += 1 + sovPool(uint64(m.TotalRemainder))
+	}
+	if m.LastReceived != 0 {
+		n += 1 + sovPool(uint64(m.LastReceived))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovPool(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Protocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Protocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpgrade", wireType)
+			}
+			m.LastUpgrade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpgrade |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeBinaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeBinaries = append(m.RuntimeBinaries, RuntimeBinary{})
+			if err := m.RuntimeBinaries[len(m.RuntimeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeBinary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeBinary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeBinary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.ReadinessThreshold = &v
+			if err := m.ReadinessThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessDeadline", wireType)
+			}
+			m.ReadinessDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadinessDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadinessReached = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeBinaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeBinaries = append(m.RuntimeBinaries, RuntimeBinary{})
+			if err := m.RuntimeBinaries[len(m.RuntimeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSummary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentSummary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentIndex", wireType)
+			}
+			m.CurrentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBundles", wireType)
+			}
+			m.TotalBundles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBundles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationShareWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationShareWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			m.MinDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Protocol == nil {
+				m.Protocol = &Protocol{}
+			}
+			if err := m.Protocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpgradePlan == nil {
+				m.UpgradePlan = &UpgradePlan{}
+			}
+			if err := m.UpgradePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStorageProviderId", wireType)
+			}
+			m.CurrentStorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentStorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentCompressionId", wireType)
+			}
+			m.CurrentCompressionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentCompressionId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseFrom", wireType)
+			}
+			m.PauseFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseFrom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseUntil", wireType)
+			}
+			m.PauseUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Predecessor == nil {
+				m.Predecessor = &PoolLink{}
+			}
+			if err := m.Predecessor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Successor == nil {
+				m.Successor = &PoolLink{}
+			}
+			if err := m.Successor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationShareWeightRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InflationShareWeightRamp == nil {
+				m.InflationShareWeightRamp = &InflationShareWeightRamp{}
+			}
+			if err := m.InflationShareWeightRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakers", wireType)
+			}
+			m.MaxStakers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovPool(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
-	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
-	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
-	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
-	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
-	}
-	l = len(m.CurrentKey)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
-	}
-	l = len(m.CurrentSummary)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
-	}
-	if m.CurrentIndex != 0 {
-		n += 1 + sovPool(uint64(m.CurrentIndex))
-	}
-	if m.TotalBundles != 0 {
-		n += 1 + sovPool(uint64(m.TotalBundles))
-	}
-	if m.UploadInterval != 0 {
-		n += 1 + sovPool(uint64(m.UploadInterval))
-	}
-	l = m.InflationShareWeight.Size()
-	n += 1 + l + sovPool(uint64(l))
-	if m.MinDelegation != 0 {
-		n += 1 + sovPool(uint64(m.MinDelegation))
-	}
-	if m.MaxBundleSize != 0 {
-		n += 1 + sovPool(uint64(m.MaxBundleSize))
-	}
-	if m.Disabled {
-		n += 2
-	}
-	if m.Protocol != nil {
-		l = m.Protocol.Size()
-		n += 2 + l + sovPool(uint64(l))
-	}
-	if m.UpgradePlan != nil {
-		l = m.UpgradePlan.Size()
-		n += 2 + l + sovPool(uint64(l))
-	}
-	if m.CurrentStorageProviderId != 0 {
-		n += 2 + sovPool(uint64(m.CurrentStorageProviderId))
-	}
-	if m.CurrentCompressionId != 0 {
-		n += 2 + sovPool(uint64(m.CurrentCompressionId))
-	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 2 + l + sovPool(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPool(x uint64) (n int) {
-	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Protocol) Unmarshal(dAtA []byte) error {
+func (m *InflationShareWeightRamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,15 +3895,49 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Protocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationShareWeightRamp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationShareWeightRamp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -966,11 +3965,139 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			if err := m.ToWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			}
+			m.StartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			m.EndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -998,13 +4125,13 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkedAt", wireType)
 			}
-			m.LastUpgrade = 0
+			m.ForkedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1014,7 +4141,7 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpgrade |= uint64(b&0x7F) << shift
+				m.ForkedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1040,7 +4167,7 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
+func (m *UpgradeReadiness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1063,17 +4190,17 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradePlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeReadiness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1083,27 +4210,14 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPool
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPool
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1131,13 +4245,13 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.ScheduledAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1147,16 +4261,29 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledAt |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignaledAt", wireType)
 			}
-			m.Duration = 0
+			m.SignaledAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1166,7 +4293,7 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
+				m.SignaledAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1192,7 +4319,7 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *PoolConfigChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1215,17 +4342,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolConfigChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolConfigChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1235,16 +4362,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1254,27 +4381,52 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPool
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPool
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1302,13 +4454,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1318,27 +4470,79 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
+			m.ChangedFields = append(m.ChangedFields, PoolChangedField{})
+			if err := m.ChangedFields[len(m.ChangedFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolChangedField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolChangedField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolChangedField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1366,11 +4570,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1398,11 +4602,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = string(dAtA[iNdEx:postIndex])
+			m.OldValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1430,13 +4634,63 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentKey = string(dAtA[iNdEx:postIndex])
+			m.NewValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSummary", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunderPayouts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunderPayouts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunderPayouts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1446,29 +4700,35 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPool
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPool
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentSummary = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
-			m.CurrentIndex = 0
+			m.Current = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1478,16 +4738,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentIndex |= uint64(b&0x7F) << shift
+				m.Current |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
 			}
-			m.TotalBundles = 0
+			m.Previous = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1497,16 +4757,66 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalBundles |= uint64(b&0x7F) << shift
+				m.Previous |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolInflationAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolInflationAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolInflationAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.UploadInterval = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1516,12 +4826,12 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadInterval |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InflationShareWeight", wireType)
 			}
@@ -1555,11 +4865,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Demand", wireType)
 			}
-			m.MinDelegation = 0
+			m.Demand = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1569,16 +4879,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinDelegation |= uint64(b&0x7F) << shift
+				m.Demand |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticShare", wireType)
 			}
-			m.MaxBundleSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1588,36 +4898,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPool
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
 			}
-			m.Disabled = bool(v != 0)
-		case 16:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicShare", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1627,33 +4932,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Protocol == nil {
-				m.Protocol = &Protocol{}
-			}
-			if err := m.Protocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DynamicShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1663,33 +4966,81 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpgradePlan == nil {
-				m.UpgradePlan = &UpgradePlan{}
-			}
-			if err := m.UpgradePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationLedger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationLedger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationLedger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStorageProviderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.CurrentStorageProviderId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1699,16 +5050,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentStorageProviderId |= uint32(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentCompressionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReceived", wireType)
 			}
-			m.CurrentCompressionId = 0
+			m.TotalReceived = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1718,16 +5069,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentCompressionId |= uint32(b&0x7F) << shift
+				m.TotalReceived |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainder", wireType)
 			}
-			var stringLen uint64
+			m.TotalRemainder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1737,24 +5088,49 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalRemainder |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPool
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReceived", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPool
+			m.LastReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReceived |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.EndKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
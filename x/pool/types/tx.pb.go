@@ -63,6 +63,11 @@ type MsgCreatePool struct {
 	CompressionId uint32 `protobuf:"varint,14,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
 	// end_key ...
 	EndKey string `protobuf:"bytes,15,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// runtime_binaries are the checksummed binaries of the protocol node
+	RuntimeBinaries []RuntimeBinary `protobuf:"bytes,16,rep,name=runtime_binaries,json=runtimeBinaries,proto3" json:"runtime_binaries"`
+	// max_stakers is the maximum amount of stakers which can join the pool,
+	// zero falls back to the max_stakers_per_pool param
+	MaxStakers uint64 `protobuf:"varint,17,opt,name=max_stakers,json=maxStakers,proto3" json:"max_stakers,omitempty"`
 }
 
 func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
@@ -196,6 +201,20 @@ func (m *MsgCreatePool) GetEndKey() string {
 	return ""
 }
 
+func (m *MsgCreatePool) GetRuntimeBinaries() []RuntimeBinary {
+	if m != nil {
+		return m.RuntimeBinaries
+	}
+	return nil
+}
+
+func (m *MsgCreatePool) GetMaxStakers() uint64 {
+	if m != nil {
+		return m.MaxStakers
+	}
+	return 0
+}
+
 // MsgCreatePoolResponse defines the Msg/CreatePool response type.
 type MsgCreatePoolResponse struct {
 }
@@ -518,34 +537,39 @@ func (m *MsgEnablePoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEnablePoolResponse proto.InternalMessageInfo
 
-// MsgScheduleRuntimeUpgrade defines a SDK message for scheduling a runtime upgrade.
-type MsgScheduleRuntimeUpgrade struct {
+// MsgForkPool defines a SDK message for forking an existing pool.
+type MsgForkPool struct {
 	// authority is the address of the governance account.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// runtime ...
-	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	// version ...
-	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	// scheduled_at ...
-	ScheduledAt uint64 `protobuf:"varint,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
-	// duration ...
-	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
-	// binaries ...
-	Binaries string `protobuf:"bytes,6,opt,name=binaries,proto3" json:"binaries,omitempty"`
-}
-
-func (m *MsgScheduleRuntimeUpgrade) Reset()         { *m = MsgScheduleRuntimeUpgrade{} }
-func (m *MsgScheduleRuntimeUpgrade) String() string { return proto.CompactTextString(m) }
-func (*MsgScheduleRuntimeUpgrade) ProtoMessage()    {}
-func (*MsgScheduleRuntimeUpgrade) Descriptor() ([]byte, []int) {
+	// id is the id of the pool which gets forked
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// payload is an optional json object which overwrites the copied
+	// config of the pool, it has the same format as in MsgUpdatePool
+	Payload string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// version overwrites the protocol version if not empty
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// binaries overwrites the protocol binaries if not empty
+	Binaries string `protobuf:"bytes,5,opt,name=binaries,proto3" json:"binaries,omitempty"`
+	// runtime_binaries overwrites the checksummed protocol binaries if not empty
+	RuntimeBinaries []RuntimeBinary `protobuf:"bytes,6,rep,name=runtime_binaries,json=runtimeBinaries,proto3" json:"runtime_binaries"`
+	// migrate_valaccounts moves all valaccounts of the pool to the successor
+	MigrateValaccounts bool `protobuf:"varint,7,opt,name=migrate_valaccounts,json=migrateValaccounts,proto3" json:"migrate_valaccounts,omitempty"`
+	// migrate_fundings moves all active fundings of the pool to the successor
+	MigrateFundings bool `protobuf:"varint,8,opt,name=migrate_fundings,json=migrateFundings,proto3" json:"migrate_fundings,omitempty"`
+}
+
+func (m *MsgForkPool) Reset()         { *m = MsgForkPool{} }
+func (m *MsgForkPool) String() string { return proto.CompactTextString(m) }
+func (*MsgForkPool) ProtoMessage()    {}
+func (*MsgForkPool) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20ddefdf83388ddc, []int{8}
 }
-func (m *MsgScheduleRuntimeUpgrade) XXX_Unmarshal(b []byte) error {
+func (m *MsgForkPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgScheduleRuntimeUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgForkPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgScheduleRuntimeUpgrade.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgForkPool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -555,76 +579,92 @@ func (m *MsgScheduleRuntimeUpgrade) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgScheduleRuntimeUpgrade) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgScheduleRuntimeUpgrade.Merge(m, src)
+func (m *MsgForkPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForkPool.Merge(m, src)
 }
-func (m *MsgScheduleRuntimeUpgrade) XXX_Size() int {
+func (m *MsgForkPool) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgScheduleRuntimeUpgrade) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgScheduleRuntimeUpgrade.DiscardUnknown(m)
+func (m *MsgForkPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForkPool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgScheduleRuntimeUpgrade proto.InternalMessageInfo
+var xxx_messageInfo_MsgForkPool proto.InternalMessageInfo
 
-func (m *MsgScheduleRuntimeUpgrade) GetAuthority() string {
+func (m *MsgForkPool) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgScheduleRuntimeUpgrade) GetRuntime() string {
+func (m *MsgForkPool) GetId() uint64 {
 	if m != nil {
-		return m.Runtime
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgForkPool) GetPayload() string {
+	if m != nil {
+		return m.Payload
 	}
 	return ""
 }
 
-func (m *MsgScheduleRuntimeUpgrade) GetVersion() string {
+func (m *MsgForkPool) GetVersion() string {
 	if m != nil {
 		return m.Version
 	}
 	return ""
 }
 
-func (m *MsgScheduleRuntimeUpgrade) GetScheduledAt() uint64 {
+func (m *MsgForkPool) GetBinaries() string {
 	if m != nil {
-		return m.ScheduledAt
+		return m.Binaries
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgScheduleRuntimeUpgrade) GetDuration() uint64 {
+func (m *MsgForkPool) GetRuntimeBinaries() []RuntimeBinary {
 	if m != nil {
-		return m.Duration
+		return m.RuntimeBinaries
 	}
-	return 0
+	return nil
 }
 
-func (m *MsgScheduleRuntimeUpgrade) GetBinaries() string {
+func (m *MsgForkPool) GetMigrateValaccounts() bool {
 	if m != nil {
-		return m.Binaries
+		return m.MigrateValaccounts
 	}
-	return ""
+	return false
 }
 
-// MsgScheduleRuntimeUpgradeResponse defines the Msg/ScheduleRuntimeUpgrade response type.
-type MsgScheduleRuntimeUpgradeResponse struct {
+func (m *MsgForkPool) GetMigrateFundings() bool {
+	if m != nil {
+		return m.MigrateFundings
+	}
+	return false
 }
 
-func (m *MsgScheduleRuntimeUpgradeResponse) Reset()         { *m = MsgScheduleRuntimeUpgradeResponse{} }
-func (m *MsgScheduleRuntimeUpgradeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgScheduleRuntimeUpgradeResponse) ProtoMessage()    {}
-func (*MsgScheduleRuntimeUpgradeResponse) Descriptor() ([]byte, []int) {
+// MsgForkPoolResponse defines the Msg/ForkPool response type.
+type MsgForkPoolResponse struct {
+	// id is the id of the successor pool
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgForkPoolResponse) Reset()         { *m = MsgForkPoolResponse{} }
+func (m *MsgForkPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForkPoolResponse) ProtoMessage()    {}
+func (*MsgForkPoolResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20ddefdf83388ddc, []int{9}
 }
-func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgForkPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgForkPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgScheduleRuntimeUpgradeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgForkPoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -634,38 +674,45 @@ func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgScheduleRuntimeUpgradeResponse.Merge(m, src)
+func (m *MsgForkPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForkPoolResponse.Merge(m, src)
 }
-func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Size() int {
+func (m *MsgForkPoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgScheduleRuntimeUpgradeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgScheduleRuntimeUpgradeResponse.DiscardUnknown(m)
+func (m *MsgForkPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForkPoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgScheduleRuntimeUpgradeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgForkPoolResponse proto.InternalMessageInfo
 
-// MsgCancelRuntimeUpgrade defines a SDK message for cancelling a runtime upgrade.
-type MsgCancelRuntimeUpgrade struct {
+func (m *MsgForkPoolResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgRetirePool defines a SDK message for retiring a disabled pool.
+type MsgRetirePool struct {
 	// authority is the address of the governance account.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// runtime ...
-	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// id is the id of the pool which gets retired
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgCancelRuntimeUpgrade) Reset()         { *m = MsgCancelRuntimeUpgrade{} }
-func (m *MsgCancelRuntimeUpgrade) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelRuntimeUpgrade) ProtoMessage()    {}
-func (*MsgCancelRuntimeUpgrade) Descriptor() ([]byte, []int) {
+func (m *MsgRetirePool) Reset()         { *m = MsgRetirePool{} }
+func (m *MsgRetirePool) String() string { return proto.CompactTextString(m) }
+func (*MsgRetirePool) ProtoMessage()    {}
+func (*MsgRetirePool) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20ddefdf83388ddc, []int{10}
 }
-func (m *MsgCancelRuntimeUpgrade) XXX_Unmarshal(b []byte) error {
+func (m *MsgRetirePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelRuntimeUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRetirePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelRuntimeUpgrade.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRetirePool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -675,48 +722,48 @@ func (m *MsgCancelRuntimeUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelRuntimeUpgrade) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelRuntimeUpgrade.Merge(m, src)
+func (m *MsgRetirePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetirePool.Merge(m, src)
 }
-func (m *MsgCancelRuntimeUpgrade) XXX_Size() int {
+func (m *MsgRetirePool) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelRuntimeUpgrade) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelRuntimeUpgrade.DiscardUnknown(m)
+func (m *MsgRetirePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetirePool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelRuntimeUpgrade proto.InternalMessageInfo
+var xxx_messageInfo_MsgRetirePool proto.InternalMessageInfo
 
-func (m *MsgCancelRuntimeUpgrade) GetAuthority() string {
+func (m *MsgRetirePool) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgCancelRuntimeUpgrade) GetRuntime() string {
+func (m *MsgRetirePool) GetId() uint64 {
 	if m != nil {
-		return m.Runtime
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-// MsgCancelRuntimeUpgradeResponse defines the Msg/CancelRuntimeUpgrade response type.
-type MsgCancelRuntimeUpgradeResponse struct {
+// MsgRetirePoolResponse defines the Msg/RetirePool response type.
+type MsgRetirePoolResponse struct {
 }
 
-func (m *MsgCancelRuntimeUpgradeResponse) Reset()         { *m = MsgCancelRuntimeUpgradeResponse{} }
-func (m *MsgCancelRuntimeUpgradeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelRuntimeUpgradeResponse) ProtoMessage()    {}
-func (*MsgCancelRuntimeUpgradeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRetirePoolResponse) Reset()         { *m = MsgRetirePoolResponse{} }
+func (m *MsgRetirePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetirePoolResponse) ProtoMessage()    {}
+func (*MsgRetirePoolResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20ddefdf83388ddc, []int{11}
 }
-func (m *MsgCancelRuntimeUpgradeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRetirePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelRuntimeUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRetirePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelRuntimeUpgradeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRetirePoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -726,38 +773,43 @@ func (m *MsgCancelRuntimeUpgradeResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelRuntimeUpgradeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelRuntimeUpgradeResponse.Merge(m, src)
+func (m *MsgRetirePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetirePoolResponse.Merge(m, src)
 }
-func (m *MsgCancelRuntimeUpgradeResponse) XXX_Size() int {
+func (m *MsgRetirePoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelRuntimeUpgradeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelRuntimeUpgradeResponse.DiscardUnknown(m)
+func (m *MsgRetirePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetirePoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelRuntimeUpgradeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRetirePoolResponse proto.InternalMessageInfo
 
-// MsgUpdateParams defines a SDK message for updating the module parameters.
-type MsgUpdateParams struct {
+// MsgPausePool defines a SDK message for pausing an existing pool.
+type MsgPausePool struct {
 	// authority is the address of the governance account.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// payload defines the x/stakers parameters to update.
-	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// pause_from is the unix time the pause starts, if it is in the past
+	// the pool is paused immediately
+	PauseFrom uint64 `protobuf:"varint,3,opt,name=pause_from,json=pauseFrom,proto3" json:"pause_from,omitempty"`
+	// pause_until is the unix time the pause ends and the pool resumes
+	PauseUntil uint64 `protobuf:"varint,4,opt,name=pause_until,json=pauseUntil,proto3" json:"pause_until,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgPausePool) Reset()         { *m = MsgPausePool{} }
+func (m *MsgPausePool) String() string { return proto.CompactTextString(m) }
+func (*MsgPausePool) ProtoMessage()    {}
+func (*MsgPausePool) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20ddefdf83388ddc, []int{12}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgPausePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgPausePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgPausePool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -767,48 +819,62 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgPausePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPausePool.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgPausePool) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgPausePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPausePool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgPausePool proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgPausePool) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetPayload() string {
+func (m *MsgPausePool) GetId() uint64 {
 	if m != nil {
-		return m.Payload
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
-type MsgUpdateParamsResponse struct {
+func (m *MsgPausePool) GetPauseFrom() uint64 {
+	if m != nil {
+		return m.PauseFrom
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgPausePool) GetPauseUntil() uint64 {
+	if m != nil {
+		return m.PauseUntil
+	}
+	return 0
+}
+
+// MsgPausePoolResponse defines the Msg/PausePool response type.
+type MsgPausePoolResponse struct {
+}
+
+func (m *MsgPausePoolResponse) Reset()         { *m = MsgPausePoolResponse{} }
+func (m *MsgPausePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPausePoolResponse) ProtoMessage()    {}
+func (*MsgPausePoolResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20ddefdf83388ddc, []int{13}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgPausePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgPausePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgPausePoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -818,1211 +884,3946 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgPausePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPausePoolResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgPausePoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgPausePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPausePoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgPausePoolResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreatePool)(nil), "kyve.pool.v1beta1.MsgCreatePool")
-	proto.RegisterType((*MsgCreatePoolResponse)(nil), "kyve.pool.v1beta1.MsgCreatePoolResponse")
-	proto.RegisterType((*MsgUpdatePool)(nil), "kyve.pool.v1beta1.MsgUpdatePool")
-	proto.RegisterType((*MsgUpdatePoolResponse)(nil), "kyve.pool.v1beta1.MsgUpdatePoolResponse")
-	proto.RegisterType((*MsgDisablePool)(nil), "kyve.pool.v1beta1.MsgDisablePool")
-	proto.RegisterType((*MsgDisablePoolResponse)(nil), "kyve.pool.v1beta1.MsgDisablePoolResponse")
-	proto.RegisterType((*MsgEnablePool)(nil), "kyve.pool.v1beta1.MsgEnablePool")
-	proto.RegisterType((*MsgEnablePoolResponse)(nil), "kyve.pool.v1beta1.MsgEnablePoolResponse")
-	proto.RegisterType((*MsgScheduleRuntimeUpgrade)(nil), "kyve.pool.v1beta1.MsgScheduleRuntimeUpgrade")
-	proto.RegisterType((*MsgScheduleRuntimeUpgradeResponse)(nil), "kyve.pool.v1beta1.MsgScheduleRuntimeUpgradeResponse")
-	proto.RegisterType((*MsgCancelRuntimeUpgrade)(nil), "kyve.pool.v1beta1.MsgCancelRuntimeUpgrade")
-	proto.RegisterType((*MsgCancelRuntimeUpgradeResponse)(nil), "kyve.pool.v1beta1.MsgCancelRuntimeUpgradeResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "kyve.pool.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kyve.pool.v1beta1.MsgUpdateParamsResponse")
+// MsgResumePool defines a SDK message for ending the pause of a pool.
+type MsgResumePool struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/pool/v1beta1/tx.proto", fileDescriptor_20ddefdf83388ddc) }
-
-var fileDescriptor_20ddefdf83388ddc = []byte{
-	// 907 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0x37, 0x9b, 0x7f, 0x93, 0xec, 0x46, 0x35, 0x21, 0x71, 0x5c, 0x69, 0xf3, 0xa7, 0x02,
-	0xd2, 0x08, 0x6c, 0xa5, 0x20, 0x0e, 0xdc, 0x9a, 0xa6, 0x87, 0x28, 0x04, 0x55, 0x8e, 0x0a, 0x14,
-	0x24, 0xac, 0x59, 0xcf, 0x74, 0x76, 0x14, 0x7b, 0xc6, 0x9a, 0x99, 0xdd, 0xee, 0x16, 0x0e, 0xc0,
-	0x27, 0xe0, 0xa3, 0xf4, 0xc0, 0x77, 0xa0, 0x17, 0xa4, 0x8a, 0x13, 0xe2, 0x50, 0xa1, 0xe4, 0xd0,
-	0x3b, 0x9f, 0x00, 0xcd, 0xd8, 0xeb, 0xf5, 0x6a, 0xd7, 0x0d, 0x50, 0x72, 0x5a, 0xbf, 0xf7, 0x7e,
-	0xfb, 0xde, 0x6f, 0xde, 0x7b, 0xbf, 0xb1, 0x81, 0x7b, 0x3e, 0xe8, 0x61, 0x3f, 0xe5, 0x3c, 0xf6,
-	0x7b, 0x07, 0x6d, 0xac, 0xe0, 0x81, 0xaf, 0xfa, 0x5e, 0x2a, 0xb8, 0xe2, 0xf6, 0x0d, 0x1d, 0xf3,
-	0x74, 0xcc, 0xcb, 0x63, 0xee, 0x46, 0xc4, 0x65, 0xc2, 0xa5, 0x9f, 0x48, 0xe2, 0xf7, 0x0e, 0xf4,
-	0x4f, 0x86, 0x75, 0x37, 0xb3, 0x40, 0x68, 0x2c, 0x3f, 0x33, 0xf2, 0xd0, 0x1a, 0xe1, 0x84, 0x67,
-	0x7e, 0xfd, 0x94, 0x79, 0x77, 0x7f, 0xa9, 0x83, 0xc6, 0xa9, 0x24, 0xf7, 0x04, 0x86, 0x0a, 0x3f,
-	0xe0, 0x3c, 0xb6, 0x3f, 0x06, 0x4b, 0xb0, 0xab, 0x3a, 0x5c, 0x50, 0x35, 0x70, 0xac, 0x6d, 0x6b,
-	0x6f, 0xe9, 0xd0, 0xf9, 0xed, 0xe7, 0x0f, 0xd6, 0xf2, 0x64, 0x77, 0x11, 0x12, 0x58, 0xca, 0x33,
-	0x25, 0x28, 0x23, 0xc1, 0x08, 0x6a, 0xdb, 0xa0, 0xce, 0x60, 0x82, 0x9d, 0x9a, 0xfe, 0x4b, 0x60,
-	0x9e, 0x6d, 0x07, 0x2c, 0x88, 0x2e, 0x53, 0x34, 0xc1, 0xce, 0xac, 0x71, 0x0f, 0x4d, 0x8d, 0x8e,
-	0x39, 0xe1, 0x4e, 0x3d, 0x43, 0xeb, 0x67, 0x7b, 0x1d, 0xcc, 0x47, 0x9c, 0x3d, 0xa6, 0xc4, 0x99,
-	0x33, 0xde, 0xdc, 0xb2, 0x6f, 0x82, 0x25, 0xa9, 0xa0, 0x50, 0xe1, 0x39, 0x1e, 0x38, 0xf3, 0x26,
-	0xb4, 0x68, 0x1c, 0x27, 0x78, 0x60, 0xbf, 0x07, 0x56, 0xbb, 0x69, 0xcc, 0x21, 0x0a, 0x29, 0x53,
-	0x58, 0xf4, 0x60, 0xec, 0x2c, 0x6c, 0x5b, 0x7b, 0xf5, 0xa0, 0x99, 0xb9, 0x8f, 0x73, 0xaf, 0xfd,
-	0x08, 0xac, 0x53, 0xf6, 0x38, 0x86, 0x8a, 0x72, 0x16, 0xca, 0x0e, 0x14, 0x38, 0x7c, 0x82, 0x29,
-	0xe9, 0x28, 0x67, 0xd1, 0x1c, 0xf2, 0xd6, 0xf3, 0x97, 0x5b, 0x33, 0x7f, 0xbc, 0xdc, 0xba, 0x99,
-	0x1d, 0x54, 0xa2, 0x73, 0x8f, 0x72, 0x3f, 0x81, 0xaa, 0xe3, 0x7d, 0x8a, 0x09, 0x8c, 0x06, 0x47,
-	0x38, 0x0a, 0xd6, 0x8a, 0x14, 0x67, 0x3a, 0xc3, 0x17, 0x26, 0x81, 0xfd, 0x0e, 0x68, 0x26, 0x94,
-	0x85, 0x08, 0xc7, 0x98, 0x98, 0xa0, 0xb3, 0x64, 0x28, 0x34, 0x12, 0xca, 0x8e, 0x0a, 0xa7, 0xfd,
-	0x2e, 0x58, 0x4d, 0x60, 0x3f, 0x6c, 0x77, 0x19, 0x8a, 0x71, 0x28, 0xe9, 0x53, 0xec, 0x80, 0x1c,
-	0x07, 0xfb, 0x87, 0xc6, 0x7b, 0x46, 0x9f, 0x9a, 0xae, 0xf5, 0xb0, 0x90, 0x3a, 0xcf, 0x72, 0xd6,
-	0xb5, 0xdc, 0xb4, 0x5d, 0xb0, 0xd8, 0xa6, 0x0c, 0x0a, 0x8a, 0xa5, 0xb3, 0x92, 0x35, 0x62, 0x68,
-	0xdb, 0x1e, 0x78, 0x4b, 0x2a, 0x2e, 0x20, 0xc1, 0x7a, 0xfa, 0x3d, 0x8a, 0xb0, 0x08, 0x29, 0x72,
-	0x1a, 0xdb, 0xd6, 0x5e, 0x23, 0xb8, 0x91, 0x87, 0x1e, 0xe4, 0x91, 0x63, 0xa4, 0x49, 0x47, 0x3c,
-	0x49, 0xf5, 0x30, 0x75, 0x47, 0x28, 0x72, 0x9a, 0x06, 0xda, 0x28, 0x79, 0x8f, 0x91, 0xbd, 0x01,
-	0x16, 0x30, 0x43, 0xa6, 0xf5, 0xab, 0xd9, 0x54, 0x30, 0x43, 0x27, 0x78, 0xf0, 0x49, 0xf3, 0xc7,
-	0x57, 0xcf, 0xf6, 0x47, 0xf3, 0xdf, 0xdd, 0x00, 0x6f, 0x8f, 0x2d, 0x52, 0x80, 0x65, 0xca, 0x99,
-	0xc4, 0xbb, 0x3f, 0x58, 0x66, 0xc5, 0x1e, 0xa6, 0xe8, 0x4d, 0x57, 0xac, 0x09, 0x6a, 0x14, 0x99,
-	0x05, 0xab, 0x07, 0x35, 0x8a, 0x74, 0xa3, 0x52, 0x38, 0xd0, 0x53, 0x1e, 0xae, 0x57, 0x6e, 0x56,
-	0x90, 0x1b, 0x51, 0x28, 0xc8, 0x75, 0x40, 0xf3, 0x54, 0x92, 0x23, 0x2a, 0x61, 0x3b, 0xfe, 0x5f,
-	0xc9, 0x4d, 0x50, 0x70, 0xc0, 0xfa, 0x78, 0xa5, 0x82, 0x03, 0x31, 0xfd, 0xb9, 0xcf, 0xae, 0x9d,
-	0x42, 0xd6, 0x85, 0x51, 0xa1, 0x82, 0xc1, 0x5f, 0x16, 0xd8, 0x3c, 0x95, 0xe4, 0x2c, 0xea, 0x60,
-	0xd4, 0x8d, 0x71, 0x90, 0x89, 0xf4, 0x61, 0x4a, 0x04, 0x44, 0xf8, 0x3f, 0xd3, 0x29, 0xa9, 0xbf,
-	0x36, 0xae, 0xfe, 0xd2, 0x86, 0xcf, 0x8e, 0x6f, 0xf8, 0x0e, 0x58, 0x91, 0x39, 0x0b, 0x14, 0x42,
-	0x65, 0xee, 0x87, 0x7a, 0xb0, 0x5c, 0xf8, 0xee, 0x2a, 0x2d, 0x02, 0xd4, 0x15, 0x99, 0xce, 0xe6,
-	0x4c, 0xb8, 0xb0, 0xc7, 0x04, 0x32, 0x3f, 0x2e, 0x90, 0x89, 0x6e, 0xdc, 0x02, 0x3b, 0x95, 0x67,
-	0x2e, 0x3a, 0xf3, 0x2d, 0xd8, 0xd0, 0x5b, 0x0d, 0x59, 0x84, 0xe3, 0xeb, 0x6e, 0xcb, 0x04, 0xc3,
-	0x1d, 0xb0, 0x55, 0x51, 0xbc, 0xe0, 0x27, 0xc1, 0xea, 0x68, 0xb1, 0xa1, 0x80, 0x89, 0x7c, 0x13,
-	0x5e, 0x43, 0x35, 0xd5, 0x5e, 0xaf, 0xa6, 0x4d, 0xd3, 0x94, 0x72, 0xd1, 0x21, 0x9f, 0x3b, 0xbf,
-	0xce, 0x81, 0xd9, 0x53, 0x49, 0xec, 0x2f, 0x01, 0x28, 0xbd, 0x53, 0xb6, 0xbd, 0x89, 0x77, 0x98,
-	0x37, 0x76, 0x59, 0xb8, 0x7b, 0x57, 0x21, 0x86, 0x15, 0x74, 0xe6, 0xd2, 0x55, 0x52, 0x91, 0x79,
-	0x84, 0xa8, 0xca, 0x3c, 0x79, 0x17, 0xd8, 0x5f, 0x83, 0xe5, 0xf2, 0x45, 0xb0, 0x33, 0xfd, 0x8f,
-	0x25, 0x88, 0x7b, 0xfb, 0x4a, 0x48, 0x99, 0x76, 0x49, 0xe1, 0x15, 0xb4, 0x47, 0x88, 0x2a, 0xda,
-	0x93, 0xe2, 0xb5, 0xbf, 0x03, 0xeb, 0x15, 0xc2, 0x7d, 0x7f, 0x7a, 0x8e, 0xe9, 0x68, 0xf7, 0xa3,
-	0x7f, 0x83, 0x2e, 0xaa, 0xf7, 0xc0, 0xda, 0x54, 0x75, 0xec, 0x57, 0x0c, 0x74, 0x0a, 0xd6, 0xbd,
-	0xf3, 0xcf, 0xb1, 0x45, 0xdd, 0x6f, 0xc0, 0xca, 0xd8, 0xd6, 0xef, 0xbe, 0x76, 0xcc, 0x06, 0xe3,
-	0xee, 0x5f, 0x8d, 0x19, 0xe6, 0x77, 0xe7, 0xbe, 0x7f, 0xf5, 0x6c, 0xdf, 0x3a, 0xbc, 0xf7, 0xfc,
-	0xa2, 0x65, 0xbd, 0xb8, 0x68, 0x59, 0x7f, 0x5e, 0xb4, 0xac, 0x9f, 0x2e, 0x5b, 0x33, 0x2f, 0x2e,
-	0x5b, 0x33, 0xbf, 0x5f, 0xb6, 0x66, 0xbe, 0xba, 0x4d, 0xa8, 0xea, 0x74, 0xdb, 0x5e, 0xc4, 0x13,
-	0xff, 0xe4, 0xd1, 0xe7, 0xf7, 0x3f, 0xc3, 0xea, 0x09, 0x17, 0xe7, 0x7e, 0xd4, 0x81, 0x94, 0xf9,
-	0xfd, 0xec, 0x63, 0x4e, 0x0d, 0x52, 0x2c, 0xdb, 0xf3, 0xe6, 0x5b, 0xeb, 0xc3, 0xbf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xfd, 0x1d, 0x19, 0xf0, 0xe6, 0x09, 0x00, 0x00,
+func (m *MsgResumePool) Reset()         { *m = MsgResumePool{} }
+func (m *MsgResumePool) String() string { return proto.CompactTextString(m) }
+func (*MsgResumePool) ProtoMessage()    {}
+func (*MsgResumePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{14}
+}
+func (m *MsgResumePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumePool.Merge(m, src)
+}
+func (m *MsgResumePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumePool.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgResumePool proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgResumePool) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreatePool defines a governance operation for creating a new pool.
-	// The authority is hard-coded to the x/gov module account.
-	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
-	// UpdatePool defines a governance operation for updating an existing pool.
-	// The authority is hard-coded to the x/gov module account.
-	UpdatePool(ctx context.Context, in *MsgUpdatePool, opts ...grpc.CallOption) (*MsgUpdatePoolResponse, error)
-	// DisablePool defines a governance operation for disabling an existing pool.
-	// The authority is hard-coded to the x/gov module account.
-	DisablePool(ctx context.Context, in *MsgDisablePool, opts ...grpc.CallOption) (*MsgDisablePoolResponse, error)
-	// EnablePool defines a governance operation for enabling an existing pool.
-	// The authority is hard-coded to the x/gov module account.
-	EnablePool(ctx context.Context, in *MsgEnablePool, opts ...grpc.CallOption) (*MsgEnablePoolResponse, error)
-	// ScheduleRuntimeUpgrade defines a governance operation for scheduling a runtime upgrade.
-	// The authority is hard-coded to the x/gov module account.
-	ScheduleRuntimeUpgrade(ctx context.Context, in *MsgScheduleRuntimeUpgrade, opts ...grpc.CallOption) (*MsgScheduleRuntimeUpgradeResponse, error)
-	// CancelRuntimeUpgrade defines a governance operation for cancelling a runtime upgrade.
-	// The authority is hard-coded to the x/gov module account.
-	CancelRuntimeUpgrade(ctx context.Context, in *MsgCancelRuntimeUpgrade, opts ...grpc.CallOption) (*MsgCancelRuntimeUpgradeResponse, error)
-	// UpdateParams defines a governance operation for updating the x/pool module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgResumePool) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgResumePoolResponse defines the Msg/ResumePool response type.
+type MsgResumePoolResponse struct {
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgResumePoolResponse) Reset()         { *m = MsgResumePoolResponse{} }
+func (m *MsgResumePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumePoolResponse) ProtoMessage()    {}
+func (*MsgResumePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{15}
 }
-
-func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
-	out := new(MsgCreatePoolResponse)
-	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/CreatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgResumePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdatePool(ctx context.Context, in *MsgUpdatePool, opts ...grpc.CallOption) (*MsgUpdatePoolResponse, error) {
-	out := new(MsgUpdatePoolResponse)
-	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/UpdatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgResumePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgResumePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumePoolResponse.Merge(m, src)
+}
+func (m *MsgResumePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumePoolResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DisablePool(ctx context.Context, in *MsgDisablePool, opts ...grpc.CallOption) (*MsgDisablePoolResponse, error) {
-	out := new(MsgDisablePoolResponse)
-	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/DisablePool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResumePoolResponse proto.InternalMessageInfo
+
+// MsgScheduleInflationShareWeightRamp defines a SDK message for scheduling a linear
+// change of the inflation share weight of a pool.
+type MsgScheduleInflationShareWeightRamp struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// from_weight is the inflation share weight at the start of the ramp
+	FromWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=from_weight,json=fromWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"from_weight"`
+	// to_weight is the inflation share weight at the end of the ramp
+	ToWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=to_weight,json=toWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"to_weight"`
+	// start_at is the unix time the ramp starts, if it is in the past
+	// the ramp starts immediately
+	StartAt uint64 `protobuf:"varint,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// end_at is the unix time the ramp ends
+	EndAt uint64 `protobuf:"varint,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+}
+
+func (m *MsgScheduleInflationShareWeightRamp) Reset()         { *m = MsgScheduleInflationShareWeightRamp{} }
+func (m *MsgScheduleInflationShareWeightRamp) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleInflationShareWeightRamp) ProtoMessage()    {}
+func (*MsgScheduleInflationShareWeightRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{16}
+}
+func (m *MsgScheduleInflationShareWeightRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleInflationShareWeightRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleInflationShareWeightRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgScheduleInflationShareWeightRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleInflationShareWeightRamp.Merge(m, src)
+}
+func (m *MsgScheduleInflationShareWeightRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleInflationShareWeightRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleInflationShareWeightRamp.DiscardUnknown(m)
 }
 
-func (c *msgClient) EnablePool(ctx context.Context, in *MsgEnablePool, opts ...grpc.CallOption) (*MsgEnablePoolResponse, error) {
-	out := new(MsgEnablePoolResponse)
-	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/EnablePool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleInflationShareWeightRamp proto.InternalMessageInfo
+
+func (m *MsgScheduleInflationShareWeightRamp) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ScheduleRuntimeUpgrade(ctx context.Context, in *MsgScheduleRuntimeUpgrade, opts ...grpc.CallOption) (*MsgScheduleRuntimeUpgradeResponse, error) {
-	out := new(MsgScheduleRuntimeUpgradeResponse)
-	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/ScheduleRuntimeUpgrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleInflationShareWeightRamp) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CancelRuntimeUpgrade(ctx context.Context, in *MsgCancelRuntimeUpgrade, opts ...grpc.CallOption) (*MsgCancelRuntimeUpgradeResponse, error) {
-	out := new(MsgCancelRuntimeUpgradeResponse)
-	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/CancelRuntimeUpgrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleInflationShareWeightRamp) GetStartAt() uint64 {
+	if m != nil {
+		return m.StartAt
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleInflationShareWeightRamp) GetEndAt() uint64 {
+	if m != nil {
+		return m.EndAt
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreatePool defines a governance operation for creating a new pool.
-	// The authority is hard-coded to the x/gov module account.
-	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
-	// UpdatePool defines a governance operation for updating an existing pool.
-	// The authority is hard-coded to the x/gov module account.
-	UpdatePool(context.Context, *MsgUpdatePool) (*MsgUpdatePoolResponse, error)
-	// DisablePool defines a governance operation for disabling an existing pool.
-	// The authority is hard-coded to the x/gov module account.
-	DisablePool(context.Context, *MsgDisablePool) (*MsgDisablePoolResponse, error)
-	// EnablePool defines a governance operation for enabling an existing pool.
-	// The authority is hard-coded to the x/gov module account.
-	EnablePool(context.Context, *MsgEnablePool) (*MsgEnablePoolResponse, error)
-	// ScheduleRuntimeUpgrade defines a governance operation for scheduling a runtime upgrade.
-	// The authority is hard-coded to the x/gov module account.
-	ScheduleRuntimeUpgrade(context.Context, *MsgScheduleRuntimeUpgrade) (*MsgScheduleRuntimeUpgradeResponse, error)
-	// CancelRuntimeUpgrade defines a governance operation for cancelling a runtime upgrade.
-	// The authority is hard-coded to the x/gov module account.
-	CancelRuntimeUpgrade(context.Context, *MsgCancelRuntimeUpgrade) (*MsgCancelRuntimeUpgradeResponse, error)
-	// UpdateParams defines a governance operation for updating the x/pool module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgScheduleInflationShareWeightRampResponse defines the Msg/ScheduleInflationShareWeightRamp response type.
+type MsgScheduleInflationShareWeightRampResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgScheduleInflationShareWeightRampResponse) Reset() {
+	*m = MsgScheduleInflationShareWeightRampResponse{}
 }
-
-func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+func (m *MsgScheduleInflationShareWeightRampResponse) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedMsgServer) UpdatePool(ctx context.Context, req *MsgUpdatePool) (*MsgUpdatePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePool not implemented")
+func (*MsgScheduleInflationShareWeightRampResponse) ProtoMessage() {}
+func (*MsgScheduleInflationShareWeightRampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{17}
 }
-func (*UnimplementedMsgServer) DisablePool(ctx context.Context, req *MsgDisablePool) (*MsgDisablePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisablePool not implemented")
+func (m *MsgScheduleInflationShareWeightRampResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) EnablePool(ctx context.Context, req *MsgEnablePool) (*MsgEnablePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnablePool not implemented")
+func (m *MsgScheduleInflationShareWeightRampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleInflationShareWeightRampResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ScheduleRuntimeUpgrade(ctx context.Context, req *MsgScheduleRuntimeUpgrade) (*MsgScheduleRuntimeUpgradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRuntimeUpgrade not implemented")
+func (m *MsgScheduleInflationShareWeightRampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleInflationShareWeightRampResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelRuntimeUpgrade(ctx context.Context, req *MsgCancelRuntimeUpgrade) (*MsgCancelRuntimeUpgradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRuntimeUpgrade not implemented")
+func (m *MsgScheduleInflationShareWeightRampResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgScheduleInflationShareWeightRampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleInflationShareWeightRampResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgScheduleInflationShareWeightRampResponse proto.InternalMessageInfo
+
+// MsgScheduleRuntimeUpgrade defines a SDK message for scheduling a runtime upgrade.
+type MsgScheduleRuntimeUpgrade struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// runtime ...
+	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// version ...
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// scheduled_at ...
+	ScheduledAt uint64 `protobuf:"varint,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// duration ...
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// binaries ...
+	Binaries string `protobuf:"bytes,6,opt,name=binaries,proto3" json:"binaries,omitempty"`
+	// readiness_threshold is the optional fraction of delegation which has to
+	// signal readiness before the upgrade gets activated
+	ReadinessThreshold *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=readiness_threshold,json=readinessThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"readiness_threshold,omitempty"`
+	// readiness_deadline is the unix time at which the upgrade gets aborted
+	// if the readiness threshold was not reached
+	ReadinessDeadline uint64 `protobuf:"varint,8,opt,name=readiness_deadline,json=readinessDeadline,proto3" json:"readiness_deadline,omitempty"`
+	// runtime_binaries are the checksummed binaries of the upgrade version
+	RuntimeBinaries []RuntimeBinary `protobuf:"bytes,9,rep,name=runtime_binaries,json=runtimeBinaries,proto3" json:"runtime_binaries"`
 }
 
-func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePool)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgScheduleRuntimeUpgrade) Reset()         { *m = MsgScheduleRuntimeUpgrade{} }
+func (m *MsgScheduleRuntimeUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleRuntimeUpgrade) ProtoMessage()    {}
+func (*MsgScheduleRuntimeUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{18}
+}
+func (m *MsgScheduleRuntimeUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleRuntimeUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleRuntimeUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePool(ctx, in)
+}
+func (m *MsgScheduleRuntimeUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleRuntimeUpgrade.Merge(m, src)
+}
+func (m *MsgScheduleRuntimeUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleRuntimeUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleRuntimeUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleRuntimeUpgrade proto.InternalMessageInfo
+
+func (m *MsgScheduleRuntimeUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.pool.v1beta1.Msg/CreatePool",
+	return ""
+}
+
+func (m *MsgScheduleRuntimeUpgrade) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+	return ""
+}
+
+func (m *MsgScheduleRuntimeUpgrade) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdatePool)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgScheduleRuntimeUpgrade) GetScheduledAt() uint64 {
+	if m != nil {
+		return m.ScheduledAt
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdatePool(ctx, in)
+	return 0
+}
+
+func (m *MsgScheduleRuntimeUpgrade) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.pool.v1beta1.Msg/UpdatePool",
+	return 0
+}
+
+func (m *MsgScheduleRuntimeUpgrade) GetBinaries() string {
+	if m != nil {
+		return m.Binaries
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdatePool(ctx, req.(*MsgUpdatePool))
+	return ""
+}
+
+func (m *MsgScheduleRuntimeUpgrade) GetReadinessDeadline() uint64 {
+	if m != nil {
+		return m.ReadinessDeadline
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_DisablePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDisablePool)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgScheduleRuntimeUpgrade) GetRuntimeBinaries() []RuntimeBinary {
+	if m != nil {
+		return m.RuntimeBinaries
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DisablePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.pool.v1beta1.Msg/DisablePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DisablePool(ctx, req.(*MsgDisablePool))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_EnablePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEnablePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EnablePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.pool.v1beta1.Msg/EnablePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EnablePool(ctx, req.(*MsgEnablePool))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgScheduleRuntimeUpgradeResponse defines the Msg/ScheduleRuntimeUpgrade response type.
+type MsgScheduleRuntimeUpgradeResponse struct {
 }
 
-func _Msg_ScheduleRuntimeUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgScheduleRuntimeUpgrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ScheduleRuntimeUpgrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.pool.v1beta1.Msg/ScheduleRuntimeUpgrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ScheduleRuntimeUpgrade(ctx, req.(*MsgScheduleRuntimeUpgrade))
+func (m *MsgScheduleRuntimeUpgradeResponse) Reset()         { *m = MsgScheduleRuntimeUpgradeResponse{} }
+func (m *MsgScheduleRuntimeUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleRuntimeUpgradeResponse) ProtoMessage()    {}
+func (*MsgScheduleRuntimeUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{19}
+}
+func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleRuntimeUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleRuntimeUpgradeResponse.Merge(m, src)
+}
+func (m *MsgScheduleRuntimeUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleRuntimeUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleRuntimeUpgradeResponse.DiscardUnknown(m)
 }
 
-func _Msg_CancelRuntimeUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRuntimeUpgrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelRuntimeUpgrade(ctx, in)
+var xxx_messageInfo_MsgScheduleRuntimeUpgradeResponse proto.InternalMessageInfo
+
+// MsgCancelRuntimeUpgrade defines a SDK message for cancelling a runtime upgrade.
+type MsgCancelRuntimeUpgrade struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// runtime ...
+	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+}
+
+func (m *MsgCancelRuntimeUpgrade) Reset()         { *m = MsgCancelRuntimeUpgrade{} }
+func (m *MsgCancelRuntimeUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRuntimeUpgrade) ProtoMessage()    {}
+func (*MsgCancelRuntimeUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{20}
+}
+func (m *MsgCancelRuntimeUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRuntimeUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRuntimeUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.pool.v1beta1.Msg/CancelRuntimeUpgrade",
+}
+func (m *MsgCancelRuntimeUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRuntimeUpgrade.Merge(m, src)
+}
+func (m *MsgCancelRuntimeUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRuntimeUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRuntimeUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRuntimeUpgrade proto.InternalMessageInfo
+
+func (m *MsgCancelRuntimeUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelRuntimeUpgrade(ctx, req.(*MsgCancelRuntimeUpgrade))
+	return ""
+}
+
+func (m *MsgCancelRuntimeUpgrade) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgCancelRuntimeUpgradeResponse defines the Msg/CancelRuntimeUpgrade response type.
+type MsgCancelRuntimeUpgradeResponse struct {
+}
+
+func (m *MsgCancelRuntimeUpgradeResponse) Reset()         { *m = MsgCancelRuntimeUpgradeResponse{} }
+func (m *MsgCancelRuntimeUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRuntimeUpgradeResponse) ProtoMessage()    {}
+func (*MsgCancelRuntimeUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{21}
+}
+func (m *MsgCancelRuntimeUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRuntimeUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRuntimeUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgCancelRuntimeUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRuntimeUpgradeResponse.Merge(m, src)
+}
+func (m *MsgCancelRuntimeUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRuntimeUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRuntimeUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRuntimeUpgradeResponse proto.InternalMessageInfo
+
+// MsgSignalUpgradeReady defines a SDK message for signaling readiness for a runtime upgrade.
+type MsgSignalUpgradeReady struct {
+	// creator is the valaddress of the protocol node
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staker is the address of the staker the valaddress belongs to
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id is the id of the pool with the scheduled upgrade
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// version is the upgrade version the protocol node has installed
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgSignalUpgradeReady) Reset()         { *m = MsgSignalUpgradeReady{} }
+func (m *MsgSignalUpgradeReady) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReady) ProtoMessage()    {}
+func (*MsgSignalUpgradeReady) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{22}
+}
+func (m *MsgSignalUpgradeReady) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReady) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReady.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.pool.v1beta1.Msg/UpdateParams",
+}
+func (m *MsgSignalUpgradeReady) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReady.Merge(m, src)
+}
+func (m *MsgSignalUpgradeReady) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReady) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReady.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeReady proto.InternalMessageInfo
+
+func (m *MsgSignalUpgradeReady) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgSignalUpgradeReady) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kyve.pool.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreatePool",
-			Handler:    _Msg_CreatePool_Handler,
-		},
-		{
-			MethodName: "UpdatePool",
-			Handler:    _Msg_UpdatePool_Handler,
-		},
-		{
-			MethodName: "DisablePool",
-			Handler:    _Msg_DisablePool_Handler,
-		},
-		{
-			MethodName: "EnablePool",
-			Handler:    _Msg_EnablePool_Handler,
-		},
-		{
-			MethodName: "ScheduleRuntimeUpgrade",
-			Handler:    _Msg_ScheduleRuntimeUpgrade_Handler,
-		},
-		{
-			MethodName: "CancelRuntimeUpgrade",
-			Handler:    _Msg_CancelRuntimeUpgrade_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kyve/pool/v1beta1/tx.proto",
+func (m *MsgSignalUpgradeReady) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSignalUpgradeReady) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSignalUpgradeReadyResponse defines the Msg/SignalUpgradeReady response type.
+type MsgSignalUpgradeReadyResponse struct {
 }
 
-func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EndKey) > 0 {
-		i -= len(m.EndKey)
-		copy(dAtA[i:], m.EndKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EndKey)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if m.CompressionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompressionId))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StorageProviderId))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.Binaries) > 0 {
-		i -= len(m.Binaries)
-		copy(dAtA[i:], m.Binaries)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Binaries)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.MaxBundleSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxBundleSize))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.MinDelegation != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MinDelegation))
-		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size := m.InflationShareWeight.Size()
-		i -= size
-		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSignalUpgradeReadyResponse) Reset()         { *m = MsgSignalUpgradeReadyResponse{} }
+func (m *MsgSignalUpgradeReadyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReadyResponse) ProtoMessage()    {}
+func (*MsgSignalUpgradeReadyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{23}
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReadyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if m.UploadInterval != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UploadInterval))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.StartKey) > 0 {
-		i -= len(m.StartKey)
-		copy(dAtA[i:], m.StartKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StartKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Config) > 0 {
-		i -= len(m.Config)
-		copy(dAtA[i:], m.Config)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Config)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Logo)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSignalUpgradeReadyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReadyResponse.Merge(m, src)
 }
-
-func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSignalUpgradeReadyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReadyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSignalUpgradeReadyResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a SDK message for updating the module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// payload defines the x/stakers parameters to update.
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (m *MsgUpdatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{24}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUpdatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdatePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetPayload() string {
+	if m != nil {
+		return m.Payload
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgUpdatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20ddefdf83388ddc, []int{25}
 }
-
-func (m *MsgDisablePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDisablePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDisablePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreatePool)(nil), "kyve.pool.v1beta1.MsgCreatePool")
+	proto.RegisterType((*MsgCreatePoolResponse)(nil), "kyve.pool.v1beta1.MsgCreatePoolResponse")
+	proto.RegisterType((*MsgUpdatePool)(nil), "kyve.pool.v1beta1.MsgUpdatePool")
+	proto.RegisterType((*MsgUpdatePoolResponse)(nil), "kyve.pool.v1beta1.MsgUpdatePoolResponse")
+	proto.RegisterType((*MsgDisablePool)(nil), "kyve.pool.v1beta1.MsgDisablePool")
+	proto.RegisterType((*MsgDisablePoolResponse)(nil), "kyve.pool.v1beta1.MsgDisablePoolResponse")
+	proto.RegisterType((*MsgEnablePool)(nil), "kyve.pool.v1beta1.MsgEnablePool")
+	proto.RegisterType((*MsgEnablePoolResponse)(nil), "kyve.pool.v1beta1.MsgEnablePoolResponse")
+	proto.RegisterType((*MsgForkPool)(nil), "kyve.pool.v1beta1.MsgForkPool")
+	proto.RegisterType((*MsgForkPoolResponse)(nil), "kyve.pool.v1beta1.MsgForkPoolResponse")
+	proto.RegisterType((*MsgRetirePool)(nil), "kyve.pool.v1beta1.MsgRetirePool")
+	proto.RegisterType((*MsgRetirePoolResponse)(nil), "kyve.pool.v1beta1.MsgRetirePoolResponse")
+	proto.RegisterType((*MsgPausePool)(nil), "kyve.pool.v1beta1.MsgPausePool")
+	proto.RegisterType((*MsgPausePoolResponse)(nil), "kyve.pool.v1beta1.MsgPausePoolResponse")
+	proto.RegisterType((*MsgResumePool)(nil), "kyve.pool.v1beta1.MsgResumePool")
+	proto.RegisterType((*MsgResumePoolResponse)(nil), "kyve.pool.v1beta1.MsgResumePoolResponse")
+	proto.RegisterType((*MsgScheduleInflationShareWeightRamp)(nil), "kyve.pool.v1beta1.MsgScheduleInflationShareWeightRamp")
+	proto.RegisterType((*MsgScheduleInflationShareWeightRampResponse)(nil), "kyve.pool.v1beta1.MsgScheduleInflationShareWeightRampResponse")
+	proto.RegisterType((*MsgScheduleRuntimeUpgrade)(nil), "kyve.pool.v1beta1.MsgScheduleRuntimeUpgrade")
+	proto.RegisterType((*MsgScheduleRuntimeUpgradeResponse)(nil), "kyve.pool.v1beta1.MsgScheduleRuntimeUpgradeResponse")
+	proto.RegisterType((*MsgCancelRuntimeUpgrade)(nil), "kyve.pool.v1beta1.MsgCancelRuntimeUpgrade")
+	proto.RegisterType((*MsgCancelRuntimeUpgradeResponse)(nil), "kyve.pool.v1beta1.MsgCancelRuntimeUpgradeResponse")
+	proto.RegisterType((*MsgSignalUpgradeReady)(nil), "kyve.pool.v1beta1.MsgSignalUpgradeReady")
+	proto.RegisterType((*MsgSignalUpgradeReadyResponse)(nil), "kyve.pool.v1beta1.MsgSignalUpgradeReadyResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "kyve.pool.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kyve.pool.v1beta1.MsgUpdateParamsResponse")
 }
 
-func (m *MsgDisablePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("kyve/pool/v1beta1/tx.proto", fileDescriptor_20ddefdf83388ddc) }
+
+var fileDescriptor_20ddefdf83388ddc = []byte{
+	// 1430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6e, 0x14, 0xc7,
+	0x16, 0x76, 0xdb, 0xe3, 0x9f, 0x39, 0xfe, 0xc3, 0x6d, 0x63, 0xb7, 0x9b, 0xcb, 0xf8, 0x07, 0x01,
+	0x83, 0xef, 0x65, 0xe6, 0xc2, 0xbd, 0xca, 0x82, 0x45, 0x14, 0x1b, 0x83, 0x64, 0x11, 0x47, 0xa4,
+	0x8d, 0x09, 0x24, 0x52, 0x5a, 0xe5, 0xe9, 0x72, 0x4f, 0xc9, 0xdd, 0x5d, 0xa3, 0xaa, 0x9a, 0xc1,
+	0x43, 0xb2, 0x48, 0xb2, 0xcc, 0x2a, 0xeb, 0x3c, 0x40, 0xd6, 0x2c, 0x22, 0x65, 0xc1, 0x0b, 0xa0,
+	0xac, 0x50, 0x56, 0x51, 0x16, 0x28, 0x82, 0x48, 0xbc, 0x46, 0x54, 0xd5, 0xdd, 0x35, 0x3d, 0x9e,
+	0x19, 0x8f, 0x83, 0x81, 0x15, 0x53, 0xe7, 0x7c, 0x75, 0xea, 0xeb, 0xef, 0x9c, 0x3a, 0x75, 0x30,
+	0xd8, 0x07, 0xcd, 0x06, 0x2e, 0xd7, 0x28, 0x0d, 0xca, 0x8d, 0x6b, 0x7b, 0x58, 0xa0, 0x6b, 0x65,
+	0x71, 0x58, 0xaa, 0x31, 0x2a, 0xa8, 0x39, 0x23, 0x7d, 0x25, 0xe9, 0x2b, 0x25, 0x3e, 0x7b, 0xa1,
+	0x42, 0x79, 0x48, 0x79, 0x39, 0xe4, 0x7e, 0xb9, 0x71, 0x4d, 0xfe, 0x13, 0x63, 0xed, 0xc5, 0xd8,
+	0xe1, 0xaa, 0x55, 0x39, 0x5e, 0x24, 0xae, 0x39, 0x9f, 0xfa, 0x34, 0xb6, 0xcb, 0x5f, 0x89, 0xf5,
+	0x5f, 0x9d, 0x07, 0xab, 0x93, 0x94, 0x77, 0xf5, 0xe9, 0x30, 0x4c, 0x6e, 0x73, 0xff, 0x26, 0xc3,
+	0x48, 0xe0, 0xbb, 0x94, 0x06, 0xe6, 0x07, 0x90, 0x47, 0x75, 0x51, 0xa5, 0x8c, 0x88, 0xa6, 0x65,
+	0x2c, 0x1b, 0xc5, 0xfc, 0x86, 0xf5, 0xdb, 0xcf, 0x57, 0xe7, 0x92, 0xa3, 0xd6, 0x3d, 0x8f, 0x61,
+	0xce, 0x77, 0x04, 0x23, 0x91, 0xef, 0xb4, 0xa0, 0xa6, 0x09, 0xb9, 0x08, 0x85, 0xd8, 0x1a, 0x94,
+	0x5b, 0x1c, 0xf5, 0xdb, 0xb4, 0x60, 0x94, 0xd5, 0x23, 0x41, 0x42, 0x6c, 0x0d, 0x29, 0x73, 0xba,
+	0x94, 0xe8, 0x80, 0xfa, 0xd4, 0xca, 0xc5, 0x68, 0xf9, 0xdb, 0x9c, 0x87, 0x91, 0x0a, 0x8d, 0xf6,
+	0x89, 0x6f, 0x0d, 0x2b, 0x6b, 0xb2, 0x32, 0xcf, 0x41, 0x9e, 0x0b, 0xc4, 0x84, 0x7b, 0x80, 0x9b,
+	0xd6, 0x88, 0x72, 0x8d, 0x29, 0xc3, 0x1d, 0xdc, 0x34, 0x2f, 0xc3, 0x74, 0xbd, 0x16, 0x50, 0xe4,
+	0xb9, 0x24, 0x12, 0x98, 0x35, 0x50, 0x60, 0x8d, 0x2e, 0x1b, 0xc5, 0x9c, 0x33, 0x15, 0x9b, 0xb7,
+	0x12, 0xab, 0xf9, 0x10, 0xe6, 0x49, 0xb4, 0x1f, 0x20, 0x41, 0x68, 0xe4, 0xf2, 0x2a, 0x62, 0xd8,
+	0x7d, 0x84, 0x89, 0x5f, 0x15, 0xd6, 0x98, 0xfa, 0xc8, 0x0b, 0xcf, 0x5e, 0x2c, 0x0d, 0xfc, 0xf1,
+	0x62, 0xe9, 0x5c, 0xfc, 0xa1, 0xdc, 0x3b, 0x28, 0x11, 0x5a, 0x0e, 0x91, 0xa8, 0x96, 0x3e, 0xc6,
+	0x3e, 0xaa, 0x34, 0x37, 0x71, 0xc5, 0x99, 0xd3, 0x21, 0x76, 0x64, 0x84, 0xcf, 0x54, 0x00, 0xf3,
+	0x22, 0x4c, 0x85, 0x24, 0x72, 0x3d, 0x1c, 0x60, 0x5f, 0x39, 0xad, 0xbc, 0xa2, 0x30, 0x19, 0x92,
+	0x68, 0x53, 0x1b, 0xcd, 0x4b, 0x30, 0x1d, 0xa2, 0x43, 0x77, 0xaf, 0x1e, 0x79, 0x01, 0x76, 0x39,
+	0x79, 0x8c, 0x2d, 0x48, 0x70, 0xe8, 0x70, 0x43, 0x59, 0x77, 0xc8, 0x63, 0xa5, 0x5a, 0x03, 0x33,
+	0x2e, 0xe3, 0x8c, 0xc7, 0xaa, 0x25, 0x4b, 0xd3, 0x86, 0xb1, 0x3d, 0x12, 0x21, 0x46, 0x30, 0xb7,
+	0x26, 0x62, 0x21, 0xd2, 0xb5, 0x59, 0x82, 0x59, 0x2e, 0x28, 0x43, 0x3e, 0x96, 0xb5, 0xd1, 0x20,
+	0x1e, 0x66, 0x2e, 0xf1, 0xac, 0xc9, 0x65, 0xa3, 0x38, 0xe9, 0xcc, 0x24, 0xae, 0xbb, 0x89, 0x67,
+	0xcb, 0x93, 0xa4, 0x2b, 0x34, 0xac, 0xc9, 0x64, 0x4a, 0x45, 0x88, 0x67, 0x4d, 0x29, 0xe8, 0x64,
+	0xc6, 0xba, 0xe5, 0x99, 0x0b, 0x30, 0x8a, 0x23, 0x4f, 0x49, 0x3f, 0x1d, 0x67, 0x05, 0x47, 0x9e,
+	0x14, 0xfe, 0x53, 0x38, 0x93, 0x24, 0xd3, 0xd5, 0x9c, 0xce, 0x2c, 0x0f, 0x15, 0xc7, 0xaf, 0x2f,
+	0x97, 0x3a, 0xea, 0xb9, 0xe4, 0xc4, 0xd0, 0x0d, 0x89, 0x6c, 0x6e, 0xe4, 0xa4, 0xd6, 0xce, 0x34,
+	0xcb, 0x18, 0xe5, 0x27, 0x2c, 0xc1, 0xb8, 0x14, 0x88, 0x0b, 0x74, 0x80, 0x19, 0xb7, 0x66, 0x94,
+	0x38, 0x10, 0xa2, 0xc3, 0x9d, 0xd8, 0x72, 0x63, 0xea, 0xbb, 0xd7, 0x4f, 0xd6, 0x5a, 0x35, 0xb7,
+	0xba, 0x00, 0x67, 0xdb, 0x8a, 0xd7, 0xc1, 0xbc, 0x46, 0x23, 0x8e, 0x57, 0xbf, 0x35, 0x54, 0x59,
+	0xef, 0xd6, 0xbc, 0xd3, 0x96, 0xf5, 0x14, 0x0c, 0x12, 0x4f, 0x15, 0x75, 0xce, 0x19, 0x24, 0x9e,
+	0x4c, 0x4e, 0x0d, 0x35, 0x65, 0x65, 0xa5, 0x25, 0x9d, 0x2c, 0x7b, 0x90, 0x6b, 0x51, 0xd0, 0xe4,
+	0xaa, 0x30, 0xb5, 0xcd, 0xfd, 0x4d, 0xc2, 0xd1, 0x5e, 0xf0, 0x56, 0xc9, 0x75, 0x50, 0xb0, 0x60,
+	0xbe, 0xfd, 0x24, 0xcd, 0xc1, 0x57, 0xfa, 0xdc, 0x8a, 0xde, 0x39, 0x85, 0x58, 0x85, 0x5b, 0x51,
+	0x07, 0x83, 0xbf, 0x06, 0x61, 0x7c, 0x9b, 0xfb, 0xb7, 0x29, 0x3b, 0x78, 0x3f, 0x09, 0xca, 0xde,
+	0xab, 0x5c, 0xef, 0x7b, 0x35, 0x7c, 0xe4, 0x5e, 0x75, 0xab, 0xf3, 0x91, 0xd3, 0xd5, 0x79, 0x19,
+	0x66, 0x43, 0xe2, 0x33, 0x24, 0xb0, 0xdb, 0x40, 0x01, 0xaa, 0x54, 0x68, 0x3d, 0x12, 0x5c, 0xf5,
+	0xad, 0x31, 0xc7, 0x4c, 0x5c, 0xf7, 0x5b, 0x1e, 0xf3, 0x0a, 0x9c, 0x49, 0x37, 0xec, 0xd7, 0x23,
+	0x8f, 0x44, 0x3e, 0x57, 0x5d, 0x6b, 0xcc, 0x99, 0x4e, 0xec, 0xb7, 0x13, 0x73, 0x87, 0xfe, 0x17,
+	0x61, 0x36, 0xa3, 0x72, 0xaa, 0x7e, 0xa2, 0x9a, 0x91, 0xaa, 0x96, 0xd4, 0x83, 0x83, 0x05, 0x61,
+	0xef, 0xa3, 0x1e, 0x5a, 0x07, 0xe9, 0x7a, 0xf8, 0xc9, 0x80, 0x89, 0x6d, 0xee, 0xdf, 0x45, 0x75,
+	0xfe, 0x76, 0x6f, 0xec, 0x79, 0x80, 0x9a, 0x0c, 0xea, 0xee, 0x33, 0x1a, 0xaa, 0x9a, 0xc8, 0x39,
+	0x79, 0x65, 0xb9, 0xcd, 0x68, 0x28, 0x9b, 0x4e, 0xec, 0x96, 0x49, 0x0a, 0x54, 0x65, 0xe4, 0x9c,
+	0x78, 0xc7, 0xae, 0xb4, 0x74, 0x7c, 0xc1, 0x3c, 0xcc, 0x65, 0x79, 0x1e, 0xb9, 0x52, 0x0e, 0xe6,
+	0xf5, 0xf0, 0xfd, 0x48, 0x98, 0x1e, 0xa4, 0x19, 0xfc, 0x32, 0x08, 0x17, 0xb6, 0xb9, 0xbf, 0x53,
+	0xa9, 0x62, 0xaf, 0x1e, 0xe0, 0xad, 0x2e, 0x6f, 0x95, 0x83, 0xc2, 0xda, 0x5b, 0x53, 0x76, 0x13,
+	0xc6, 0xa5, 0xa6, 0xe9, 0x3b, 0x3a, 0x74, 0xf2, 0x77, 0x14, 0xe4, 0xbe, 0xe4, 0xf5, 0xfc, 0x08,
+	0xf2, 0x82, 0xa6, 0x31, 0x72, 0x27, 0x8f, 0x31, 0x26, 0x68, 0x12, 0x61, 0x11, 0xe2, 0x79, 0xc0,
+	0x45, 0x42, 0x5d, 0xdf, 0x9c, 0x33, 0xaa, 0xd6, 0xeb, 0xc2, 0x3c, 0x0b, 0xf2, 0xbd, 0x92, 0x8e,
+	0x11, 0xe5, 0x18, 0xc6, 0x91, 0xb7, 0x2e, 0x3a, 0x24, 0xbd, 0x0a, 0xff, 0x3e, 0x81, 0x70, 0x5a,
+	0xe8, 0x5f, 0x87, 0x60, 0x31, 0x83, 0x4f, 0x2e, 0xfd, 0x6e, 0xcd, 0x67, 0xc8, 0xc3, 0x6f, 0x2c,
+	0x6f, 0x66, 0x5a, 0x1a, 0x6c, 0x9f, 0x96, 0x32, 0x9d, 0x6b, 0xa8, 0xbd, 0x73, 0xad, 0xc0, 0x04,
+	0x4f, 0x58, 0xa8, 0xaf, 0x8c, 0xcb, 0x77, 0x5c, 0xdb, 0xd6, 0x85, 0x6c, 0x6e, 0x5e, 0x9d, 0xc5,
+	0x73, 0x49, 0xac, 0x8e, 0x5e, 0xb7, 0x35, 0xbe, 0x91, 0x23, 0x8d, 0xef, 0x1e, 0xcc, 0x32, 0x8c,
+	0x3c, 0x12, 0x61, 0xce, 0x5d, 0x51, 0x65, 0x98, 0x57, 0x69, 0xe0, 0x59, 0xa3, 0x3a, 0x43, 0x46,
+	0xbf, 0x0c, 0x99, 0x7a, 0xff, 0xbd, 0x74, 0xbb, 0x79, 0x15, 0x5a, 0x56, 0xd7, 0xc3, 0xc8, 0x0b,
+	0x48, 0x84, 0x55, 0x33, 0xcb, 0x39, 0x33, 0xda, 0xb3, 0x99, 0x38, 0xba, 0x76, 0xdf, 0xfc, 0xa9,
+	0xba, 0x6f, 0x47, 0xee, 0x2f, 0xc0, 0x4a, 0xcf, 0x5c, 0xea, 0x8c, 0x7f, 0x05, 0x0b, 0x72, 0xd2,
+	0x40, 0x51, 0x05, 0x07, 0xef, 0x3a, 0xdd, 0x1d, 0x0c, 0x57, 0x60, 0xa9, 0xc7, 0xe1, 0x9a, 0xdf,
+	0xf7, 0x86, 0x6a, 0x0a, 0x3b, 0xc4, 0x8f, 0x50, 0xa0, 0x9d, 0xc8, 0x53, 0xc7, 0x54, 0x18, 0x46,
+	0x82, 0xb2, 0x98, 0x9c, 0x93, 0x2e, 0xe5, 0xbc, 0x1d, 0x8f, 0x5a, 0xc9, 0xf9, 0xc9, 0x4a, 0x8e,
+	0x7c, 0x52, 0x55, 0x39, 0x12, 0xc6, 0xdd, 0x72, 0x44, 0x2e, 0xb7, 0x8e, 0x79, 0x40, 0x6f, 0x4c,
+	0x48, 0xc6, 0x69, 0xe0, 0xd5, 0x25, 0x38, 0xdf, 0x95, 0x8b, 0x66, 0xcb, 0x61, 0xba, 0x35, 0x1a,
+	0x21, 0x86, 0x42, 0x7e, 0x1a, 0x15, 0xd3, 0xe7, 0x7e, 0xf0, 0xf8, 0x79, 0x6c, 0x11, 0x16, 0x8e,
+	0x1c, 0x9a, 0xf2, 0xb9, 0xfe, 0x14, 0x60, 0x68, 0x9b, 0xfb, 0xe6, 0x03, 0x80, 0xcc, 0xff, 0x84,
+	0xba, 0x55, 0x58, 0xdb, 0xb8, 0x69, 0x17, 0xfb, 0x21, 0xf4, 0x7b, 0xfb, 0x00, 0x20, 0x33, 0x8c,
+	0xf6, 0x88, 0xdc, 0x42, 0xd8, 0xc5, 0x7e, 0x08, 0x1d, 0xf9, 0x0b, 0x18, 0xcf, 0x8e, 0x92, 0x2b,
+	0xdd, 0x37, 0x66, 0x20, 0xf6, 0x95, 0xbe, 0x90, 0x2c, 0xed, 0xcc, 0x8c, 0xd8, 0x83, 0x76, 0x0b,
+	0x61, 0x17, 0xfb, 0x21, 0x74, 0x64, 0x07, 0xc6, 0xf4, 0xe8, 0x57, 0xe8, 0xbe, 0x2b, 0xf5, 0xdb,
+	0x97, 0x8e, 0xf7, 0x67, 0xd9, 0x66, 0x26, 0x98, 0x1e, 0x6c, 0x5b, 0x08, 0xbb, 0xd8, 0x0f, 0xa1,
+	0x23, 0xef, 0x42, 0xbe, 0x35, 0x98, 0x2c, 0x75, 0xdf, 0xa6, 0x01, 0xf6, 0xe5, 0x3e, 0x80, 0x76,
+	0xc2, 0x7a, 0x5e, 0xe8, 0x49, 0x38, 0x45, 0xd8, 0xc5, 0x7e, 0x08, 0x1d, 0xf9, 0x47, 0x03, 0x96,
+	0xfb, 0xcf, 0x01, 0xdd, 0xc3, 0xf5, 0xdb, 0x67, 0x7f, 0xf8, 0x66, 0xfb, 0x34, 0xb9, 0xaf, 0x61,
+	0xbe, 0xc7, 0xd3, 0xf9, 0x9f, 0xe3, 0x23, 0xb7, 0xa3, 0xed, 0xff, 0xff, 0x13, 0xb4, 0x3e, 0xbd,
+	0x01, 0x73, 0x5d, 0xfb, 0xf8, 0x5a, 0x8f, 0xcb, 0xdc, 0x05, 0x6b, 0x5f, 0x3f, 0x39, 0x56, 0x9f,
+	0x5b, 0x03, 0xb3, 0x4b, 0x7b, 0xee, 0x91, 0xd2, 0x4e, 0xa4, 0xfd, 0xdf, 0x93, 0x22, 0xf5, 0x89,
+	0x5f, 0xc2, 0x44, 0x5b, 0x8f, 0x5d, 0x3d, 0xb6, 0xa9, 0x28, 0x8c, 0xbd, 0xd6, 0x1f, 0x93, 0xc6,
+	0xb7, 0x87, 0xbf, 0x79, 0xfd, 0x64, 0xcd, 0xd8, 0xb8, 0xf9, 0xec, 0x65, 0xc1, 0x78, 0xfe, 0xb2,
+	0x60, 0xfc, 0xf9, 0xb2, 0x60, 0xfc, 0xf0, 0xaa, 0x30, 0xf0, 0xfc, 0x55, 0x61, 0xe0, 0xf7, 0x57,
+	0x85, 0x81, 0xcf, 0xaf, 0xf8, 0x44, 0x54, 0xeb, 0x7b, 0xa5, 0x0a, 0x0d, 0xcb, 0x77, 0x1e, 0xde,
+	0xbf, 0xf5, 0x09, 0x16, 0x8f, 0x28, 0x3b, 0x28, 0x57, 0xaa, 0x88, 0x44, 0xe5, 0xc3, 0xf8, 0xaf,
+	0x52, 0xa2, 0x59, 0xc3, 0x7c, 0x6f, 0x44, 0xfd, 0x3d, 0xea, 0x7f, 0x7f, 0x0f, 0x00, 0xf1, 0xe2,
+	0x9c, 0x82, 0x28, 0x13, 0x00, 0x00,
 }
 
-func (m *MsgDisablePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreatePool defines a governance operation for creating a new pool.
+	// The authority is hard-coded to the x/gov module account.
+	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
+	// UpdatePool defines a governance operation for updating an existing pool.
+	// The authority is hard-coded to the x/gov module account.
+	UpdatePool(ctx context.Context, in *MsgUpdatePool, opts ...grpc.CallOption) (*MsgUpdatePoolResponse, error)
+	// DisablePool defines a governance operation for disabling an existing pool.
+	// The authority is hard-coded to the x/gov module account.
+	DisablePool(ctx context.Context, in *MsgDisablePool, opts ...grpc.CallOption) (*MsgDisablePoolResponse, error)
+	// EnablePool defines a governance operation for enabling an existing pool.
+	// The authority is hard-coded to the x/gov module account.
+	EnablePool(ctx context.Context, in *MsgEnablePool, opts ...grpc.CallOption) (*MsgEnablePoolResponse, error)
+	// ForkPool defines a governance operation for creating a successor pool from the
+	// current state of an existing pool. The authority is hard-coded to the x/gov module account.
+	ForkPool(ctx context.Context, in *MsgForkPool, opts ...grpc.CallOption) (*MsgForkPoolResponse, error)
+	// RetirePool defines a governance operation for removing a disabled pool together
+	// with all of its state. The authority is hard-coded to the x/gov module account.
+	RetirePool(ctx context.Context, in *MsgRetirePool, opts ...grpc.CallOption) (*MsgRetirePoolResponse, error)
+	// PausePool defines a governance operation for pausing an existing pool
+	// for a given time range. The authority is hard-coded to the x/gov module account.
+	PausePool(ctx context.Context, in *MsgPausePool, opts ...grpc.CallOption) (*MsgPausePoolResponse, error)
+	// ResumePool defines a governance operation for ending the pause of a pool early.
+	// The authority is hard-coded to the x/gov module account.
+	ResumePool(ctx context.Context, in *MsgResumePool, opts ...grpc.CallOption) (*MsgResumePoolResponse, error)
+	// ScheduleInflationShareWeightRamp defines a governance operation for scheduling a linear
+	// change of the inflation share weight of a pool. The authority is hard-coded to the x/gov module account.
+	ScheduleInflationShareWeightRamp(ctx context.Context, in *MsgScheduleInflationShareWeightRamp, opts ...grpc.CallOption) (*MsgScheduleInflationShareWeightRampResponse, error)
+	// ScheduleRuntimeUpgrade defines a governance operation for scheduling a runtime upgrade.
+	// The authority is hard-coded to the x/gov module account.
+	ScheduleRuntimeUpgrade(ctx context.Context, in *MsgScheduleRuntimeUpgrade, opts ...grpc.CallOption) (*MsgScheduleRuntimeUpgradeResponse, error)
+	// CancelRuntimeUpgrade defines a governance operation for cancelling a runtime upgrade.
+	// The authority is hard-coded to the x/gov module account.
+	CancelRuntimeUpgrade(ctx context.Context, in *MsgCancelRuntimeUpgrade, opts ...grpc.CallOption) (*MsgCancelRuntimeUpgradeResponse, error)
+	// SignalUpgradeReady defines an operation for valaccounts to signal that
+	// they are ready for the scheduled runtime upgrade of a pool.
+	SignalUpgradeReady(ctx context.Context, in *MsgSignalUpgradeReady, opts ...grpc.CallOption) (*MsgSignalUpgradeReadyResponse, error)
+	// UpdateParams defines a governance operation for updating the x/pool module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgDisablePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgEnablePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
+	out := new(MsgCreatePoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/CreatePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgEnablePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdatePool(ctx context.Context, in *MsgUpdatePool, opts ...grpc.CallOption) (*MsgUpdatePoolResponse, error) {
+	out := new(MsgUpdatePoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/UpdatePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEnablePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DisablePool(ctx context.Context, in *MsgDisablePool, opts ...grpc.CallOption) (*MsgDisablePoolResponse, error) {
+	out := new(MsgDisablePoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/DisablePool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgEnablePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) EnablePool(ctx context.Context, in *MsgEnablePool, opts ...grpc.CallOption) (*MsgEnablePoolResponse, error) {
+	out := new(MsgEnablePoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/EnablePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgEnablePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ForkPool(ctx context.Context, in *MsgForkPool, opts ...grpc.CallOption) (*MsgForkPoolResponse, error) {
+	out := new(MsgForkPoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/ForkPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEnablePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RetirePool(ctx context.Context, in *MsgRetirePool, opts ...grpc.CallOption) (*MsgRetirePoolResponse, error) {
+	out := new(MsgRetirePoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/RetirePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgScheduleRuntimeUpgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PausePool(ctx context.Context, in *MsgPausePool, opts ...grpc.CallOption) (*MsgPausePoolResponse, error) {
+	out := new(MsgPausePoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/PausePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgScheduleRuntimeUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ResumePool(ctx context.Context, in *MsgResumePool, opts ...grpc.CallOption) (*MsgResumePoolResponse, error) {
+	out := new(MsgResumePoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/ResumePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgScheduleRuntimeUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Binaries) > 0 {
-		i -= len(m.Binaries)
-		copy(dAtA[i:], m.Binaries)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Binaries)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Duration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ScheduledAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ScheduledAt))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) ScheduleInflationShareWeightRamp(ctx context.Context, in *MsgScheduleInflationShareWeightRamp, opts ...grpc.CallOption) (*MsgScheduleInflationShareWeightRampResponse, error) {
+	out := new(MsgScheduleInflationShareWeightRampResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/ScheduleInflationShareWeightRamp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ScheduleRuntimeUpgrade(ctx context.Context, in *MsgScheduleRuntimeUpgrade, opts ...grpc.CallOption) (*MsgScheduleRuntimeUpgradeResponse, error) {
+	out := new(MsgScheduleRuntimeUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/ScheduleRuntimeUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgScheduleRuntimeUpgradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelRuntimeUpgrade(ctx context.Context, in *MsgCancelRuntimeUpgrade, opts ...grpc.CallOption) (*MsgCancelRuntimeUpgradeResponse, error) {
+	out := new(MsgCancelRuntimeUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/CancelRuntimeUpgrade", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgScheduleRuntimeUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SignalUpgradeReady(ctx context.Context, in *MsgSignalUpgradeReady, opts ...grpc.CallOption) (*MsgSignalUpgradeReadyResponse, error) {
+	out := new(MsgSignalUpgradeReadyResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/SignalUpgradeReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgScheduleRuntimeUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelRuntimeUpgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelRuntimeUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreatePool defines a governance operation for creating a new pool.
+	// The authority is hard-coded to the x/gov module account.
+	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
+	// UpdatePool defines a governance operation for updating an existing pool.
+	// The authority is hard-coded to the x/gov module account.
+	UpdatePool(context.Context, *MsgUpdatePool) (*MsgUpdatePoolResponse, error)
+	// DisablePool defines a governance operation for disabling an existing pool.
+	// The authority is hard-coded to the x/gov module account.
+	DisablePool(context.Context, *MsgDisablePool) (*MsgDisablePoolResponse, error)
+	// EnablePool defines a governance operation for enabling an existing pool.
+	// The authority is hard-coded to the x/gov module account.
+	EnablePool(context.Context, *MsgEnablePool) (*MsgEnablePoolResponse, error)
+	// ForkPool defines a governance operation for creating a successor pool from the
+	// current state of an existing pool. The authority is hard-coded to the x/gov module account.
+	ForkPool(context.Context, *MsgForkPool) (*MsgForkPoolResponse, error)
+	// RetirePool defines a governance operation for removing a disabled pool together
+	// with all of its state. The authority is hard-coded to the x/gov module account.
+	RetirePool(context.Context, *MsgRetirePool) (*MsgRetirePoolResponse, error)
+	// PausePool defines a governance operation for pausing an existing pool
+	// for a given time range. The authority is hard-coded to the x/gov module account.
+	PausePool(context.Context, *MsgPausePool) (*MsgPausePoolResponse, error)
+	// ResumePool defines a governance operation for ending the pause of a pool early.
+	// The authority is hard-coded to the x/gov module account.
+	ResumePool(context.Context, *MsgResumePool) (*MsgResumePoolResponse, error)
+	// ScheduleInflationShareWeightRamp defines a governance operation for scheduling a linear
+	// change of the inflation share weight of a pool. The authority is hard-coded to the x/gov module account.
+	ScheduleInflationShareWeightRamp(context.Context, *MsgScheduleInflationShareWeightRamp) (*MsgScheduleInflationShareWeightRampResponse, error)
+	// ScheduleRuntimeUpgrade defines a governance operation for scheduling a runtime upgrade.
+	// The authority is hard-coded to the x/gov module account.
+	ScheduleRuntimeUpgrade(context.Context, *MsgScheduleRuntimeUpgrade) (*MsgScheduleRuntimeUpgradeResponse, error)
+	// CancelRuntimeUpgrade defines a governance operation for cancelling a runtime upgrade.
+	// The authority is hard-coded to the x/gov module account.
+	CancelRuntimeUpgrade(context.Context, *MsgCancelRuntimeUpgrade) (*MsgCancelRuntimeUpgradeResponse, error)
+	// SignalUpgradeReady defines an operation for valaccounts to signal that
+	// they are ready for the scheduled runtime upgrade of a pool.
+	SignalUpgradeReady(context.Context, *MsgSignalUpgradeReady) (*MsgSignalUpgradeReadyResponse, error)
+	// UpdateParams defines a governance operation for updating the x/pool module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgCancelRuntimeUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgCancelRuntimeUpgradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
 }
-
-func (m *MsgCancelRuntimeUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) UpdatePool(ctx context.Context, req *MsgUpdatePool) (*MsgUpdatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePool not implemented")
+}
+func (*UnimplementedMsgServer) DisablePool(ctx context.Context, req *MsgDisablePool) (*MsgDisablePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePool not implemented")
+}
+func (*UnimplementedMsgServer) EnablePool(ctx context.Context, req *MsgEnablePool) (*MsgEnablePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnablePool not implemented")
+}
+func (*UnimplementedMsgServer) ForkPool(ctx context.Context, req *MsgForkPool) (*MsgForkPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkPool not implemented")
+}
+func (*UnimplementedMsgServer) RetirePool(ctx context.Context, req *MsgRetirePool) (*MsgRetirePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirePool not implemented")
+}
+func (*UnimplementedMsgServer) PausePool(ctx context.Context, req *MsgPausePool) (*MsgPausePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePool not implemented")
+}
+func (*UnimplementedMsgServer) ResumePool(ctx context.Context, req *MsgResumePool) (*MsgResumePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePool not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleInflationShareWeightRamp(ctx context.Context, req *MsgScheduleInflationShareWeightRamp) (*MsgScheduleInflationShareWeightRampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleInflationShareWeightRamp not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleRuntimeUpgrade(ctx context.Context, req *MsgScheduleRuntimeUpgrade) (*MsgScheduleRuntimeUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRuntimeUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) CancelRuntimeUpgrade(ctx context.Context, req *MsgCancelRuntimeUpgrade) (*MsgCancelRuntimeUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRuntimeUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) SignalUpgradeReady(ctx context.Context, req *MsgSignalUpgradeReady) (*MsgSignalUpgradeReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalUpgradeReady not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgCancelRuntimeUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePool)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/CreatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/UpdatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePool(ctx, req.(*MsgUpdatePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_DisablePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisablePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).DisablePool(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/DisablePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisablePool(ctx, req.(*MsgDisablePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_EnablePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnablePool)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).EnablePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/EnablePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnablePool(ctx, req.(*MsgEnablePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ForkPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForkPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForkPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/ForkPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForkPool(ctx, req.(*MsgForkPool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_RetirePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetirePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetirePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/RetirePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetirePool(ctx, req.(*MsgRetirePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_PausePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPausePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).PausePool(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/PausePool",
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PausePool(ctx, req.(*MsgPausePool))
 	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ResumePool(ctx, in)
 	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/ResumePool",
 	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumePool(ctx, req.(*MsgResumePool))
 	}
-	if m.UploadInterval != 0 {
-		n += 1 + sovTx(uint64(m.UploadInterval))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleInflationShareWeightRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleInflationShareWeightRamp)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = m.InflationShareWeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.MinDelegation != 0 {
-		n += 1 + sovTx(uint64(m.MinDelegation))
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleInflationShareWeightRamp(ctx, in)
 	}
-	if m.MaxBundleSize != 0 {
-		n += 1 + sovTx(uint64(m.MaxBundleSize))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/ScheduleInflationShareWeightRamp",
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleInflationShareWeightRamp(ctx, req.(*MsgScheduleInflationShareWeightRamp))
 	}
-	l = len(m.Binaries)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleRuntimeUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleRuntimeUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StorageProviderId != 0 {
-		n += 1 + sovTx(uint64(m.StorageProviderId))
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleRuntimeUpgrade(ctx, in)
 	}
-	if m.CompressionId != 0 {
-		n += 1 + sovTx(uint64(m.CompressionId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/ScheduleRuntimeUpgrade",
 	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleRuntimeUpgrade(ctx, req.(*MsgScheduleRuntimeUpgrade))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelRuntimeUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRuntimeUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRuntimeUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/CancelRuntimeUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRuntimeUpgrade(ctx, req.(*MsgCancelRuntimeUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePool) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SignalUpgradeReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalUpgradeReady)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SignalUpgradeReady(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/SignalUpgradeReady",
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalUpgradeReady(ctx, req.(*MsgSignalUpgradeReady))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDisablePool) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Msg/UpdateParams",
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDisablePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kyve.pool.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePool",
+			Handler:    _Msg_CreatePool_Handler,
+		},
+		{
+			MethodName: "UpdatePool",
+			Handler:    _Msg_UpdatePool_Handler,
+		},
+		{
+			MethodName: "DisablePool",
+			Handler:    _Msg_DisablePool_Handler,
+		},
+		{
+			MethodName: "EnablePool",
+			Handler:    _Msg_EnablePool_Handler,
+		},
+		{
+			MethodName: "ForkPool",
+			Handler:    _Msg_ForkPool_Handler,
+		},
+		{
+			MethodName: "RetirePool",
+			Handler:    _Msg_RetirePool_Handler,
+		},
+		{
+			MethodName: "PausePool",
+			Handler:    _Msg_PausePool_Handler,
+		},
+		{
+			MethodName: "ResumePool",
+			Handler:    _Msg_ResumePool_Handler,
+		},
+		{
+			MethodName: "ScheduleInflationShareWeightRamp",
+			Handler:    _Msg_ScheduleInflationShareWeightRamp_Handler,
+		},
+		{
+			MethodName: "ScheduleRuntimeUpgrade",
+			Handler:    _Msg_ScheduleRuntimeUpgrade_Handler,
+		},
+		{
+			MethodName: "CancelRuntimeUpgrade",
+			Handler:    _Msg_CancelRuntimeUpgrade_Handler,
+		},
+		{
+			MethodName: "SignalUpgradeReady",
+			Handler:    _Msg_SignalUpgradeReady_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyve/pool/v1beta1/tx.proto",
 }
 
-func (m *MsgEnablePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgEnablePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgScheduleRuntimeUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MaxStakers != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxStakers))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for iNdEx := len(m.RuntimeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.ScheduledAt != 0 {
-		n += 1 + sovTx(uint64(m.ScheduledAt))
+	if m.CompressionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompressionId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxBundleSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinDelegation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinDelegation))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.InflationShareWeight.Size()
+		i -= size
+		if _, err := m.InflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.UploadInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UploadInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisablePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisablePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisablePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisablePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisablePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisablePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnablePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnablePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnablePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnablePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnablePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnablePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForkPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForkPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForkPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigrateFundings {
+		i--
+		if m.MigrateFundings {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MigrateValaccounts {
+		i--
+		if m.MigrateValaccounts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for iNdEx := len(m.RuntimeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForkPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForkPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForkPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetirePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetirePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetirePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetirePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetirePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetirePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPausePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPausePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPausePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PauseUntil != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PauseUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PauseFrom != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PauseFrom))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPausePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPausePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPausePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleInflationShareWeightRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleInflationShareWeightRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleInflationShareWeightRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ToWeight.Size()
+		i -= size
+		if _, err := m.ToWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FromWeight.Size()
+		i -= size
+		if _, err := m.FromWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleInflationShareWeightRampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleInflationShareWeightRampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleInflationShareWeightRampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleRuntimeUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleRuntimeUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleRuntimeUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RuntimeBinaries) > 0 {
+		for iNdEx := len(m.RuntimeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ReadinessDeadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReadinessDeadline))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReadinessThreshold != nil {
+		{
+			size := m.ReadinessThreshold.Size()
+			i -= size
+			if _, err := m.ReadinessThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ScheduledAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleRuntimeUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleRuntimeUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleRuntimeUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRuntimeUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRuntimeUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRuntimeUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRuntimeUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRuntimeUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRuntimeUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalUpgradeReady) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgradeReady) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeReady) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalUpgradeReadyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgradeReadyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeReadyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UploadInterval != 0 {
+		n += 1 + sovTx(uint64(m.UploadInterval))
+	}
+	l = m.InflationShareWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MinDelegation != 0 {
+		n += 1 + sovTx(uint64(m.MinDelegation))
+	}
+	if m.MaxBundleSize != 0 {
+		n += 1 + sovTx(uint64(m.MaxBundleSize))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovTx(uint64(m.StorageProviderId))
+	}
+	if m.CompressionId != 0 {
+		n += 1 + sovTx(uint64(m.CompressionId))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for _, e := range m.RuntimeBinaries {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxStakers != 0 {
+		n += 2 + sovTx(uint64(m.MaxStakers))
+	}
+	return n
+}
+
+func (m *MsgCreatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisablePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDisablePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnablePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgEnablePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForkPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for _, e := range m.RuntimeBinaries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MigrateValaccounts {
+		n += 2
+	}
+	if m.MigrateFundings {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgForkPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRetirePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRetirePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPausePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.PauseFrom != 0 {
+		n += 1 + sovTx(uint64(m.PauseFrom))
+	}
+	if m.PauseUntil != 0 {
+		n += 1 + sovTx(uint64(m.PauseUntil))
+	}
+	return n
+}
+
+func (m *MsgPausePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgResumePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleInflationShareWeightRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.FromWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ToWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.StartAt != 0 {
+		n += 1 + sovTx(uint64(m.StartAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovTx(uint64(m.EndAt))
+	}
+	return n
+}
+
+func (m *MsgScheduleInflationShareWeightRampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleRuntimeUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovTx(uint64(m.ScheduledAt))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReadinessThreshold != nil {
+		l = m.ReadinessThreshold.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReadinessDeadline != 0 {
+		n += 1 + sovTx(uint64(m.ReadinessDeadline))
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for _, e := range m.RuntimeBinaries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleRuntimeUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRuntimeUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRuntimeUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSignalUpgradeReady) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalUpgradeReadyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationShareWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationShareWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			m.MinDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionId", wireType)
+			}
+			m.CompressionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeBinaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeBinaries = append(m.RuntimeBinaries, RuntimeBinary{})
+			if err := m.RuntimeBinaries[len(m.RuntimeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakers", wireType)
+			}
+			m.MaxStakers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Duration != 0 {
-		n += 1 + sovTx(uint64(m.Duration))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.Binaries)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *MsgDisablePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisablePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisablePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgScheduleRuntimeUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisablePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisablePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisablePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgCancelRuntimeUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgCancelRuntimeUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEnablePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnablePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnablePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEnablePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnablePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnablePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgForkPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2045,15 +4846,66 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForkPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForkPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2081,11 +4933,11 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Payload = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2113,11 +4965,11 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2145,13 +4997,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Binaries = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeBinaries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2161,27 +5013,188 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
+			m.RuntimeBinaries = append(m.RuntimeBinaries, RuntimeBinary{})
+			if err := m.RuntimeBinaries[len(m.RuntimeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateValaccounts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MigrateValaccounts = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateFundings", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MigrateFundings = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForkPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForkPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForkPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetirePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetirePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetirePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2202,69 +5215,137 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetirePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetirePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetirePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPausePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
-			}
-			m.UploadInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UploadInterval |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPausePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPausePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationShareWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2292,15 +5373,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationShareWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.MinDelegation = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2310,16 +5389,16 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinDelegation |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseFrom", wireType)
 			}
-			m.MaxBundleSize = 0
+			m.PauseFrom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2329,16 +5408,16 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				m.PauseFrom |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseUntil", wireType)
 			}
-			var stringLen uint64
+			m.PauseUntil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2348,27 +5427,114 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PauseUntil |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPausePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPausePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPausePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2396,51 +5562,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
-			}
-			m.StorageProviderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressionId", wireType)
-			}
-			m.CompressionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompressionId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2450,24 +5578,11 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EndKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2489,7 +5604,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2512,10 +5627,10 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2539,7 +5654,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleInflationShareWeightRamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2562,10 +5677,10 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleInflationShareWeightRamp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleInflationShareWeightRamp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2621,7 +5736,7 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2649,8 +5764,82 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = string(dAtA[iNdEx:postIndex])
+			if err := m.FromWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			}
+			m.StartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			m.EndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2672,7 +5861,7 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleInflationShareWeightRampResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2695,10 +5884,10 @@ func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleInflationShareWeightRampResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleInflationShareWeightRampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2722,7 +5911,7 @@ func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisablePool) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleRuntimeUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2745,10 +5934,10 @@ func (m *MsgDisablePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisablePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleRuntimeUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisablePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleRuntimeUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2784,10 +5973,10 @@ func (m *MsgDisablePool) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2797,114 +5986,129 @@ func (m *MsgDisablePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDisablePoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisablePoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisablePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEnablePool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnablePool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnablePool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2932,13 +6136,17 @@ func (m *MsgEnablePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.LegacyDec
+			m.ReadinessThreshold = &v
+			if err := m.ReadinessThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessDeadline", wireType)
 			}
-			m.Id = 0
+			m.ReadinessDeadline = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2948,11 +6156,45 @@ func (m *MsgEnablePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ReadinessDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeBinaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeBinaries = append(m.RuntimeBinaries, RuntimeBinary{})
+			if err := m.RuntimeBinaries[len(m.RuntimeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2974,7 +6216,7 @@ func (m *MsgEnablePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnablePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleRuntimeUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2997,10 +6239,10 @@ func (m *MsgEnablePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnablePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleRuntimeUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnablePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleRuntimeUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3024,7 +6266,7 @@ func (m *MsgEnablePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScheduleRuntimeUpgrade) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRuntimeUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3047,10 +6289,10 @@ func (m *MsgScheduleRuntimeUpgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScheduleRuntimeUpgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRuntimeUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScheduleRuntimeUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRuntimeUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3117,108 +6359,6 @@ func (m *MsgScheduleRuntimeUpgrade) Unmarshal(dAtA []byte) error {
 			}
 			m.Runtime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
-			}
-			m.ScheduledAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			m.Duration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3240,7 +6380,7 @@ func (m *MsgScheduleRuntimeUpgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScheduleRuntimeUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRuntimeUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3263,10 +6403,10 @@ func (m *MsgScheduleRuntimeUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScheduleRuntimeUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRuntimeUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScheduleRuntimeUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRuntimeUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3290,7 +6430,7 @@ func (m *MsgScheduleRuntimeUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRuntimeUpgrade) Unmarshal(dAtA []byte) error {
+func (m *MsgSignalUpgradeReady) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3313,15 +6453,15 @@ func (m *MsgCancelRuntimeUpgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRuntimeUpgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignalUpgradeReady: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRuntimeUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignalUpgradeReady: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3349,11 +6489,11 @@ func (m *MsgCancelRuntimeUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3381,7 +6521,58 @@ func (m *MsgCancelRuntimeUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3404,7 +6595,7 @@ func (m *MsgCancelRuntimeUpgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRuntimeUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSignalUpgradeReadyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3427,10 +6618,10 @@ func (m *MsgCancelRuntimeUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRuntimeUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignalUpgradeReadyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRuntimeUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignalUpgradeReadyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
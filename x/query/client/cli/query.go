@@ -33,6 +33,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Pool
 	cmd.AddCommand(CmdShowPool())
 	cmd.AddCommand(CmdListPool())
+	cmd.AddCommand(CmdListPoolConfigChanges())
+	cmd.AddCommand(CmdShowPoolRuntimeBinaries())
+	cmd.AddCommand(CmdShowInflationAllocation())
+	cmd.AddCommand(CmdShowInflationLedger())
 
 	// Staking
 	cmd.AddCommand(CmdShowStaker())
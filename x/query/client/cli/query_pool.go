@@ -94,3 +94,145 @@ func CmdShowPool() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListPoolConfigChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-config-changes [pool_id]",
+		Short: "list the configuration change history of the pool given by pool_id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryPoolClient(clientCtx)
+
+			params := &types.QueryPoolConfigChangesRequest{
+				PoolId:     poolId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PoolConfigChanges(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPoolRuntimeBinaries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-runtime-binaries [pool_id]",
+		Short: "shows the checksummed runtime binaries of the pool given by pool_id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryPoolClient(clientCtx)
+
+			params := &types.QueryPoolRuntimeBinariesRequest{
+				PoolId: poolId,
+			}
+
+			res, err := queryClient.PoolRuntimeBinaries(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowInflationAllocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-allocation",
+		Short: "shows how the protocol inflation is currently split between all active pools",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryPoolClient(clientCtx)
+
+			res, err := queryClient.InflationAllocation(context.Background(), &types.QueryInflationAllocationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowInflationLedger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-ledger [pool_id]",
+		Short: "shows the cumulative protocol inflation the pool given by pool_id has received",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryPoolClient(clientCtx)
+
+			params := &types.QueryInflationLedgerRequest{
+				PoolId: poolId,
+			}
+
+			res, err := queryClient.InflationLedger(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
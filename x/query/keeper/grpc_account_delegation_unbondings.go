@@ -33,9 +33,11 @@ func (k Keeper) AccountDelegationUnbondings(goCtx context.Context, req *types.Qu
 			unbondingEntry, _ := k.delegationKeeper.GetUndelegationQueueEntry(ctx, index)
 
 			delegationUnbondings = append(delegationUnbondings, types.DelegationUnbonding{
-				Amount:       unbondingEntry.Amount,
-				CreationTime: unbondingEntry.CreationTime,
-				Staker:       k.GetFullStaker(ctx, unbondingEntry.Staker),
+				Amount:         unbondingEntry.Amount,
+				CreationTime:   unbondingEntry.CreationTime,
+				Staker:         k.GetFullStaker(ctx, unbondingEntry.Staker),
+				PoolDelegation: unbondingEntry.PoolDelegation,
+				PoolId:         unbondingEntry.PoolId,
 			})
 		}
 		return true, nil
@@ -32,7 +32,7 @@ func (k Keeper) AccountFundedList(goCtx context.Context, req *types.QueryAccount
 					Name:                 pool.Name,
 					Runtime:              pool.Runtime,
 					Logo:                 pool.Logo,
-					InflationShareWeight: pool.InflationShareWeight,
+					InflationShareWeight: pool.GetInflationShareWeightAt(uint64(ctx.BlockTime().Unix())),
 					UploadInterval:       pool.UploadInterval,
 					TotalFunds:           k.fundersKeeper.GetTotalActiveFunding(ctx, pool.Id),
 					TotalDelegation:      k.delegationKeeper.GetDelegationOfPool(ctx, pool.Id),
@@ -25,6 +25,7 @@ func (k Keeper) Delegator(goCtx context.Context, req *types.QueryDelegatorReques
 		CurrentRewards:   k.delegationKeeper.GetOutstandingRewards(ctx, req.Staker, req.Delegator),
 		DelegationAmount: k.delegationKeeper.GetDelegationAmountOfDelegator(ctx, req.Staker, req.Delegator),
 		Staker:           req.Staker,
+		AutoRestake:      k.delegationKeeper.DoesAutoRestakeExist(ctx, req.Staker, req.Delegator),
 	}
 
 	return &response, nil
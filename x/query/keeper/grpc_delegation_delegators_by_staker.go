@@ -38,6 +38,7 @@ func (k Keeper) DelegatorsByStaker(goCtx context.Context, req *types.QueryDelega
 				CurrentRewards:   k.delegationKeeper.GetOutstandingRewards(ctx, req.Staker, delegator.Delegator),
 				DelegationAmount: k.delegationKeeper.GetDelegationAmountOfDelegator(ctx, req.Staker, delegator.Delegator),
 				Staker:           req.Staker,
+				AutoRestake:      k.delegationKeeper.DoesAutoRestakeExist(ctx, req.Staker, delegator.Delegator),
 			})
 		}
 		return true, nil
@@ -34,6 +34,7 @@ func (k Keeper) StakersByDelegator(goCtx context.Context, req *types.QueryStaker
 				Staker:           k.GetFullStaker(ctx, staker),
 				CurrentRewards:   k.delegationKeeper.GetOutstandingRewards(ctx, staker, req.Delegator),
 				DelegationAmount: k.delegationKeeper.GetDelegationAmountOfDelegator(ctx, staker, req.Delegator),
+				AutoRestake:      k.delegationKeeper.DoesAutoRestakeExist(ctx, staker, req.Delegator),
 			})
 		}
 
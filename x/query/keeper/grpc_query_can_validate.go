@@ -27,7 +27,7 @@ func (k Keeper) CanValidate(c context.Context, req *types.QueryCanValidateReques
 
 	// Check if valaddress has a valaccount in pool
 	for _, valaccount := range k.stakerKeeper.GetAllValaccountsOfPool(ctx, req.PoolId) {
-		if valaccount.Valaddress == req.Valaddress {
+		if valaccount.HasValaddress(req.Valaddress) {
 			staker = valaccount.Staker
 			break
 		}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	// Query
+	"github.com/KYVENetwork/chain/x/query/types"
+)
+
+func (k Keeper) InflationAllocation(c context.Context, req *types.QueryInflationAllocationRequest) (*types.QueryInflationAllocationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// only active pools receive protocol inflation
+	activePools := make([]pooltypes.Pool, 0)
+
+	for _, pool := range k.poolKeeper.GetAllPools(ctx) {
+		if err := k.bundleKeeper.AssertPoolCanRun(ctx, pool.Id); err == nil {
+			activePools = append(activePools, pool)
+		}
+	}
+
+	allocations := k.poolKeeper.GetInflationAllocations(ctx, activePools)
+
+	totalInflationShareWeight := math.LegacyZeroDec()
+	totalDemand := uint64(0)
+
+	for _, allocation := range allocations {
+		totalInflationShareWeight = totalInflationShareWeight.Add(allocation.InflationShareWeight)
+		totalDemand += allocation.Demand
+	}
+
+	return &types.QueryInflationAllocationResponse{
+		DynamicInflationShare:     k.poolKeeper.GetDynamicInflationShare(ctx),
+		DynamicInflationMode:      k.poolKeeper.GetDynamicInflationMode(ctx),
+		TotalInflationShareWeight: totalInflationShareWeight,
+		TotalDemand:               totalDemand,
+		Allocations:               allocations,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	// Query
+	"github.com/KYVENetwork/chain/x/query/types"
+)
+
+func (k Keeper) InflationLedger(c context.Context, req *types.QueryInflationLedgerRequest) (*types.QueryInflationLedgerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the ledger is kept after a pool got retired so its history can still be audited
+	inflationLedger, found := k.poolKeeper.GetInflationLedger(ctx, req.PoolId)
+	if !found {
+		if err := k.poolKeeper.AssertPoolExists(ctx, req.PoolId); err != nil {
+			return nil, err
+		}
+
+		inflationLedger = pooltypes.InflationLedger{PoolId: req.PoolId}
+	}
+
+	return &types.QueryInflationLedgerResponse{InflationLedger: inflationLedger}, nil
+}
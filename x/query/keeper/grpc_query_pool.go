@@ -72,6 +72,8 @@ func (k Keeper) parsePoolResponse(ctx sdk.Context, pool *poolTypes.Pool) types.P
 		fundings = append(fundings, &fundingsOfPool[index])
 	}
 
+	readyStakers, readyDelegation, totalDelegationOfPool := k.poolKeeper.GetUpgradeReadinessTally(ctx, *pool)
+
 	return types.PoolResponse{
 		Id:                  pool.Id,
 		Data:                pool,
@@ -83,5 +85,10 @@ func (k Keeper) parsePoolResponse(ctx sdk.Context, pool *poolTypes.Pool) types.P
 		Account:             poolAccount.String(),
 		AccountBalance:      poolBalance,
 		Fundings:            fundings,
+		UpgradeReadiness: &types.UpgradeReadinessResponse{
+			ReadyStakers:    readyStakers,
+			ReadyDelegation: readyDelegation,
+			TotalDelegation: totalDelegationOfPool,
+		},
 	}
 }
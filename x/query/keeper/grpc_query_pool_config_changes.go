@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	// Query
+	"github.com/KYVENetwork/chain/x/query/types"
+)
+
+func (k Keeper) PoolConfigChanges(c context.Context, req *types.QueryPoolConfigChangesRequest) (*types.QueryPoolConfigChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := k.poolKeeper.AssertPoolExists(ctx, req.PoolId); err != nil {
+		return nil, err
+	}
+
+	changes, pageRes, err := k.poolKeeper.GetPaginatedPoolConfigChangesQuery(ctx, req.Pagination, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolConfigChangesResponse{ConfigChanges: changes, Pagination: pageRes}, nil
+}
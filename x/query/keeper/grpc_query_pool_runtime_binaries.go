@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	// Query
+	"github.com/KYVENetwork/chain/x/query/types"
+)
+
+func (k Keeper) PoolRuntimeBinaries(c context.Context, req *types.QueryPoolRuntimeBinariesRequest) (*types.QueryPoolRuntimeBinariesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, err := k.poolKeeper.GetPoolWithError(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolRuntimeBinariesResponse{
+		Version:                pool.Protocol.GetVersion(),
+		RuntimeBinaries:        pool.Protocol.GetRuntimeBinaries(),
+		UpgradeVersion:         pool.UpgradePlan.GetVersion(),
+		UpgradeRuntimeBinaries: pool.UpgradePlan.GetRuntimeBinaries(),
+	}, nil
+}
@@ -28,6 +28,9 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) *types.Full
 		Details:                 staker.Details,
 		PendingCommissionChange: commissionChangeEntry,
 		CommissionRewards:       staker.CommissionRewards,
+		MaxCommission:           staker.MaxCommission,
+		MaxCommissionChange:     staker.MaxCommissionChange,
+		AutoCompoundCommission:  staker.AutoCompoundCommission,
 	}
 
 	delegationData, _ := k.delegationKeeper.GetDelegationData(ctx, staker.Address)
@@ -41,6 +44,28 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) *types.Full
 		accountValaddress, _ := sdk.AccAddressFromBech32(valaccount.Valaddress)
 		balanceValaccount := k.bankKeeper.GetBalance(ctx, accountValaddress, globalTypes.Denom).Amount.Uint64()
 
+		balanceSecondaryValaccount := uint64(0)
+		if valaccount.SecondaryValaddress != "" {
+			accountSecondaryValaddress, _ := sdk.AccAddressFromBech32(valaccount.SecondaryValaddress)
+			balanceSecondaryValaccount = k.bankKeeper.GetBalance(ctx, accountSecondaryValaddress, globalTypes.Denom).Amount.Uint64()
+		}
+
+		var feeAllowance *types.FeeAllowance = nil
+		if allowance, found := k.stakerKeeper.GetFeeAllowance(ctx, staker.Address, valaccount.Valaddress); found {
+			feeAllowance = &types.FeeAllowance{
+				Period:           uint64(allowance.Period.Seconds()),
+				PeriodSpendLimit: allowance.PeriodSpendLimit.AmountOf(globalTypes.Denom).Uint64(),
+				PeriodCanSpend:   allowance.PeriodCanSpend.AmountOf(globalTypes.Denom).Uint64(),
+				PeriodReset:      uint64(allowance.PeriodReset.Unix()),
+			}
+
+			// the allowance is only updated when it is used, therefore
+			// a new period might have already started
+			if !ctx.BlockTime().Before(allowance.PeriodReset) {
+				feeAllowance.PeriodCanSpend = feeAllowance.PeriodSpendLimit
+			}
+		}
+
 		poolMemberships = append(
 			poolMemberships, &types.PoolMembership{
 				Pool: &types.BasicPool{
@@ -48,16 +73,20 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) *types.Full
 					Name:                 pool.Name,
 					Runtime:              pool.Runtime,
 					Logo:                 pool.Logo,
-					InflationShareWeight: pool.InflationShareWeight,
+					InflationShareWeight: pool.GetInflationShareWeightAt(uint64(ctx.BlockTime().Unix())),
 					UploadInterval:       pool.UploadInterval,
 					TotalFunds:           k.fundersKeeper.GetTotalActiveFunding(ctx, pool.Id),
 					TotalDelegation:      k.delegationKeeper.GetDelegationOfPool(ctx, pool.Id),
 					Status:               k.GetPoolStatus(ctx, &pool),
 				},
-				Points:     valaccount.Points,
-				IsLeaving:  valaccount.IsLeaving,
-				Valaddress: valaccount.Valaddress,
-				Balance:    balanceValaccount,
+				Points:              valaccount.Points,
+				IsLeaving:           valaccount.IsLeaving,
+				Valaddress:          valaccount.Valaddress,
+				Balance:             balanceValaccount,
+				SecondaryValaddress: valaccount.SecondaryValaddress,
+				SecondaryBalance:    balanceSecondaryValaccount,
+				MaintenanceUntil:    valaccount.MaintenanceUntil,
+				FeeAllowance:        feeAllowance,
 			},
 		)
 	}
@@ -90,10 +119,12 @@ func (k Keeper) GetPoolStatus(ctx sdk.Context, pool *pooltypes.Pool) pooltypes.P
 	var poolStatus pooltypes.PoolStatus
 
 	poolStatus = pooltypes.POOL_STATUS_ACTIVE
-	if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
+	if pool.UpgradePlan.IsUpgrading(uint64(ctx.BlockTime().Unix())) {
 		poolStatus = pooltypes.POOL_STATUS_UPGRADING
 	} else if pool.Disabled {
 		poolStatus = pooltypes.POOL_STATUS_DISABLED
+	} else if pool.IsPaused(uint64(ctx.BlockTime().Unix())) {
+		poolStatus = pooltypes.POOL_STATUS_PAUSED
 	} else if pool.EndKey != "" && pool.EndKey == pool.CurrentKey {
 		poolStatus = pooltypes.POOL_STATUS_END_KEY_REACHED
 	} else if totalDelegation < pool.MinDelegation {
@@ -301,6 +301,10 @@ type DelegationUnbonding struct {
 	CreationTime uint64 `protobuf:"varint,2,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
 	// staker
 	Staker *FullStaker `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_delegation is true if the unbonding is from a pool delegation
+	PoolDelegation bool `protobuf:"varint,4,opt,name=pool_delegation,json=poolDelegation,proto3" json:"pool_delegation,omitempty"`
+	// pool_id of the pool delegation
+	PoolId uint64 `protobuf:"varint,5,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
 func (m *DelegationUnbonding) Reset()         { *m = DelegationUnbonding{} }
@@ -357,6 +361,20 @@ func (m *DelegationUnbonding) GetStaker() *FullStaker {
 	return nil
 }
 
+func (m *DelegationUnbonding) GetPoolDelegation() bool {
+	if m != nil {
+		return m.PoolDelegation
+	}
+	return false
+}
+
+func (m *DelegationUnbonding) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 // QueryAccountFundedListRequest is the request type for the account queries with pagination
 type QueryAccountFundedListRequest struct {
 	// address ...
@@ -677,69 +695,70 @@ func init() {
 func init() { proto.RegisterFile("kyve/query/v1beta1/account.proto", fileDescriptor_51ca316755261aec) }
 
 var fileDescriptor_51ca316755261aec = []byte{
-	// 979 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x26, 0xfe, 0x3a, 0xfa, 0xbe, 0xb4, 0x94, 0x4c, 0x50, 0xe5, 0x3a, 0x64, 0x1d, 0x2d,
-	0x82, 0x44, 0x11, 0xdd, 0x95, 0x9d, 0x14, 0xb5, 0x04, 0x0e, 0x75, 0x7e, 0x70, 0x28, 0xa0, 0xb2,
-	0x01, 0xa4, 0xf6, 0x62, 0x8d, 0x77, 0xc7, 0x9b, 0x21, 0xeb, 0x1d, 0x77, 0x67, 0x9c, 0x10, 0x55,
-	0x5c, 0x38, 0x02, 0x07, 0x24, 0xfe, 0x05, 0x24, 0x10, 0xe2, 0xc0, 0x85, 0x5b, 0x4f, 0x9c, 0x7a,
-	0xac, 0xc4, 0x85, 0x0b, 0x3f, 0x94, 0x20, 0xf1, 0x6f, 0xa0, 0x9d, 0x99, 0x75, 0xc6, 0x78, 0x6d,
-	0x53, 0xa4, 0x5e, 0x92, 0x9d, 0x99, 0xcf, 0xe7, 0xbd, 0xcf, 0x7b, 0xf3, 0xde, 0x1b, 0xc3, 0xea,
-	0xd1, 0xe9, 0x31, 0xf1, 0x1e, 0xf4, 0x49, 0x7a, 0xea, 0x1d, 0xd7, 0xdb, 0x44, 0xe0, 0xba, 0x87,
-	0x83, 0x80, 0xf5, 0x13, 0xe1, 0xf6, 0x52, 0x26, 0x18, 0x42, 0x19, 0xc2, 0x95, 0x08, 0x57, 0x23,
-	0xaa, 0x8b, 0xb8, 0x4b, 0x13, 0xe6, 0xc9, 0xbf, 0x0a, 0x56, 0xdd, 0x08, 0x18, 0xef, 0x32, 0xee,
-	0xb5, 0x31, 0xff, 0xa7, 0xbd, 0x1e, 0x8e, 0x68, 0x82, 0x05, 0x65, 0x89, 0xc6, 0xda, 0x26, 0x36,
-	0x47, 0x05, 0x8c, 0xe6, 0xe7, 0x2f, 0x44, 0x2c, 0x62, 0xf2, 0xd3, 0xcb, 0xbe, 0xf4, 0xee, 0x8b,
-	0x11, 0x63, 0x51, 0x4c, 0x3c, 0xdc, 0xa3, 0x1e, 0x4e, 0x12, 0x26, 0xa4, 0x49, 0x9e, 0xdb, 0x2c,
-	0x08, 0x44, 0x89, 0x96, 0xe7, 0xce, 0x0d, 0xb8, 0xf6, 0x5e, 0xb6, 0xbc, 0xad, 0x82, 0xbb, 0xcd,
-	0x39, 0x11, 0xdc, 0x27, 0x0f, 0xfa, 0x84, 0x0b, 0x54, 0x81, 0x79, 0x1c, 0x86, 0x29, 0xe1, 0xbc,
-	0x62, 0xad, 0x5a, 0xeb, 0xff, 0xf7, 0xf3, 0xa5, 0xf3, 0x7d, 0x09, 0xaa, 0x45, 0x3c, 0xde, 0x63,
-	0x09, 0x27, 0x19, 0xb1, 0x8d, 0x63, 0x9c, 0x04, 0x44, 0x12, 0x4b, 0x7e, 0xbe, 0x44, 0x37, 0xa1,
-	0x22, 0x1d, 0x07, 0x2c, 0x6e, 0x71, 0x12, 0x77, 0x5a, 0x21, 0x89, 0x49, 0x24, 0x25, 0x57, 0x66,
-	0x25, 0xf4, 0x6a, 0x7e, 0x7e, 0x40, 0xe2, 0xce, 0xee, 0xe0, 0x14, 0xdd, 0x01, 0x67, 0x1c, 0xb3,
-	0xd5, 0x4f, 0xda, 0x2c, 0x09, 0x69, 0x12, 0x55, 0xe6, 0xa4, 0x8d, 0x5a, 0xb1, 0x8d, 0x0f, 0x72,
-	0x18, 0xf2, 0x60, 0x69, 0x60, 0xcc, 0x50, 0x50, 0x92, 0x6c, 0x94, 0x1f, 0x19, 0xde, 0x9b, 0xb0,
-	0x52, 0x40, 0x30, 0x1c, 0xff, 0x4f, 0x52, 0x97, 0x47, 0xa9, 0x17, 0x4e, 0x1f, 0xc2, 0xf3, 0x03,
-	0x1b, 0x29, 0x39, 0xc1, 0x69, 0xc8, 0x2b, 0xe5, 0xd5, 0xb9, 0xf5, 0x85, 0xc6, 0x35, 0x57, 0x5d,
-	0xbd, 0x9b, 0x5d, 0x7d, 0x5e, 0x4e, 0xee, 0x0e, 0xa3, 0x49, 0xf3, 0xc6, 0xe3, 0xdf, 0x6a, 0x33,
-	0xdf, 0xfd, 0x5e, 0x5b, 0x8f, 0xa8, 0x38, 0xec, 0xb7, 0xdd, 0x80, 0x75, 0x3d, 0x5d, 0x27, 0xea,
-	0xdf, 0x75, 0x1e, 0x1e, 0x79, 0xe2, 0xb4, 0x47, 0xb8, 0x24, 0xf0, 0x6f, 0xff, 0xfa, 0x61, 0xc3,
-	0xf2, 0xaf, 0xe4, 0x9e, 0x7c, 0xe5, 0x68, 0xc8, 0x79, 0xa7, 0xaf, 0x34, 0xcf, 0x3f, 0x6b, 0xe7,
-	0xfb, 0xca, 0x91, 0xf3, 0xb9, 0x05, 0x6b, 0x66, 0xb9, 0x14, 0x64, 0x67, 0x50, 0x74, 0xfb, 0x00,
-	0x17, 0x9d, 0x21, 0xcb, 0x67, 0xa1, 0xf1, 0xca, 0x90, 0xc4, 0xa1, 0xa6, 0x73, 0xef, 0xe2, 0x88,
-	0x68, 0xae, 0x6f, 0x30, 0xcd, 0xe2, 0x9d, 0x1d, 0x2e, 0xde, 0x9f, 0x2c, 0x58, 0x9f, 0xae, 0x46,
-	0x97, 0xf2, 0x3b, 0x00, 0x83, 0x4b, 0xce, 0xda, 0x20, 0xcb, 0xd8, 0x9a, 0x3b, 0xda, 0xfc, 0x6e,
-	0x81, 0x95, 0x66, 0x29, 0xcb, 0x9f, 0x6f, 0x18, 0x40, 0x6f, 0x0d, 0x45, 0x37, 0x2b, 0xa3, 0x5b,
-	0x9b, 0x1a, 0x9d, 0xd2, 0x62, 0x86, 0xe7, 0x7c, 0x66, 0xc1, 0x52, 0x51, 0x91, 0x5d, 0x85, 0x32,
-	0xee, 0x66, 0x51, 0xe9, 0xce, 0xd3, 0x2b, 0xf4, 0x12, 0x5c, 0x0e, 0x52, 0xa2, 0xaa, 0x56, 0xd0,
-	0x2e, 0xd1, 0xdd, 0x76, 0x29, 0xdf, 0x7c, 0x9f, 0x76, 0x09, 0x7a, 0x0d, 0xca, 0x5c, 0xe0, 0x23,
-	0x92, 0xca, 0x3e, 0x5a, 0x68, 0xd8, 0x45, 0x81, 0xee, 0xf7, 0xe3, 0xf8, 0x40, 0xa2, 0x7c, 0x8d,
-	0x76, 0x6e, 0xc1, 0x8a, 0x99, 0xd0, 0xec, 0xda, 0x49, 0xf8, 0x36, 0xe5, 0x62, 0xfa, 0x24, 0xb9,
-	0x0f, 0xf6, 0x38, 0xaa, 0xbe, 0x81, 0x9b, 0x50, 0xee, 0xc8, 0x5d, 0x9d, 0xfd, 0x6a, 0xb1, 0xa8,
-	0x0c, 0xa1, 0x13, 0xae, 0xf1, 0xce, 0x37, 0x16, 0x94, 0xd5, 0x01, 0xfa, 0x08, 0xe6, 0x55, 0x22,
-	0x78, 0x65, 0xee, 0x19, 0x55, 0x7d, 0xee, 0x00, 0xd5, 0xa1, 0xd4, 0x63, 0x2c, 0xd6, 0xb7, 0xbb,
-	0x52, 0x24, 0xb7, 0x89, 0x39, 0x0d, 0xee, 0x32, 0x16, 0xfb, 0x12, 0xea, 0x6c, 0x43, 0xcd, 0xcc,
-	0x82, 0x4f, 0x2e, 0x66, 0xcc, 0xf4, 0x14, 0x3e, 0xb2, 0x60, 0x75, 0x3c, 0x5b, 0x67, 0x91, 0xc1,
-	0x4a, 0x6a, 0xec, 0xb7, 0x02, 0xc6, 0xe2, 0x90, 0x9d, 0x24, 0x2d, 0x92, 0x88, 0x94, 0x92, 0xbc,
-	0xb4, 0x5f, 0x2e, 0x52, 0x6b, 0x1a, 0xdc, 0x4b, 0x44, 0x7a, 0xaa, 0xf3, 0xbc, 0x6c, 0x5a, 0xdc,
-	0xd1, 0x06, 0xf7, 0x94, 0x3d, 0xb4, 0x06, 0x57, 0xf0, 0x31, 0xa6, 0x31, 0x6e, 0xc7, 0xa4, 0xc5,
-	0x63, 0x26, 0xb8, 0x2e, 0xb9, 0xe7, 0x06, 0xdb, 0x07, 0xd9, 0xae, 0x73, 0x0f, 0x16, 0x47, 0x1c,
-	0x0c, 0x95, 0x6b, 0x88, 0x45, 0xfe, 0x8e, 0x0c, 0xca, 0x75, 0x17, 0x0b, 0x82, 0x6a, 0xb0, 0xd0,
-	0xa1, 0x09, 0xe5, 0x87, 0x0a, 0xa2, 0xcc, 0x83, 0xda, 0xca, 0x00, 0x8d, 0x2f, 0xca, 0x70, 0xc9,
-	0xcc, 0x0c, 0xfa, 0xda, 0x82, 0xcb, 0x43, 0x4f, 0x16, 0xba, 0x5e, 0x14, 0xf0, 0xd8, 0x27, 0xb1,
-	0xea, 0xfe, 0x5b, 0xb8, 0x4a, 0xbb, 0xb3, 0xf5, 0xe9, 0xcf, 0x7f, 0x7e, 0x35, 0xeb, 0xa2, 0x57,
-	0xbd, 0xf1, 0xbf, 0x28, 0x5a, 0x58, 0x72, 0xbc, 0x87, 0xfa, 0x42, 0x3f, 0x41, 0xbf, 0x5a, 0xb0,
-	0x3c, 0x61, 0x38, 0xa1, 0xed, 0x69, 0x2a, 0x26, 0x0c, 0xd8, 0xea, 0x1b, 0xff, 0x8d, 0xac, 0x03,
-	0xda, 0x91, 0x01, 0xbd, 0x89, 0xb6, 0x27, 0x05, 0x54, 0xf4, 0x42, 0x9a, 0xf1, 0xfd, 0x68, 0xc1,
-	0xe2, 0x48, 0xc3, 0xa3, 0xfa, 0x34, 0x61, 0x23, 0x73, 0xa5, 0xda, 0x78, 0x1a, 0x8a, 0x8e, 0xe0,
-	0x96, 0x8c, 0x60, 0x13, 0xd5, 0x27, 0x45, 0xa0, 0x26, 0x48, 0x2b, 0xa6, 0x5c, 0x18, 0xba, 0x1f,
-	0x59, 0xb0, 0x54, 0xd0, 0x64, 0x68, 0x73, 0x9a, 0x8c, 0x82, 0x86, 0xae, 0x6e, 0x3d, 0x1d, 0x49,
-	0xab, 0x7f, 0x5d, 0xaa, 0xdf, 0x42, 0x8d, 0x49, 0xea, 0xcd, 0xbe, 0xbc, 0x90, 0xdf, 0xdc, 0x7d,
-	0x7c, 0x66, 0x5b, 0x4f, 0xce, 0x6c, 0xeb, 0x8f, 0x33, 0xdb, 0xfa, 0xf2, 0xdc, 0x9e, 0x79, 0x72,
-	0x6e, 0xcf, 0xfc, 0x72, 0x6e, 0xcf, 0xdc, 0xdf, 0x30, 0x46, 0xdd, 0x9d, 0x7b, 0x1f, 0xee, 0xbd,
-	0x4b, 0xc4, 0x09, 0x4b, 0x8f, 0xbc, 0xe0, 0x10, 0xd3, 0xc4, 0xfb, 0x58, 0xbb, 0x91, 0x23, 0xaf,
-	0x5d, 0x96, 0xaf, 0xfb, 0xe6, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x21, 0xde, 0xf4, 0x2f, 0x24,
-	0x0b, 0x00, 0x00,
+	// 1000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0xc6, 0x81, 0x97, 0x7e, 0x90, 0x09, 0x2a, 0xae, 0x43, 0xec, 0x68, 0x11, 0x24,
+	0x8a, 0xe8, 0xae, 0xec, 0xa4, 0xa8, 0x25, 0x70, 0xa8, 0xf3, 0x81, 0x50, 0x01, 0x95, 0x0d, 0x20,
+	0xb5, 0x17, 0x6b, 0xbc, 0x3b, 0xde, 0x0c, 0x59, 0xef, 0xb8, 0x3b, 0xe3, 0x84, 0xa8, 0xe2, 0xc2,
+	0x15, 0x0e, 0x48, 0xfc, 0x0b, 0x48, 0x20, 0xc4, 0x81, 0x0b, 0xb7, 0x9e, 0x38, 0xf5, 0xc0, 0xa1,
+	0x12, 0x17, 0x2e, 0x7c, 0x28, 0x41, 0xe2, 0xdf, 0x40, 0x3b, 0x33, 0xeb, 0x8c, 0xf1, 0xda, 0xa6,
+	0x95, 0x72, 0x49, 0x3c, 0x33, 0xbf, 0xf7, 0xde, 0xef, 0xf7, 0x3e, 0x66, 0x16, 0x96, 0x0f, 0x8e,
+	0x0f, 0x89, 0x7b, 0xbf, 0x47, 0x92, 0x63, 0xf7, 0xb0, 0xd6, 0x22, 0x02, 0xd7, 0x5c, 0xec, 0xfb,
+	0xac, 0x17, 0x0b, 0xa7, 0x9b, 0x30, 0xc1, 0x10, 0x4a, 0x11, 0x8e, 0x44, 0x38, 0x1a, 0x51, 0x9e,
+	0xc7, 0x1d, 0x1a, 0x33, 0x57, 0xfe, 0x55, 0xb0, 0xf2, 0x9a, 0xcf, 0x78, 0x87, 0x71, 0xb7, 0x85,
+	0xf9, 0x7f, 0xfd, 0x75, 0x71, 0x48, 0x63, 0x2c, 0x28, 0x8b, 0x35, 0xb6, 0x62, 0x62, 0x33, 0x94,
+	0xcf, 0x68, 0x76, 0xfe, 0x42, 0xc8, 0x42, 0x26, 0x7f, 0xba, 0xe9, 0x2f, 0xbd, 0xfb, 0x52, 0xc8,
+	0x58, 0x18, 0x11, 0x17, 0x77, 0xa9, 0x8b, 0xe3, 0x98, 0x09, 0xe9, 0x92, 0x67, 0x3e, 0x73, 0x84,
+	0x28, 0xd2, 0xf2, 0xdc, 0xbe, 0x0e, 0x57, 0x3f, 0x48, 0x97, 0xb7, 0x94, 0xb8, 0x5b, 0x9c, 0x13,
+	0xc1, 0x3d, 0x72, 0xbf, 0x47, 0xb8, 0x40, 0x25, 0x98, 0xc5, 0x41, 0x90, 0x10, 0xce, 0x4b, 0xd6,
+	0xb2, 0xb5, 0xfa, 0x9c, 0x97, 0x2d, 0xed, 0x1f, 0x0a, 0x50, 0xce, 0xb3, 0xe3, 0x5d, 0x16, 0x73,
+	0x92, 0x1a, 0xb6, 0x70, 0x84, 0x63, 0x9f, 0x48, 0xc3, 0x82, 0x97, 0x2d, 0xd1, 0x0d, 0x28, 0xc9,
+	0xc0, 0x3e, 0x8b, 0x9a, 0x9c, 0x44, 0xed, 0x66, 0x40, 0x22, 0x12, 0x4a, 0xca, 0xa5, 0x69, 0x09,
+	0xbd, 0x92, 0x9d, 0xef, 0x91, 0xa8, 0xbd, 0xdd, 0x3f, 0x45, 0xb7, 0xc1, 0x1e, 0x65, 0xd9, 0xec,
+	0xc5, 0x2d, 0x16, 0x07, 0x34, 0x0e, 0x4b, 0x33, 0xd2, 0x47, 0x35, 0xdf, 0xc7, 0x47, 0x19, 0x0c,
+	0xb9, 0xb0, 0xd0, 0x77, 0x66, 0x30, 0x28, 0x48, 0x6b, 0x94, 0x1d, 0x19, 0xd1, 0x1b, 0xb0, 0x94,
+	0x63, 0x60, 0x04, 0x7e, 0x46, 0x9a, 0x2e, 0x0e, 0x9b, 0x9e, 0x05, 0x7d, 0x00, 0xcf, 0xf7, 0x7d,
+	0x24, 0xe4, 0x08, 0x27, 0x01, 0x2f, 0x15, 0x97, 0x67, 0x56, 0xe7, 0xea, 0x57, 0x1d, 0x55, 0x7a,
+	0x27, 0x2d, 0x7d, 0xd6, 0x4e, 0xce, 0x16, 0xa3, 0x71, 0xe3, 0xfa, 0xa3, 0x3f, 0xaa, 0x53, 0xdf,
+	0xff, 0x59, 0x5d, 0x0d, 0xa9, 0xd8, 0xef, 0xb5, 0x1c, 0x9f, 0x75, 0x5c, 0xdd, 0x27, 0xea, 0xdf,
+	0x35, 0x1e, 0x1c, 0xb8, 0xe2, 0xb8, 0x4b, 0xb8, 0x34, 0xe0, 0xdf, 0xfd, 0xf3, 0xe3, 0x9a, 0xe5,
+	0x5d, 0xce, 0x22, 0x79, 0x2a, 0xd0, 0x40, 0xf0, 0x76, 0x4f, 0x71, 0x9e, 0x3d, 0xef, 0xe0, 0xbb,
+	0x2a, 0x90, 0xfd, 0x85, 0x05, 0x2b, 0x66, 0xbb, 0xe4, 0x64, 0xa7, 0xdf, 0x74, 0xbb, 0x00, 0x67,
+	0x93, 0x21, 0xdb, 0x67, 0xae, 0xfe, 0xea, 0x00, 0xc5, 0x81, 0xa1, 0x73, 0xee, 0xe0, 0x90, 0x68,
+	0x5b, 0xcf, 0xb0, 0x34, 0x9b, 0x77, 0x7a, 0xb0, 0x79, 0x7f, 0xb6, 0x60, 0x75, 0x32, 0x1b, 0xdd,
+	0xca, 0xef, 0x01, 0xf4, 0x8b, 0x9c, 0x8e, 0x41, 0x9a, 0xb1, 0x15, 0x67, 0x78, 0xf8, 0x9d, 0x1c,
+	0x2f, 0x8d, 0x42, 0x9a, 0x3f, 0xcf, 0x70, 0x80, 0xde, 0x1e, 0x50, 0x37, 0x2d, 0xd5, 0xad, 0x4c,
+	0x54, 0xa7, 0xb8, 0x98, 0xf2, 0xec, 0x5f, 0x2c, 0x58, 0xc8, 0x6b, 0xb2, 0x2b, 0x50, 0xc4, 0x9d,
+	0x54, 0x95, 0x9e, 0x3c, 0xbd, 0x42, 0x2f, 0xc3, 0x45, 0x3f, 0x21, 0xaa, 0x6b, 0x05, 0xed, 0x10,
+	0x3d, 0x6d, 0x17, 0xb2, 0xcd, 0x0f, 0x69, 0x87, 0xa0, 0xd7, 0xa1, 0xc8, 0x05, 0x3e, 0x20, 0x89,
+	0x9c, 0xa3, 0xb9, 0x7a, 0x25, 0x4f, 0xe8, 0x6e, 0x2f, 0x8a, 0xf6, 0x24, 0xca, 0xd3, 0x68, 0xb4,
+	0x02, 0x97, 0xbb, 0x6c, 0x78, 0x94, 0x9e, 0xf5, 0x2e, 0xa5, 0xdb, 0xc6, 0x18, 0xbd, 0x08, 0xb3,
+	0x12, 0x48, 0x03, 0x3d, 0x30, 0xc5, 0x74, 0xf9, 0x4e, 0x60, 0xdf, 0x84, 0x25, 0xb3, 0x24, 0x69,
+	0xe3, 0x90, 0xe0, 0x5d, 0xca, 0xc5, 0xe4, 0xbb, 0xe8, 0x1e, 0x54, 0x46, 0x99, 0xea, 0x1a, 0xde,
+	0x80, 0x62, 0x5b, 0xee, 0xea, 0xfa, 0x95, 0xf3, 0x65, 0xa5, 0x08, 0x5d, 0x32, 0x8d, 0xb7, 0xbf,
+	0xb5, 0xa0, 0xa8, 0x0e, 0xd0, 0x27, 0x30, 0xab, 0x52, 0xc9, 0x4b, 0x33, 0xe7, 0x34, 0x37, 0x59,
+	0x00, 0x54, 0x83, 0x42, 0x9a, 0x17, 0xdd, 0x1f, 0x4b, 0x79, 0x74, 0x1b, 0x98, 0x53, 0xff, 0x0e,
+	0x63, 0x91, 0x27, 0xa1, 0xf6, 0x26, 0x54, 0xcd, 0x2c, 0x78, 0xe4, 0xac, 0x16, 0x93, 0x53, 0xf8,
+	0xd0, 0x82, 0xe5, 0xd1, 0xd6, 0x3a, 0x8b, 0x0c, 0x96, 0x12, 0x63, 0xbf, 0xe9, 0x33, 0x16, 0x05,
+	0xec, 0x28, 0x6e, 0x92, 0x58, 0x24, 0x94, 0x64, 0xc3, 0xf1, 0x4a, 0x1e, 0x5b, 0xd3, 0xe1, 0x4e,
+	0x2c, 0x92, 0x63, 0x9d, 0xe7, 0x45, 0xd3, 0xe3, 0x96, 0x76, 0xb8, 0xa3, 0xfc, 0xa5, 0x5d, 0x85,
+	0x0f, 0x31, 0x8d, 0x70, 0x2b, 0x22, 0x4d, 0x1e, 0x31, 0xc1, 0x75, 0xd3, 0x5e, 0xea, 0x6f, 0xef,
+	0xa5, 0xbb, 0xf6, 0x5d, 0x98, 0x1f, 0x0a, 0x30, 0xd0, 0xf0, 0x01, 0x16, 0xd9, 0x4b, 0xd4, 0x6f,
+	0xf8, 0x6d, 0x2c, 0x08, 0xaa, 0xc2, 0x5c, 0x9b, 0xc6, 0x94, 0xef, 0x2b, 0x88, 0x72, 0x0f, 0x6a,
+	0x2b, 0x05, 0xd4, 0xbf, 0x2c, 0xc2, 0x05, 0x33, 0x33, 0xe8, 0x1b, 0x0b, 0x2e, 0x0e, 0x3c, 0x7a,
+	0xe8, 0x5a, 0x9e, 0xe0, 0x91, 0x8f, 0x6a, 0xd9, 0xf9, 0xbf, 0x70, 0x95, 0x76, 0x7b, 0xe3, 0xf3,
+	0x5f, 0xff, 0xfe, 0x7a, 0xda, 0x41, 0xaf, 0xb9, 0xa3, 0xbf, 0x49, 0x9a, 0x58, 0xda, 0xb8, 0x0f,
+	0x74, 0x41, 0x3f, 0x43, 0xbf, 0x5b, 0xb0, 0x38, 0xe6, 0x7a, 0x43, 0x9b, 0x93, 0x58, 0x8c, 0xb9,
+	0xa2, 0xcb, 0x6f, 0x3e, 0x9d, 0xb1, 0x16, 0xb4, 0x25, 0x05, 0xbd, 0x85, 0x36, 0xc7, 0x09, 0xca,
+	0x7b, 0x63, 0x4d, 0x7d, 0x3f, 0x59, 0x30, 0x3f, 0x34, 0xf0, 0xa8, 0x36, 0x89, 0xd8, 0xd0, 0xbd,
+	0x52, 0xae, 0x3f, 0x89, 0x89, 0x56, 0x70, 0x53, 0x2a, 0x58, 0x47, 0xb5, 0x71, 0x0a, 0xd4, 0x0d,
+	0xd2, 0x8c, 0x28, 0x17, 0x06, 0xef, 0x87, 0x16, 0x2c, 0xe4, 0x0c, 0x19, 0x5a, 0x9f, 0x44, 0x23,
+	0x67, 0xa0, 0xcb, 0x1b, 0x4f, 0x66, 0xa4, 0xd9, 0xbf, 0x21, 0xd9, 0x6f, 0xa0, 0xfa, 0x38, 0xf6,
+	0xe6, 0x5c, 0x9e, 0xd1, 0x6f, 0x6c, 0x3f, 0x3a, 0xa9, 0x58, 0x8f, 0x4f, 0x2a, 0xd6, 0x5f, 0x27,
+	0x15, 0xeb, 0xab, 0xd3, 0xca, 0xd4, 0xe3, 0xd3, 0xca, 0xd4, 0x6f, 0xa7, 0x95, 0xa9, 0x7b, 0x6b,
+	0xc6, 0x55, 0x77, 0xfb, 0xee, 0xc7, 0x3b, 0xef, 0x13, 0x71, 0xc4, 0x92, 0x03, 0xd7, 0xdf, 0xc7,
+	0x34, 0x76, 0x3f, 0xd5, 0x61, 0xe4, 0x95, 0xd7, 0x2a, 0xca, 0xef, 0x83, 0xf5, 0x7f, 0x07, 0x00,
+	0x09, 0xd8, 0x43, 0x46, 0x66, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1155,6 +1174,21 @@ func (m *DelegationUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolDelegation {
+		i--
+		if m.PoolDelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Staker != nil {
 		{
 			size, err := m.Staker.MarshalToSizedBuffer(dAtA[:i])
@@ -1513,6 +1547,12 @@ func (m *DelegationUnbonding) Size() (n int) {
 		l = m.Staker.Size()
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if m.PoolDelegation {
+		n += 2
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovAccount(uint64(m.PoolId))
+	}
 	return n
 }
 
@@ -2251,6 +2291,45 @@ func (m *DelegationUnbonding) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PoolDelegation = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -144,6 +144,8 @@ type StakerDelegatorResponse struct {
 	DelegationAmount uint64 `protobuf:"varint,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount,omitempty"`
 	// staker ...
 	Staker string `protobuf:"bytes,4,opt,name=staker,proto3" json:"staker,omitempty"`
+	// auto_restake shows if the $KYVE rewards get restaked automatically
+	AutoRestake bool `protobuf:"varint,7,opt,name=auto_restake,json=autoRestake,proto3" json:"auto_restake,omitempty"`
 }
 
 func (m *StakerDelegatorResponse) Reset()         { *m = StakerDelegatorResponse{} }
@@ -207,6 +209,13 @@ func (m *StakerDelegatorResponse) GetStaker() string {
 	return ""
 }
 
+func (m *StakerDelegatorResponse) GetAutoRestake() bool {
+	if m != nil {
+		return m.AutoRestake
+	}
+	return false
+}
+
 // QueryDelegatorsByStakerRequest ...
 type QueryDelegatorsByStakerRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -462,6 +471,8 @@ type DelegationForStakerResponse struct {
 	CurrentRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=current_rewards,json=currentRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_rewards"`
 	// delegation_amount ...
 	DelegationAmount uint64 `protobuf:"varint,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount,omitempty"`
+	// auto_restake shows if the $KYVE rewards get restaked automatically
+	AutoRestake bool `protobuf:"varint,7,opt,name=auto_restake,json=autoRestake,proto3" json:"auto_restake,omitempty"`
 }
 
 func (m *DelegationForStakerResponse) Reset()         { *m = DelegationForStakerResponse{} }
@@ -518,6 +529,13 @@ func (m *DelegationForStakerResponse) GetDelegationAmount() uint64 {
 	return 0
 }
 
+func (m *DelegationForStakerResponse) GetAutoRestake() bool {
+	if m != nil {
+		return m.AutoRestake
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryDelegatorRequest)(nil), "kyve.query.v1beta1.QueryDelegatorRequest")
 	proto.RegisterType((*QueryDelegatorResponse)(nil), "kyve.query.v1beta1.QueryDelegatorResponse")
@@ -534,56 +552,57 @@ func init() {
 }
 
 var fileDescriptor_5e1c28c162a0498a = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6b, 0x13, 0x4d,
-	0x18, 0xce, 0xa4, 0xa1, 0x1f, 0x9d, 0xc2, 0xd7, 0x76, 0xbe, 0xaf, 0x35, 0xc6, 0xb2, 0x2d, 0x11,
-	0x34, 0x4d, 0x71, 0x87, 0x6e, 0x6b, 0x41, 0xf1, 0x62, 0x5a, 0x2b, 0x22, 0xfe, 0xe8, 0x0a, 0x82,
-	0x5e, 0xc2, 0x66, 0x33, 0x6c, 0x97, 0x24, 0x3b, 0xe9, 0xce, 0xa6, 0x35, 0x94, 0x82, 0x78, 0xf0,
-	0x2c, 0x78, 0x15, 0xcf, 0xa2, 0x17, 0x8f, 0x82, 0xff, 0x40, 0x8f, 0x05, 0x2f, 0x7a, 0x51, 0x69,
-	0x05, 0xff, 0x04, 0x4f, 0x82, 0xec, 0xec, 0x6c, 0x76, 0x36, 0xd9, 0xa4, 0xad, 0x78, 0xf1, 0x92,
-	0xec, 0xce, 0xbc, 0x3f, 0x9e, 0xf7, 0x79, 0xde, 0xf7, 0x4d, 0xe0, 0xd9, 0x5a, 0x7b, 0x8b, 0xe0,
-	0xcd, 0x16, 0x71, 0xdb, 0x78, 0x6b, 0xa1, 0x42, 0x3c, 0x63, 0x01, 0x57, 0x49, 0x9d, 0x58, 0x86,
-	0x67, 0x53, 0x47, 0x6d, 0xba, 0xd4, 0xa3, 0x08, 0xf9, 0x46, 0x2a, 0x37, 0x52, 0x85, 0x51, 0x6e,
-	0xc2, 0x68, 0xd8, 0x0e, 0xc5, 0xfc, 0x33, 0x30, 0xcb, 0x15, 0x4d, 0xca, 0x1a, 0x94, 0xe1, 0x8a,
-	0xc1, 0xba, 0x43, 0x36, 0x0d, 0xcb, 0x76, 0xa4, 0x90, 0x39, 0x45, 0xb6, 0x0d, 0xad, 0x4c, 0x6a,
-	0x87, 0xf7, 0xff, 0x5b, 0xd4, 0xa2, 0xfc, 0x11, 0xfb, 0x4f, 0xe2, 0x74, 0xda, 0xa2, 0xd4, 0xaa,
-	0x13, 0x6c, 0x34, 0x6d, 0x6c, 0x38, 0x0e, 0xf5, 0x78, 0x48, 0x16, 0xc6, 0x4c, 0xa8, 0x25, 0x00,
-	0xcd, 0xef, 0xf3, 0xb7, 0xe0, 0xe4, 0xba, 0xff, 0xba, 0x1a, 0xd4, 0x47, 0x5d, 0x9d, 0x6c, 0xb6,
-	0x08, 0xf3, 0xd0, 0x14, 0x1c, 0x66, 0x9e, 0x51, 0x23, 0x6e, 0x16, 0xcc, 0x82, 0xc2, 0x88, 0x2e,
-	0xde, 0xd0, 0x34, 0x1c, 0xa9, 0x86, 0xb6, 0xd9, 0x34, 0xbf, 0x8a, 0x0e, 0xf2, 0x26, 0x9c, 0xea,
-	0x0e, 0xc7, 0x9a, 0xd4, 0x61, 0x04, 0xdd, 0x90, 0xfd, 0xfc, 0x90, 0xa3, 0xda, 0xbc, 0xda, 0xcb,
-	0xa1, 0x7a, 0x8f, 0xa7, 0xe9, 0xf1, 0x97, 0x93, 0xfc, 0x00, 0xf0, 0x54, 0x1f, 0xb3, 0x38, 0x3c,
-	0xd0, 0x05, 0x0f, 0xb5, 0xe1, 0x98, 0xd9, 0x72, 0x5d, 0xe2, 0x78, 0x65, 0x97, 0x6c, 0x1b, 0x6e,
-	0x95, 0x65, 0x87, 0x67, 0x87, 0x0a, 0xa3, 0xda, 0x69, 0x35, 0xe0, 0x5e, 0xf5, 0xb9, 0xef, 0x60,
-	0x59, 0xa1, 0xb6, 0x53, 0xba, 0xb8, 0xf7, 0x79, 0x26, 0xf5, 0xfa, 0xcb, 0x4c, 0xc1, 0xb2, 0xbd,
-	0x8d, 0x56, 0x45, 0x35, 0x69, 0x03, 0x0b, 0xa1, 0x82, 0xaf, 0x0b, 0xac, 0x5a, 0xc3, 0x5e, 0xbb,
-	0x49, 0x18, 0x77, 0x60, 0xaf, 0xbe, 0xbf, 0x2d, 0x02, 0xfd, 0x5f, 0x91, 0x48, 0x0f, 0xf2, 0xa0,
-	0x79, 0x38, 0x11, 0xf5, 0x50, 0xd9, 0x68, 0xd0, 0x96, 0xe3, 0x65, 0x87, 0x66, 0x41, 0x21, 0xa3,
-	0x8f, 0x47, 0x17, 0x57, 0xf9, 0xb9, 0x44, 0x7e, 0x46, 0x26, 0x3f, 0xff, 0x18, 0x40, 0x25, 0xce,
-	0x2f, 0x2b, 0xb5, 0x03, 0x2a, 0x42, 0xdd, 0xd6, 0x20, 0x8c, 0x1a, 0x4b, 0x10, 0x7d, 0x2e, 0x56,
-	0x5d, 0x9c, 0xef, 0xbb, 0x86, 0x45, 0x84, 0xaf, 0x2e, 0x79, 0x4a, 0x10, 0xd2, 0x31, 0x08, 0x2f,
-	0xd2, 0x70, 0xa6, 0x2f, 0x04, 0x21, 0xc2, 0x3a, 0x84, 0x1d, 0xce, 0x59, 0x16, 0x70, 0x86, 0x4f,
-	0x22, 0x76, 0x29, 0xe3, 0x73, 0xae, 0x4b, 0x41, 0xd0, 0x1c, 0x1c, 0xf7, 0xa8, 0x67, 0xd4, 0xcb,
-	0x11, 0x57, 0x1c, 0x58, 0x46, 0x1f, 0xe3, 0xe7, 0xab, 0x9d, 0x63, 0xa4, 0xc1, 0xc9, 0x98, 0x29,
-	0x75, 0xcb, 0xa6, 0xc4, 0xf6, 0x7f, 0xb2, 0x3d, 0x75, 0x57, 0x38, 0xe1, 0xd7, 0x63, 0xac, 0x65,
-	0x38, 0x6b, 0xe7, 0x8f, 0x64, 0x4d, 0xb4, 0xa6, 0xe4, 0x9a, 0x7f, 0x1a, 0x2a, 0x14, 0x94, 0xc6,
-	0x4a, 0xbd, 0x93, 0xf5, 0xa7, 0x14, 0x1a, 0x3c, 0x89, 0x9f, 0x80, 0xd0, 0x29, 0x09, 0xc8, 0xb1,
-	0x86, 0xe5, 0x0e, 0xfc, 0x27, 0xd0, 0x9c, 0x65, 0xd3, 0x5c, 0x42, 0x9c, 0x24, 0x61, 0x44, 0xfc,
-	0x1a, 0x75, 0xe3, 0x7d, 0x20, 0x64, 0x0c, 0xa3, 0x74, 0x91, 0x3c, 0xf4, 0xfb, 0x24, 0xff, 0x04,
-	0xf0, 0xcc, 0x80, 0xbc, 0x68, 0x39, 0xb6, 0xbb, 0x46, 0x35, 0x25, 0x09, 0xf8, 0x5a, 0xab, 0x5e,
-	0x17, 0x7e, 0xe1, 0x6e, 0xfb, 0x4b, 0xd6, 0x83, 0xf6, 0x26, 0x03, 0xc7, 0xe4, 0x19, 0xf4, 0xbb,
-	0xe1, 0x25, 0x80, 0x23, 0x1d, 0x85, 0xd1, 0x5c, 0x52, 0xc5, 0x89, 0x8b, 0x3e, 0x57, 0x3c, 0x8e,
-	0x69, 0x40, 0x6c, 0xfe, 0xf2, 0x93, 0x0f, 0xdf, 0x9e, 0xa7, 0x97, 0x90, 0x86, 0xfb, 0xff, 0x44,
-	0x52, 0x17, 0xef, 0x04, 0x7c, 0xee, 0xe2, 0x9d, 0xce, 0xd9, 0x2e, 0x7a, 0x07, 0x20, 0xea, 0xdd,
-	0x19, 0x48, 0x3b, 0x3a, 0x7d, 0xf7, 0x8e, 0xcb, 0x2d, 0x9e, 0xc8, 0x47, 0x60, 0xbf, 0xc4, 0xb1,
-	0x2f, 0xa2, 0x85, 0x81, 0xd8, 0x59, 0xb9, 0xd2, 0x2e, 0x07, 0xf0, 0x3b, 0x65, 0xa0, 0xf7, 0x00,
-	0xa2, 0xde, 0x31, 0x1a, 0x00, 0xbd, 0xef, 0xf0, 0x0f, 0x80, 0xde, 0x7f, 0x4e, 0xf3, 0x57, 0x38,
-	0xf4, 0x65, 0xb4, 0x94, 0x04, 0x5d, 0x4c, 0x97, 0x8f, 0x5b, 0x52, 0x20, 0x22, 0xbe, 0xb4, 0xba,
-	0x77, 0xa0, 0x80, 0xfd, 0x03, 0x05, 0x7c, 0x3d, 0x50, 0xc0, 0xb3, 0x43, 0x25, 0xb5, 0x7f, 0xa8,
-	0xa4, 0x3e, 0x1e, 0x2a, 0xa9, 0x87, 0x45, 0xa9, 0x67, 0x6f, 0x3e, 0xb8, 0x7f, 0xed, 0x36, 0xf1,
-	0xb6, 0xa9, 0x5b, 0xc3, 0xe6, 0x86, 0x61, 0x3b, 0xf8, 0x91, 0x48, 0xc4, 0x7b, 0xb7, 0x32, 0xcc,
-	0xff, 0x2f, 0x2c, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x0e, 0x9f, 0xd2, 0x1d, 0x09, 0x00,
-	0x00,
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0x26, 0xa1, 0xb5, 0x13, 0xb1, 0xed, 0x68, 0x6b, 0x8c, 0x65, 0x13, 0x23, 0x68, 0x9a,
+	0xe2, 0x0e, 0x4d, 0x6a, 0x41, 0xf1, 0x62, 0x5a, 0x2b, 0x22, 0x7e, 0x74, 0x05, 0x41, 0x2f, 0x61,
+	0x93, 0x0c, 0xdb, 0x25, 0xc9, 0x4e, 0xba, 0x33, 0x69, 0x0d, 0xa5, 0x20, 0x1e, 0x3c, 0x0b, 0xde,
+	0x44, 0xc4, 0xa3, 0xe8, 0xc5, 0xa3, 0xe0, 0x3f, 0xd0, 0x63, 0xc1, 0x8b, 0x5e, 0x54, 0x5a, 0xc1,
+	0x7f, 0x43, 0x76, 0x76, 0x36, 0x99, 0xcd, 0x57, 0x5b, 0xf1, 0xe2, 0xa5, 0xdd, 0x7d, 0xf3, 0x3e,
+	0x7e, 0xf3, 0xfb, 0xbd, 0xf7, 0x36, 0xe0, 0x7c, 0xb5, 0xb5, 0x81, 0xd1, 0x7a, 0x13, 0x3b, 0x2d,
+	0xb4, 0x31, 0x5f, 0xc2, 0xcc, 0x98, 0x47, 0x15, 0x5c, 0xc3, 0xa6, 0xc1, 0x2c, 0x62, 0x6b, 0x0d,
+	0x87, 0x30, 0x02, 0xa1, 0xeb, 0xa4, 0x71, 0x27, 0x4d, 0x38, 0x25, 0x26, 0x8d, 0xba, 0x65, 0x13,
+	0xc4, 0xff, 0x7a, 0x6e, 0x89, 0x6c, 0x99, 0xd0, 0x3a, 0xa1, 0xa8, 0x64, 0xd0, 0xee, 0x94, 0x0d,
+	0xc3, 0xb4, 0x6c, 0x29, 0x65, 0x42, 0x95, 0x7d, 0x7d, 0xaf, 0x32, 0xb1, 0xfc, 0xf3, 0x53, 0x26,
+	0x31, 0x09, 0x7f, 0x44, 0xee, 0x93, 0xb0, 0xce, 0x98, 0x84, 0x98, 0x35, 0x8c, 0x8c, 0x86, 0x85,
+	0x0c, 0xdb, 0x26, 0x8c, 0xa7, 0xa4, 0x7e, 0xce, 0x3e, 0x77, 0xf1, 0x40, 0xf3, 0xf3, 0xf4, 0x1d,
+	0x30, 0xb5, 0xea, 0xbe, 0x2e, 0x7b, 0xf7, 0x23, 0x8e, 0x8e, 0xd7, 0x9b, 0x98, 0x32, 0x38, 0x0d,
+	0x46, 0x28, 0x33, 0xaa, 0xd8, 0x89, 0x2b, 0x29, 0x25, 0x33, 0xa6, 0x8b, 0x37, 0x38, 0x03, 0xc6,
+	0x2a, 0xbe, 0x6f, 0x3c, 0xcc, 0x8f, 0x3a, 0x86, 0x74, 0x19, 0x4c, 0x77, 0xa7, 0xa3, 0x0d, 0x62,
+	0x53, 0x0c, 0x6f, 0xc9, 0x71, 0x6e, 0xca, 0x58, 0x6e, 0x4e, 0xeb, 0xe5, 0x50, 0x7b, 0xc0, 0xcb,
+	0xf4, 0xc4, 0xcb, 0x45, 0x5e, 0x85, 0xc1, 0xe9, 0x01, 0x6e, 0x70, 0xa6, 0xbb, 0x8c, 0x0c, 0x0f,
+	0xb6, 0xc0, 0x78, 0xb9, 0xe9, 0x38, 0xd8, 0x66, 0x45, 0x07, 0x6f, 0x1a, 0x4e, 0x85, 0xc6, 0x47,
+	0x52, 0x91, 0x4c, 0x2c, 0x77, 0x46, 0xf3, 0xb8, 0xd7, 0x5c, 0xee, 0xdb, 0x58, 0x96, 0x88, 0x65,
+	0x17, 0x2e, 0xef, 0x7c, 0x4f, 0x86, 0xde, 0xff, 0x48, 0x66, 0x4c, 0x8b, 0xad, 0x35, 0x4b, 0x5a,
+	0x99, 0xd4, 0x91, 0x10, 0xca, 0xfb, 0x77, 0x89, 0x56, 0xaa, 0x88, 0xb5, 0x1a, 0x98, 0xf2, 0x00,
+	0xfa, 0xee, 0xf7, 0xc7, 0xac, 0xa2, 0x9f, 0x10, 0x85, 0x74, 0xaf, 0x0e, 0x9c, 0x03, 0x93, 0x9d,
+	0x1e, 0x2a, 0x1a, 0x75, 0xd2, 0xb4, 0x59, 0x3c, 0x92, 0x52, 0x32, 0x51, 0x7d, 0xa2, 0x73, 0x70,
+	0x9d, 0xdb, 0x25, 0xf2, 0xa3, 0x01, 0xf2, 0xcf, 0x81, 0xe3, 0x46, 0x93, 0x91, 0xa2, 0x83, 0xb9,
+	0x21, 0x3e, 0x9a, 0x52, 0x32, 0xc7, 0xf4, 0x98, 0x6b, 0xd3, 0x3d, 0x53, 0xfa, 0xa9, 0x02, 0xd4,
+	0xa0, 0x04, 0xb4, 0xd0, 0xf2, 0xd8, 0xf2, 0xa5, 0x5d, 0x01, 0xa0, 0xd3, 0x7b, 0x42, 0x8b, 0x0b,
+	0x01, 0x02, 0x82, 0x92, 0xdc, 0x37, 0x4c, 0x2c, 0x62, 0x75, 0x29, 0x52, 0x42, 0x19, 0x96, 0x51,
+	0xa6, 0x5f, 0x87, 0x41, 0x72, 0x20, 0x04, 0xa1, 0xd3, 0x2a, 0x00, 0x6d, 0x59, 0x68, 0x5c, 0x49,
+	0x45, 0x8e, 0xd8, 0x0f, 0x85, 0xa8, 0x2b, 0x8b, 0x2e, 0x25, 0x81, 0xb3, 0x60, 0x82, 0x11, 0x66,
+	0xd4, 0x8a, 0x1d, 0x3a, 0x39, 0xb0, 0xa8, 0x3e, 0xce, 0xed, 0xcb, 0x6d, 0x33, 0xcc, 0x81, 0xa9,
+	0x80, 0x2b, 0x71, 0x8a, 0x65, 0x49, 0x90, 0x93, 0xb2, 0x3f, 0x71, 0x96, 0xb8, 0x26, 0x37, 0x03,
+	0xac, 0x45, 0x39, 0x6b, 0x17, 0x0f, 0x64, 0x4d, 0x74, 0xaf, 0x14, 0x9a, 0x7e, 0xee, 0x2b, 0xe4,
+	0x5d, 0x8d, 0x16, 0x7a, 0x87, 0xef, 0x5f, 0x29, 0x34, 0x7c, 0x58, 0xbf, 0x29, 0x20, 0x39, 0x10,
+	0xc8, 0xa1, 0xe6, 0xe9, 0x1e, 0x18, 0xf5, 0x34, 0xa7, 0xf1, 0x30, 0x97, 0x10, 0xf5, 0x93, 0xb0,
+	0x43, 0xfc, 0x0a, 0x71, 0x82, 0x7d, 0x20, 0x64, 0xf4, 0xb3, 0x74, 0x91, 0x1c, 0xf9, 0x7b, 0x92,
+	0xdf, 0x86, 0xc1, 0xd9, 0x21, 0x75, 0xe1, 0x62, 0x60, 0xbd, 0xc5, 0x72, 0x6a, 0x3f, 0xe0, 0x2b,
+	0xcd, 0x5a, 0x4d, 0xc4, 0xf9, 0x13, 0xf8, 0xbf, 0x6c, 0x90, 0x83, 0x37, 0x45, 0xee, 0x43, 0x14,
+	0x8c, 0xcb, 0x63, 0xea, 0x36, 0xcc, 0x1b, 0x05, 0x8c, 0xb5, 0x9b, 0x00, 0xce, 0xf6, 0x23, 0xa5,
+	0xef, 0xe7, 0x22, 0x91, 0x3d, 0x8c, 0xab, 0xc7, 0x7d, 0xfa, 0xea, 0xb3, 0x2f, 0xbf, 0x5e, 0x86,
+	0x17, 0x60, 0x0e, 0x0d, 0xfe, 0xd0, 0x12, 0x07, 0x6d, 0x79, 0x94, 0x6f, 0xa3, 0xad, 0xb6, 0x6d,
+	0x1b, 0x7e, 0x52, 0x00, 0xec, 0x5d, 0x2b, 0x30, 0x77, 0x70, 0xf9, 0xee, 0x35, 0x98, 0xc8, 0x1f,
+	0x29, 0x46, 0x60, 0xbf, 0xc2, 0xb1, 0xe7, 0xe1, 0xfc, 0x50, 0xec, 0xb4, 0x58, 0x6a, 0x15, 0x3d,
+	0xf8, 0xed, 0x6b, 0xc0, 0xcf, 0x0a, 0x80, 0xbd, 0x93, 0x36, 0x04, 0xfa, 0xc0, 0xfd, 0x90, 0xc8,
+	0x1f, 0x29, 0x46, 0x40, 0xbf, 0xc6, 0xa1, 0x2f, 0xc2, 0x85, 0x7e, 0xd0, 0xc5, 0x00, 0xba, 0xb8,
+	0x25, 0x05, 0x3a, 0xc4, 0x17, 0x96, 0x77, 0xf6, 0x54, 0x65, 0x77, 0x4f, 0x55, 0x7e, 0xee, 0xa9,
+	0xca, 0x8b, 0x7d, 0x35, 0xb4, 0xbb, 0xaf, 0x86, 0xbe, 0xee, 0xab, 0xa1, 0xc7, 0x59, 0xa9, 0xad,
+	0x6f, 0x3f, 0x7a, 0x78, 0xe3, 0x2e, 0x66, 0x9b, 0xc4, 0xa9, 0xa2, 0xf2, 0x9a, 0x61, 0xd9, 0xe8,
+	0x89, 0x28, 0xc4, 0xdb, 0xbb, 0x34, 0xc2, 0x7f, 0x75, 0xe4, 0xff, 0x0c, 0x00, 0x59, 0x75, 0xbf,
+	0x3d, 0x63, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -840,6 +859,16 @@ func (m *StakerDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRestake {
+		i--
+		if m.AutoRestake {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.CurrentRewards) > 0 {
 		for iNdEx := len(m.CurrentRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1095,6 +1124,16 @@ func (m *DelegationForStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRestake {
+		i--
+		if m.AutoRestake {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.CurrentRewards) > 0 {
 		for iNdEx := len(m.CurrentRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1193,6 +1232,9 @@ func (m *StakerDelegatorResponse) Size() (n int) {
 			n += 1 + l + sovDelegation(uint64(l))
 		}
 	}
+	if m.AutoRestake {
+		n += 2
+	}
 	return n
 }
 
@@ -1297,6 +1339,9 @@ func (m *DelegationForStakerResponse) Size() (n int) {
 			n += 1 + l + sovDelegation(uint64(l))
 		}
 	}
+	if m.AutoRestake {
+		n += 2
+	}
 	return n
 }
 
@@ -1652,6 +1697,26 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRestake = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -2337,6 +2402,26 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRestake = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
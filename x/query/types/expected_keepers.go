@@ -21,6 +21,7 @@ type BankKeeper interface {
 }
 
 type BundlesKeeper interface {
+	AssertPoolCanRun(sdk.Context, uint64) error
 	AssertCanVote(sdk.Context, uint64, string, string, string) error
 	AssertCanPropose(sdk.Context, uint64, string, string, uint64) error
 	GetBundleVersionMap(sdk.Context) bundlesTypes.BundleVersionMap
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types1 "github.com/KYVENetwork/chain/x/bundles/types"
 	types2 "github.com/KYVENetwork/chain/x/funders/types"
@@ -192,6 +193,8 @@ type PoolResponse struct {
 	AccountBalance uint64 `protobuf:"varint,9,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
 	// funders ...
 	Fundings []*types2.Funding `protobuf:"bytes,10,rep,name=fundings,proto3" json:"fundings,omitempty"`
+	// upgrade_readiness is the readiness tally of the scheduled runtime upgrade
+	UpgradeReadiness *UpgradeReadinessResponse `protobuf:"bytes,11,opt,name=upgrade_readiness,json=upgradeReadiness,proto3" json:"upgrade_readiness,omitempty"`
 }
 
 func (m *PoolResponse) Reset()         { *m = PoolResponse{} }
@@ -297,6 +300,79 @@ func (m *PoolResponse) GetFundings() []*types2.Funding {
 	return nil
 }
 
+func (m *PoolResponse) GetUpgradeReadiness() *UpgradeReadinessResponse {
+	if m != nil {
+		return m.UpgradeReadiness
+	}
+	return nil
+}
+
+// UpgradeReadinessResponse contains the readiness tally of the scheduled
+// runtime upgrade of a pool
+type UpgradeReadinessResponse struct {
+	// ready_stakers are all stakers of the pool which signaled readiness
+	// for the upgrade version
+	ReadyStakers []string `protobuf:"bytes,1,rep,name=ready_stakers,json=readyStakers,proto3" json:"ready_stakers,omitempty"`
+	// ready_delegation is the total delegation of all ready stakers
+	ReadyDelegation uint64 `protobuf:"varint,2,opt,name=ready_delegation,json=readyDelegation,proto3" json:"ready_delegation,omitempty"`
+	// total_delegation is the total delegation of the pool
+	TotalDelegation uint64 `protobuf:"varint,3,opt,name=total_delegation,json=totalDelegation,proto3" json:"total_delegation,omitempty"`
+}
+
+func (m *UpgradeReadinessResponse) Reset()         { *m = UpgradeReadinessResponse{} }
+func (m *UpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*UpgradeReadinessResponse) ProtoMessage()    {}
+func (*UpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{3}
+}
+func (m *UpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeReadinessResponse.Merge(m, src)
+}
+func (m *UpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeReadinessResponse proto.InternalMessageInfo
+
+func (m *UpgradeReadinessResponse) GetReadyStakers() []string {
+	if m != nil {
+		return m.ReadyStakers
+	}
+	return nil
+}
+
+func (m *UpgradeReadinessResponse) GetReadyDelegation() uint64 {
+	if m != nil {
+		return m.ReadyDelegation
+	}
+	return 0
+}
+
+func (m *UpgradeReadinessResponse) GetTotalDelegation() uint64 {
+	if m != nil {
+		return m.TotalDelegation
+	}
+	return 0
+}
+
 // QueryPoolRequest is the request type for the Query/Pool RPC method.
 type QueryPoolRequest struct {
 	// id defines the unique ID of the pool.
@@ -307,7 +383,7 @@ func (m *QueryPoolRequest) Reset()         { *m = QueryPoolRequest{} }
 func (m *QueryPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolRequest) ProtoMessage()    {}
 func (*QueryPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b627739c2d7723dc, []int{3}
+	return fileDescriptor_b627739c2d7723dc, []int{4}
 }
 func (m *QueryPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +429,7 @@ func (m *QueryPoolResponse) Reset()         { *m = QueryPoolResponse{} }
 func (m *QueryPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolResponse) ProtoMessage()    {}
 func (*QueryPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b627739c2d7723dc, []int{4}
+	return fileDescriptor_b627739c2d7723dc, []int{5}
 }
 func (m *QueryPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,597 +465,2551 @@ func (m *QueryPoolResponse) GetPool() PoolResponse {
 	return PoolResponse{}
 }
 
-func init() {
-	proto.RegisterType((*QueryPoolsRequest)(nil), "kyve.query.v1beta1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "kyve.query.v1beta1.QueryPoolsResponse")
-	proto.RegisterType((*PoolResponse)(nil), "kyve.query.v1beta1.PoolResponse")
-	proto.RegisterType((*QueryPoolRequest)(nil), "kyve.query.v1beta1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "kyve.query.v1beta1.QueryPoolResponse")
+// QueryPoolConfigChangesRequest is the request type for the Query/PoolConfigChanges RPC method.
+type QueryPoolConfigChangesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/query/v1beta1/pools.proto", fileDescriptor_b627739c2d7723dc) }
-
-var fileDescriptor_b627739c2d7723dc = []byte{
-	// 723 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x4f, 0xd4, 0x4e,
-	0x18, 0xdf, 0xee, 0x1b, 0xcb, 0xf0, 0xff, 0x2f, 0x30, 0xf8, 0x52, 0x57, 0x29, 0xb5, 0xe1, 0x65,
-	0xc5, 0xa4, 0x0d, 0x6b, 0x3c, 0x68, 0x3c, 0x6d, 0x10, 0x63, 0x8c, 0xba, 0x96, 0xc4, 0x44, 0x2f,
-	0x9b, 0x69, 0x3b, 0x94, 0x86, 0xd2, 0x29, 0x9d, 0x29, 0xba, 0x1a, 0x2f, 0x7c, 0x02, 0x13, 0x8f,
-	0x7e, 0x21, 0x8e, 0x24, 0x5e, 0xf4, 0x62, 0x0c, 0xf8, 0x41, 0x4c, 0x67, 0xa6, 0xa5, 0x2b, 0x22,
-	0xde, 0xfa, 0xcc, 0xf3, 0xfb, 0x3d, 0xbf, 0xe7, 0xb5, 0x40, 0xdb, 0x19, 0xed, 0x63, 0x6b, 0x2f,
-	0xc5, 0xc9, 0xc8, 0xda, 0x5f, 0x73, 0x30, 0x43, 0x6b, 0x56, 0x4c, 0x48, 0x48, 0xcd, 0x38, 0x21,
-	0x8c, 0x40, 0x98, 0xf9, 0x4d, 0xee, 0x37, 0xa5, 0xbf, 0xb3, 0xea, 0x12, 0xba, 0x4b, 0xa8, 0xe5,
-	0x20, 0x7a, 0x86, 0x8a, 0xfc, 0x20, 0x42, 0x2c, 0x20, 0x91, 0xe0, 0x77, 0x2e, 0xf9, 0xc4, 0x27,
-	0xfc, 0xd3, 0xca, 0xbe, 0xe4, 0xeb, 0x0d, 0x9f, 0x10, 0x3f, 0xc4, 0x16, 0x8a, 0x03, 0x0b, 0x45,
-	0x11, 0x61, 0x9c, 0x22, 0x35, 0x3b, 0x06, 0xcf, 0xc9, 0x49, 0x23, 0x2f, 0xc4, 0xb4, 0x08, 0x2d,
-	0xed, 0x31, 0xcc, 0x56, 0x1a, 0x79, 0x38, 0x39, 0xc5, 0x48, 0x3b, 0x57, 0xe1, 0x98, 0xac, 0x9a,
-	0xb1, 0xd2, 0x84, 0xd7, 0xf8, 0xa6, 0x80, 0xd9, 0x17, 0x59, 0xf2, 0x83, 0xac, 0x5c, 0x1b, 0xef,
-	0xa5, 0x98, 0x32, 0xb8, 0x01, 0xc0, 0x69, 0x0d, 0xaa, 0xa2, 0x2b, 0xdd, 0xa9, 0xde, 0xb2, 0x29,
-	0x0a, 0x36, 0xb3, 0x82, 0xc7, 0x7b, 0x61, 0x0e, 0x90, 0x8f, 0x25, 0xd7, 0x2e, 0x31, 0xe1, 0x15,
-	0xd0, 0xa4, 0x18, 0x25, 0xee, 0xb6, 0x5a, 0xd5, 0x95, 0xee, 0xa4, 0x2d, 0x2d, 0xa8, 0x82, 0x89,
-	0x24, 0x8d, 0x58, 0xb0, 0x8b, 0xd5, 0x1a, 0x77, 0xe4, 0x26, 0xec, 0x80, 0x96, 0x17, 0x50, 0xe4,
-	0x84, 0xd8, 0x53, 0xeb, 0xba, 0xd2, 0x6d, 0xd9, 0x85, 0x0d, 0x4d, 0x30, 0x47, 0x19, 0x49, 0x90,
-	0x8f, 0x87, 0x71, 0x42, 0xf6, 0x03, 0x0f, 0x27, 0xc3, 0xc0, 0x53, 0x1b, 0xba, 0xd2, 0xfd, 0xdf,
-	0x9e, 0x95, 0xae, 0x81, 0xf4, 0x3c, 0xf6, 0x8c, 0xcf, 0x0a, 0x80, 0xe5, 0xda, 0x68, 0x4c, 0x22,
-	0x8a, 0xe1, 0x03, 0xd0, 0xe0, 0xb3, 0x55, 0x15, 0xbd, 0xd6, 0x9d, 0xea, 0xe9, 0xe6, 0xd9, 0xe1,
-	0x9a, 0x19, 0x23, 0x27, 0xf4, 0xeb, 0x87, 0xdf, 0x17, 0x2a, 0xb6, 0x20, 0xc1, 0x47, 0x63, 0xad,
-	0xa9, 0xf2, 0xd6, 0xac, 0x5c, 0xd8, 0x1a, 0x11, 0xa9, 0xdc, 0x1b, 0xe3, 0xb0, 0x06, 0xfe, 0x2b,
-	0xcb, 0xc0, 0x36, 0xa8, 0x06, 0x1e, 0x6f, 0x76, 0xdd, 0xae, 0x06, 0x1e, 0xbc, 0x0d, 0xea, 0x1e,
-	0x62, 0x48, 0x6a, 0x5c, 0x15, 0x69, 0xf2, 0xd1, 0x8d, 0x65, 0xc9, 0x41, 0xf0, 0x29, 0x98, 0x16,
-	0xab, 0x91, 0xb5, 0x26, 0x26, 0x14, 0x85, 0xbc, 0xb3, 0x53, 0xbd, 0x45, 0xc1, 0xcb, 0xf7, 0x26,
-	0xa7, 0xf6, 0xb9, 0x3d, 0x90, 0x58, 0xbb, 0xed, 0x8c, 0xd9, 0xd9, 0x80, 0x28, 0x43, 0x3b, 0x38,
-	0xa1, 0x6a, 0x5d, 0xaf, 0x65, 0x03, 0x92, 0x26, 0xec, 0x81, 0xcb, 0x8c, 0x30, 0x14, 0x0e, 0x29,
-	0x0e, 0xb7, 0x86, 0x1e, 0x0e, 0xb1, 0x2f, 0x5a, 0xd1, 0xe0, 0x89, 0xcf, 0x71, 0xe7, 0x26, 0x0e,
-	0xb7, 0xd6, 0x0b, 0x17, 0xbc, 0x05, 0x66, 0x04, 0xa7, 0x04, 0x6f, 0x72, 0xf8, 0x34, 0x7f, 0x2f,
-	0x41, 0xef, 0x82, 0x26, 0x65, 0x88, 0xa5, 0x54, 0x9d, 0xd0, 0x95, 0x6e, 0xbb, 0x37, 0x7f, 0x4e,
-	0xd9, 0x9b, 0x1c, 0x64, 0x4b, 0x70, 0x96, 0x2f, 0x72, 0x5d, 0x92, 0x46, 0x4c, 0x6d, 0x89, 0x85,
-	0x92, 0x26, 0x5c, 0x01, 0xd3, 0xf2, 0x73, 0xe8, 0xa0, 0x10, 0x45, 0x2e, 0x56, 0x27, 0xb9, 0x74,
-	0x5b, 0x3e, 0xf7, 0xc5, 0x2b, 0xbc, 0x07, 0x5a, 0xd9, 0xe1, 0x04, 0x91, 0x4f, 0x55, 0xc0, 0x37,
-	0x43, 0x6a, 0xe7, 0xe7, 0x94, 0xcb, 0x6f, 0x08, 0x94, 0x5d, 0xc0, 0x0d, 0x03, 0xcc, 0x14, 0x7b,
-	0x96, 0x9f, 0xd0, 0x6f, 0xd3, 0x34, 0x9e, 0x97, 0xee, 0xac, 0x18, 0xf9, 0x7d, 0x50, 0xcf, 0x2a,
-	0x93, 0x17, 0xf6, 0xaf, 0x9b, 0xc8, 0x39, 0xbd, 0x83, 0x2a, 0x98, 0x2c, 0x22, 0xc2, 0x11, 0x68,
-	0xf0, 0x2d, 0x87, 0x4b, 0x7f, 0x0a, 0x72, 0xe6, 0xc2, 0x3b, 0xcb, 0x17, 0xc1, 0x84, 0xa2, 0x71,
-	0xf3, 0xe0, 0xcb, 0xcf, 0x4f, 0xd5, 0xeb, 0xf0, 0x9a, 0x75, 0xde, 0x2f, 0x12, 0xbe, 0x03, 0x75,
-	0x9e, 0xc2, 0xe2, 0x5f, 0x43, 0xe6, 0xc2, 0x4b, 0x17, 0xa0, 0xa4, 0xee, 0x12, 0xd7, 0x5d, 0x80,
-	0xf3, 0xe7, 0xe9, 0x5a, 0xef, 0x03, 0xef, 0x43, 0x7f, 0xfd, 0xf0, 0x58, 0x53, 0x8e, 0x8e, 0x35,
-	0xe5, 0xc7, 0xb1, 0xa6, 0x7c, 0x3c, 0xd1, 0x2a, 0x47, 0x27, 0x5a, 0xe5, 0xeb, 0x89, 0x56, 0x79,
-	0xbd, 0xea, 0x07, 0x6c, 0x3b, 0x75, 0x4c, 0x97, 0xec, 0x5a, 0x4f, 0x5e, 0xbd, 0x7c, 0xf8, 0x0c,
-	0xb3, 0x37, 0x24, 0xd9, 0xb1, 0xdc, 0x6d, 0x14, 0x44, 0xd6, 0x5b, 0x19, 0x91, 0x8d, 0x62, 0x4c,
-	0x9d, 0x26, 0xff, 0x17, 0xde, 0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0xef, 0xab, 0xd1, 0xcb, 0x07,
-	0x06, 0x00, 0x00,
+func (m *QueryPoolConfigChangesRequest) Reset()         { *m = QueryPoolConfigChangesRequest{} }
+func (m *QueryPoolConfigChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolConfigChangesRequest) ProtoMessage()    {}
+func (*QueryPoolConfigChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{6}
+}
+func (m *QueryPoolConfigChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolConfigChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolConfigChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolConfigChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolConfigChangesRequest.Merge(m, src)
+}
+func (m *QueryPoolConfigChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolConfigChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolConfigChangesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPoolConfigChangesRequest proto.InternalMessageInfo
 
-// QueryPoolClient is the client API for QueryPool service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryPoolClient interface {
-	// Pools queries for all pools.
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	// Pool queries a pool by its Id.
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+func (m *QueryPoolConfigChangesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryPoolClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPoolConfigChangesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func NewQueryPoolClient(cc grpc1.ClientConn) QueryPoolClient {
-	return &queryPoolClient{cc}
+// QueryPoolConfigChangesResponse is the response type for the Query/PoolConfigChanges RPC method.
+type QueryPoolConfigChangesResponse struct {
+	// config_changes are all recorded configuration changes of the pool, ordered by their index
+	ConfigChanges []types.PoolConfigChange `protobuf:"bytes,1,rep,name=config_changes,json=configChanges,proto3" json:"config_changes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryPoolClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolConfigChangesResponse) Reset()         { *m = QueryPoolConfigChangesResponse{} }
+func (m *QueryPoolConfigChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolConfigChangesResponse) ProtoMessage()    {}
+func (*QueryPoolConfigChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{7}
+}
+func (m *QueryPoolConfigChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolConfigChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolConfigChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolConfigChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolConfigChangesResponse.Merge(m, src)
+}
+func (m *QueryPoolConfigChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolConfigChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolConfigChangesResponse.DiscardUnknown(m)
 }
 
-func (c *queryPoolClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolConfigChangesResponse proto.InternalMessageInfo
+
+func (m *QueryPoolConfigChangesResponse) GetConfigChanges() []types.PoolConfigChange {
+	if m != nil {
+		return m.ConfigChanges
 	}
-	return out, nil
+	return nil
 }
 
-// QueryPoolServer is the server API for QueryPool service.
-type QueryPoolServer interface {
-	// Pools queries for all pools.
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	// Pool queries a pool by its Id.
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+func (m *QueryPoolConfigChangesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryPoolServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryPoolServer struct {
+// QueryPoolRuntimeBinariesRequest is the request type for the Query/PoolRuntimeBinaries RPC method.
+type QueryPoolRuntimeBinariesRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (*UnimplementedQueryPoolServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *QueryPoolRuntimeBinariesRequest) Reset()         { *m = QueryPoolRuntimeBinariesRequest{} }
+func (m *QueryPoolRuntimeBinariesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolRuntimeBinariesRequest) ProtoMessage()    {}
+func (*QueryPoolRuntimeBinariesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{8}
 }
-func (*UnimplementedQueryPoolServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+func (m *QueryPoolRuntimeBinariesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryPoolServer(s grpc1.Server, srv QueryPoolServer) {
-	s.RegisterService(&_QueryPool_serviceDesc, srv)
+func (m *QueryPoolRuntimeBinariesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolRuntimeBinariesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolRuntimeBinariesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolRuntimeBinariesRequest.Merge(m, src)
+}
+func (m *QueryPoolRuntimeBinariesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolRuntimeBinariesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolRuntimeBinariesRequest.DiscardUnknown(m)
 }
 
-func _QueryPool_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryPoolServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.query.v1beta1.QueryPool/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryPoolServer).Pools(ctx, req.(*QueryPoolsRequest))
+var xxx_messageInfo_QueryPoolRuntimeBinariesRequest proto.InternalMessageInfo
+
+func (m *QueryPoolRuntimeBinariesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _QueryPool_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryPoolServer).Pool(ctx, in)
+// QueryPoolRuntimeBinariesResponse is the response type for the Query/PoolRuntimeBinaries RPC method.
+type QueryPoolRuntimeBinariesResponse struct {
+	// version is the current runtime version of the pool
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// runtime_binaries are the checksummed binaries of the current version
+	RuntimeBinaries []types.RuntimeBinary `protobuf:"bytes,2,rep,name=runtime_binaries,json=runtimeBinaries,proto3" json:"runtime_binaries"`
+	// upgrade_version is the version of the scheduled upgrade, empty if
+	// no upgrade is scheduled
+	UpgradeVersion string `protobuf:"bytes,3,opt,name=upgrade_version,json=upgradeVersion,proto3" json:"upgrade_version,omitempty"`
+	// upgrade_runtime_binaries are the checksummed binaries of the scheduled upgrade
+	UpgradeRuntimeBinaries []types.RuntimeBinary `protobuf:"bytes,4,rep,name=upgrade_runtime_binaries,json=upgradeRuntimeBinaries,proto3" json:"upgrade_runtime_binaries"`
+}
+
+func (m *QueryPoolRuntimeBinariesResponse) Reset()         { *m = QueryPoolRuntimeBinariesResponse{} }
+func (m *QueryPoolRuntimeBinariesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolRuntimeBinariesResponse) ProtoMessage()    {}
+func (*QueryPoolRuntimeBinariesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{9}
+}
+func (m *QueryPoolRuntimeBinariesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolRuntimeBinariesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolRuntimeBinariesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.query.v1beta1.QueryPool/Pool",
+}
+func (m *QueryPoolRuntimeBinariesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolRuntimeBinariesResponse.Merge(m, src)
+}
+func (m *QueryPoolRuntimeBinariesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolRuntimeBinariesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolRuntimeBinariesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolRuntimeBinariesResponse proto.InternalMessageInfo
+
+func (m *QueryPoolRuntimeBinariesResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryPoolServer).Pool(ctx, req.(*QueryPoolRequest))
+	return ""
+}
+
+func (m *QueryPoolRuntimeBinariesResponse) GetRuntimeBinaries() []types.RuntimeBinary {
+	if m != nil {
+		return m.RuntimeBinaries
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _QueryPool_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kyve.query.v1beta1.QueryPool",
-	HandlerType: (*QueryPoolServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _QueryPool_Pools_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _QueryPool_Pool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kyve/query/v1beta1/pools.proto",
+func (m *QueryPoolRuntimeBinariesResponse) GetUpgradeVersion() string {
+	if m != nil {
+		return m.UpgradeVersion
+	}
+	return ""
 }
 
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPoolRuntimeBinariesResponse) GetUpgradeRuntimeBinaries() []types.RuntimeBinary {
+	if m != nil {
+		return m.UpgradeRuntimeBinaries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryInflationAllocationRequest is the request type for the Query/InflationAllocation RPC method.
+type QueryInflationAllocationRequest struct {
 }
 
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageProviderId != 0 {
-		i = encodeVarintPools(dAtA, i, uint64(m.StorageProviderId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Disabled {
-		i--
-		if m.Disabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryInflationAllocationRequest) Reset()         { *m = QueryInflationAllocationRequest{} }
+func (m *QueryInflationAllocationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationAllocationRequest) ProtoMessage()    {}
+func (*QueryInflationAllocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{10}
+}
+func (m *QueryInflationAllocationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationAllocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationAllocationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintPools(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Search) > 0 {
-		i -= len(m.Search)
-		copy(dAtA[i:], m.Search)
-		i = encodeVarintPools(dAtA, i, uint64(len(m.Search)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPools(dAtA, i, uint64(size))
+}
+func (m *QueryInflationAllocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationAllocationRequest.Merge(m, src)
+}
+func (m *QueryInflationAllocationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationAllocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationAllocationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationAllocationRequest proto.InternalMessageInfo
+
+// QueryInflationAllocationResponse is the response type for the Query/InflationAllocation RPC method.
+//
+// The share of an active pool is calculated as
+// share = (1 - dynamic_inflation_share) * weight / total_weight + dynamic_inflation_share * demand / total_demand
+// If the total demand is zero the whole protocol inflation is split by the static weights.
+type QueryInflationAllocationResponse struct {
+	// dynamic_inflation_share is the share of the protocol inflation which is distributed by demand
+	DynamicInflationShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=dynamic_inflation_share,json=dynamicInflationShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dynamic_inflation_share"`
+	// dynamic_inflation_mode defines how the demand of a pool is measured
+	DynamicInflationMode types.DynamicInflationMode `protobuf:"varint,2,opt,name=dynamic_inflation_mode,json=dynamicInflationMode,proto3,enum=kyve.pool.v1beta1.DynamicInflationMode" json:"dynamic_inflation_mode,omitempty"`
+	// total_inflation_share_weight is the sum of the inflation share weights of all active pools
+	TotalInflationShareWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=total_inflation_share_weight,json=totalInflationShareWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_inflation_share_weight"`
+	// total_demand is the sum of the demand of all active pools
+	TotalDemand uint64 `protobuf:"varint,4,opt,name=total_demand,json=totalDemand,proto3" json:"total_demand,omitempty"`
+	// allocations are the inflation allocations of all active pools
+	Allocations []types.PoolInflationAllocation `protobuf:"bytes,5,rep,name=allocations,proto3" json:"allocations"`
+}
+
+func (m *QueryInflationAllocationResponse) Reset()         { *m = QueryInflationAllocationResponse{} }
+func (m *QueryInflationAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationAllocationResponse) ProtoMessage()    {}
+func (*QueryInflationAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{11}
+}
+func (m *QueryInflationAllocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationAllocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryInflationAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationAllocationResponse.Merge(m, src)
+}
+func (m *QueryInflationAllocationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationAllocationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInflationAllocationResponse proto.InternalMessageInfo
+
+func (m *QueryInflationAllocationResponse) GetDynamicInflationMode() types.DynamicInflationMode {
+	if m != nil {
+		return m.DynamicInflationMode
 	}
-	return dAtA[:n], nil
+	return types.DYNAMIC_INFLATION_MODE_FUNDER_PAYOUTS
 }
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryInflationAllocationResponse) GetTotalDemand() uint64 {
+	if m != nil {
+		return m.TotalDemand
+	}
+	return 0
 }
 
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPools(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryInflationAllocationResponse) GetAllocations() []types.PoolInflationAllocation {
+	if m != nil {
+		return m.Allocations
 	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPools(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+// QueryInflationLedgerRequest is the request type for the Query/InflationLedger RPC method.
+type QueryInflationLedgerRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryInflationLedgerRequest) Reset()         { *m = QueryInflationLedgerRequest{} }
+func (m *QueryInflationLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationLedgerRequest) ProtoMessage()    {}
+func (*QueryInflationLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{12}
+}
+func (m *QueryInflationLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationLedgerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryInflationLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationLedgerRequest.Merge(m, src)
+}
+func (m *QueryInflationLedgerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationLedgerRequest.DiscardUnknown(m)
 }
 
-func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInflationLedgerRequest proto.InternalMessageInfo
+
+func (m *QueryInflationLedgerRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryInflationLedgerResponse is the response type for the Query/InflationLedger RPC method.
+type QueryInflationLedgerResponse struct {
+	// inflation_ledger is the cumulative protocol inflation the pool has received
+	InflationLedger types.InflationLedger `protobuf:"bytes,1,opt,name=inflation_ledger,json=inflationLedger,proto3" json:"inflation_ledger"`
 }
 
-func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fundings) > 0 {
-		for iNdEx := len(m.Fundings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPools(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if m.AccountBalance != 0 {
-		i = encodeVarintPools(dAtA, i, uint64(m.AccountBalance))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintPools(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Status != 0 {
-		i = encodeVarintPools(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.TotalDelegation != 0 {
-		i = encodeVarintPools(dAtA, i, uint64(m.TotalDelegation))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.TotalSelfDelegation != 0 {
-		i = encodeVarintPools(dAtA, i, uint64(m.TotalSelfDelegation))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Stakers) > 0 {
-		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Stakers[iNdEx])
-			copy(dAtA[i:], m.Stakers[iNdEx])
-			i = encodeVarintPools(dAtA, i, uint64(len(m.Stakers[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.BundleProposal != nil {
-		{
-			size, err := m.BundleProposal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPools(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPools(dAtA, i, uint64(size))
+func (m *QueryInflationLedgerResponse) Reset()         { *m = QueryInflationLedgerResponse{} }
+func (m *QueryInflationLedgerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationLedgerResponse) ProtoMessage()    {}
+func (*QueryInflationLedgerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{13}
+}
+func (m *QueryInflationLedgerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationLedgerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationLedgerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintPools(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryInflationLedgerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationLedgerResponse.Merge(m, src)
+}
+func (m *QueryInflationLedgerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationLedgerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationLedgerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInflationLedgerResponse proto.InternalMessageInfo
+
+func (m *QueryInflationLedgerResponse) GetInflationLedger() types.InflationLedger {
+	if m != nil {
+		return m.InflationLedger
 	}
-	return dAtA[:n], nil
+	return types.InflationLedger{}
 }
 
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryPoolsRequest)(nil), "kyve.query.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "kyve.query.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*PoolResponse)(nil), "kyve.query.v1beta1.PoolResponse")
+	proto.RegisterType((*UpgradeReadinessResponse)(nil), "kyve.query.v1beta1.UpgradeReadinessResponse")
+	proto.RegisterType((*QueryPoolRequest)(nil), "kyve.query.v1beta1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "kyve.query.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolConfigChangesRequest)(nil), "kyve.query.v1beta1.QueryPoolConfigChangesRequest")
+	proto.RegisterType((*QueryPoolConfigChangesResponse)(nil), "kyve.query.v1beta1.QueryPoolConfigChangesResponse")
+	proto.RegisterType((*QueryPoolRuntimeBinariesRequest)(nil), "kyve.query.v1beta1.QueryPoolRuntimeBinariesRequest")
+	proto.RegisterType((*QueryPoolRuntimeBinariesResponse)(nil), "kyve.query.v1beta1.QueryPoolRuntimeBinariesResponse")
+	proto.RegisterType((*QueryInflationAllocationRequest)(nil), "kyve.query.v1beta1.QueryInflationAllocationRequest")
+	proto.RegisterType((*QueryInflationAllocationResponse)(nil), "kyve.query.v1beta1.QueryInflationAllocationResponse")
+	proto.RegisterType((*QueryInflationLedgerRequest)(nil), "kyve.query.v1beta1.QueryInflationLedgerRequest")
+	proto.RegisterType((*QueryInflationLedgerResponse)(nil), "kyve.query.v1beta1.QueryInflationLedgerResponse")
 }
 
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintPools(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func init() { proto.RegisterFile("kyve/query/v1beta1/pools.proto", fileDescriptor_b627739c2d7723dc) }
+
+var fileDescriptor_b627739c2d7723dc = []byte{
+	// 1328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x13, 0x37, 0x65, 0xf9, 0x35, 0x4e, 0x64, 0x7b, 0x9d, 0x07, 0xa3, 0x38, 0xb2, 0xc2, 0xbc, 0x14,
+	0xff, 0xff, 0x20, 0x63, 0x39, 0x09, 0x90, 0xb4, 0x97, 0x3a, 0x6e, 0x8a, 0xa0, 0x49, 0xeb, 0xd0,
+	0x68, 0x8a, 0xb4, 0x28, 0xd8, 0x15, 0xb9, 0xa6, 0x08, 0x53, 0x5c, 0x85, 0x4b, 0x39, 0x55, 0x83,
+	0x00, 0x45, 0x3f, 0x41, 0x81, 0xa2, 0x97, 0xf6, 0xd8, 0x6b, 0x4f, 0x05, 0x7a, 0x0a, 0x7a, 0xcf,
+	0x31, 0x40, 0x2f, 0x6d, 0x0f, 0x41, 0x91, 0xf4, 0x83, 0x14, 0xdc, 0x5d, 0x4a, 0xa4, 0x4c, 0xda,
+	0x31, 0x90, 0x1b, 0x67, 0xe7, 0xf5, 0x9b, 0xdf, 0xec, 0x8c, 0x56, 0x50, 0xdb, 0xe9, 0xef, 0x12,
+	0xe3, 0x51, 0x8f, 0x84, 0x7d, 0x63, 0x77, 0xb5, 0x45, 0x22, 0xbc, 0x6a, 0x74, 0x29, 0xf5, 0x99,
+	0xde, 0x0d, 0x69, 0x44, 0x11, 0x8a, 0xf5, 0x3a, 0xd7, 0xeb, 0x52, 0x5f, 0x5d, 0xb1, 0x29, 0xeb,
+	0x50, 0x66, 0xb4, 0x30, 0xdb, 0xe3, 0x8a, 0x5d, 0x2f, 0xc0, 0x91, 0x47, 0x03, 0xe1, 0x5f, 0x3d,
+	0xe6, 0x52, 0x97, 0xf2, 0x4f, 0x23, 0xfe, 0x92, 0xa7, 0x4b, 0x2e, 0xa5, 0xae, 0x4f, 0x0c, 0xdc,
+	0xf5, 0x0c, 0x1c, 0x04, 0x34, 0xe2, 0x2e, 0x32, 0x67, 0x55, 0xe3, 0x98, 0x5a, 0xbd, 0xc0, 0xf1,
+	0x09, 0x1b, 0x84, 0x96, 0x72, 0xc6, 0x66, 0xbb, 0x17, 0x38, 0x24, 0x1c, 0xda, 0x48, 0x59, 0xda,
+	0x88, 0xda, 0xe2, 0x6a, 0x52, 0xf8, 0x42, 0xdc, 0x49, 0xf4, 0x4b, 0x39, 0x7a, 0x4a, 0x7d, 0xa1,
+	0xd5, 0xfe, 0x52, 0x60, 0xe1, 0x7e, 0x5c, 0xdc, 0x66, 0x4c, 0x87, 0x49, 0x1e, 0xf5, 0x08, 0x8b,
+	0xd0, 0x6d, 0x80, 0x61, 0x8d, 0xaa, 0x52, 0x57, 0x1a, 0xb3, 0xcd, 0x8b, 0xba, 0x20, 0x44, 0x8f,
+	0x09, 0xc9, 0x72, 0xa5, 0x6f, 0x62, 0x97, 0x48, 0x5f, 0x33, 0xe5, 0x89, 0x4e, 0xc0, 0x24, 0x23,
+	0x38, 0xb4, 0xdb, 0x6a, 0xa9, 0xae, 0x34, 0x66, 0x4c, 0x29, 0x21, 0x15, 0xa6, 0xc2, 0x5e, 0x10,
+	0x79, 0x1d, 0xa2, 0x8e, 0x73, 0x45, 0x22, 0xa2, 0x2a, 0x4c, 0x3b, 0x1e, 0xc3, 0x2d, 0x9f, 0x38,
+	0x6a, 0xb9, 0xae, 0x34, 0xa6, 0xcd, 0x81, 0x8c, 0x74, 0x58, 0x64, 0x11, 0x0d, 0xb1, 0x4b, 0xac,
+	0x6e, 0x48, 0x77, 0x3d, 0x87, 0x84, 0x96, 0xe7, 0xa8, 0x13, 0x75, 0xa5, 0x71, 0xd4, 0x5c, 0x90,
+	0xaa, 0x4d, 0xa9, 0xb9, 0xe3, 0x68, 0x3f, 0x29, 0x80, 0xd2, 0xb5, 0xb1, 0x2e, 0x0d, 0x18, 0x41,
+	0xef, 0xc2, 0x04, 0xef, 0xbd, 0xaa, 0xd4, 0xc7, 0x1b, 0xb3, 0xcd, 0xba, 0xbe, 0xb7, 0xf9, 0x7a,
+	0xec, 0x91, 0x38, 0xac, 0x97, 0x9f, 0xbf, 0x5c, 0x1e, 0x33, 0x85, 0x13, 0xfa, 0x20, 0x43, 0x4d,
+	0x89, 0x53, 0x73, 0xe9, 0x40, 0x6a, 0x44, 0xa4, 0x34, 0x37, 0xda, 0xcf, 0x65, 0x38, 0x92, 0x4e,
+	0x83, 0x2a, 0x50, 0xf2, 0x1c, 0x4e, 0x76, 0xd9, 0x2c, 0x79, 0x0e, 0xfa, 0x1f, 0x94, 0x1d, 0x1c,
+	0x61, 0x99, 0xe3, 0xa4, 0x80, 0xc9, 0x5b, 0x97, 0x41, 0xc9, 0x8d, 0xd0, 0x3d, 0x98, 0x13, 0x57,
+	0x27, 0xa6, 0xa6, 0x4b, 0x19, 0xf6, 0x39, 0xb3, 0xb3, 0xcd, 0xf3, 0xc2, 0x2f, 0xb9, 0x57, 0x89,
+	0xeb, 0x3a, 0x97, 0x37, 0xa5, 0xad, 0x59, 0x69, 0x65, 0xe4, 0xb8, 0x41, 0x2c, 0xc2, 0x3b, 0x24,
+	0x64, 0x6a, 0xb9, 0x3e, 0x1e, 0x37, 0x48, 0x8a, 0xa8, 0x09, 0xc7, 0x23, 0x1a, 0x61, 0xdf, 0x62,
+	0xc4, 0xdf, 0xb6, 0x1c, 0xe2, 0x13, 0x57, 0x50, 0x31, 0xc1, 0x81, 0x2f, 0x72, 0xe5, 0x16, 0xf1,
+	0xb7, 0x37, 0x06, 0x2a, 0x74, 0x19, 0xe6, 0x85, 0x4f, 0xca, 0x7c, 0x92, 0x9b, 0xcf, 0xf1, 0xf3,
+	0x94, 0xe9, 0x35, 0x98, 0x64, 0x11, 0x8e, 0x7a, 0x4c, 0x9d, 0xaa, 0x2b, 0x8d, 0x4a, 0xf3, 0x4c,
+	0x41, 0xd9, 0x5b, 0xdc, 0xc8, 0x94, 0xc6, 0x31, 0x5e, 0x6c, 0xdb, 0xb4, 0x17, 0x44, 0xea, 0xb4,
+	0xb8, 0x50, 0x52, 0x44, 0x97, 0x60, 0x4e, 0x7e, 0x5a, 0x2d, 0xec, 0xe3, 0xc0, 0x26, 0xea, 0x0c,
+	0x4f, 0x5d, 0x91, 0xc7, 0xeb, 0xe2, 0x14, 0xdd, 0x80, 0xe9, 0x78, 0xb0, 0xbc, 0xc0, 0x65, 0x2a,
+	0xf0, 0x9b, 0x21, 0x73, 0x27, 0xe3, 0x96, 0xa4, 0xbf, 0x2d, 0xac, 0xcc, 0x81, 0x39, 0x7a, 0x08,
+	0x0b, 0xbd, 0xae, 0x1b, 0x62, 0x87, 0x58, 0x21, 0xc1, 0x8e, 0x17, 0x10, 0xc6, 0xd4, 0x59, 0x4e,
+	0xff, 0xff, 0xf3, 0x6e, 0xd7, 0x27, 0xc2, 0xd8, 0x4c, 0x6c, 0x07, 0xf7, 0x63, 0xbe, 0x37, 0xa2,
+	0xd1, 0x7e, 0x50, 0x40, 0x2d, 0x32, 0x47, 0xe7, 0xe0, 0x68, 0x9c, 0xaf, 0x6f, 0x25, 0xbd, 0x52,
+	0x78, 0xaf, 0x8e, 0xf0, 0xc3, 0x2d, 0xd9, 0xb0, 0xcb, 0x30, 0x2f, 0x8c, 0x52, 0xe4, 0x97, 0x04,
+	0xf9, 0xfc, 0xfc, 0x80, 0x3e, 0x8d, 0xe7, 0xf6, 0x49, 0xd3, 0x60, 0x7e, 0x30, 0x5a, 0xc9, 0xd6,
+	0x18, 0xb9, 0xc0, 0xda, 0xc7, 0xa9, 0xd5, 0x32, 0xc0, 0x7c, 0x13, 0xca, 0x71, 0x33, 0xe5, 0x52,
+	0x79, 0xd3, 0xe1, 0xe3, 0x3e, 0xda, 0x37, 0x0a, 0x9c, 0x19, 0x44, 0xbc, 0x45, 0x83, 0x6d, 0xcf,
+	0xbd, 0xd5, 0xc6, 0x81, 0x4b, 0xde, 0xfa, 0xe2, 0x3a, 0x09, 0x53, 0x71, 0xc6, 0x78, 0xbd, 0x08,
+	0xae, 0x26, 0x63, 0xf1, 0x8e, 0xa3, 0x3d, 0x53, 0xa0, 0x56, 0x04, 0x41, 0x56, 0xb8, 0x09, 0x15,
+	0x9b, 0x2b, 0x2c, 0x5b, 0x68, 0xe4, 0xa2, 0x39, 0x57, 0x70, 0x95, 0xd3, 0x51, 0x64, 0xb9, 0x47,
+	0xed, 0x74, 0xe4, 0xb7, 0xb7, 0x73, 0x6e, 0xc2, 0xf2, 0xb0, 0x23, 0x62, 0xe3, 0xae, 0x7b, 0x01,
+	0x0e, 0xbd, 0x21, 0x83, 0xa9, 0xca, 0x95, 0x4c, 0xe5, 0x3f, 0x96, 0xa0, 0x5e, 0xec, 0x2c, 0x6b,
+	0x57, 0x61, 0x6a, 0x97, 0x84, 0x2c, 0x21, 0x7f, 0xc6, 0x4c, 0x44, 0x74, 0x1f, 0xe6, 0xe5, 0x8e,
+	0xb7, 0x5a, 0xd2, 0x4b, 0x2d, 0xa5, 0x17, 0x70, 0x86, 0x97, 0x74, 0xfc, 0xbe, 0x24, 0x65, 0x2e,
+	0xcc, 0x26, 0x8d, 0x47, 0x3b, 0x19, 0xbb, 0x24, 0xa9, 0xf8, 0x35, 0xa9, 0xc8, 0xe3, 0x07, 0x32,
+	0xf7, 0x97, 0xa0, 0x0e, 0xe6, 0x73, 0x14, 0x43, 0xf9, 0x50, 0x18, 0x4e, 0x24, 0x03, 0x9a, 0x85,
+	0xa2, 0x9d, 0x95, 0xc4, 0xde, 0x09, 0xb6, 0x7d, 0x4e, 0xf5, 0x7b, 0xbe, 0x4f, 0x6d, 0xfe, 0x25,
+	0x89, 0xd5, 0x9e, 0x8d, 0x43, 0xbd, 0xd8, 0x46, 0xf2, 0xf7, 0x39, 0x9c, 0x74, 0xfa, 0x01, 0xee,
+	0x78, 0xb6, 0xe5, 0x25, 0x66, 0x16, 0x6b, 0xe3, 0x90, 0x08, 0x3e, 0xd7, 0xcf, 0xc5, 0x30, 0xfe,
+	0x7e, 0xb9, 0x7c, 0x5a, 0x74, 0x9f, 0x39, 0x3b, 0xba, 0x47, 0x8d, 0x0e, 0x8e, 0xda, 0xfa, 0x5d,
+	0xe2, 0x62, 0xbb, 0xbf, 0x41, 0x6c, 0xf3, 0xb8, 0x8c, 0x31, 0xc8, 0xb4, 0x15, 0x47, 0x40, 0x5f,
+	0xc0, 0x89, 0xbd, 0xc1, 0x3b, 0xd4, 0x21, 0xfc, 0x4a, 0x55, 0x9a, 0x97, 0x72, 0x48, 0xd8, 0x18,
+	0x89, 0x74, 0x8f, 0x3a, 0xc4, 0x3c, 0xe6, 0xe4, 0x9c, 0x22, 0x07, 0x96, 0xc4, 0xf6, 0x18, 0x41,
+	0x6e, 0x3d, 0x26, 0x9e, 0xdb, 0x8e, 0xd4, 0xf1, 0x37, 0x2f, 0xe0, 0x14, 0x0f, 0x94, 0x85, 0xff,
+	0x29, 0x8f, 0x82, 0xce, 0xc2, 0x91, 0x64, 0x47, 0x75, 0x70, 0x20, 0x1e, 0x09, 0x65, 0x73, 0x56,
+	0xee, 0xa7, 0xf8, 0x08, 0x99, 0x30, 0x8b, 0x07, 0xd4, 0x32, 0x75, 0x82, 0x77, 0x78, 0xa5, 0x60,
+	0xfa, 0x72, 0xba, 0x21, 0x7b, 0x9d, 0x0e, 0xa2, 0x5d, 0x87, 0xd3, 0xd9, 0xe6, 0xdd, 0x25, 0x8e,
+	0x4b, 0xc2, 0x03, 0xa7, 0x86, 0xc1, 0x52, 0xbe, 0x9f, 0x6c, 0xf8, 0x16, 0xcc, 0x0f, 0xe9, 0xf2,
+	0xb9, 0x4e, 0xae, 0x2d, 0x2d, 0x07, 0xf0, 0x48, 0x94, 0x64, 0x30, 0xbc, 0xec, 0x71, 0xf3, 0xe5,
+	0x14, 0xcc, 0x0c, 0x46, 0x15, 0xf5, 0x61, 0x62, 0x93, 0x3f, 0x5d, 0x2e, 0xe4, 0x2d, 0xdb, 0x3d,
+	0x8f, 0xbf, 0xea, 0xc5, 0x83, 0xcc, 0x04, 0x74, 0xed, 0xec, 0xb7, 0x7f, 0xfc, 0xfb, 0x7d, 0xe9,
+	0x34, 0x3a, 0x65, 0x14, 0xbd, 0xae, 0xd1, 0xd7, 0x50, 0xe6, 0x10, 0xce, 0xef, 0x1b, 0x32, 0x49,
+	0x7c, 0xe1, 0x00, 0x2b, 0x99, 0xf7, 0x02, 0xcf, 0xbb, 0x8c, 0xce, 0x14, 0xe5, 0x35, 0x9e, 0x78,
+	0xce, 0x53, 0xf4, 0x9b, 0x02, 0x0b, 0x7b, 0x96, 0x34, 0x5a, 0xdd, 0x37, 0x47, 0xde, 0x6f, 0x4a,
+	0xb5, 0x79, 0x18, 0x17, 0x89, 0xf1, 0x06, 0xc7, 0xb8, 0x86, 0x56, 0x8b, 0x30, 0x5a, 0xd9, 0x9f,
+	0x08, 0xe3, 0x89, 0xbc, 0x3e, 0x4f, 0xd1, 0xef, 0x0a, 0x2c, 0xe6, 0xac, 0x58, 0xb4, 0xb6, 0x3f,
+	0x3b, 0xb9, 0xdb, 0xbc, 0x7a, 0xf5, 0x70, 0x4e, 0x12, 0xfd, 0x3b, 0x1c, 0xfd, 0x35, 0xb4, 0x56,
+	0x88, 0x7e, 0x74, 0x8d, 0xa6, 0xf0, 0xff, 0xaa, 0xc0, 0x62, 0xce, 0x50, 0xed, 0x83, 0xbf, 0x78,
+	0x69, 0x56, 0xaf, 0x1e, 0xce, 0x49, 0xe2, 0xbf, 0xc2, 0xf1, 0xaf, 0xa0, 0x46, 0x1e, 0xfe, 0xe1,
+	0xb8, 0x0d, 0xe7, 0x1b, 0xfd, 0xa2, 0xc0, 0xdc, 0xc8, 0x70, 0x21, 0xe3, 0xe0, 0xdc, 0x99, 0x25,
+	0x50, 0xbd, 0xf2, 0xe6, 0x0e, 0x12, 0xe8, 0x75, 0x0e, 0xf4, 0x0a, 0xd2, 0xf7, 0x07, 0x2a, 0xf6,
+	0xc2, 0x90, 0xe3, 0xf5, 0x8d, 0xe7, 0xaf, 0x6a, 0xca, 0x8b, 0x57, 0x35, 0xe5, 0x9f, 0x57, 0x35,
+	0xe5, 0xbb, 0xd7, 0xb5, 0xb1, 0x17, 0xaf, 0x6b, 0x63, 0x7f, 0xbe, 0xae, 0x8d, 0x7d, 0xb6, 0xe2,
+	0x7a, 0x51, 0xbb, 0xd7, 0xd2, 0x6d, 0xda, 0x31, 0x3e, 0x7c, 0xf8, 0xe0, 0xfd, 0x8f, 0x48, 0xf4,
+	0x98, 0x86, 0x3b, 0x86, 0xdd, 0xc6, 0x5e, 0x60, 0x7c, 0x25, 0x53, 0x44, 0xfd, 0x2e, 0x61, 0xad,
+	0x49, 0xfe, 0x17, 0x70, 0xed, 0xbf, 0x01, 0x00, 0x17, 0xad, 0x4f, 0x34, 0x1e, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryPoolClient is the client API for QueryPool service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryPoolClient interface {
+	// Pools queries for all pools.
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// Pool queries a pool by its Id.
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// PoolConfigChanges queries the configuration change history of a pool.
+	PoolConfigChanges(ctx context.Context, in *QueryPoolConfigChangesRequest, opts ...grpc.CallOption) (*QueryPoolConfigChangesResponse, error)
+	// PoolRuntimeBinaries queries the checksummed runtime binaries of a pool.
+	PoolRuntimeBinaries(ctx context.Context, in *QueryPoolRuntimeBinariesRequest, opts ...grpc.CallOption) (*QueryPoolRuntimeBinariesResponse, error)
+	// InflationAllocation queries how the protocol inflation is currently split between all active pools.
+	InflationAllocation(ctx context.Context, in *QueryInflationAllocationRequest, opts ...grpc.CallOption) (*QueryInflationAllocationResponse, error)
+	// InflationLedger queries the cumulative protocol inflation a pool has received.
+	InflationLedger(ctx context.Context, in *QueryInflationLedgerRequest, opts ...grpc.CallOption) (*QueryInflationLedgerResponse, error)
+}
+
+type queryPoolClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryPoolClient(cc grpc1.ClientConn) QueryPoolClient {
+	return &queryPoolClient{cc}
+}
+
+func (c *queryPoolClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryPoolClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/Pool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryPoolClient) PoolConfigChanges(ctx context.Context, in *QueryPoolConfigChangesRequest, opts ...grpc.CallOption) (*QueryPoolConfigChangesResponse, error) {
+	out := new(QueryPoolConfigChangesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/PoolConfigChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintPools(dAtA, i, uint64(size))
+func (c *queryPoolClient) PoolRuntimeBinaries(ctx context.Context, in *QueryPoolRuntimeBinariesRequest, opts ...grpc.CallOption) (*QueryPoolRuntimeBinariesResponse, error) {
+	out := new(QueryPoolRuntimeBinariesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/PoolRuntimeBinaries", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintPools(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPools(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryPoolClient) InflationAllocation(ctx context.Context, in *QueryInflationAllocationRequest, opts ...grpc.CallOption) (*QueryInflationAllocationResponse, error) {
+	out := new(QueryInflationAllocationResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/InflationAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryPoolClient) InflationLedger(ctx context.Context, in *QueryInflationLedgerRequest, opts ...grpc.CallOption) (*QueryInflationLedgerResponse, error) {
+	out := new(QueryInflationLedgerResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/InflationLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovPools(uint64(l))
+	return out, nil
+}
+
+// QueryPoolServer is the server API for QueryPool service.
+type QueryPoolServer interface {
+	// Pools queries for all pools.
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// Pool queries a pool by its Id.
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// PoolConfigChanges queries the configuration change history of a pool.
+	PoolConfigChanges(context.Context, *QueryPoolConfigChangesRequest) (*QueryPoolConfigChangesResponse, error)
+	// PoolRuntimeBinaries queries the checksummed runtime binaries of a pool.
+	PoolRuntimeBinaries(context.Context, *QueryPoolRuntimeBinariesRequest) (*QueryPoolRuntimeBinariesResponse, error)
+	// InflationAllocation queries how the protocol inflation is currently split between all active pools.
+	InflationAllocation(context.Context, *QueryInflationAllocationRequest) (*QueryInflationAllocationResponse, error)
+	// InflationLedger queries the cumulative protocol inflation a pool has received.
+	InflationLedger(context.Context, *QueryInflationLedgerRequest) (*QueryInflationLedgerResponse, error)
+}
+
+// UnimplementedQueryPoolServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryPoolServer struct {
+}
+
+func (*UnimplementedQueryPoolServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryPoolServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryPoolServer) PoolConfigChanges(ctx context.Context, req *QueryPoolConfigChangesRequest) (*QueryPoolConfigChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolConfigChanges not implemented")
+}
+func (*UnimplementedQueryPoolServer) PoolRuntimeBinaries(ctx context.Context, req *QueryPoolRuntimeBinariesRequest) (*QueryPoolRuntimeBinariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolRuntimeBinaries not implemented")
+}
+func (*UnimplementedQueryPoolServer) InflationAllocation(ctx context.Context, req *QueryInflationAllocationRequest) (*QueryInflationAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationAllocation not implemented")
+}
+func (*UnimplementedQueryPoolServer) InflationLedger(ctx context.Context, req *QueryInflationLedgerRequest) (*QueryInflationLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationLedger not implemented")
+}
+
+func RegisterQueryPoolServer(s grpc1.Server, srv QueryPoolServer) {
+	s.RegisterService(&_QueryPool_serviceDesc, srv)
+}
+
+func _QueryPool_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Search)
-	if l > 0 {
-		n += 1 + l + sovPools(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryPoolServer).Pools(ctx, in)
 	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovPools(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryPool/Pools",
 	}
-	if m.Disabled {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryPoolServer).Pools(ctx, req.(*QueryPoolsRequest))
 	}
-	if m.StorageProviderId != 0 {
-		n += 1 + sovPools(uint64(m.StorageProviderId))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryPool_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryPoolServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryPool/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryPoolServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _QueryPool_PoolConfigChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolConfigChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovPools(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryPoolServer).PoolConfigChanges(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovPools(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryPool/PoolConfigChanges",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryPoolServer).PoolConfigChanges(ctx, req.(*QueryPoolConfigChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _QueryPool_PoolRuntimeBinaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRuntimeBinariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovPools(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryPoolServer).PoolRuntimeBinaries(ctx, in)
 	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovPools(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryPool/PoolRuntimeBinaries",
 	}
-	if m.BundleProposal != nil {
-		l = m.BundleProposal.Size()
-		n += 1 + l + sovPools(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryPoolServer).PoolRuntimeBinaries(ctx, req.(*QueryPoolRuntimeBinariesRequest))
 	}
-	if len(m.Stakers) > 0 {
-		for _, s := range m.Stakers {
-			l = len(s)
-			n += 1 + l + sovPools(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryPool_InflationAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TotalSelfDelegation != 0 {
-		n += 1 + sovPools(uint64(m.TotalSelfDelegation))
+	if interceptor == nil {
+		return srv.(QueryPoolServer).InflationAllocation(ctx, in)
 	}
-	if m.TotalDelegation != 0 {
-		n += 1 + sovPools(uint64(m.TotalDelegation))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryPool/InflationAllocation",
 	}
-	if m.Status != 0 {
-		n += 1 + sovPools(uint64(m.Status))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryPoolServer).InflationAllocation(ctx, req.(*QueryInflationAllocationRequest))
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovPools(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryPool_InflationLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AccountBalance != 0 {
-		n += 1 + sovPools(uint64(m.AccountBalance))
+	if interceptor == nil {
+		return srv.(QueryPoolServer).InflationLedger(ctx, in)
 	}
-	if len(m.Fundings) > 0 {
-		for _, e := range m.Fundings {
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryPool/InflationLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryPoolServer).InflationLedger(ctx, req.(*QueryInflationLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryPool_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kyve.query.v1beta1.QueryPool",
+	HandlerType: (*QueryPoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _QueryPool_Pools_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _QueryPool_Pool_Handler,
+		},
+		{
+			MethodName: "PoolConfigChanges",
+			Handler:    _QueryPool_PoolConfigChanges_Handler,
+		},
+		{
+			MethodName: "PoolRuntimeBinaries",
+			Handler:    _QueryPool_PoolRuntimeBinaries_Handler,
+		},
+		{
+			MethodName: "InflationAllocation",
+			Handler:    _QueryPool_InflationAllocation_Handler,
+		},
+		{
+			MethodName: "InflationLedger",
+			Handler:    _QueryPool_InflationLedger_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyve/query/v1beta1/pools.proto",
+}
+
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintPools(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintPools(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPools(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPools(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradeReadiness != nil {
+		{
+			size, err := m.UpgradeReadiness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPools(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Fundings) > 0 {
+		for iNdEx := len(m.Fundings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.AccountBalance != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.AccountBalance))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintPools(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalDelegation != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.TotalDelegation))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalSelfDelegation != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.TotalSelfDelegation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Stakers) > 0 {
+		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Stakers[iNdEx])
+			copy(dAtA[i:], m.Stakers[iNdEx])
+			i = encodeVarintPools(dAtA, i, uint64(len(m.Stakers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BundleProposal != nil {
+		{
+			size, err := m.BundleProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPools(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPools(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalDelegation != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.TotalDelegation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReadyDelegation != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.ReadyDelegation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ReadyStakers) > 0 {
+		for iNdEx := len(m.ReadyStakers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReadyStakers[iNdEx])
+			copy(dAtA[i:], m.ReadyStakers[iNdEx])
+			i = encodeVarintPools(dAtA, i, uint64(len(m.ReadyStakers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPools(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolConfigChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolConfigChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolConfigChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPools(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolConfigChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolConfigChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolConfigChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPools(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConfigChanges) > 0 {
+		for iNdEx := len(m.ConfigChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfigChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolRuntimeBinariesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRuntimeBinariesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRuntimeBinariesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolRuntimeBinariesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRuntimeBinariesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRuntimeBinariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpgradeRuntimeBinaries) > 0 {
+		for iNdEx := len(m.UpgradeRuntimeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeRuntimeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UpgradeVersion) > 0 {
+		i -= len(m.UpgradeVersion)
+		copy(dAtA[i:], m.UpgradeVersion)
+		i = encodeVarintPools(dAtA, i, uint64(len(m.UpgradeVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for iNdEx := len(m.RuntimeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintPools(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationAllocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationAllocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationAllocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TotalDemand != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.TotalDemand))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalInflationShareWeight.Size()
+		i -= size
+		if _, err := m.TotalInflationShareWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPools(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DynamicInflationMode != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.DynamicInflationMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.DynamicInflationShare.Size()
+		i -= size
+		if _, err := m.DynamicInflationShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPools(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationLedgerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationLedgerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationLedgerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationLedgerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationLedgerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationLedgerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InflationLedger.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPools(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPools(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPools(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovPools(uint64(m.StorageProviderId))
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPools(uint64(m.Id))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.BundleProposal != nil {
+		l = m.BundleProposal.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if len(m.Stakers) > 0 {
+		for _, s := range m.Stakers {
+			l = len(s)
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	if m.TotalSelfDelegation != 0 {
+		n += 1 + sovPools(uint64(m.TotalSelfDelegation))
+	}
+	if m.TotalDelegation != 0 {
+		n += 1 + sovPools(uint64(m.TotalDelegation))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPools(uint64(m.Status))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.AccountBalance != 0 {
+		n += 1 + sovPools(uint64(m.AccountBalance))
+	}
+	if len(m.Fundings) > 0 {
+		for _, e := range m.Fundings {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	if m.UpgradeReadiness != nil {
+		l = m.UpgradeReadiness.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReadyStakers) > 0 {
+		for _, s := range m.ReadyStakers {
+			l = len(s)
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	if m.ReadyDelegation != 0 {
+		n += 1 + sovPools(uint64(m.ReadyDelegation))
+	}
+	if m.TotalDelegation != 0 {
+		n += 1 + sovPools(uint64(m.TotalDelegation))
+	}
+	return n
+}
+
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPools(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovPools(uint64(l))
+	return n
+}
+
+func (m *QueryPoolConfigChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovPools(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolConfigChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConfigChanges) > 0 {
+		for _, e := range m.ConfigChanges {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolRuntimeBinariesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPools(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolRuntimeBinariesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if len(m.RuntimeBinaries) > 0 {
+		for _, e := range m.RuntimeBinaries {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	l = len(m.UpgradeVersion)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if len(m.UpgradeRuntimeBinaries) > 0 {
+		for _, e := range m.UpgradeRuntimeBinaries {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInflationAllocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DynamicInflationShare.Size()
+	n += 1 + l + sovPools(uint64(l))
+	if m.DynamicInflationMode != 0 {
+		n += 1 + sovPools(uint64(m.DynamicInflationMode))
+	}
+	l = m.TotalInflationShareWeight.Size()
+	n += 1 + l + sovPools(uint64(l))
+	if m.TotalDemand != 0 {
+		n += 1 + sovPools(uint64(m.TotalDemand))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
 			l = e.Size()
 			n += 1 + l + sovPools(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovPools(uint64(m.Id))
-	}
-	return n
-}
+	return n
+}
+
+func (m *QueryInflationLedgerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPools(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryInflationLedgerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationLedger.Size()
+	n += 1 + l + sovPools(uint64(l))
+	return n
+}
+
+func sovPools(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPools(x uint64) (n int) {
+	return sovPools(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, PoolResponse{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Pool{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BundleProposal == nil {
+				m.BundleProposal = &types1.BundleProposal{}
+			}
+			if err := m.BundleProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakers = append(m.Stakers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSelfDelegation", wireType)
+			}
+			m.TotalSelfDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSelfDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			}
+			m.TotalDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.PoolStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountBalance", wireType)
+			}
+			m.AccountBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fundings = append(m.Fundings, &types2.Funding{})
+			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeReadiness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpgradeReadiness == nil {
+				m.UpgradeReadiness = &UpgradeReadinessResponse{}
+			}
+			if err := m.UpgradeReadiness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyStakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadyStakers = append(m.ReadyStakers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyDelegation", wireType)
+			}
+			m.ReadyDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			}
+			m.TotalDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovPools(uint64(l))
-	return n
-}
-
-func sovPools(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPools(x uint64) (n int) {
-	return sovPools(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1002,17 +3032,17 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1022,33 +3052,66 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPools
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPools
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1058,29 +3121,80 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPools
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPools
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Search = string(dAtA[iNdEx:postIndex])
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolConfigChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolConfigChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolConfigChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1090,49 +3204,33 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPools
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPools
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPools
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Disabled = bool(v != 0)
-		case 5:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.StorageProviderId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1142,7 +3240,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1168,7 +3266,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolConfigChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1191,15 +3289,15 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolConfigChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolConfigChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1226,8 +3324,8 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, PoolResponse{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConfigChanges = append(m.ConfigChanges, types.PoolConfigChange{})
+			if err := m.ConfigChanges[len(m.ConfigChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1288,7 +3386,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolRuntimeBinariesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1311,17 +3409,17 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolRuntimeBinariesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolRuntimeBinariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1331,16 +3429,66 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolRuntimeBinariesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRuntimeBinariesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRuntimeBinariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1350,31 +3498,27 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPools
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPools
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types.Pool{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleProposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeBinaries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1401,16 +3545,14 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BundleProposal == nil {
-				m.BundleProposal = &types1.BundleProposal{}
-			}
-			if err := m.BundleProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RuntimeBinaries = append(m.RuntimeBinaries, types.RuntimeBinary{})
+			if err := m.RuntimeBinaries[len(m.RuntimeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1438,13 +3580,13 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stakers = append(m.Stakers, string(dAtA[iNdEx:postIndex]))
+			m.UpgradeVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSelfDelegation", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeRuntimeBinaries", wireType)
 			}
-			m.TotalSelfDelegation = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1454,16 +3596,131 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSelfDelegation |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPools
 			}
-			m.TotalDelegation = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeRuntimeBinaries = append(m.UpgradeRuntimeBinaries, types.RuntimeBinary{})
+			if err := m.UpgradeRuntimeBinaries[len(m.UpgradeRuntimeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationAllocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationAllocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationAllocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicInflationShare", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1473,16 +3730,31 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalDelegation |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicInflationShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicInflationMode", wireType)
 			}
-			m.Status = 0
+			m.DynamicInflationMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1492,14 +3764,14 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= types.PoolStatus(b&0x7F) << shift
+				m.DynamicInflationMode |= types.DynamicInflationMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInflationShareWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1527,13 +3799,15 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalInflationShareWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDemand", wireType)
 			}
-			m.AccountBalance = 0
+			m.TotalDemand = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1543,14 +3817,14 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountBalance |= uint64(b&0x7F) << shift
+				m.TotalDemand |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1577,8 +3851,8 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fundings = append(m.Fundings, &types2.Funding{})
-			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allocations = append(m.Allocations, types.PoolInflationAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1603,7 +3877,7 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationLedgerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1626,17 +3900,17 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationLedgerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationLedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPools
@@ -1646,7 +3920,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1672,7 +3946,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationLedgerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1695,15 +3969,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationLedgerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationLedgerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationLedger", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1730,7 +4004,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InflationLedger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -278,6 +278,16 @@ type StakerMetadata struct {
 	PendingCommissionChange *CommissionChangeEntry `protobuf:"bytes,7,opt,name=pending_commission_change,json=pendingCommissionChange,proto3" json:"pending_commission_change,omitempty"`
 	// commission_rewards are the rewards through commission and storage cost
 	CommissionRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=commission_rewards,json=commissionRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission_rewards"`
+	// max_commission is the highest commission the staker
+	// can ever set. It is fixed when the staker is created.
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change is the maximum amount the commission
+	// can be changed by a single commission change. It is fixed when
+	// the staker is created.
+	MaxCommissionChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_commission_change,json=maxCommissionChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change"`
+	// auto_compound_commission shows if the $KYVE part of the
+	// commission rewards gets automatically delegated to the staker
+	AutoCompoundCommission bool `protobuf:"varint,11,opt,name=auto_compound_commission,json=autoCompoundCommission,proto3" json:"auto_compound_commission,omitempty"`
 }
 
 func (m *StakerMetadata) Reset()         { *m = StakerMetadata{} }
@@ -362,6 +372,13 @@ func (m *StakerMetadata) GetCommissionRewards() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *StakerMetadata) GetAutoCompoundCommission() bool {
+	if m != nil {
+		return m.AutoCompoundCommission
+	}
+	return false
+}
+
 // CommissionChangeEntry shows when the old commission
 // of a staker will change to the new commission
 type CommissionChangeEntry struct {
@@ -435,6 +452,17 @@ type PoolMembership struct {
 	// whether or not the valaccount needs additional funds to
 	// pay for gas fees
 	Balance uint64 `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	// secondary_valaddress is the optional hot-standby address
+	// which is also authorized to vote and submit bundles.
+	SecondaryValaddress string `protobuf:"bytes,6,opt,name=secondary_valaddress,json=secondaryValaddress,proto3" json:"secondary_valaddress,omitempty"`
+	// secondary_balance is the secondary valaddress account balance
+	SecondaryBalance uint64 `protobuf:"varint,7,opt,name=secondary_balance,json=secondaryBalance,proto3" json:"secondary_balance,omitempty"`
+	// maintenance_until is the UNIX-timestamp in seconds until
+	// the staker is in maintenance in this pool.
+	MaintenanceUntil uint64 `protobuf:"varint,8,opt,name=maintenance_until,json=maintenanceUntil,proto3" json:"maintenance_until,omitempty"`
+	// fee_allowance is the fee grant from the staker to the valaddress,
+	// it is empty if the staker has not granted any fees
+	FeeAllowance *FeeAllowance `protobuf:"bytes,9,opt,name=fee_allowance,json=feeAllowance,proto3" json:"fee_allowance,omitempty"`
 }
 
 func (m *PoolMembership) Reset()         { *m = PoolMembership{} }
@@ -505,73 +533,192 @@ func (m *PoolMembership) GetBalance() uint64 {
 	return 0
 }
 
+func (m *PoolMembership) GetSecondaryValaddress() string {
+	if m != nil {
+		return m.SecondaryValaddress
+	}
+	return ""
+}
+
+func (m *PoolMembership) GetSecondaryBalance() uint64 {
+	if m != nil {
+		return m.SecondaryBalance
+	}
+	return 0
+}
+
+func (m *PoolMembership) GetMaintenanceUntil() uint64 {
+	if m != nil {
+		return m.MaintenanceUntil
+	}
+	return 0
+}
+
+func (m *PoolMembership) GetFeeAllowance() *FeeAllowance {
+	if m != nil {
+		return m.FeeAllowance
+	}
+	return nil
+}
+
+// FeeAllowance shows the status of the fee grant from
+// a staker to its valaddress
+type FeeAllowance struct {
+	// period is the duration of one fee allowance period in seconds
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// period_spend_limit is the amount the valaddress can spend
+	// on fees per period
+	PeriodSpendLimit uint64 `protobuf:"varint,2,opt,name=period_spend_limit,json=periodSpendLimit,proto3" json:"period_spend_limit,omitempty"`
+	// period_can_spend is the amount the valaddress can still
+	// spend on fees in the current period
+	PeriodCanSpend uint64 `protobuf:"varint,3,opt,name=period_can_spend,json=periodCanSpend,proto3" json:"period_can_spend,omitempty"`
+	// period_reset is the UNIX-timestamp in seconds when
+	// the current period ends
+	PeriodReset uint64 `protobuf:"varint,4,opt,name=period_reset,json=periodReset,proto3" json:"period_reset,omitempty"`
+}
+
+func (m *FeeAllowance) Reset()         { *m = FeeAllowance{} }
+func (m *FeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*FeeAllowance) ProtoMessage()    {}
+func (*FeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b41255feae93a15, []int{5}
+}
+func (m *FeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeAllowance.Merge(m, src)
+}
+func (m *FeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeAllowance proto.InternalMessageInfo
+
+func (m *FeeAllowance) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *FeeAllowance) GetPeriodSpendLimit() uint64 {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return 0
+}
+
+func (m *FeeAllowance) GetPeriodCanSpend() uint64 {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return 0
+}
+
+func (m *FeeAllowance) GetPeriodReset() uint64 {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BasicPool)(nil), "kyve.query.v1beta1.BasicPool")
 	proto.RegisterType((*FullStaker)(nil), "kyve.query.v1beta1.FullStaker")
 	proto.RegisterType((*StakerMetadata)(nil), "kyve.query.v1beta1.StakerMetadata")
 	proto.RegisterType((*CommissionChangeEntry)(nil), "kyve.query.v1beta1.CommissionChangeEntry")
 	proto.RegisterType((*PoolMembership)(nil), "kyve.query.v1beta1.PoolMembership")
+	proto.RegisterType((*FeeAllowance)(nil), "kyve.query.v1beta1.FeeAllowance")
 }
 
 func init() { proto.RegisterFile("kyve/query/v1beta1/query.proto", fileDescriptor_6b41255feae93a15) }
 
 var fileDescriptor_6b41255feae93a15 = []byte{
-	// 871 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x4e, 0x62, 0x4f, 0xc0, 0xa1, 0xa3, 0xd2, 0x6e, 0x02, 0xdd, 0x44, 0xee, 0xa1,
-	0x6e, 0x25, 0x76, 0x95, 0xa0, 0x4a, 0x88, 0x03, 0x87, 0x38, 0xad, 0x84, 0x68, 0x11, 0x9a, 0x0a,
-	0x50, 0xb9, 0xac, 0x66, 0x77, 0x5f, 0xd6, 0x23, 0xef, 0xce, 0xb8, 0x3b, 0xb3, 0x0e, 0x3e, 0x21,
-	0xbe, 0x01, 0x1f, 0x03, 0x71, 0xe2, 0x80, 0xf8, 0x0c, 0x3d, 0x56, 0x9c, 0x80, 0x43, 0x41, 0xc9,
-	0x81, 0xaf, 0x81, 0xe6, 0xcf, 0x6e, 0xed, 0xe2, 0x03, 0x07, 0xb8, 0xd8, 0xf3, 0x7e, 0xef, 0xef,
-	0xfc, 0xde, 0x7b, 0xb3, 0x28, 0x98, 0x2e, 0xe6, 0x10, 0x3d, 0xab, 0xa1, 0x5a, 0x44, 0xf3, 0xe3,
-	0x04, 0x14, 0x3d, 0xb6, 0x52, 0x38, 0xab, 0x84, 0x12, 0x18, 0x6b, 0x7d, 0x68, 0x11, 0xa7, 0x3f,
-	0xb8, 0x46, 0x4b, 0xc6, 0x45, 0x64, 0x7e, 0xad, 0xd9, 0x41, 0x90, 0x0a, 0x59, 0x0a, 0x19, 0x25,
-	0x54, 0x42, 0x1b, 0x27, 0x15, 0x8c, 0x3b, 0xfd, 0xf5, 0x5c, 0xe4, 0xc2, 0x1c, 0x23, 0x7d, 0x72,
-	0xe8, 0xbb, 0x26, 0xf9, 0x4c, 0x88, 0xa2, 0xf5, 0xd1, 0x82, 0xd5, 0x0e, 0x7f, 0xee, 0xa0, 0xfe,
-	0x29, 0x95, 0x2c, 0xfd, 0x4c, 0x88, 0x02, 0x0f, 0xd0, 0x26, 0xcb, 0x7c, 0xef, 0xc8, 0x1b, 0x75,
-	0xc9, 0x26, 0xcb, 0x30, 0x46, 0x5d, 0x4e, 0x4b, 0xf0, 0x37, 0x8f, 0xbc, 0x51, 0x9f, 0x98, 0x33,
-	0xf6, 0xd1, 0x4e, 0x55, 0x73, 0xc5, 0x4a, 0xf0, 0x3b, 0x06, 0x6e, 0x44, 0x6d, 0x5d, 0x88, 0x5c,
-	0xf8, 0x5d, 0x6b, 0xad, 0xcf, 0xf8, 0x29, 0xba, 0xc1, 0xf8, 0x79, 0x41, 0x15, 0x13, 0x3c, 0x96,
-	0x13, 0x5a, 0x41, 0x7c, 0x01, 0x2c, 0x9f, 0x28, 0x7f, 0x4b, 0x5b, 0x9d, 0xde, 0x7e, 0xfe, 0xf2,
-	0x70, 0xe3, 0xf7, 0x97, 0x87, 0xef, 0xd8, 0xbb, 0xc9, 0x6c, 0x1a, 0x32, 0x11, 0x95, 0x54, 0x4d,
-	0xc2, 0x47, 0x90, 0xd3, 0x74, 0x71, 0x06, 0x29, 0xb9, 0xde, 0x86, 0x78, 0xa2, 0x23, 0x7c, 0x69,
-	0x02, 0xe0, 0x3b, 0x68, 0xaf, 0x9e, 0x15, 0x82, 0x66, 0x31, 0xe3, 0x0a, 0xaa, 0x39, 0x2d, 0xfc,
-	0x6d, 0x53, 0xf9, 0xc0, 0xc2, 0x1f, 0x3b, 0x14, 0x3f, 0x43, 0xbb, 0x4a, 0x28, 0x5a, 0xc4, 0xe7,
-	0x35, 0xcf, 0xa4, 0xbf, 0x73, 0xd4, 0x19, 0xed, 0x9e, 0xec, 0x87, 0x36, 0x63, 0xa8, 0xd9, 0x6c,
-	0x58, 0x0f, 0xc7, 0x82, 0xf1, 0xd3, 0xfb, 0xba, 0xa6, 0x1f, 0xfe, 0x38, 0x1c, 0xe5, 0x4c, 0x4d,
-	0xea, 0x24, 0x4c, 0x45, 0x19, 0x39, 0xea, 0xed, 0xdf, 0x7b, 0x32, 0x9b, 0x46, 0x6a, 0x31, 0x03,
-	0x69, 0x1c, 0xe4, 0xf7, 0x7f, 0xfd, 0x78, 0xcf, 0x23, 0xc8, 0x24, 0x79, 0xa8, 0x73, 0xe0, 0xbb,
-	0xe8, 0x2d, 0x9b, 0x32, 0x83, 0x02, 0x72, 0x53, 0xba, 0xdf, 0x33, 0xc5, 0xed, 0x19, 0xfc, 0xac,
-	0x85, 0xf1, 0x7d, 0xb4, 0x2d, 0x15, 0x55, 0xb5, 0xf4, 0xfb, 0x47, 0xde, 0x68, 0x70, 0x72, 0x2b,
-	0x34, 0xd3, 0x60, 0x7a, 0xd4, 0x94, 0xa5, 0x9b, 0xf3, 0xc4, 0x18, 0x11, 0x67, 0x3c, 0xfc, 0x6d,
-	0x13, 0xa1, 0x87, 0x75, 0xa1, 0xe1, 0x29, 0x54, 0xba, 0x2b, 0x34, 0xcb, 0x2a, 0x90, 0xd2, 0xb4,
-	0xaf, 0x4f, 0x1a, 0x11, 0x7f, 0x84, 0x7a, 0x25, 0x28, 0x9a, 0x51, 0x45, 0x4d, 0x1f, 0x77, 0x4f,
-	0x86, 0xe1, 0x3f, 0xe7, 0x2d, 0xb4, 0x71, 0x1e, 0x3b, 0x4b, 0xd2, 0xfa, 0x68, 0x9a, 0x25, 0x14,
-	0xe7, 0xcb, 0x37, 0xe9, 0x58, 0x9a, 0x35, 0xbc, 0x74, 0x91, 0x0f, 0xd1, 0xfe, 0x6b, 0x86, 0x71,
-	0xcd, 0x13, 0xc1, 0x33, 0xc6, 0x73, 0x33, 0x13, 0x5d, 0x72, 0x73, 0xd5, 0xe5, 0xf3, 0x46, 0xbd,
-	0x96, 0xaf, 0xad, 0xf5, 0x7c, 0xdd, 0x41, 0x7b, 0xce, 0x48, 0x54, 0x71, 0x2a, 0x6a, 0xae, 0x9a,
-	0xb6, 0xb7, 0xf0, 0x58, 0xa3, 0xf8, 0x03, 0xb4, 0xa5, 0x49, 0x6c, 0x1a, 0xbe, 0xf6, 0xd6, 0x9a,
-	0xd8, 0xc7, 0x50, 0x26, 0x50, 0xc9, 0x09, 0x9b, 0x11, 0xeb, 0x30, 0xfc, 0xa5, 0x83, 0x06, 0xab,
-	0x7c, 0xe0, 0x31, 0x42, 0xa9, 0x28, 0x4b, 0x26, 0xa5, 0x2e, 0xcd, 0xfb, 0xf7, 0xb3, 0xbb, 0xe4,
-	0xa6, 0x9b, 0x54, 0x0a, 0xce, 0xa6, 0x50, 0xb9, 0x8d, 0x6a, 0x44, 0xad, 0xb9, 0x80, 0x44, 0x32,
-	0xd5, 0x2e, 0x95, 0x13, 0xf1, 0x01, 0xea, 0xb1, 0x0c, 0xb8, 0x62, 0x6a, 0xe1, 0x16, 0xab, 0x95,
-	0x35, 0x6b, 0x12, 0xd2, 0xba, 0x62, 0x6a, 0x11, 0xa7, 0x82, 0x2b, 0x9a, 0xba, 0xb5, 0x22, 0x7b,
-	0x0d, 0x3e, 0xb6, 0xb0, 0x4e, 0x90, 0x81, 0xa2, 0xac, 0x90, 0x86, 0xad, 0x3e, 0x69, 0x44, 0x0c,
-	0x68, 0x7f, 0x06, 0xa6, 0x0b, 0xf1, 0xab, 0x52, 0xe3, 0x74, 0x42, 0x79, 0x0e, 0xfe, 0x8e, 0x19,
-	0x98, 0xbb, 0xeb, 0xa8, 0x1b, 0xb7, 0xc6, 0x63, 0x63, 0xfb, 0x80, 0xab, 0x6a, 0x41, 0x6e, 0xba,
-	0x58, 0xaf, 0x6b, 0xf1, 0x37, 0x08, 0x2f, 0x85, 0xaf, 0xe0, 0x82, 0x56, 0x99, 0xf4, 0x7b, 0xff,
-	0xd3, 0x2e, 0x5e, 0x7b, 0x95, 0x8b, 0xd8, 0x54, 0xc3, 0x6f, 0x3d, 0xf4, 0xf6, 0xda, 0x9a, 0xff,
-	0x9b, 0xde, 0xde, 0x46, 0x6f, 0xa6, 0x15, 0xd8, 0xb1, 0xcf, 0xa8, 0xb2, 0x6f, 0x66, 0x87, 0xbc,
-	0xd1, 0x80, 0x67, 0x54, 0xc1, 0xf0, 0x27, 0x0f, 0x0d, 0x56, 0x47, 0x0e, 0x1f, 0xa3, 0xae, 0x1e,
-	0x3a, 0x93, 0x76, 0xb7, 0x59, 0xfe, 0x55, 0xa6, 0xdb, 0xf7, 0x99, 0x18, 0x53, 0x7c, 0x03, 0x6d,
-	0xcf, 0x04, 0xe3, 0x4a, 0x9a, 0x1c, 0x5d, 0xe2, 0x24, 0x7c, 0x0b, 0x21, 0x26, 0xe3, 0x02, 0xe8,
-	0x5c, 0x6f, 0x9c, 0x9e, 0xa3, 0x1e, 0xe9, 0x33, 0xf9, 0xc8, 0x02, 0x38, 0x40, 0x68, 0x4e, 0x8b,
-	0xe6, 0x95, 0xb0, 0xb3, 0xb4, 0x84, 0xe8, 0x11, 0x49, 0x68, 0x41, 0x79, 0x0a, 0x6e, 0xf5, 0x1a,
-	0xf1, 0xf4, 0xec, 0xf9, 0x65, 0xe0, 0xbd, 0xb8, 0x0c, 0xbc, 0x3f, 0x2f, 0x03, 0xef, 0xbb, 0xab,
-	0x60, 0xe3, 0xc5, 0x55, 0xb0, 0xf1, 0xeb, 0x55, 0xb0, 0xf1, 0xd5, 0xbd, 0xa5, 0xb6, 0x7c, 0xf2,
-	0xf4, 0x8b, 0x07, 0x9f, 0x82, 0xba, 0x10, 0xd5, 0x34, 0x4a, 0x27, 0x94, 0xf1, 0xe8, 0x6b, 0xf7,
-	0xcd, 0x33, 0xed, 0x49, 0xb6, 0xcd, 0x17, 0xe7, 0xfd, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xea,
-	0xce, 0x18, 0xb8, 0x0e, 0x07, 0x00, 0x00,
+	// 1082 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0xce, 0x1f, 0x3f, 0x27, 0x4e, 0x32, 0x4d, 0xd3, 0x4d, 0x20, 0x8e, 0x71, 0x0f,
+	0x75, 0x5b, 0xb0, 0x95, 0xa0, 0x4a, 0x15, 0x07, 0x24, 0xe2, 0x24, 0x12, 0x90, 0x22, 0xb4, 0x51,
+	0x5b, 0x95, 0xcb, 0x6a, 0xbc, 0x3b, 0xb1, 0x47, 0xde, 0x9d, 0x71, 0x77, 0x66, 0x93, 0xf8, 0x84,
+	0xb8, 0x71, 0xe4, 0x2b, 0x70, 0x43, 0x9c, 0xe0, 0xc2, 0x67, 0xe8, 0xb1, 0x47, 0xe0, 0x50, 0x50,
+	0x72, 0xe0, 0x6b, 0xa0, 0xf9, 0xb3, 0xeb, 0x4d, 0xf1, 0x21, 0x07, 0x7a, 0x49, 0xe6, 0xfd, 0xde,
+	0x7b, 0xbf, 0x37, 0xf3, 0x7b, 0xf3, 0x76, 0x0c, 0xf5, 0xe1, 0xf8, 0x8c, 0x74, 0x5e, 0xa6, 0x24,
+	0x19, 0x77, 0xce, 0x76, 0x7b, 0x44, 0xe2, 0x5d, 0x63, 0xb5, 0x47, 0x09, 0x97, 0x1c, 0x21, 0xe5,
+	0x6f, 0x1b, 0xc4, 0xfa, 0xb7, 0xd6, 0x70, 0x4c, 0x19, 0xef, 0xe8, 0xbf, 0x26, 0x6c, 0xab, 0x1e,
+	0x70, 0x11, 0x73, 0xd1, 0xe9, 0x61, 0x41, 0x72, 0x9e, 0x80, 0x53, 0x66, 0xfd, 0xeb, 0x7d, 0xde,
+	0xe7, 0x7a, 0xd9, 0x51, 0x2b, 0x8b, 0xbe, 0xaf, 0x8b, 0x8f, 0x38, 0x8f, 0xf2, 0x1c, 0x65, 0x18,
+	0x6f, 0xf3, 0xb7, 0x12, 0x54, 0xf6, 0xb1, 0xa0, 0xc1, 0xd7, 0x9c, 0x47, 0xa8, 0x06, 0xb3, 0x34,
+	0x74, 0x9d, 0x86, 0xd3, 0x2a, 0x7b, 0xb3, 0x34, 0x44, 0x08, 0xca, 0x0c, 0xc7, 0xc4, 0x9d, 0x6d,
+	0x38, 0xad, 0x8a, 0xa7, 0xd7, 0xc8, 0x85, 0x85, 0x24, 0x65, 0x92, 0xc6, 0xc4, 0x2d, 0x69, 0x38,
+	0x33, 0x55, 0x74, 0xc4, 0xfb, 0xdc, 0x2d, 0x9b, 0x68, 0xb5, 0x46, 0x2f, 0x60, 0x83, 0xb2, 0xd3,
+	0x08, 0x4b, 0xca, 0x99, 0x2f, 0x06, 0x38, 0x21, 0xfe, 0x39, 0xa1, 0xfd, 0x81, 0x74, 0xe7, 0x54,
+	0xd4, 0xfe, 0xdd, 0x57, 0x6f, 0x76, 0x66, 0xfe, 0x7c, 0xb3, 0xf3, 0x9e, 0x39, 0x9b, 0x08, 0x87,
+	0x6d, 0xca, 0x3b, 0x31, 0x96, 0x83, 0xf6, 0x31, 0xe9, 0xe3, 0x60, 0x7c, 0x40, 0x02, 0x6f, 0x3d,
+	0xa7, 0x38, 0x51, 0x0c, 0xcf, 0x35, 0x01, 0xba, 0x07, 0x2b, 0xe9, 0x28, 0xe2, 0x38, 0xf4, 0x29,
+	0x93, 0x24, 0x39, 0xc3, 0x91, 0x3b, 0xaf, 0x77, 0x5e, 0x33, 0xf0, 0xe7, 0x16, 0x45, 0x2f, 0xa1,
+	0x2a, 0xb9, 0xc4, 0x91, 0x7f, 0x9a, 0xb2, 0x50, 0xb8, 0x0b, 0x8d, 0x52, 0xab, 0xba, 0xb7, 0xd9,
+	0x36, 0x15, 0xdb, 0x4a, 0xcd, 0x4c, 0xf5, 0x76, 0x97, 0x53, 0xb6, 0xff, 0x48, 0xed, 0xe9, 0xe7,
+	0xbf, 0x76, 0x5a, 0x7d, 0x2a, 0x07, 0x69, 0xaf, 0x1d, 0xf0, 0xb8, 0x63, 0xa5, 0x37, 0xff, 0x3e,
+	0x12, 0xe1, 0xb0, 0x23, 0xc7, 0x23, 0x22, 0x74, 0x82, 0xf8, 0xe9, 0x9f, 0x5f, 0x1e, 0x38, 0x1e,
+	0xe8, 0x22, 0x47, 0xaa, 0x06, 0xba, 0x0f, 0xab, 0xa6, 0x64, 0x48, 0x22, 0xd2, 0xd7, 0x5b, 0x77,
+	0x17, 0xf5, 0xe6, 0x56, 0x34, 0x7e, 0x90, 0xc3, 0xe8, 0x11, 0xcc, 0x0b, 0x89, 0x65, 0x2a, 0xdc,
+	0x4a, 0xc3, 0x69, 0xd5, 0xf6, 0xb6, 0xdb, 0xfa, 0x36, 0xe8, 0x1e, 0x65, 0xdb, 0x52, 0xcd, 0x39,
+	0xd1, 0x41, 0x9e, 0x0d, 0x6e, 0xfe, 0x31, 0x0b, 0x70, 0x94, 0x46, 0x0a, 0x1e, 0x92, 0x44, 0x75,
+	0x05, 0x87, 0x61, 0x42, 0x84, 0xd0, 0xed, 0xab, 0x78, 0x99, 0x89, 0x3e, 0x85, 0xc5, 0x98, 0x48,
+	0x1c, 0x62, 0x89, 0x75, 0x1f, 0xab, 0x7b, 0xcd, 0xf6, 0x7f, 0xef, 0x5b, 0xdb, 0xf0, 0x3c, 0xb1,
+	0x91, 0x5e, 0x9e, 0xa3, 0x64, 0x16, 0x24, 0x3a, 0x2d, 0x9e, 0xa4, 0x64, 0x64, 0x56, 0x70, 0xe1,
+	0x20, 0x9f, 0xc0, 0xe6, 0x5b, 0x81, 0x7e, 0xca, 0x7a, 0x9c, 0x85, 0x94, 0xf5, 0xf5, 0x9d, 0x28,
+	0x7b, 0x77, 0xae, 0xa7, 0x3c, 0xcd, 0xdc, 0x53, 0xf5, 0x9a, 0x9b, 0xae, 0xd7, 0x3d, 0x58, 0xb1,
+	0x41, 0x3c, 0xf1, 0x03, 0x9e, 0x32, 0x99, 0xb5, 0x3d, 0x87, 0xbb, 0x0a, 0x45, 0x8f, 0x61, 0x4e,
+	0x89, 0x98, 0x35, 0x7c, 0xea, 0xa9, 0x95, 0xb0, 0x4f, 0x48, 0xdc, 0x23, 0x89, 0x18, 0xd0, 0x91,
+	0x67, 0x12, 0x9a, 0xbf, 0xce, 0x41, 0xed, 0xba, 0x1e, 0xa8, 0x0b, 0x10, 0xf0, 0x38, 0xa6, 0x42,
+	0xa8, 0xad, 0x39, 0x37, 0xbf, 0xbb, 0x85, 0x34, 0xd5, 0xa4, 0x98, 0x33, 0x3a, 0x24, 0x89, 0x9d,
+	0xa8, 0xcc, 0x54, 0x9e, 0x73, 0xd2, 0x13, 0x54, 0xe6, 0x43, 0x65, 0x4d, 0xb4, 0x05, 0x8b, 0x34,
+	0x24, 0x4c, 0x52, 0x39, 0xb6, 0x83, 0x95, 0xdb, 0x4a, 0x35, 0x41, 0x82, 0x34, 0xa1, 0x72, 0xec,
+	0x07, 0x9c, 0x49, 0x1c, 0xd8, 0xb1, 0xf2, 0x56, 0x32, 0xbc, 0x6b, 0x60, 0x55, 0x20, 0x24, 0x12,
+	0xd3, 0x48, 0x68, 0xb5, 0x2a, 0x5e, 0x66, 0x22, 0x02, 0x9b, 0x23, 0xa2, 0xbb, 0xe0, 0x4f, 0xb6,
+	0xea, 0x07, 0x03, 0xcc, 0xfa, 0xc4, 0x5d, 0xd0, 0x17, 0xe6, 0xfe, 0x34, 0xe9, 0xba, 0x79, 0x70,
+	0x57, 0xc7, 0x1e, 0x32, 0x99, 0x8c, 0xbd, 0x3b, 0x96, 0xeb, 0x6d, 0x2f, 0xfa, 0x16, 0x50, 0x81,
+	0x3e, 0x21, 0xe7, 0x38, 0x09, 0x85, 0xbb, 0xf8, 0x8e, 0x66, 0x71, 0x6d, 0x52, 0xcb, 0x33, 0xa5,
+	0xd0, 0x17, 0x50, 0x8b, 0xf1, 0x45, 0xe1, 0x8c, 0x6e, 0xe5, 0xe6, 0x5d, 0x5c, 0x8e, 0xf1, 0xc5,
+	0xe4, 0x48, 0xe8, 0x39, 0xdc, 0xbe, 0xce, 0x95, 0xe9, 0x05, 0x37, 0xa7, 0xbc, 0x75, 0x8d, 0xd2,
+	0xaa, 0xf4, 0x18, 0x5c, 0x9c, 0x4a, 0xae, 0x98, 0x47, 0x3c, 0x65, 0x61, 0x71, 0xbb, 0xd5, 0x86,
+	0xd3, 0x5a, 0xf4, 0x36, 0x94, 0xbf, 0x6b, 0xdd, 0x93, 0xfc, 0xe6, 0x77, 0x0e, 0xdc, 0x9e, 0xda,
+	0x92, 0xff, 0xe7, 0xea, 0xde, 0x85, 0xe5, 0x20, 0x21, 0x66, 0xaa, 0x43, 0x2c, 0xcd, 0x93, 0x50,
+	0xf2, 0x96, 0x32, 0xf0, 0x00, 0x4b, 0xd2, 0xfc, 0xbe, 0x04, 0xb5, 0xeb, 0x13, 0x85, 0x76, 0xa1,
+	0xac, 0x66, 0x4a, 0x97, 0xad, 0xee, 0x6d, 0x4f, 0xbb, 0x48, 0xf9, 0xf3, 0xe3, 0xe9, 0x50, 0xb4,
+	0x01, 0xf3, 0x23, 0x4e, 0x99, 0x14, 0xba, 0x46, 0xd9, 0xb3, 0x16, 0xda, 0x06, 0xa0, 0xc2, 0x8f,
+	0x08, 0x3e, 0x53, 0x1f, 0x94, 0x92, 0x56, 0xa3, 0x42, 0xc5, 0xb1, 0x01, 0x50, 0x1d, 0xe0, 0x0c,
+	0x47, 0xd9, 0x47, 0xd0, 0x8c, 0x4a, 0x01, 0x51, 0x13, 0xd0, 0xc3, 0x11, 0x66, 0x01, 0xb1, 0x5f,
+	0x96, 0xcc, 0x44, 0xbb, 0xb0, 0x2e, 0x48, 0xc0, 0x59, 0x88, 0x93, 0xb1, 0x5f, 0xe0, 0x30, 0x83,
+	0x72, 0x2b, 0xf7, 0x3d, 0x9b, 0x90, 0x3d, 0x84, 0xb5, 0x49, 0x4a, 0x46, 0xbb, 0xa0, 0x69, 0x57,
+	0x73, 0xc7, 0xbe, 0xe5, 0x7f, 0x08, 0x6b, 0x31, 0x56, 0x6f, 0x14, 0x53, 0xa6, 0xaf, 0x5e, 0xcb,
+	0xc8, 0xbe, 0x06, 0xab, 0x05, 0xc7, 0x53, 0x85, 0xa3, 0x43, 0x58, 0x3e, 0x25, 0xc4, 0xc7, 0x51,
+	0xc4, 0xcf, 0x35, 0x6b, 0x45, 0x2b, 0xd7, 0x98, 0xa6, 0xdc, 0x11, 0x21, 0x9f, 0x65, 0x71, 0xde,
+	0xd2, 0x69, 0xc1, 0x6a, 0xfe, 0xe8, 0xc0, 0x52, 0xd1, 0xad, 0x55, 0x25, 0x09, 0xe5, 0xd9, 0xf3,
+	0x6e, 0x2d, 0xf4, 0x21, 0x20, 0xb3, 0xf2, 0x85, 0x1a, 0x5d, 0x3f, 0xa2, 0x31, 0x95, 0x56, 0xf9,
+	0x55, 0xe3, 0x39, 0x51, 0x8e, 0x63, 0x85, 0xa3, 0x16, 0x58, 0xcc, 0x0f, 0x30, 0x33, 0x19, 0xd9,
+	0x6b, 0x60, 0xf0, 0x2e, 0x66, 0x3a, 0x1c, 0x7d, 0x00, 0x4b, 0x36, 0x32, 0x21, 0x82, 0x48, 0xfb,
+	0x00, 0x54, 0x0d, 0xe6, 0x29, 0x68, 0xff, 0xe0, 0xd5, 0x65, 0xdd, 0x79, 0x7d, 0x59, 0x77, 0xfe,
+	0xbe, 0xac, 0x3b, 0x3f, 0x5c, 0xd5, 0x67, 0x5e, 0x5f, 0xd5, 0x67, 0x7e, 0xbf, 0xaa, 0xcf, 0x7c,
+	0xf3, 0xa0, 0x30, 0xed, 0x5f, 0xbe, 0x78, 0x76, 0xf8, 0x15, 0x91, 0xe7, 0x3c, 0x19, 0x76, 0x82,
+	0x01, 0xa6, 0xac, 0x73, 0x61, 0x7f, 0x4a, 0xe9, 0xa9, 0xef, 0xcd, 0xeb, 0x1f, 0x32, 0x1f, 0xff,
+	0x3b, 0x00, 0xc9, 0x35, 0x13, 0xdb, 0x65, 0x09, 0x00, 0x00,
 }
 
 func (m *BasicPool) Marshal() (dAtA []byte, err error) {
@@ -758,6 +905,36 @@ func (m *StakerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundCommission {
+		i--
+		if m.AutoCompoundCommission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxCommissionChange.Size()
+		i -= size
+		if _, err := m.MaxCommissionChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.CommissionRewards) > 0 {
 		for iNdEx := len(m.CommissionRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -890,6 +1067,35 @@ func (m *PoolMembership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeAllowance != nil {
+		{
+			size, err := m.FeeAllowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaintenanceUntil != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaintenanceUntil))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SecondaryBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondaryBalance))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SecondaryValaddress) > 0 {
+		i -= len(m.SecondaryValaddress)
+		copy(dAtA[i:], m.SecondaryValaddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SecondaryValaddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Balance != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Balance))
 		i--
@@ -932,6 +1138,49 @@ func (m *PoolMembership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodReset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PeriodReset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PeriodCanSpend != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PeriodCanSpend))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PeriodSpendLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PeriodSpendLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1057,6 +1306,13 @@ func (m *StakerMetadata) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxCommissionChange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AutoCompoundCommission {
+		n += 2
+	}
 	return n
 }
 
@@ -1097,6 +1353,41 @@ func (m *PoolMembership) Size() (n int) {
 	if m.Balance != 0 {
 		n += 1 + sovQuery(uint64(m.Balance))
 	}
+	l = len(m.SecondaryValaddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SecondaryBalance != 0 {
+		n += 1 + sovQuery(uint64(m.SecondaryBalance))
+	}
+	if m.MaintenanceUntil != 0 {
+		n += 1 + sovQuery(uint64(m.MaintenanceUntil))
+	}
+	if m.FeeAllowance != nil {
+		l = m.FeeAllowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	if m.PeriodSpendLimit != 0 {
+		n += 1 + sovQuery(uint64(m.PeriodSpendLimit))
+	}
+	if m.PeriodCanSpend != 0 {
+		n += 1 + sovQuery(uint64(m.PeriodCanSpend))
+	}
+	if m.PeriodReset != 0 {
+		n += 1 + sovQuery(uint64(m.PeriodReset))
+	}
 	return n
 }
 
@@ -1917,6 +2208,94 @@ func (m *StakerMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundCommission", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompoundCommission = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2196,6 +2575,238 @@ func (m *PoolMembership) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryValaddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondaryValaddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryBalance", wireType)
+			}
+			m.SecondaryBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondaryBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceUntil", wireType)
+			}
+			m.MaintenanceUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeAllowance == nil {
+				m.FeeAllowance = &FeeAllowance{}
+			}
+			if err := m.FeeAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			m.PeriodSpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodSpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			m.PeriodCanSpend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodCanSpend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			m.PeriodReset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodReset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
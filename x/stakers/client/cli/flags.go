@@ -0,0 +1,30 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagMaxCommission       = "max-commission"
+	FlagMaxCommissionChange = "max-commission-change"
+	FlagFeeAllowance        = "fee-allowance"
+	FlagFeeAllowancePeriod  = "fee-allowance-period"
+)
+
+func flagSetStakerCreate() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMaxCommission, "1", "The highest commission the staker can ever set")
+	fs.String(FlagMaxCommissionChange, "1", "The maximum amount the commission can be changed by a single commission change")
+
+	return fs
+}
+
+func flagSetPoolJoin() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagFeeAllowance, 0, "The amount the valaddress can spend per period on fees of bundle messages, zero disables the fee grant")
+	fs.Uint64(FlagFeeAllowancePeriod, 0, "The duration of one fee allowance period in seconds, defaults to one day")
+
+	return fs
+}
@@ -22,8 +22,14 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateStaker())
 	cmd.AddCommand(CmdJoinPool())
 	cmd.AddCommand(CmdLeavePool())
+	cmd.AddCommand(CmdCancelLeavePool())
+	cmd.AddCommand(CmdUpdateValaddress())
+	cmd.AddCommand(CmdUpdateSecondaryValaddress())
+	cmd.AddCommand(CmdEnterMaintenance())
 	cmd.AddCommand(CmdUpdateCommission())
+	cmd.AddCommand(CmdCancelCommissionChange())
 	cmd.AddCommand(CmdClaimCommissionRewards())
+	cmd.AddCommand(CmdUpdateAutoCompoundCommission())
 	cmd.AddCommand(CmdUpdateMetadata())
 
 	return cmd
@@ -26,15 +26,29 @@ func CmdCreateStaker() *cobra.Command {
 				return err
 			}
 
+			maxCommissionFlag, _ := cmd.Flags().GetString(FlagMaxCommission)
+			maxCommission, err := math.LegacyNewDecFromStr(maxCommissionFlag)
+			if err != nil {
+				return err
+			}
+
+			maxCommissionChangeFlag, _ := cmd.Flags().GetString(FlagMaxCommissionChange)
+			maxCommissionChange, err := math.LegacyNewDecFromStr(maxCommissionChangeFlag)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
 			msg := types.MsgCreateStaker{
-				Creator:    clientCtx.GetFromAddress().String(),
-				Amount:     argAmount,
-				Commission: argCommission,
+				Creator:             clientCtx.GetFromAddress().String(),
+				Amount:              argAmount,
+				Commission:          argCommission,
+				MaxCommission:       maxCommission,
+				MaxCommissionChange: maxCommissionChange,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -45,6 +59,7 @@ func CmdCreateStaker() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetStakerCreate())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -27,16 +27,28 @@ func CmdJoinPool() *cobra.Command {
 				return err
 			}
 
+			feeAllowance, err := cmd.Flags().GetUint64(FlagFeeAllowance)
+			if err != nil {
+				return err
+			}
+
+			feeAllowancePeriod, err := cmd.Flags().GetUint64(FlagFeeAllowancePeriod)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
 			msg := types.MsgJoinPool{
-				Creator:    clientCtx.GetFromAddress().String(),
-				PoolId:     argPoolId,
-				Valaddress: argValaddress,
-				Amount:     argAmount,
+				Creator:            clientCtx.GetFromAddress().String(),
+				PoolId:             argPoolId,
+				Valaddress:         argValaddress,
+				Amount:             argAmount,
+				FeeAllowance:       feeAllowance,
+				FeeAllowancePeriod: feeAllowancePeriod,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -47,6 +59,7 @@ func CmdJoinPool() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetPoolJoin())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpdateSecondaryValaddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-secondary-valaddress [pool_id] [secondary_valaddress] [amount]",
+		Short: "Broadcast message update-secondary-valaddress, an empty secondary_valaddress removes it",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argSecondaryValaddress := args[1]
+
+			argAmount, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateSecondaryValaddress{
+				Creator:             clientCtx.GetFromAddress().String(),
+				PoolId:              argPoolId,
+				SecondaryValaddress: argSecondaryValaddress,
+				Amount:              argAmount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	// Gov
@@ -18,16 +19,94 @@ import (
 
 // LeavePool removes a staker from a pool and emits the corresponding event.
 // The staker is no longer able to participate in the given pool.
-// All points the staker had in that pool are deleted.
+// All points the staker had in that pool are deleted. The fee allowance
+// to the valaddress is revoked unless the valaddress is still in use.
 func (k Keeper) LeavePool(ctx sdk.Context, staker string, poolId uint64) {
+	valaccount, _ := k.GetValaccount(ctx, poolId, staker)
 	k.RemoveValaccountFromPool(ctx, poolId, staker)
 
+	valaddressInUse := false
+	for _, otherValaccount := range k.GetValaccountsFromStaker(ctx, staker) {
+		if otherValaccount.HasValaddress(valaccount.Valaddress) {
+			valaddressInUse = true
+		}
+	}
+
+	if !valaddressInUse {
+		_ = k.revokeFeeAllowance(ctx, staker, valaccount.Valaddress)
+	}
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventLeavePool{
 		PoolId: poolId,
 		Staker: staker,
 	})
 }
 
+// MigrateValaccounts moves all valaccounts of a pool to another pool, keeping
+// all of their fields like the valaddresses and the maintenance state. Stakers
+// which are currently leaving the pool are not migrated. It returns the amount
+// of migrated valaccounts.
+func (k Keeper) MigrateValaccounts(ctx sdk.Context, fromPoolId uint64, toPoolId uint64) (migrated uint64) {
+	for _, valaccount := range k.GetAllValaccountsOfPool(ctx, fromPoolId) {
+		if k.DoesLeavePoolEntryExistByIndex2(ctx, valaccount.Staker, fromPoolId) {
+			continue
+		}
+
+		k.AddValaccountToPool(ctx, toPoolId, valaccount.Staker, valaccount.Valaddress)
+
+		migratedValaccount := *valaccount
+		migratedValaccount.PoolId = toPoolId
+		k.SetValaccount(ctx, migratedValaccount)
+
+		k.LeavePool(ctx, valaccount.Staker, fromPoolId)
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventJoinPool{
+			PoolId:     toPoolId,
+			Staker:     valaccount.Staker,
+			Valaddress: valaccount.Valaddress,
+		})
+
+		migrated++
+	}
+
+	return migrated
+}
+
+// RemoveAllValaccountsOfPool forces all valaccounts out of a pool, including
+// those which are currently leaving the pool. It returns the amount of removed
+// valaccounts.
+func (k Keeper) RemoveAllValaccountsOfPool(ctx sdk.Context, poolId uint64) (removed uint64) {
+	for _, leavePoolEntry := range k.GetAllLeavePoolEntries(ctx) {
+		if leavePoolEntry.PoolId == poolId {
+			k.RemoveLeavePoolEntry(ctx, &leavePoolEntry)
+		}
+	}
+
+	for _, staker := range k.GetAllStakerAddressesOfPool(ctx, poolId) {
+		k.LeavePool(ctx, staker, poolId)
+		removed++
+	}
+
+	return removed
+}
+
+// EnforceMaxStakers removes the stakers with the lowest delegation from a pool
+// until the pool has no more than maxStakers stakers. It returns the amount of
+// evicted stakers.
+func (k Keeper) EnforceMaxStakers(ctx sdk.Context, poolId uint64, maxStakers uint64) (evicted uint64) {
+	for k.GetStakerCountOfPool(ctx, poolId) > maxStakers {
+		lowestStaker, found := k.getLowestStaker(ctx, poolId)
+		if !found {
+			break
+		}
+
+		k.LeavePool(ctx, lowestStaker.Address, poolId)
+		evicted++
+	}
+
+	return evicted
+}
+
 // GetAllStakerAddressesOfPool returns a list of all stakers
 // which have currently a valaccount registered for the given pool
 // and are therefore allowed to participate in that pool.
@@ -46,7 +125,8 @@ func (k Keeper) GetCommission(ctx sdk.Context, stakerAddress string) math.Legacy
 }
 
 // AssertValaccountAuthorized checks if the given `valaddress` is allowed to vote in pool
-// with id `poolId` to vote in favor of `stakerAddress`.
+// with id `poolId` to vote in favor of `stakerAddress`. Both the valaddress and the
+// secondary valaddress of the valaccount are authorized.
 // If the valaddress is not authorized the appropriate error is returned.
 // Otherwise, it returns `nil`
 func (k Keeper) AssertValaccountAuthorized(ctx sdk.Context, poolId uint64, stakerAddress string, valaddress string) error {
@@ -56,13 +136,33 @@ func (k Keeper) AssertValaccountAuthorized(ctx sdk.Context, poolId uint64, stake
 		return types.ErrValaccountUnauthorized
 	}
 
-	if valaccount.Valaddress != valaddress {
+	if !valaccount.HasValaddress(valaddress) {
 		return types.ErrValaccountUnauthorized
 	}
 
 	return nil
 }
 
+// IsSecondaryValaddress returns true if the given `valaddress` is the secondary
+// valaddress of `stakerAddress` in the pool with id `poolId`.
+func (k Keeper) IsSecondaryValaddress(ctx sdk.Context, poolId uint64, stakerAddress string, valaddress string) bool {
+	valaccount, _ := k.GetValaccount(ctx, poolId, stakerAddress)
+	return valaccount.SecondaryValaddress != "" && valaccount.SecondaryValaddress == valaddress
+}
+
+// IsInMaintenance returns true if `stakerAddress` is currently in
+// maintenance in the pool with id `poolId`.
+func (k Keeper) IsInMaintenance(ctx sdk.Context, poolId uint64, stakerAddress string) bool {
+	valaccount, _ := k.GetValaccount(ctx, poolId, stakerAddress)
+	return valaccount.IsInMaintenance(uint64(ctx.BlockTime().Unix()))
+}
+
+// GetFeeAllowance returns the periodic fee allowance the staker has granted
+// to the valaddress when joining a pool (if there is one).
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, stakerAddress string, valaddress string) (*feegrant.PeriodicAllowance, bool) {
+	return k.getFeeAllowance(ctx, stakerAddress, valaddress)
+}
+
 // GetActiveStakers returns all staker-addresses that are
 // currently participating in at least one pool.
 func (k Keeper) GetActiveStakers(ctx sdk.Context) []string {
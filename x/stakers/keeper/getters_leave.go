@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	"cosmossdk.io/store/prefix"
 	storeTypes "cosmossdk.io/store/types"
 	"github.com/KYVENetwork/chain/x/stakers/types"
@@ -22,11 +24,14 @@ func (k Keeper) SetLeavePoolEntry(ctx sdk.Context, leavePoolEntry types.LeavePoo
 	), b)
 
 	// Insert the same entry with a different key prefix for query lookup
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, leavePoolEntry.Index)
+
 	indexStore := prefix.NewStore(storeAdapter, types.LeavePoolEntryKeyPrefixIndex2)
 	indexStore.Set(types.LeavePoolEntryKeyIndex2(
 		leavePoolEntry.Staker,
 		leavePoolEntry.PoolId,
-	), []byte{1})
+	), indexBytes)
 }
 
 // GetLeavePoolEntry ...
@@ -43,7 +48,8 @@ func (k Keeper) GetLeavePoolEntry(ctx sdk.Context, index uint64) (val types.Leav
 	return val, true
 }
 
-// GetLeavePoolEntryByIndex2 ...
+// GetLeavePoolEntryByIndex2 returns a pending leave pool entry by staker address
+// and pool id (if there is one)
 func (k Keeper) GetLeavePoolEntryByIndex2(ctx sdk.Context, staker string, poolId uint64) (val types.LeavePoolEntry, found bool) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.LeavePoolEntryKeyPrefixIndex2)
@@ -53,8 +59,9 @@ func (k Keeper) GetLeavePoolEntryByIndex2(ctx sdk.Context, staker string, poolId
 		return val, false
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+	index := binary.BigEndian.Uint64(b)
+
+	return k.GetLeavePoolEntry(ctx, index)
 }
 
 // DoesLeavePoolEntryExistByIndex2 ...
@@ -29,6 +29,16 @@ func (k Keeper) GetLeavePoolTime(ctx sdk.Context) (res uint64) {
 	return k.GetParams(ctx).LeavePoolTime
 }
 
+// GetMaintenancePeriod returns the MaintenancePeriod param
+func (k Keeper) GetMaintenancePeriod(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MaintenancePeriod
+}
+
+// GetMaintenanceBudget returns the MaintenanceBudget param
+func (k Keeper) GetMaintenanceBudget(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).MaintenanceBudget
+}
+
 // SetParams sets the x/stakers module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -68,6 +68,7 @@ func (k Keeper) AddValaccountToPool(ctx sdk.Context, poolId uint64, stakerAddres
 			})
 			k.AddOneToCount(ctx, poolId)
 			k.AddActiveStaker(ctx, stakerAddress)
+			k.delegationKeeper.SchedulePoolRebalance(ctx, poolId)
 		}
 	}
 }
@@ -84,6 +85,7 @@ func (k Keeper) RemoveValaccountFromPool(ctx sdk.Context, poolId uint64, stakerA
 		k.removeValaccount(ctx, valaccount)
 		k.subtractOneFromCount(ctx, poolId)
 		k.removeActiveStaker(ctx, stakerAddress)
+		k.delegationKeeper.SchedulePoolRebalance(ctx, poolId)
 	}
 }
 
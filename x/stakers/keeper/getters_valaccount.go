@@ -24,6 +24,21 @@ func (k Keeper) IncrementPoints(ctx sdk.Context, poolId uint64, stakerAddress st
 	return valaccount.Points
 }
 
+// IncrementDecayingPoints adds a point with the current block time to the staker
+// in the given pool after removing all points received at or before `windowStart`.
+// Returns the amount of points within the window (including the current incrementation)
+func (k Keeper) IncrementDecayingPoints(ctx sdk.Context, poolId uint64, stakerAddress string, windowStart uint64) uint64 {
+	valaccount, found := k.GetValaccount(ctx, poolId, stakerAddress)
+	if found {
+		now := uint64(ctx.BlockTime().Unix())
+		valaccount.DecayPoints(windowStart, now)
+		valaccount.PointTimestamps = append(valaccount.PointTimestamps, now)
+		valaccount.Points += 1
+		k.SetValaccount(ctx, valaccount)
+	}
+	return valaccount.Points
+}
+
 // ResetPoints sets the point count for the staker in the given pool back to zero.
 // Returns the amount of points the staker had before the reset.
 func (k Keeper) ResetPoints(ctx sdk.Context, poolId uint64, stakerAddress string) (previousPoints uint64) {
@@ -31,11 +46,28 @@ func (k Keeper) ResetPoints(ctx sdk.Context, poolId uint64, stakerAddress string
 	if found {
 		previousPoints = valaccount.Points
 		valaccount.Points = 0
+		valaccount.PointTimestamps = nil
 		k.SetValaccount(ctx, valaccount)
 	}
 	return
 }
 
+// DecayPoints removes all points of the staker in the given pool which were
+// received at or before `windowStart`.
+// Returns the amount of points the staker had before and after the decay.
+func (k Keeper) DecayPoints(ctx sdk.Context, poolId uint64, stakerAddress string, windowStart uint64) (previousPoints uint64, currentPoints uint64) {
+	valaccount, found := k.GetValaccount(ctx, poolId, stakerAddress)
+	if found {
+		previousPoints = valaccount.Points
+		valaccount.DecayPoints(windowStart, uint64(ctx.BlockTime().Unix()))
+		currentPoints = valaccount.Points
+		if currentPoints != previousPoints {
+			k.SetValaccount(ctx, valaccount)
+		}
+	}
+	return
+}
+
 // GetAllValaccountsOfPool returns a list of all valaccount
 func (k Keeper) GetAllValaccountsOfPool(ctx sdk.Context, poolId uint64) (val []*types.Valaccount) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
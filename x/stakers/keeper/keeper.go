@@ -24,12 +24,14 @@ type (
 
 		authority string
 
-		accountKeeper    util.AccountKeeper
-		bankKeeper       util.BankKeeper
-		distrkeeper      util.DistributionKeeper
-		poolKeeper       types.PoolKeeper
-		upgradeKeeper    util.UpgradeKeeper
-		delegationKeeper delegationKeeper.Keeper
+		accountKeeper     util.AccountKeeper
+		bankKeeper        util.BankKeeper
+		distrkeeper       util.DistributionKeeper
+		poolKeeper        types.PoolKeeper
+		upgradeKeeper     util.UpgradeKeeper
+		feeGrantKeeper    types.FeeGrantKeeper
+		feeGrantMsgServer types.FeeGrantMsgServer
+		delegationKeeper  delegationKeeper.Keeper
 	}
 )
 
@@ -46,6 +48,8 @@ func NewKeeper(
 	distrkeeper util.DistributionKeeper,
 	poolKeeper types.PoolKeeper,
 	upgradeKeeper util.UpgradeKeeper,
+	feeGrantKeeper types.FeeGrantKeeper,
+	feeGrantMsgServer types.FeeGrantMsgServer,
 ) *Keeper {
 	return &Keeper{
 		cdc:          cdc,
@@ -55,11 +59,13 @@ func NewKeeper(
 
 		authority: authority,
 
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		distrkeeper:   distrkeeper,
-		poolKeeper:    poolKeeper,
-		upgradeKeeper: upgradeKeeper,
+		accountKeeper:     accountKeeper,
+		bankKeeper:        bankKeeper,
+		distrkeeper:       distrkeeper,
+		poolKeeper:        poolKeeper,
+		upgradeKeeper:     upgradeKeeper,
+		feeGrantKeeper:    feeGrantKeeper,
+		feeGrantMsgServer: feeGrantMsgServer,
 	}
 }
 
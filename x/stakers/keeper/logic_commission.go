@@ -10,7 +10,15 @@ import (
 // The queue is checked in every endBlock and when the commissionChangeTime
 // is over the new commission will be applied to the user.
 // If another entry is currently in the queue it will be removed.
-func (k Keeper) orderNewCommissionChange(ctx sdk.Context, staker string, commission math.LegacyDec) {
+// The new commission must not exceed the max commission of the staker
+// and must not differ from the current commission by more than the
+// max commission change.
+func (k Keeper) orderNewCommissionChange(ctx sdk.Context, staker string, commission math.LegacyDec) error {
+	stakerObj, _ := k.GetStaker(ctx, staker)
+	if err := stakerObj.ValidateCommissionChange(commission); err != nil {
+		return err
+	}
+
 	// Remove existing queue entry
 	queueEntry, found := k.GetCommissionChangeEntryByIndex2(ctx, staker)
 	if found {
@@ -27,11 +35,14 @@ func (k Keeper) orderNewCommissionChange(ctx sdk.Context, staker string, commiss
 	}
 
 	k.SetCommissionChangeEntry(ctx, commissionChangeEntry)
+
+	return nil
 }
 
 // ProcessCommissionChangeQueue checks the queue for entries which are due
 // and can be executed. If this is the case, the new commission
-// will be applied to the staker
+// will be applied to the staker. Entries which violate the commission
+// limits of the staker are removed without being applied.
 func (k Keeper) ProcessCommissionChangeQueue(ctx sdk.Context) {
 	k.processQueue(ctx, types.QUEUE_IDENTIFIER_COMMISSION, func(index uint64) bool {
 		// Get queue entry in question
@@ -44,6 +55,13 @@ func (k Keeper) ProcessCommissionChangeQueue(ctx sdk.Context) {
 
 			k.RemoveCommissionChangeEntry(ctx, &queueEntry)
 
+			// Double check the commission limits, the entry is dropped if
+			// the commission change is no longer allowed
+			staker, _ := k.GetStaker(ctx, queueEntry.Staker)
+			if err := staker.ValidateCommissionChange(queueEntry.Commission); err != nil {
+				return true
+			}
+
 			k.UpdateStakerCommission(ctx, queueEntry.Staker, queueEntry.Commission)
 
 			_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateCommission{
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"slices"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// getAnyFeeAllowance returns the fee allowance from the staker to the
+// valaddress (if there is one), regardless of how it was created.
+func (k Keeper) getAnyFeeAllowance(ctx sdk.Context, stakerAddress string, valaddress string) (feegrant.FeeAllowanceI, bool) {
+	granter, err := sdk.AccAddressFromBech32(stakerAddress)
+	if err != nil {
+		return nil, false
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(valaddress)
+	if err != nil {
+		return nil, false
+	}
+
+	allowance, err := k.feeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil || allowance == nil {
+		return nil, false
+	}
+
+	return allowance, true
+}
+
+// getManagedFeeAllowance returns the periodic allowance wrapped in the given
+// fee allowance if it has the exact form of the fee allowances granted by
+// this module. All other fee allowances are left untouched by the module.
+func getManagedFeeAllowance(allowance feegrant.FeeAllowanceI) (*feegrant.PeriodicAllowance, bool) {
+	allowedMsgAllowance, ok := allowance.(*feegrant.AllowedMsgAllowance)
+	if !ok || !slices.Equal(allowedMsgAllowance.AllowedMessages, types.FeeAllowanceMessages) {
+		return nil, false
+	}
+
+	innerAllowance, err := allowedMsgAllowance.GetAllowance()
+	if err != nil {
+		return nil, false
+	}
+
+	periodicAllowance, ok := innerAllowance.(*feegrant.PeriodicAllowance)
+	if !ok || !periodicAllowance.Basic.SpendLimit.Empty() || periodicAllowance.Basic.Expiration != nil {
+		return nil, false
+	}
+
+	return periodicAllowance, true
+}
+
+// getFeeAllowance returns the fee allowance from the staker to the valaddress
+// if it was granted by this module.
+func (k Keeper) getFeeAllowance(ctx sdk.Context, stakerAddress string, valaddress string) (*feegrant.PeriodicAllowance, bool) {
+	allowance, found := k.getAnyFeeAllowance(ctx, stakerAddress, valaddress)
+	if !found {
+		return nil, false
+	}
+
+	return getManagedFeeAllowance(allowance)
+}
+
+// setFeeAllowance grants the given periodic allowance from the staker to the
+// valaddress for bundle messages. An existing fee allowance is only replaced
+// if it was granted by this module, otherwise an error is returned.
+func (k Keeper) setFeeAllowance(ctx sdk.Context, stakerAddress string, valaddress string, periodicAllowance *feegrant.PeriodicAllowance) error {
+	granter, err := sdk.AccAddressFromBech32(stakerAddress)
+	if err != nil {
+		return err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(valaddress)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := feegrant.NewAllowedMsgAllowance(periodicAllowance, types.FeeAllowanceMessages)
+	if err != nil {
+		return err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return err
+	}
+
+	existingAllowance, found := k.getAnyFeeAllowance(ctx, stakerAddress, valaddress)
+	if !found {
+		return k.feeGrantKeeper.GrantAllowance(ctx, granter, grantee, allowance)
+	}
+
+	if _, managed := getManagedFeeAllowance(existingAllowance); !managed {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrFeeAllowanceNotManaged.Error(), valaddress)
+	}
+
+	return k.feeGrantKeeper.UpdateAllowance(ctx, granter, grantee, allowance)
+}
+
+// grantFeeAllowance grants the valaddress a periodic fee allowance of the
+// staker which can only be used for the messages of the bundles module.
+func (k Keeper) grantFeeAllowance(ctx sdk.Context, stakerAddress string, valaddress string, spendLimit uint64, period uint64) error {
+	periodDuration := time.Duration(period) * time.Second
+	periodSpendLimit := sdk.NewCoins(sdk.NewCoin(globalTypes.Denom, math.NewIntFromUint64(spendLimit)))
+
+	return k.setFeeAllowance(ctx, stakerAddress, valaddress, &feegrant.PeriodicAllowance{
+		Period:           periodDuration,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      ctx.BlockTime().Add(periodDuration),
+	})
+}
+
+// moveFeeAllowance moves the fee allowance of the staker from the old
+// valaddress to the new valaddress if it was granted by this module.
+func (k Keeper) moveFeeAllowance(ctx sdk.Context, stakerAddress string, oldValaddress string, newValaddress string) error {
+	periodicAllowance, found := k.getFeeAllowance(ctx, stakerAddress, oldValaddress)
+	if !found {
+		return nil
+	}
+
+	if err := k.setFeeAllowance(ctx, stakerAddress, newValaddress, periodicAllowance); err != nil {
+		return err
+	}
+
+	return k.revokeFeeAllowance(ctx, stakerAddress, oldValaddress)
+}
+
+// revokeFeeAllowance removes the fee allowance from the staker to the
+// valaddress if it was granted by this module.
+func (k Keeper) revokeFeeAllowance(ctx sdk.Context, stakerAddress string, valaddress string) error {
+	if _, found := k.getFeeAllowance(ctx, stakerAddress, valaddress); !found {
+		return nil
+	}
+
+	_, err := k.feeGrantMsgServer.RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{
+		Granter: stakerAddress,
+		Grantee: valaddress,
+	})
+	return err
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"math"
 
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	"github.com/KYVENetwork/chain/x/stakers/types"
 
 	"cosmossdk.io/errors"
@@ -30,6 +31,38 @@ func (k Keeper) IncreaseStakerCommissionRewards(ctx sdk.Context, address string,
 	}
 
 	k.updateStakerCommissionRewards(ctx, address, amount)
+
+	return nil
+}
+
+// CompoundCommissionRewards delegates the $KYVE part of the commission rewards
+// to the staker itself if the staker has enabled auto-compounding. All other
+// denoms remain in the commission rewards and can be claimed manually. It has
+// to be called after the delegators have been paid out, otherwise the new
+// self-delegation would already receive a share of the same payout.
+func (k Keeper) CompoundCommissionRewards(ctx sdk.Context, address string) error {
+	staker, found := k.GetStaker(ctx, address)
+	if !found || !staker.AutoCompoundCommission {
+		return nil
+	}
+
+	amount := staker.CommissionRewards.AmountOf(globalTypes.Denom)
+	if amount.IsZero() {
+		return nil
+	}
+
+	if err := k.delegationKeeper.DelegateFromModule(ctx, address, address, amount.Uint64(), types.ModuleName); err != nil {
+		return err
+	}
+
+	staker.CommissionRewards = staker.CommissionRewards.Sub(sdk.NewCoin(globalTypes.Denom, amount))
+	k.setStaker(ctx, staker)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventCompoundCommissionRewards{
+		Staker: address,
+		Amount: amount.Uint64(),
+	})
+
 	return nil
 }
 
@@ -45,15 +78,47 @@ func (k Keeper) getLowestStaker(ctx sdk.Context, poolId uint64) (val types.Stake
 		if delegationAmount < minAmount {
 			minAmount = delegationAmount
 			val = staker
+			found = true
 		}
 	}
 
 	return
 }
 
+// ensureValaddressUnused makes sure that a staker can use the given
+// valaddress for the given pool. If this is not possible an appropriate
+// error is returned.
+func (k Keeper) ensureValaddressUnused(ctx sdk.Context, poolId uint64, stakerAddress string, valaddress string) error {
+	// Stakers are not allowed to use their own address, to prevent
+	// users from putting their staker private key on the protocol node server.
+	if stakerAddress == valaddress {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrValaddressSameAsStaker.Error())
+	}
+
+	// Every valaddress can only be used for one pool. It is not allowed
+	// to use the same valaddress for multiple pools. (to avoid account sequence errors,
+	// when two processes try so submit transactions simultaneously)
+	for _, valaccount := range k.GetValaccountsFromStaker(ctx, stakerAddress) {
+		if valaccount.HasValaddress(valaddress) {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ValaddressAlreadyUsed.Error())
+		}
+	}
+
+	// It is not allowed to use the valaddress of somebody else.
+	for _, poolStaker := range k.GetAllStakerAddressesOfPool(ctx, poolId) {
+		valaccount, _ := k.GetValaccount(ctx, poolId, poolStaker)
+
+		if valaccount.HasValaddress(valaddress) {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ValaddressAlreadyUsed.Error())
+		}
+	}
+
+	return nil
+}
+
 // ensureFreeSlot makes sure that a staker can join a given pool.
 // If this is not possible an appropriate error is returned.
-// A pool has a fixed amount of slots. If there are still free slots
+// A pool has a limited amount of slots. If there are still free slots
 // a staker can just join (even with the smallest stake possible).
 // If all slots are taken, it checks if the new staker has more stake
 // than the current lowest staker in that pool.
@@ -61,7 +126,7 @@ func (k Keeper) getLowestStaker(ctx sdk.Context, poolId uint64) (val types.Stake
 // new staker can join.
 func (k Keeper) ensureFreeSlot(ctx sdk.Context, poolId uint64, stakerAddress string) error {
 	// check if slots are still available
-	if k.GetStakerCountOfPool(ctx, poolId) >= types.MaxStakers {
+	if k.GetStakerCountOfPool(ctx, poolId) >= k.poolKeeper.GetMaxStakersOfPool(ctx, poolId) {
 		// if not - get lowest staker
 		lowestStaker, _ := k.getLowestStaker(ctx, poolId)
 
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CancelCommissionChange handles the SDK message of cancelling a pending
+// commission change. The commission change entry is removed from the queue
+// and the current commission of the staker stays as it is.
+func (k msgServer) CancelCommissionChange(goCtx context.Context, msg *types.MsgCancelCommissionChange) (*types.MsgCancelCommissionChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	commissionChangeEntry, found := k.GetCommissionChangeEntryByIndex2(ctx, msg.Creator)
+	if !found {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrNoCommissionChangeInProgress.Error())
+	}
+
+	k.RemoveCommissionChangeEntry(ctx, &commissionChangeEntry)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventCancelCommissionChange{
+		Staker:     msg.Creator,
+		Commission: commissionChangeEntry.Commission,
+	})
+
+	return &types.MsgCancelCommissionChangeResponse{}, nil
+}
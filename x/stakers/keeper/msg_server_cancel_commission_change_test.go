@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_cancel_commission_change.go
+
+* Cancel a commission change and keep the commission after the change time
+* Try to cancel a commission change which was never started
+* Update commission again after cancelling a commission change
+
+*/
+
+var _ = Describe("msg_server_cancel_commission_change.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Cancel a commission change and keep the commission after the change time", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			Commission: math.LegacyMustNewDecFromStr("0.5"),
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgCancelCommissionChange{
+			Creator: i.STAKER_0,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeFalse())
+		Expect(s.App().StakersKeeper.GetAllCommissionChangeEntries(s.Ctx())).To(BeEmpty())
+
+		// wait for update
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetCommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.Commission).To(Equal(stakerstypes.DefaultCommission))
+	})
+
+	It("Try to cancel a commission change which was never started", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgCancelCommissionChange{
+			Creator: i.STAKER_0,
+		})
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.Commission).To(Equal(stakerstypes.DefaultCommission))
+	})
+
+	It("Update commission again after cancelling a commission change", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			Commission: math.LegacyMustNewDecFromStr("0.5"),
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCancelCommissionChange{
+			Creator: i.STAKER_0,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			Commission: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetCommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+	})
+})
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CancelLeavePool handles the SDK message of cancelling a pending pool leave.
+// The leave pool entry is removed from the queue and the staker
+// continues to participate in the pool as before.
+func (k msgServer) CancelLeavePool(goCtx context.Context, msg *types.MsgCancelLeavePool) (*types.MsgCancelLeavePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	leavePoolEntry, found := k.GetLeavePoolEntryByIndex2(ctx, msg.Creator, msg.PoolId)
+	if !found {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrNoPoolLeaveInProgress.Error())
+	}
+
+	k.RemoveLeavePoolEntry(ctx, &leavePoolEntry)
+
+	if valaccount, valaccountFound := k.GetValaccount(ctx, msg.PoolId, msg.Creator); valaccountFound {
+		valaccount.IsLeaving = false
+		k.SetValaccount(ctx, valaccount)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventCancelLeavePool{
+		PoolId: msg.PoolId,
+		Staker: msg.Creator,
+	})
+
+	return &types.MsgCancelLeavePoolResponse{}, nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_cancel_leave_pool.go
+
+* Cancel a pool leave and stay in the pool after the leave time
+* Try to cancel a pool leave which was never started
+* Try to cancel a pool leave again
+* Leave a pool again after cancelling a pool leave
+
+*/
+
+var _ = Describe("msg_server_cancel_leave_pool.go", Ordered, func() {
+	s := i.NewCleanChain()
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pool
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		})
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// join pool
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Cancel a pool leave and stay in the pool after the leave time", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgCancelLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(valaccount.IsLeaving).To(BeFalse())
+
+		Expect(s.App().StakersKeeper.DoesLeavePoolEntryExistByIndex2(s.Ctx(), i.STAKER_0, 0)).To(BeFalse())
+		Expect(s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())).To(BeEmpty())
+
+		// wait for leave pool
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		_, found = s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ConsistOf(i.STAKER_0))
+	})
+
+	It("Try to cancel a pool leave which was never started", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgCancelLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(valaccount.IsLeaving).To(BeFalse())
+	})
+
+	It("Try to cancel a pool leave again", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCancelLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgCancelLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.IsLeaving).To(BeFalse())
+	})
+
+	It("Leave a pool again after cancelling a pool leave", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCancelLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.IsLeaving).To(BeTrue())
+
+		// wait for leave pool
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeFalse())
+	})
+})
@@ -25,8 +25,8 @@ func (k msgServer) ClaimCommissionRewards(goCtx context.Context, msg *types.MsgC
 		return nil, types.ErrNotEnoughRewards
 	}
 
-	// send commission rewards from stakers module to claimer
-	recipient := sdk.MustAccAddressFromBech32(msg.Creator)
+	// send commission rewards from stakers module to the withdraw address of the claimer
+	recipient := sdk.MustAccAddressFromBech32(k.delegationKeeper.GetWithdrawAddress(ctx, msg.Creator))
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, msg.Amounts); err != nil {
 		return nil, err
 	}
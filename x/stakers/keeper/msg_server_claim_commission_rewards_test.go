@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/math"
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
 	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
 	globaltypes "github.com/KYVENetwork/chain/x/global/types"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
@@ -26,6 +27,7 @@ TEST CASES - msg_server_claim_commission_rewards.go
 * Claim partial rewards
 * Claim partial rewards twice
 * Claim all rewards
+* Claim all rewards to a withdraw address
 * Claim multiple coins
 * Claim one coin of multiple coins
 * Claim more rewards than available with multiple coins
@@ -388,6 +390,31 @@ var _ = Describe("msg_server_claim_commission_rewards.go", Ordered, func() {
 		Expect(s.GetCoinsFromAddress(i.STAKER_0).String()).To(Equal(initialBalanceStaker0.Add(commissionRewardsBefore...).String()))
 	})
 
+	It("Claim all rewards to a withdraw address", func() {
+		// ARRANGE
+		uploader, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		commissionRewardsBefore := uploader.CommissionRewards
+		initialBalanceDummy0 := s.GetCoinsFromAddress(i.DUMMY[0])
+
+		s.RunTxSuccess(&delegationtypes.MsgSetWithdrawAddress{
+			Creator:         i.STAKER_0,
+			WithdrawAddress: i.DUMMY[0],
+		})
+
+		// ACT
+		s.RunTxSuccess(&stakertypes.MsgClaimCommissionRewards{
+			Creator: i.STAKER_0,
+			Amounts: uploader.CommissionRewards,
+		})
+
+		// ASSERT
+		uploader, _ = s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		Expect(uploader.CommissionRewards).To(BeEmpty())
+		Expect(s.GetCoinsFromAddress(i.STAKER_0).String()).To(Equal(initialBalanceStaker0.String()))
+		Expect(s.GetCoinsFromAddress(i.DUMMY[0]).String()).To(Equal(initialBalanceDummy0.Add(commissionRewardsBefore...).String()))
+	})
+
 	It("Claim multiple coins", func() {
 		// ARRANGE
 		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
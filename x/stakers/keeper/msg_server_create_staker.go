@@ -3,11 +3,13 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	delegationKeeper "github.com/KYVENetwork/chain/x/delegation/keeper"
 	delegationTypes "github.com/KYVENetwork/chain/x/delegation/types"
 
 	"github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // CreateStaker handles the logic of an SDK message that allows protocol nodes to create
@@ -26,10 +28,21 @@ func (k msgServer) CreateStaker(
 		return nil, types.ErrStakerAlreadyCreated
 	}
 
+	// Apply the defaults for all commission settings which were not provided
+	commission := msg.GetCommissionOrDefault()
+	maxCommission := msg.GetMaxCommissionOrDefault()
+	maxCommissionChange := msg.GetMaxCommissionChangeOrDefault()
+
+	if commission.GT(maxCommission) {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrCommissionTooHigh.Error(), commission, maxCommission)
+	}
+
 	// Create and append new staker to store
 	k.AppendStaker(ctx, types.Staker{
-		Address:    msg.Creator,
-		Commission: msg.Commission,
+		Address:             msg.Creator,
+		Commission:          commission,
+		MaxCommission:       maxCommission,
+		MaxCommissionChange: maxCommissionChange,
 	})
 
 	// TODO: maybe we should expose the 'Delegate' function from the delegation module
@@ -44,9 +57,11 @@ func (k msgServer) CreateStaker(
 	}
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventCreateStaker{
-		Staker:     msg.Creator,
-		Amount:     msg.Amount,
-		Commission: msg.Commission,
+		Staker:              msg.Creator,
+		Amount:              msg.Amount,
+		Commission:          commission,
+		MaxCommission:       maxCommission,
+		MaxCommissionChange: maxCommissionChange,
 	})
 
 	return &types.MsgCreateStakerResponse{}, nil
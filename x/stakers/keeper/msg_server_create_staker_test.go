@@ -20,6 +20,9 @@ TEST CASES - msg_server_create_staker.go
 * Try to create staker with more $KYVE than available in balance
 * Create a second staker by staking 150 $KYVE
 * Try to create a staker again
+* Create a staker with max commission and max commission change
+* Try to create a staker with a commission above the max commission
+* Create a staker without commission settings applies the defaults without changing the message
 
 */
 
@@ -201,4 +204,74 @@ var _ = Describe("msg_server_create_staker.go", Ordered, func() {
 
 		Expect(valaccounts).To(BeEmpty())
 	})
+
+	It("Create a staker with max commission and max commission change", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator:             i.STAKER_0,
+			Amount:              100 * i.KYVE,
+			Commission:          math.LegacyMustNewDecFromStr("0.1"),
+			MaxCommission:       math.LegacyMustNewDecFromStr("0.2"),
+			MaxCommissionChange: math.LegacyMustNewDecFromStr("0.05"),
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		Expect(staker.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.1")))
+		Expect(staker.MaxCommission).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+		Expect(staker.MaxCommissionChange).To(Equal(math.LegacyMustNewDecFromStr("0.05")))
+
+		fullStaker := s.App().QueryKeeper.GetFullStaker(s.Ctx(), i.STAKER_0)
+
+		Expect(fullStaker.Metadata.MaxCommission).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+		Expect(fullStaker.Metadata.MaxCommissionChange).To(Equal(math.LegacyMustNewDecFromStr("0.05")))
+
+		staker, _ = s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+
+		Expect(staker.MaxCommission).To(Equal(types.DefaultMaxCommission))
+		Expect(staker.MaxCommissionChange).To(Equal(types.DefaultMaxCommissionChange))
+	})
+
+	It("Try to create a staker with a commission above the max commission", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgCreateStaker{
+			Creator:       i.STAKER_0,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.3"),
+			MaxCommission: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Create a staker without commission settings applies the defaults without changing the message", func() {
+		// ARRANGE
+		msg := &stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		}
+
+		// ACT
+		Expect(msg.ValidateBasic()).To(Succeed())
+		s.RunTxStakersSuccess(msg)
+
+		// ASSERT
+		Expect(msg.Commission.IsNil()).To(BeTrue())
+		Expect(msg.MaxCommission.IsNil()).To(BeTrue())
+		Expect(msg.MaxCommissionChange.IsNil()).To(BeTrue())
+
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		Expect(staker.Commission).To(Equal(types.DefaultCommission))
+		Expect(staker.MaxCommission).To(Equal(types.DefaultMaxCommission))
+		Expect(staker.MaxCommissionChange).To(Equal(types.DefaultMaxCommissionChange))
+	})
 })
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EnterMaintenance handles the SDK message of pausing the participation of a
+// staker in a pool. During maintenance the staker is not selected as the next
+// uploader, receives no points for missing votes and its stake is not counted
+// in the quorum. The total time a staker can spend in maintenance is limited
+// by the `MaintenanceBudget` which is reset every `MaintenancePeriod`. If one
+// of both params is zero maintenance is disabled.
+func (k msgServer) EnterMaintenance(goCtx context.Context, msg *types.MsgEnterMaintenance) (*types.MsgEnterMaintenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := uint64(ctx.BlockTime().Unix())
+
+	maintenancePeriod, maintenanceBudget := k.GetMaintenancePeriod(ctx), k.GetMaintenanceBudget(ctx)
+	if maintenancePeriod == 0 || maintenanceBudget == 0 {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrMaintenanceDisabled.Error())
+	}
+
+	// throw error if staker is not in the pool
+	valaccount, valaccountFound := k.GetValaccount(ctx, msg.PoolId, msg.Creator)
+	if !valaccountFound {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrAlreadyLeftPool.Error())
+	}
+
+	if valaccount.IsInMaintenance(now) {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrAlreadyInMaintenance.Error(), valaccount.MaintenanceUntil)
+	}
+
+	// start a new maintenance period with a full budget if the last one is over
+	if now >= valaccount.MaintenancePeriodStart+maintenancePeriod {
+		valaccount.MaintenancePeriodStart = now
+		valaccount.MaintenanceUsed = 0
+	}
+
+	remainingBudget := uint64(0)
+	if maintenanceBudget > valaccount.MaintenanceUsed {
+		remainingBudget = maintenanceBudget - valaccount.MaintenanceUsed
+	}
+
+	if msg.Duration > remainingBudget {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrMaintenanceBudgetExceeded.Error(), msg.Duration, remainingBudget)
+	}
+
+	valaccount.MaintenanceUsed += msg.Duration
+	valaccount.MaintenanceUntil = now + msg.Duration
+	k.SetValaccount(ctx, valaccount)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventEnterMaintenance{
+		PoolId:           msg.PoolId,
+		Staker:           msg.Creator,
+		Duration:         msg.Duration,
+		MaintenanceUntil: valaccount.MaintenanceUntil,
+	})
+
+	return &types.MsgEnterMaintenanceResponse{}, nil
+}
@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_enter_maintenance.go
+
+* Enter maintenance in a pool
+* Leave maintenance after the duration has passed
+* Enter maintenance in a pool the staker has not joined
+* Enter maintenance with a duration of zero
+* Enter maintenance while already in maintenance
+* Enter maintenance with a duration above the budget
+* Enter maintenance multiple times until the budget is used up
+* Enter maintenance again after the maintenance period has passed
+* Enter maintenance while maintenance is disabled
+
+*/
+
+var _ = Describe("msg_server_enter_maintenance.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pools
+		for range []int{0, 1} {
+			s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+				Authority:            gov,
+				UploadInterval:       60,
+				MaxBundleSize:        100,
+				InflationShareWeight: math.LegacyZeroDec(),
+				Binaries:             "{}",
+			})
+		}
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Enter maintenance in a pool", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		// ASSERT
+		now := uint64(s.Ctx().BlockTime().Unix())
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(valaccount.MaintenanceUntil).To(Equal(now + 3600))
+		Expect(valaccount.MaintenancePeriodStart).To(Equal(now))
+		Expect(valaccount.MaintenanceUsed).To(Equal(uint64(3600)))
+
+		Expect(s.App().StakersKeeper.IsInMaintenance(s.Ctx(), 0, i.STAKER_0)).To(BeTrue())
+	})
+
+	It("Leave maintenance after the duration has passed", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(3599)
+
+		Expect(s.App().StakersKeeper.IsInMaintenance(s.Ctx(), 0, i.STAKER_0)).To(BeTrue())
+
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.IsInMaintenance(s.Ctx(), 0, i.STAKER_0)).To(BeFalse())
+	})
+
+	It("Enter maintenance in a pool the staker has not joined", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   1,
+			Duration: 3600,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.IsInMaintenance(s.Ctx(), 1, i.STAKER_0)).To(BeFalse())
+	})
+
+	It("Enter maintenance with a duration of zero", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: 0,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.IsInMaintenance(s.Ctx(), 0, i.STAKER_0)).To(BeFalse())
+	})
+
+	It("Enter maintenance while already in maintenance", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.MaintenanceUsed).To(Equal(uint64(3600)))
+	})
+
+	It("Enter maintenance with a duration above the budget", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: s.App().StakersKeeper.GetMaintenanceBudget(s.Ctx()) + 1,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(valaccount.MaintenanceUntil).To(BeZero())
+		Expect(valaccount.MaintenanceUsed).To(BeZero())
+	})
+
+	It("Enter maintenance multiple times until the budget is used up", func() {
+		// ARRANGE
+		budget := s.App().StakersKeeper.GetMaintenanceBudget(s.Ctx())
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: budget / 2,
+		})
+
+		s.CommitAfterSeconds(budget / 2)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: budget / 2,
+		})
+
+		s.CommitAfterSeconds(budget / 2)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: 1,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.MaintenanceUsed).To(Equal(budget))
+
+		Expect(s.App().StakersKeeper.IsInMaintenance(s.Ctx(), 0, i.STAKER_0)).To(BeFalse())
+	})
+
+	It("Enter maintenance again after the maintenance period has passed", func() {
+		// ARRANGE
+		budget := s.App().StakersKeeper.GetMaintenanceBudget(s.Ctx())
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: budget,
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetMaintenancePeriod(s.Ctx()))
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: budget,
+		})
+
+		// ASSERT
+		now := uint64(s.Ctx().BlockTime().Unix())
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(valaccount.MaintenancePeriodStart).To(Equal(now))
+		Expect(valaccount.MaintenanceUsed).To(Equal(budget))
+
+		Expect(s.App().StakersKeeper.IsInMaintenance(s.Ctx(), 0, i.STAKER_0)).To(BeTrue())
+	})
+
+	It("Enter maintenance while maintenance is disabled", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaintenanceBudget = 0
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgEnterMaintenance{
+			Creator:  i.STAKER_0,
+			PoolId:   0,
+			Duration: 3600,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.MaintenanceUntil).To(BeZero())
+		Expect(valaccount.MaintenanceUsed).To(BeZero())
+	})
+})
@@ -37,10 +37,10 @@ func (k msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool) (*typ
 		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrNoStaker.Error())
 	}
 
-	// Stakers are not allowed to use their own address, to prevent
-	// users from putting their staker private key on the protocol node server.
-	if msg.Creator == msg.Valaddress {
-		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrValaddressSameAsStaker.Error())
+	// Stakers are not allowed to use their own address or a valaddress
+	// which is already in use
+	if err := k.ensureValaddressUnused(ctx, msg.PoolId, msg.Creator, msg.Valaddress); err != nil {
+		return nil, err
 	}
 
 	// Stakers are not allowed to join a pool twice.
@@ -53,35 +53,32 @@ func (k msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool) (*typ
 		return nil, errFreeSlot
 	}
 
-	// Every valaddress can only be used for one pool. It is not allowed
-	// to use the same valaddress for multiple pools. (to avoid account sequence errors,
-	// when two processes try so submit transactions simultaneously)
-	for _, valaccount := range k.GetValaccountsFromStaker(ctx, msg.Creator) {
-		if valaccount.Valaddress == msg.Valaddress {
-			return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ValaddressAlreadyUsed.Error())
-		}
-	}
-
-	// It is not allowed to use the valaddress of somebody else.
-	for _, poolStaker := range k.GetAllStakerAddressesOfPool(ctx, msg.PoolId) {
-		valaccount, _ := k.GetValaccount(ctx, msg.PoolId, poolStaker)
-
-		if valaccount.Valaddress == msg.Valaddress {
-			return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ValaddressAlreadyUsed.Error())
-		}
-	}
-
 	k.AddValaccountToPool(ctx, msg.PoolId, msg.Creator, msg.Valaddress)
 
 	if err := util.TransferFromAddressToAddress(k.bankKeeper, ctx, msg.Creator, msg.Valaddress, msg.Amount); err != nil {
 		return nil, err
 	}
 
+	// Optionally pay the fees of the valaddress for bundle messages
+	feeAllowancePeriod := uint64(0)
+	if msg.FeeAllowance > 0 {
+		feeAllowancePeriod = msg.FeeAllowancePeriod
+		if feeAllowancePeriod == 0 {
+			feeAllowancePeriod = types.DefaultFeeAllowancePeriod
+		}
+
+		if err := k.grantFeeAllowance(ctx, msg.Creator, msg.Valaddress, msg.FeeAllowance, feeAllowancePeriod); err != nil {
+			return nil, err
+		}
+	}
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventJoinPool{
-		PoolId:     msg.PoolId,
-		Staker:     msg.Creator,
-		Valaddress: msg.Valaddress,
-		Amount:     msg.Amount,
+		PoolId:             msg.PoolId,
+		Staker:             msg.Creator,
+		Valaddress:         msg.Valaddress,
+		Amount:             msg.Amount,
+		FeeAllowance:       msg.FeeAllowance,
+		FeeAllowancePeriod: feeAllowancePeriod,
 	})
 
 	return &types.MsgJoinPoolResponse{}, nil
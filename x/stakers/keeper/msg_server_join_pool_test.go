@@ -1,12 +1,19 @@
 package keeper_test
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundlestypes "github.com/KYVENetwork/chain/x/bundles/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
 	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
 )
@@ -35,6 +42,14 @@ TEST CASES - msg_server_join_pool.go
 * Fail to kick out lowest staker because not enough stake
 * Kick out lowest staker with respect to stake + delegation
 * Fail to kick out lowest staker because not enough stake + delegation
+* Join a pool with a fee allowance for the valaddress
+* Join a pool with a fee allowance and the default period
+* Join a pool without a fee allowance
+* Fee allowance can only be used for bundle messages
+* Fee allowance is revoked after leaving the pool
+* Revoked fee allowance is removed and granted again after rejoining
+* Join a pool with a fee allowance while the valaddress has a manual fee allowance
+* Manual fee allowance is kept after leaving the pool
 
 */
 
@@ -625,7 +640,7 @@ var _ = Describe("msg_server_join_pool.go", Ordered, func() {
 
 	It("Kick out lowest staker by joining a full pool", func() {
 		// Arrange
-		Expect(stakerstypes.MaxStakers).To(Equal(50))
+		Expect(s.App().PoolKeeper.GetMaxStakersOfPool(s.Ctx(), 0)).To(Equal(uint64(50)))
 
 		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
 			Creator:    i.STAKER_0,
@@ -670,7 +685,7 @@ var _ = Describe("msg_server_join_pool.go", Ordered, func() {
 
 	It("Fail to kick out lowest staker because not enough stake", func() {
 		// Arrange
-		Expect(stakerstypes.MaxStakers).To(Equal(50))
+		Expect(s.App().PoolKeeper.GetMaxStakersOfPool(s.Ctx(), 0)).To(Equal(uint64(50)))
 
 		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
 			Creator:    i.STAKER_0,
@@ -716,7 +731,7 @@ var _ = Describe("msg_server_join_pool.go", Ordered, func() {
 
 	It("Kick out lowest staker with respect to stake + delegation", func() {
 		// ARRANGE
-		Expect(stakerstypes.MaxStakers).To(Equal(50))
+		Expect(s.App().PoolKeeper.GetMaxStakersOfPool(s.Ctx(), 0)).To(Equal(uint64(50)))
 
 		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
 			Creator:    i.STAKER_0,
@@ -768,7 +783,7 @@ var _ = Describe("msg_server_join_pool.go", Ordered, func() {
 
 	It("Fail to kick out lowest staker because not enough stake", func() {
 		// Arrange
-		Expect(stakerstypes.MaxStakers).To(Equal(50))
+		Expect(s.App().PoolKeeper.GetMaxStakersOfPool(s.Ctx(), 0)).To(Equal(uint64(50)))
 
 		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
 			Creator:    i.STAKER_0,
@@ -814,7 +829,7 @@ var _ = Describe("msg_server_join_pool.go", Ordered, func() {
 
 	It("Fail to kick out lowest staker because not enough stake + delegation", func() {
 		// ARRANGE
-		Expect(stakerstypes.MaxStakers).To(Equal(50))
+		Expect(s.App().PoolKeeper.GetMaxStakersOfPool(s.Ctx(), 0)).To(Equal(uint64(50)))
 
 		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
 			Creator:    i.STAKER_0,
@@ -863,4 +878,220 @@ var _ = Describe("msg_server_join_pool.go", Ordered, func() {
 		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ContainElement(i.STAKER_0))
 		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).NotTo(ContainElement(i.STAKER_1))
 	})
+
+	It("Join a pool with a fee allowance for the valaddress", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:            i.STAKER_0,
+			PoolId:             0,
+			Valaddress:         i.VALADDRESS_0_A,
+			FeeAllowance:       1 * i.KYVE,
+			FeeAllowancePeriod: 3600,
+		})
+
+		// ASSERT
+		allowance, found := s.App().StakersKeeper.GetFeeAllowance(s.Ctx(), i.STAKER_0, i.VALADDRESS_0_A)
+
+		Expect(found).To(BeTrue())
+		Expect(allowance.Period).To(Equal(time.Hour))
+		Expect(allowance.PeriodSpendLimit.AmountOf(globaltypes.Denom).Uint64()).To(Equal(1 * i.KYVE))
+		Expect(allowance.PeriodCanSpend.AmountOf(globaltypes.Denom).Uint64()).To(Equal(1 * i.KYVE))
+
+		// fee allowance does not transfer any funds
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(initialBalanceStaker0))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0_A)).To(Equal(initialBalanceValaddress0))
+	})
+
+	It("Join a pool with a fee allowance and the default period", func() {
+		// ARRANGE
+		msg := &stakerstypes.MsgJoinPool{
+			Creator:      i.STAKER_0,
+			PoolId:       0,
+			Valaddress:   i.VALADDRESS_0_A,
+			FeeAllowance: 1 * i.KYVE,
+		}
+
+		// ACT
+		Expect(msg.ValidateBasic()).To(Succeed())
+		s.RunTxStakersSuccess(msg)
+
+		// ASSERT
+		Expect(msg.FeeAllowancePeriod).To(BeZero())
+
+		allowance, found := s.App().StakersKeeper.GetFeeAllowance(s.Ctx(), i.STAKER_0, i.VALADDRESS_0_A)
+
+		Expect(found).To(BeTrue())
+		Expect(allowance.Period).To(Equal(time.Duration(stakerstypes.DefaultFeeAllowancePeriod) * time.Second))
+	})
+
+	It("Join a pool without a fee allowance", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetFeeAllowance(s.Ctx(), i.STAKER_0, i.VALADDRESS_0_A)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Fee allowance can only be used for bundle messages", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:      i.STAKER_0,
+			PoolId:       0,
+			Valaddress:   i.VALADDRESS_0_A,
+			FeeAllowance: 1 * i.KYVE,
+		})
+
+		granter := sdk.MustAccAddressFromBech32(i.STAKER_0)
+		grantee := sdk.MustAccAddressFromBech32(i.VALADDRESS_0_A)
+		fee := sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, 1000))
+
+		// ACT
+		errSend := s.App().FeeGrantKeeper.UseGrantedFees(s.Ctx(), granter, grantee, fee, []sdk.Msg{
+			&banktypes.MsgSend{FromAddress: i.VALADDRESS_0_A, ToAddress: i.ALICE},
+		})
+		errVote := s.App().FeeGrantKeeper.UseGrantedFees(s.Ctx(), granter, grantee, fee, []sdk.Msg{
+			&bundlestypes.MsgVoteBundleProposal{Creator: i.VALADDRESS_0_A, Staker: i.STAKER_0},
+		})
+
+		// ASSERT
+		Expect(errSend).To(HaveOccurred())
+		Expect(errVote).NotTo(HaveOccurred())
+	})
+
+	It("Fee allowance is revoked after leaving the pool", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:      i.STAKER_0,
+			PoolId:       0,
+			Valaddress:   i.VALADDRESS_0_A,
+			FeeAllowance: 1 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetFeeAllowance(s.Ctx(), i.STAKER_0, i.VALADDRESS_0_A)
+		Expect(found).To(BeTrue())
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		_, found = s.App().StakersKeeper.GetFeeAllowance(s.Ctx(), i.STAKER_0, i.VALADDRESS_0_A)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Revoked fee allowance is removed and granted again after rejoining", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:      i.STAKER_0,
+			PoolId:       0,
+			Valaddress:   i.VALADDRESS_0_A,
+			FeeAllowance: 1 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		granter := sdk.MustAccAddressFromBech32(i.STAKER_0)
+		grantee := sdk.MustAccAddressFromBech32(i.VALADDRESS_0_A)
+		fee := sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, 1000))
+		msgs := []sdk.Msg{&bundlestypes.MsgVoteBundleProposal{Creator: i.VALADDRESS_0_A, Staker: i.STAKER_0}}
+
+		// ASSERT
+		revokedAllowance, _ := s.App().FeeGrantKeeper.GetAllowance(s.Ctx(), granter, grantee)
+		Expect(revokedAllowance).To(BeNil())
+
+		// ACT
+		errRevoked := s.App().FeeGrantKeeper.UseGrantedFees(s.Ctx(), granter, grantee, fee, msgs)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:      i.STAKER_0,
+			PoolId:       0,
+			Valaddress:   i.VALADDRESS_0_A,
+			FeeAllowance: 2 * i.KYVE,
+		})
+
+		errRejoined := s.App().FeeGrantKeeper.UseGrantedFees(s.Ctx(), granter, grantee, fee, msgs)
+
+		// ASSERT
+		Expect(errRevoked).To(HaveOccurred())
+		Expect(errRejoined).NotTo(HaveOccurred())
+
+		allowance, found := s.App().StakersKeeper.GetFeeAllowance(s.Ctx(), i.STAKER_0, i.VALADDRESS_0_A)
+		Expect(found).To(BeTrue())
+		Expect(allowance.PeriodSpendLimit.AmountOf(globaltypes.Denom).Uint64()).To(Equal(2 * i.KYVE))
+	})
+
+	It("Join a pool with a fee allowance while the valaddress has a manual fee allowance", func() {
+		// ARRANGE
+		granter := sdk.MustAccAddressFromBech32(i.STAKER_0)
+		grantee := sdk.MustAccAddressFromBech32(i.VALADDRESS_0_A)
+		manualAllowance := &feegrant.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, int64(5*i.KYVE))),
+		}
+
+		Expect(s.App().FeeGrantKeeper.GrantAllowance(s.Ctx(), granter, grantee, manualAllowance)).To(Succeed())
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgJoinPool{
+			Creator:      i.STAKER_0,
+			PoolId:       0,
+			Valaddress:   i.VALADDRESS_0_A,
+			FeeAllowance: 1 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).NotTo(ContainElement(i.STAKER_0))
+
+		allowance, err := s.App().FeeGrantKeeper.GetAllowance(s.Ctx(), granter, grantee)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowance).To(Equal(manualAllowance))
+	})
+
+	It("Manual fee allowance is kept after leaving the pool", func() {
+		// ARRANGE
+		granter := sdk.MustAccAddressFromBech32(i.STAKER_0)
+		grantee := sdk.MustAccAddressFromBech32(i.VALADDRESS_0_A)
+		manualAllowance := &feegrant.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, int64(5*i.KYVE))),
+		}
+
+		Expect(s.App().FeeGrantKeeper.GrantAllowance(s.Ctx(), granter, grantee, manualAllowance)).To(Succeed())
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).NotTo(ContainElement(i.STAKER_0))
+
+		allowance, err := s.App().FeeGrantKeeper.GetAllowance(s.Ctx(), granter, grantee)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowance).To(Equal(manualAllowance))
+	})
 })
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateAutoCompoundCommission enables or disables the auto-compounding of the
+// commission rewards of a staker. If enabled, the $KYVE part of the commission
+// rewards gets delegated to the staker itself on every payout.
+func (k msgServer) UpdateAutoCompoundCommission(goCtx context.Context, msg *types.MsgUpdateAutoCompoundCommission) (*types.MsgUpdateAutoCompoundCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	staker, found := k.GetStaker(ctx, msg.Creator)
+	if !found {
+		return nil, errors.Wrap(errorsTypes.ErrUnauthorized, types.ErrNoStaker.Error())
+	}
+
+	staker.AutoCompoundCommission = msg.AutoCompoundCommission
+	k.setStaker(ctx, staker)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateAutoCompoundCommission{
+		Staker:                 msg.Creator,
+		AutoCompoundCommission: msg.AutoCompoundCommission,
+	})
+
+	return &types.MsgUpdateAutoCompoundCommissionResponse{}, nil
+}
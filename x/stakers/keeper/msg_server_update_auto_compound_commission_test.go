@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_update_auto_compound_commission.go
+
+* Enable auto-compounding of commission rewards
+* Disable auto-compounding of commission rewards
+* Update auto-compounding with non-staker account
+* Commission rewards are not compounded if auto-compounding is disabled
+* Compound the $KYVE part of the commission rewards on payout
+* Compound previously accrued commission rewards on the next payout
+
+*/
+
+var _ = Describe("msg_server_update_auto_compound_commission.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	// payout mints the given coins to the pool module and pays them
+	// out as commission rewards to the first staker
+	payout := func(amount sdk.Coins) {
+		for _, coin := range amount {
+			Expect(s.MintDenomToModule(pooltypes.ModuleName, coin.Amount.Uint64(), coin.Denom)).To(Succeed())
+		}
+
+		Expect(s.App().StakersKeeper.IncreaseStakerCommissionRewards(s.Ctx(), i.STAKER_0, pooltypes.ModuleName, amount)).To(Succeed())
+		Expect(s.App().StakersKeeper.CompoundCommissionRewards(s.Ctx(), i.STAKER_0)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pool
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		})
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Enable auto-compounding of commission rewards", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateAutoCompoundCommission{
+			Creator:                i.STAKER_0,
+			AutoCompoundCommission: true,
+		})
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.AutoCompoundCommission).To(BeTrue())
+	})
+
+	It("Disable auto-compounding of commission rewards", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateAutoCompoundCommission{
+			Creator:                i.STAKER_0,
+			AutoCompoundCommission: true,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateAutoCompoundCommission{
+			Creator:                i.STAKER_0,
+			AutoCompoundCommission: false,
+		})
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.AutoCompoundCommission).To(BeFalse())
+	})
+
+	It("Update auto-compounding with non-staker account", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateAutoCompoundCommission{
+			Creator:                i.STAKER_1,
+			AutoCompoundCommission: true,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Commission rewards are not compounded if auto-compounding is disabled", func() {
+		// ACT
+		payout(sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, int64(10*i.KYVE)), i.ACoin(100)))
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.CommissionRewards.String()).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, int64(10*i.KYVE)), i.ACoin(100)).String()))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(100 * i.KYVE))
+	})
+
+	It("Compound the $KYVE part of the commission rewards on payout", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateAutoCompoundCommission{
+			Creator:                i.STAKER_0,
+			AutoCompoundCommission: true,
+		})
+
+		initialBalanceStaker0 := s.GetBalanceFromAddress(i.STAKER_0)
+
+		// ACT
+		payout(sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, int64(10*i.KYVE)), i.ACoin(100)))
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.CommissionRewards.String()).To(Equal(i.ACoins(100).String()))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(110 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(Equal(110 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationOfPool(s.Ctx(), 0)).To(Equal(110 * i.KYVE))
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(initialBalanceStaker0))
+	})
+
+	It("Compound previously accrued commission rewards on the next payout", func() {
+		// ARRANGE
+		payout(sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, int64(10*i.KYVE))))
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateAutoCompoundCommission{
+			Creator:                i.STAKER_0,
+			AutoCompoundCommission: true,
+		})
+
+		// ACT
+		payout(sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, int64(5*i.KYVE))))
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.CommissionRewards.IsZero()).To(BeTrue())
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(115 * i.KYVE))
+	})
+})
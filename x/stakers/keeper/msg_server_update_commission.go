@@ -22,7 +22,9 @@ func (k msgServer) UpdateCommission(goCtx context.Context, msg *types.MsgUpdateC
 	}
 
 	// Insert commission change into queue
-	k.orderNewCommissionChange(ctx, msg.Creator, msg.Commission)
+	if err := k.orderNewCommissionChange(ctx, msg.Creator, msg.Commission); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateCommissionResponse{}, nil
 }
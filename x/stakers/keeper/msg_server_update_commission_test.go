@@ -23,6 +23,10 @@ TEST CASES - msg_server_update_commission.go
 * Update commission multiple times during the commission change time
 * Update commission multiple times during the commission change time with the same value
 * Update commission with multiple stakers
+* Try to update commission above the max commission
+* Try to update commission by more than the max commission change
+* Update commission within the max commission change
+* Try to exceed the max commission change by ordering a second change during the commission change time
 
 */
 
@@ -235,4 +239,125 @@ var _ = Describe("msg_server_update_commission.go", Ordered, func() {
 		staker1, _ = s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
 		Expect(staker1.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.5")))
 	})
+
+	It("Try to update commission above the max commission", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator:       i.STAKER_1,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			MaxCommission: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: math.LegacyMustNewDecFromStr("0.21"),
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), i.STAKER_1)
+		Expect(found).To(BeFalse())
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetCommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+		Expect(staker.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.1")))
+	})
+
+	It("Try to update commission by more than the max commission change", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator:             i.STAKER_1,
+			Amount:              100 * i.KYVE,
+			Commission:          math.LegacyMustNewDecFromStr("0.1"),
+			MaxCommissionChange: math.LegacyMustNewDecFromStr("0.05"),
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: math.LegacyMustNewDecFromStr("0.04"),
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), i.STAKER_1)
+		Expect(found).To(BeFalse())
+
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+		Expect(staker.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.1")))
+	})
+
+	It("Update commission within the max commission change", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator:             i.STAKER_1,
+			Amount:              100 * i.KYVE,
+			Commission:          math.LegacyMustNewDecFromStr("0.1"),
+			MaxCommission:       math.LegacyMustNewDecFromStr("0.2"),
+			MaxCommissionChange: math.LegacyMustNewDecFromStr("0.05"),
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: math.LegacyMustNewDecFromStr("0.15"),
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetCommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+		Expect(staker.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.15")))
+
+		// the next change is again limited by the max commission change
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: math.LegacyMustNewDecFromStr("0.21"),
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: math.LegacyMustNewDecFromStr("0.2"),
+		})
+	})
+
+	It("Try to exceed the max commission change by ordering a second change during the commission change time", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator:             i.STAKER_1,
+			Amount:              100 * i.KYVE,
+			Commission:          math.LegacyMustNewDecFromStr("0.1"),
+			MaxCommissionChange: math.LegacyMustNewDecFromStr("0.05"),
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: math.LegacyMustNewDecFromStr("0.15"),
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetCommissionChangeTime(s.Ctx()) / 2)
+
+		// ACT
+		// the second change is checked against the current commission and
+		// not against the pending one
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetCommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+		Expect(staker.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.15")))
+	})
 })
@@ -64,6 +64,8 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 
 		Expect(params.CommissionChangeTime).To(Equal(types.DefaultCommissionChangeTime))
 		Expect(params.LeavePoolTime).To(Equal(types.DefaultLeavePoolTime))
+		Expect(params.MaintenancePeriod).To(Equal(types.DefaultMaintenancePeriod))
+		Expect(params.MaintenanceBudget).To(Equal(types.DefaultMaintenanceBudget))
 	})
 
 	It("Invalid authority (transaction)", func() {
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/util"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateSecondaryValaddress handles the SDK message of setting or removing
+// the secondary valaddress of a staker in a pool. The secondary valaddress
+// is authorized just like the valaddress, which allows running a hot-standby
+// protocol node. Only one of both valaddresses can vote per bundle proposal.
+// The new secondary valaddress has to fulfill the same requirements as the
+// valaddress in JoinPool.
+func (k msgServer) UpdateSecondaryValaddress(goCtx context.Context, msg *types.MsgUpdateSecondaryValaddress) (*types.MsgUpdateSecondaryValaddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// throw error if staker is not in the pool
+	valaccount, valaccountFound := k.GetValaccount(ctx, msg.PoolId, msg.Creator)
+	if !valaccountFound {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrAlreadyLeftPool.Error())
+	}
+
+	// an empty secondary valaddress removes the current one
+	if msg.SecondaryValaddress != "" {
+		if err := k.ensureValaddressUnused(ctx, msg.PoolId, msg.Creator, msg.SecondaryValaddress); err != nil {
+			return nil, err
+		}
+	}
+
+	oldSecondaryValaddress := valaccount.SecondaryValaddress
+
+	valaccount.SecondaryValaddress = msg.SecondaryValaddress
+	k.SetValaccount(ctx, valaccount)
+
+	if msg.SecondaryValaddress != "" {
+		if err := util.TransferFromAddressToAddress(k.bankKeeper, ctx, msg.Creator, msg.SecondaryValaddress, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateSecondaryValaddress{
+		PoolId:                 msg.PoolId,
+		Staker:                 msg.Creator,
+		OldSecondaryValaddress: oldSecondaryValaddress,
+		NewSecondaryValaddress: msg.SecondaryValaddress,
+		Amount:                 msg.Amount,
+	})
+
+	return &types.MsgUpdateSecondaryValaddressResponse{}, nil
+}
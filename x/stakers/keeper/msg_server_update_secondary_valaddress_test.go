@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_update_secondary_valaddress.go
+
+* Set the secondary valaddress of a pool
+* Set the secondary valaddress of a pool and fund it
+* Remove the secondary valaddress of a pool
+* Set the secondary valaddress of a pool the staker has not joined
+* Set the secondary valaddress to the valaddress
+* Set the secondary valaddress to a valaddress used by another staker
+* Try to join another pool with the secondary valaddress
+* Try to update the valaddress to the secondary valaddress
+
+*/
+
+var _ = Describe("msg_server_update_secondary_valaddress.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pools
+		for range []int{0, 1} {
+			s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+				Authority:            gov,
+				UploadInterval:       60,
+				MaxBundleSize:        100,
+				InflationShareWeight: math.LegacyZeroDec(),
+				Binaries:             "{}",
+			})
+		}
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Set the secondary valaddress of a pool", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_0,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_0_B,
+		})
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_A))
+		Expect(valaccount.SecondaryValaddress).To(Equal(i.VALADDRESS_0_B))
+
+		Expect(s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0_A)).To(Succeed())
+		Expect(s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0_B)).To(Succeed())
+
+		Expect(s.App().StakersKeeper.IsSecondaryValaddress(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0_A)).To(BeFalse())
+		Expect(s.App().StakersKeeper.IsSecondaryValaddress(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0_B)).To(BeTrue())
+	})
+
+	It("Set the secondary valaddress of a pool and fund it", func() {
+		// ARRANGE
+		initialBalanceStaker0 := s.GetBalanceFromAddress(i.STAKER_0)
+		initialBalanceValaddress0 := s.GetBalanceFromAddress(i.VALADDRESS_0_B)
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_0,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_0_B,
+			Amount:              10 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(initialBalanceStaker0 - s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(10 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0_B) - initialBalanceValaddress0).To(Equal(10 * i.KYVE))
+	})
+
+	It("Remove the secondary valaddress of a pool", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_0,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_0_B,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateSecondaryValaddress{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.SecondaryValaddress).To(BeEmpty())
+
+		Expect(s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0_B)).To(HaveOccurred())
+	})
+
+	It("Set the secondary valaddress of a pool the staker has not joined", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_0,
+			PoolId:              1,
+			SecondaryValaddress: i.VALADDRESS_0_B,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Set the secondary valaddress to the valaddress", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_0,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_0_A,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.SecondaryValaddress).To(BeEmpty())
+	})
+
+	It("Set the secondary valaddress to a valaddress used by another staker", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1_A,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_0,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_1_A,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.SecondaryValaddress).To(BeEmpty())
+	})
+
+	It("Try to join another pool with the secondary valaddress", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_0,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_0_B,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     1,
+			Valaddress: i.VALADDRESS_0_B,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to update the valaddress to the secondary valaddress", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateSecondaryValaddress{
+			Creator:             i.STAKER_0,
+			PoolId:              0,
+			SecondaryValaddress: i.VALADDRESS_0_B,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			NewValaddress: i.VALADDRESS_0_B,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_A))
+		Expect(valaccount.SecondaryValaddress).To(Equal(i.VALADDRESS_0_B))
+	})
+})
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/util"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateValaddress handles the SDK message of replacing the valaddress
+// of a staker in a pool. The staker keeps its slot and points in the pool,
+// only the protocol node which is allowed to vote in favor of the staker
+// changes. This allows rotating a compromised protocol node without
+// leaving the pool. The new valaddress has to fulfill the same requirements
+// as in JoinPool.
+func (k msgServer) UpdateValaddress(goCtx context.Context, msg *types.MsgUpdateValaddress) (*types.MsgUpdateValaddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// throw error if staker is not in the pool
+	valaccount, valaccountFound := k.GetValaccount(ctx, msg.PoolId, msg.Creator)
+	if !valaccountFound {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrAlreadyLeftPool.Error())
+	}
+
+	// Stakers are not allowed to use their own address or a valaddress
+	// which is already in use
+	if err := k.ensureValaddressUnused(ctx, msg.PoolId, msg.Creator, msg.NewValaddress); err != nil {
+		return nil, err
+	}
+
+	oldValaddress := valaccount.Valaddress
+
+	valaccount.Valaddress = msg.NewValaddress
+	k.SetValaccount(ctx, valaccount)
+
+	if err := util.TransferFromAddressToAddress(k.bankKeeper, ctx, msg.Creator, msg.NewValaddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// The new valaddress takes over the fee allowance of the old valaddress
+	if err := k.moveFeeAllowance(ctx, msg.Creator, oldValaddress, msg.NewValaddress); err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateValaddress{
+		PoolId:        msg.PoolId,
+		Staker:        msg.Creator,
+		OldValaddress: oldValaddress,
+		NewValaddress: msg.NewValaddress,
+		Amount:        msg.Amount,
+	})
+
+	return &types.MsgUpdateValaddressResponse{}, nil
+}
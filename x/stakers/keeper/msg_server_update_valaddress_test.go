@@ -0,0 +1,275 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_update_valaddress.go
+
+* Update the valaddress of a pool
+* Update the valaddress of a pool and fund the new valaddress
+* Update the valaddress of a pool the staker has not joined
+* Update the valaddress to the staker address
+* Update the valaddress to the current valaddress
+* Update the valaddress to a valaddress used in another pool
+* Update the valaddress to a valaddress used by another staker
+* Update the valaddress and fund with more KYVE than available in balance
+* Update the valaddress and move the fee allowance
+* Update the valaddress and keep a manual fee allowance
+
+*/
+
+var _ = Describe("msg_server_update_valaddress.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pools
+		for range []int{0, 1} {
+			s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+				Authority:            gov,
+				UploadInterval:       60,
+				MaxBundleSize:        100,
+				InflationShareWeight: math.LegacyZeroDec(),
+				Binaries:             "{}",
+			})
+		}
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0_A,
+		})
+
+		s.App().StakersKeeper.IncrementPoints(s.Ctx(), 0, i.STAKER_0)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Update the valaddress of a pool", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			NewValaddress: i.VALADDRESS_0_B,
+		})
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_B))
+		Expect(valaccount.Points).To(Equal(uint64(1)))
+		Expect(valaccount.IsLeaving).To(BeFalse())
+
+		Expect(s.App().StakersKeeper.GetValaccountsFromStaker(s.Ctx(), i.STAKER_0)).To(HaveLen(1))
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ConsistOf(i.STAKER_0))
+
+		Expect(s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0_A)).To(HaveOccurred())
+		Expect(s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0_B)).To(Succeed())
+	})
+
+	It("Update the valaddress of a pool and fund the new valaddress", func() {
+		// ARRANGE
+		initialBalanceStaker0 := s.GetBalanceFromAddress(i.STAKER_0)
+		initialBalanceValaddress0 := s.GetBalanceFromAddress(i.VALADDRESS_0_B)
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			NewValaddress: i.VALADDRESS_0_B,
+			Amount:        10 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(initialBalanceStaker0 - s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(10 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0_B) - initialBalanceValaddress0).To(Equal(10 * i.KYVE))
+
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_B))
+	})
+
+	It("Update the valaddress of a pool the staker has not joined", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        1,
+			NewValaddress: i.VALADDRESS_0_B,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Update the valaddress to the staker address", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			NewValaddress: i.STAKER_0,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_A))
+	})
+
+	It("Update the valaddress to the current valaddress", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			NewValaddress: i.VALADDRESS_0_A,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_A))
+	})
+
+	It("Update the valaddress to a valaddress used in another pool", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     1,
+			Valaddress: i.VALADDRESS_0_B,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			NewValaddress: i.VALADDRESS_0_B,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_A))
+	})
+
+	It("Update the valaddress to a valaddress used by another staker", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1_A,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			NewValaddress: i.VALADDRESS_1_A,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_A))
+	})
+
+	It("Update the valaddress and fund with more KYVE than available in balance", func() {
+		// ARRANGE
+		balance := s.GetBalanceFromAddress(i.STAKER_0)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			NewValaddress: i.VALADDRESS_0_B,
+			Amount:        balance + 1,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(balance))
+
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0_A))
+	})
+
+	It("Update the valaddress and move the fee allowance", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:      i.STAKER_0,
+			PoolId:       1,
+			Valaddress:   i.VALADDRESS_0_B,
+			FeeAllowance: 1 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        1,
+			NewValaddress: i.VALADDRESS_0_C,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetFeeAllowance(s.Ctx(), i.STAKER_0, i.VALADDRESS_0_B)
+		Expect(found).To(BeFalse())
+
+		allowance, found := s.App().StakersKeeper.GetFeeAllowance(s.Ctx(), i.STAKER_0, i.VALADDRESS_0_C)
+		Expect(found).To(BeTrue())
+		Expect(allowance.PeriodSpendLimit.AmountOf(globaltypes.Denom).Uint64()).To(Equal(1 * i.KYVE))
+	})
+
+	It("Update the valaddress and keep a manual fee allowance", func() {
+		// ARRANGE
+		granter := sdk.MustAccAddressFromBech32(i.STAKER_0)
+		grantee := sdk.MustAccAddressFromBech32(i.VALADDRESS_0_B)
+		manualAllowance := &feegrant.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, int64(5*i.KYVE))),
+		}
+
+		Expect(s.App().FeeGrantKeeper.GrantAllowance(s.Ctx(), granter, grantee, manualAllowance)).To(Succeed())
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     1,
+			Valaddress: i.VALADDRESS_0_B,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        1,
+			NewValaddress: i.VALADDRESS_0_C,
+		})
+
+		// ASSERT
+		allowance, err := s.App().FeeGrantKeeper.GetAllowance(s.Ctx(), granter, grantee)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowance).To(Equal(manualAllowance))
+
+		allowance, _ = s.App().FeeGrantKeeper.GetAllowance(s.Ctx(), granter, sdk.MustAccAddressFromBech32(i.VALADDRESS_0_C))
+		Expect(allowance).To(BeNil())
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/KYVENetwork/chain/util"
 	delegationKeeper "github.com/KYVENetwork/chain/x/delegation/keeper"
 	poolKeeper "github.com/KYVENetwork/chain/x/pool/keeper"
@@ -196,6 +197,7 @@ type ModuleInputs struct {
 	DistributionKeeper distributionKeeper.Keeper
 	UpgradeKeeper      util.UpgradeKeeper
 	PoolKeeper         *poolKeeper.Keeper
+	FeeGrantKeeper     feegrantkeeper.Keeper
 }
 
 type ModuleOutputs struct {
@@ -222,6 +224,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.DistributionKeeper,
 		in.PoolKeeper,
 		in.UpgradeKeeper,
+		in.FeeGrantKeeper,
+		feegrantkeeper.NewMsgServerImpl(in.FeeGrantKeeper),
 	)
 	m := NewAppModule(
 		in.Cdc,
@@ -10,20 +10,32 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateStaker{}, "kyve/stakers/MsgCreateStaker", nil)
 	cdc.RegisterConcrete(&MsgUpdateCommission{}, "kyve/stakers/MsgUpdateCommission", nil)
+	cdc.RegisterConcrete(&MsgCancelCommissionChange{}, "kyve/stakers/MsgCancelCommissionChange", nil)
 	cdc.RegisterConcrete(&MsgClaimCommissionRewards{}, "kyve/stakers/MsgClaimCommissionRewards", nil)
 	cdc.RegisterConcrete(&MsgUpdateMetadata{}, "kyve/stakers/MsgUpdateMetadata", nil)
 	cdc.RegisterConcrete(&MsgJoinPool{}, "kyve/stakers/MsgJoinPool", nil)
 	cdc.RegisterConcrete(&MsgLeavePool{}, "kyve/stakers/MsgLeavePool", nil)
+	cdc.RegisterConcrete(&MsgCancelLeavePool{}, "kyve/stakers/MsgCancelLeavePool", nil)
+	cdc.RegisterConcrete(&MsgUpdateValaddress{}, "kyve/stakers/MsgUpdateValaddress", nil)
+	cdc.RegisterConcrete(&MsgUpdateSecondaryValaddress{}, "kyve/stakers/MsgUpdateSecondaryValaddress", nil)
+	cdc.RegisterConcrete(&MsgEnterMaintenance{}, "kyve/stakers/MsgEnterMaintenance", nil)
+	cdc.RegisterConcrete(&MsgUpdateAutoCompoundCommission{}, "kyve/stakers/MsgUpdateAutoCompoundCommission", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "kyve/stakers/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCreateStaker{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateCommission{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelCommissionChange{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimCommissionRewards{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateMetadata{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgJoinPool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgLeavePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelLeavePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateValaddress{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateSecondaryValaddress{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgEnterMaintenance{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateAutoCompoundCommission{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
 }
 
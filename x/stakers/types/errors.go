@@ -19,6 +19,14 @@ var (
 	ErrInvalidIdentityString   = errors.Register(ModuleName, 1113, "invalid identity: %s")
 	ErrNotEnoughRewards        = errors.Register(ModuleName, 1114, "claim amount is larger than current rewards")
 
-	ErrPoolLeaveAlreadyInProgress = errors.Register(ModuleName, 1117, "Pool leave is already in progress")
-	ErrValaccountUnauthorized     = errors.Register(ModuleName, 1118, "valaccount unauthorized")
+	ErrPoolLeaveAlreadyInProgress   = errors.Register(ModuleName, 1117, "Pool leave is already in progress")
+	ErrValaccountUnauthorized       = errors.Register(ModuleName, 1118, "valaccount unauthorized")
+	ErrCommissionTooHigh            = errors.Register(ModuleName, 1119, "commission %v exceeds the max commission of %v")
+	ErrCommissionChangeTooHigh      = errors.Register(ModuleName, 1120, "commission change of %v exceeds the max commission change of %v")
+	ErrNoPoolLeaveInProgress        = errors.Register(ModuleName, 1121, "no pool leave in progress")
+	ErrNoCommissionChangeInProgress = errors.Register(ModuleName, 1122, "no commission change in progress")
+	ErrAlreadyInMaintenance         = errors.Register(ModuleName, 1123, "staker is already in maintenance until %v")
+	ErrMaintenanceBudgetExceeded    = errors.Register(ModuleName, 1124, "maintenance duration of %vs exceeds the remaining budget of %vs")
+	ErrMaintenanceDisabled          = errors.Register(ModuleName, 1125, "maintenance is disabled")
+	ErrFeeAllowanceNotManaged       = errors.Register(ModuleName, 1126, "valaddress %v already has a fee allowance which was not granted when joining a pool")
 )
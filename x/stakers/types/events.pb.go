@@ -98,6 +98,11 @@ type EventCreateStaker struct {
 	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// commission
 	Commission cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission"`
+	// max_commission is the highest commission the staker can ever set
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change is the maximum amount the commission
+	// can be changed by a single commission change
+	MaxCommissionChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_commission_change,json=maxCommissionChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change"`
 }
 
 func (m *EventCreateStaker) Reset()         { *m = EventCreateStaker{} }
@@ -288,6 +293,56 @@ func (m *EventUpdateCommission) GetStaker() string {
 	return ""
 }
 
+// EventCancelCommissionChange is an event emitted when a staker
+// cancels a pending commission change.
+// emitted_by: MsgCancelCommissionChange
+type EventCancelCommissionChange struct {
+	// staker is the account address of the protocol node.
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// commission is the commission of the cancelled commission change
+	Commission cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission"`
+}
+
+func (m *EventCancelCommissionChange) Reset()         { *m = EventCancelCommissionChange{} }
+func (m *EventCancelCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*EventCancelCommissionChange) ProtoMessage()    {}
+func (*EventCancelCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{4}
+}
+func (m *EventCancelCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelCommissionChange.Merge(m, src)
+}
+func (m *EventCancelCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelCommissionChange proto.InternalMessageInfo
+
+func (m *EventCancelCommissionChange) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
 // EventClaimCommissionRewards ...
 // emitted_by: MsgClaimCommissionRewards
 type EventClaimCommissionRewards struct {
@@ -301,7 +356,7 @@ func (m *EventClaimCommissionRewards) Reset()         { *m = EventClaimCommissio
 func (m *EventClaimCommissionRewards) String() string { return proto.CompactTextString(m) }
 func (*EventClaimCommissionRewards) ProtoMessage()    {}
 func (*EventClaimCommissionRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a1b3dc9634155a0, []int{4}
+	return fileDescriptor_7a1b3dc9634155a0, []int{5}
 }
 func (m *EventClaimCommissionRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,13 +411,19 @@ type EventJoinPool struct {
 	Valaddress string `protobuf:"bytes,3,opt,name=valaddress,proto3" json:"valaddress,omitempty"`
 	// amount is the amount of funds transferred to the valaddress
 	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// fee_allowance is the amount the valaddress can spend per
+	// period on fees through a fee grant of the staker
+	FeeAllowance uint64 `protobuf:"varint,5,opt,name=fee_allowance,json=feeAllowance,proto3" json:"fee_allowance,omitempty"`
+	// fee_allowance_period is the duration of one fee allowance
+	// period in seconds
+	FeeAllowancePeriod uint64 `protobuf:"varint,6,opt,name=fee_allowance_period,json=feeAllowancePeriod,proto3" json:"fee_allowance_period,omitempty"`
 }
 
 func (m *EventJoinPool) Reset()         { *m = EventJoinPool{} }
 func (m *EventJoinPool) String() string { return proto.CompactTextString(m) }
 func (*EventJoinPool) ProtoMessage()    {}
 func (*EventJoinPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a1b3dc9634155a0, []int{5}
+	return fileDescriptor_7a1b3dc9634155a0, []int{6}
 }
 func (m *EventJoinPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,27 +480,49 @@ func (m *EventJoinPool) GetAmount() uint64 {
 	return 0
 }
 
-// EventLeavePool ...
-// emitted_by: EndBlock
-type EventLeavePool struct {
-	// pool_id ...
+func (m *EventJoinPool) GetFeeAllowance() uint64 {
+	if m != nil {
+		return m.FeeAllowance
+	}
+	return 0
+}
+
+func (m *EventJoinPool) GetFeeAllowancePeriod() uint64 {
+	if m != nil {
+		return m.FeeAllowancePeriod
+	}
+	return 0
+}
+
+// EventUpdateValaddress is an event emitted when a staker replaces
+// the valaddress of a pool.
+// emitted_by: MsgUpdateValaddress
+type EventUpdateValaddress struct {
+	// pool_id is the pool the valaddress was replaced in
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// staker ...
+	// staker is the address of the staker
 	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// old_valaddress is the address of the replaced protocol node
+	OldValaddress string `protobuf:"bytes,3,opt,name=old_valaddress,json=oldValaddress,proto3" json:"old_valaddress,omitempty"`
+	// new_valaddress is the address of the new protocol node which
+	// votes in favor of the staker
+	NewValaddress string `protobuf:"bytes,4,opt,name=new_valaddress,json=newValaddress,proto3" json:"new_valaddress,omitempty"`
+	// amount is the amount of funds transferred to the new valaddress
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *EventLeavePool) Reset()         { *m = EventLeavePool{} }
-func (m *EventLeavePool) String() string { return proto.CompactTextString(m) }
-func (*EventLeavePool) ProtoMessage()    {}
-func (*EventLeavePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a1b3dc9634155a0, []int{6}
+func (m *EventUpdateValaddress) Reset()         { *m = EventUpdateValaddress{} }
+func (m *EventUpdateValaddress) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateValaddress) ProtoMessage()    {}
+func (*EventUpdateValaddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{7}
 }
-func (m *EventLeavePool) XXX_Unmarshal(b []byte) error {
+func (m *EventUpdateValaddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventLeavePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventUpdateValaddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventLeavePool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventUpdateValaddress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -449,243 +532,517 @@ func (m *EventLeavePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventLeavePool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventLeavePool.Merge(m, src)
+func (m *EventUpdateValaddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateValaddress.Merge(m, src)
 }
-func (m *EventLeavePool) XXX_Size() int {
+func (m *EventUpdateValaddress) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventLeavePool) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventLeavePool.DiscardUnknown(m)
+func (m *EventUpdateValaddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateValaddress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventLeavePool proto.InternalMessageInfo
+var xxx_messageInfo_EventUpdateValaddress proto.InternalMessageInfo
 
-func (m *EventLeavePool) GetPoolId() uint64 {
+func (m *EventUpdateValaddress) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *EventLeavePool) GetStaker() string {
+func (m *EventUpdateValaddress) GetStaker() string {
 	if m != nil {
 		return m.Staker
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventUpdateParams)(nil), "kyve.stakers.v1beta1.EventUpdateParams")
-	proto.RegisterType((*EventCreateStaker)(nil), "kyve.stakers.v1beta1.EventCreateStaker")
-	proto.RegisterType((*EventUpdateMetadata)(nil), "kyve.stakers.v1beta1.EventUpdateMetadata")
-	proto.RegisterType((*EventUpdateCommission)(nil), "kyve.stakers.v1beta1.EventUpdateCommission")
-	proto.RegisterType((*EventClaimCommissionRewards)(nil), "kyve.stakers.v1beta1.EventClaimCommissionRewards")
-	proto.RegisterType((*EventJoinPool)(nil), "kyve.stakers.v1beta1.EventJoinPool")
-	proto.RegisterType((*EventLeavePool)(nil), "kyve.stakers.v1beta1.EventLeavePool")
+func (m *EventUpdateValaddress) GetOldValaddress() string {
+	if m != nil {
+		return m.OldValaddress
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("kyve/stakers/v1beta1/events.proto", fileDescriptor_7a1b3dc9634155a0) }
-
-var fileDescriptor_7a1b3dc9634155a0 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xe3, 0xfc, 0xf2, 0x4b, 0xc8, 0x22, 0xfe, 0x99, 0x02, 0x56, 0x8a, 0xdc, 0x62, 0x2e,
-	0x45, 0x42, 0xb6, 0x0a, 0x4f, 0xd0, 0x84, 0x22, 0x01, 0x05, 0x2a, 0x23, 0x90, 0xe0, 0x12, 0x4d,
-	0xbc, 0xa3, 0x64, 0x15, 0xdb, 0x63, 0x79, 0x37, 0x49, 0xfd, 0x06, 0x1c, 0x79, 0x17, 0xde, 0x80,
-	0x53, 0x8f, 0x3d, 0x22, 0x0e, 0x15, 0x4a, 0x5e, 0x04, 0xd9, 0x5e, 0xa7, 0xae, 0xd4, 0x22, 0xd4,
-	0x9b, 0xbf, 0x33, 0xe3, 0xcf, 0x77, 0x66, 0x76, 0x97, 0x3d, 0x9a, 0x66, 0x73, 0xf4, 0xa4, 0x82,
-	0x29, 0xa6, 0xd2, 0x9b, 0xef, 0x8e, 0x50, 0xc1, 0xae, 0x87, 0x73, 0x8c, 0x95, 0x74, 0x93, 0x94,
-	0x14, 0x99, 0x1b, 0x79, 0x89, 0xab, 0x4b, 0x5c, 0x5d, 0xd2, 0xdb, 0x18, 0xd3, 0x98, 0x8a, 0x02,
-	0x2f, 0xff, 0x2a, 0x6b, 0x7b, 0x17, 0xe3, 0x12, 0x48, 0x21, 0xd2, 0x38, 0xe7, 0xbb, 0xc1, 0xee,
-	0xec, 0xe7, 0xfc, 0x8f, 0x09, 0x07, 0x85, 0x87, 0x45, 0xce, 0xdc, 0x63, 0x8c, 0x42, 0x3e, 0x2c,
-	0x2b, 0x2d, 0x63, 0xdb, 0xd8, 0xb9, 0xfe, 0xec, 0xa1, 0x7b, 0x91, 0xb3, 0x5b, 0xfe, 0xd1, 0x6f,
-	0x1d, 0x9f, 0x6e, 0x35, 0xfc, 0x2e, 0x85, 0xfc, 0x0c, 0x11, 0xe3, 0xa2, 0x42, 0x34, 0xff, 0x1d,
-	0x11, 0xe3, 0x42, 0x23, 0x2c, 0xd6, 0x49, 0x20, 0x0b, 0x09, 0xb8, 0xf5, 0xdf, 0xb6, 0xb1, 0xd3,
-	0xf5, 0x2b, 0xe9, 0x7c, 0xad, 0xba, 0x1e, 0xa4, 0x08, 0x0a, 0x3f, 0x14, 0x40, 0xf3, 0x3e, 0x6b,
-	0x97, 0xe8, 0xa2, 0xe3, 0xae, 0xaf, 0x55, 0x1e, 0x87, 0x88, 0x66, 0xb1, 0x2a, 0xda, 0x68, 0xf9,
-	0x5a, 0x99, 0x03, 0xc6, 0x02, 0x8a, 0x22, 0x21, 0xa5, 0xa0, 0xb8, 0xb4, 0xe8, 0x3f, 0xce, 0x9b,
-	0xf8, 0x75, 0xba, 0xb5, 0x19, 0x90, 0x8c, 0x48, 0x4a, 0x3e, 0x75, 0x05, 0x79, 0x11, 0xa8, 0x89,
-	0x7b, 0x80, 0x63, 0x08, 0xb2, 0x17, 0x18, 0xf8, 0xb5, 0xdf, 0x9c, 0x1f, 0x06, 0xbb, 0x5b, 0x5b,
-	0xe0, 0x5b, 0x54, 0xc0, 0x41, 0xc1, 0xa5, 0xcd, 0x58, 0xac, 0x13, 0x51, 0x2c, 0xf2, 0x44, 0xb3,
-	0x1c, 0x4a, 0xcb, 0x3c, 0xb3, 0xc0, 0x91, 0x14, 0x0a, 0xab, 0x71, 0xb5, 0x34, 0x7b, 0xec, 0x9a,
-	0xe0, 0x18, 0x2b, 0xa1, 0x32, 0xab, 0x55, 0xa4, 0xd6, 0xda, 0x7c, 0xc2, 0x6e, 0x4b, 0x0c, 0x66,
-	0xa9, 0x50, 0xd9, 0x30, 0xa0, 0x58, 0x41, 0xa0, 0xac, 0xff, 0x8b, 0x9a, 0x5b, 0x55, 0x7c, 0x50,
-	0x86, 0x73, 0x03, 0x8e, 0x0a, 0x44, 0x28, 0xad, 0x76, 0x69, 0xa0, 0xa5, 0xa3, 0xd8, 0xbd, 0xda,
-	0x0c, 0x83, 0xf5, 0x74, 0x97, 0x4e, 0x71, 0x7e, 0x75, 0xcd, 0xab, 0xad, 0xee, 0x3d, 0xdb, 0x2c,
-	0x0f, 0x31, 0x04, 0x11, 0x9d, 0x99, 0xfa, 0xb8, 0x80, 0x94, 0xcb, 0xbf, 0x6d, 0xb0, 0x3c, 0x40,
-	0x59, 0x6d, 0x50, 0x4b, 0xe7, 0x88, 0xdd, 0x28, 0x80, 0xaf, 0x49, 0xc4, 0x87, 0x44, 0xa1, 0xf9,
-	0x80, 0x75, 0x12, 0xa2, 0x70, 0x28, 0x78, 0xc1, 0x68, 0xf9, 0xed, 0x5c, 0xbe, 0xe2, 0x35, 0x76,
-	0xf3, 0x1c, 0xdb, 0x66, 0x6c, 0x0e, 0x21, 0x70, 0x9e, 0xa2, 0x94, 0xfa, 0x18, 0x6a, 0x91, 0xda,
-	0x55, 0x6a, 0xd5, 0xaf, 0x92, 0xb3, 0xc7, 0x6e, 0x16, 0xce, 0x07, 0x08, 0x73, 0xbc, 0x92, 0x75,
-	0xff, 0xe5, 0xf1, 0xd2, 0x36, 0x4e, 0x96, 0xb6, 0xf1, 0x7b, 0x69, 0x1b, 0xdf, 0x56, 0x76, 0xe3,
-	0x64, 0x65, 0x37, 0x7e, 0xae, 0xec, 0xc6, 0x97, 0xa7, 0x63, 0xa1, 0x26, 0xb3, 0x91, 0x1b, 0x50,
-	0xe4, 0xbd, 0xf9, 0xfc, 0x69, 0xff, 0x1d, 0xaa, 0x05, 0xa5, 0x53, 0x2f, 0x98, 0x80, 0x88, 0xbd,
-	0xa3, 0xf5, 0x03, 0x57, 0x59, 0x82, 0x72, 0xd4, 0x2e, 0x1e, 0xf6, 0xf3, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0x57, 0xf6, 0xb9, 0x4c, 0x04, 0x00, 0x00,
+func (m *EventUpdateValaddress) GetNewValaddress() string {
+	if m != nil {
+		return m.NewValaddress
+	}
+	return ""
 }
 
-func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUpdateValaddress) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventUpdateSecondaryValaddress is an event emitted when a staker sets
+// or removes the secondary valaddress of a pool.
+// emitted_by: MsgUpdateSecondaryValaddress
+type EventUpdateSecondaryValaddress struct {
+	// pool_id is the pool the secondary valaddress was updated in
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the address of the staker
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// old_secondary_valaddress is the previous secondary valaddress
+	OldSecondaryValaddress string `protobuf:"bytes,3,opt,name=old_secondary_valaddress,json=oldSecondaryValaddress,proto3" json:"old_secondary_valaddress,omitempty"`
+	// new_secondary_valaddress is the new secondary valaddress, empty
+	// if it was removed
+	NewSecondaryValaddress string `protobuf:"bytes,4,opt,name=new_secondary_valaddress,json=newSecondaryValaddress,proto3" json:"new_secondary_valaddress,omitempty"`
+	// amount is the amount of funds transferred to the secondary valaddress
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventUpdateSecondaryValaddress) Reset()         { *m = EventUpdateSecondaryValaddress{} }
+func (m *EventUpdateSecondaryValaddress) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateSecondaryValaddress) ProtoMessage()    {}
+func (*EventUpdateSecondaryValaddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{8}
+}
+func (m *EventUpdateSecondaryValaddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateSecondaryValaddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateSecondaryValaddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventUpdateSecondaryValaddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateSecondaryValaddress.Merge(m, src)
+}
+func (m *EventUpdateSecondaryValaddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateSecondaryValaddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateSecondaryValaddress.DiscardUnknown(m)
 }
 
-func (m *EventCreateStaker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventUpdateSecondaryValaddress proto.InternalMessageInfo
+
+func (m *EventUpdateSecondaryValaddress) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventCreateStaker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventUpdateSecondaryValaddress) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
 }
 
-func (m *EventCreateStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Commission.Size()
-		i -= size
-		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventUpdateSecondaryValaddress) GetOldSecondaryValaddress() string {
+	if m != nil {
+		return m.OldSecondaryValaddress
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventUpdateSecondaryValaddress) GetNewSecondaryValaddress() string {
+	if m != nil {
+		return m.NewSecondaryValaddress
 	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventUpdateSecondaryValaddress) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventUpdateMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// EventEnterMaintenance is an event emitted when a staker enters
+// maintenance in a pool.
+// emitted_by: MsgEnterMaintenance
+type EventEnterMaintenance struct {
+	// pool_id is the pool the staker entered maintenance in
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the address of the staker
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// duration is the time in seconds the staker stays in maintenance
+	Duration uint64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// maintenance_until is the UNIX-timestamp in seconds
+	// when the maintenance ends
+	MaintenanceUntil uint64 `protobuf:"varint,4,opt,name=maintenance_until,json=maintenanceUntil,proto3" json:"maintenance_until,omitempty"`
+}
+
+func (m *EventEnterMaintenance) Reset()         { *m = EventEnterMaintenance{} }
+func (m *EventEnterMaintenance) String() string { return proto.CompactTextString(m) }
+func (*EventEnterMaintenance) ProtoMessage()    {}
+func (*EventEnterMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{9}
+}
+func (m *EventEnterMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEnterMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEnterMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventEnterMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEnterMaintenance.Merge(m, src)
+}
+func (m *EventEnterMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEnterMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEnterMaintenance.DiscardUnknown(m)
 }
 
-func (m *EventUpdateMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventEnterMaintenance proto.InternalMessageInfo
+
+func (m *EventEnterMaintenance) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *EventUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x32
+func (m *EventEnterMaintenance) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EventEnterMaintenance) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *EventEnterMaintenance) GetMaintenanceUntil() uint64 {
+	if m != nil {
+		return m.MaintenanceUntil
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+// EventUpdateAutoCompoundCommission is an event emitted when a staker enables
+// or disables the auto-compounding of its commission rewards.
+// emitted_by: MsgUpdateAutoCompoundCommission
+type EventUpdateAutoCompoundCommission struct {
+	// staker is the address of the staker
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// auto_compound_commission is the new auto-compound setting
+	AutoCompoundCommission bool `protobuf:"varint,2,opt,name=auto_compound_commission,json=autoCompoundCommission,proto3" json:"auto_compound_commission,omitempty"`
+}
+
+func (m *EventUpdateAutoCompoundCommission) Reset()         { *m = EventUpdateAutoCompoundCommission{} }
+func (m *EventUpdateAutoCompoundCommission) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateAutoCompoundCommission) ProtoMessage()    {}
+func (*EventUpdateAutoCompoundCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{10}
+}
+func (m *EventUpdateAutoCompoundCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateAutoCompoundCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateAutoCompoundCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventUpdateAutoCompoundCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateAutoCompoundCommission.Merge(m, src)
+}
+func (m *EventUpdateAutoCompoundCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateAutoCompoundCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateAutoCompoundCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateAutoCompoundCommission proto.InternalMessageInfo
+
+func (m *EventUpdateAutoCompoundCommission) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventUpdateAutoCompoundCommission) GetAutoCompoundCommission() bool {
+	if m != nil {
+		return m.AutoCompoundCommission
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *EventUpdateCommission) Marshal() (dAtA []byte, err error) {
+// EventCompoundCommissionRewards is an event emitted when the $KYVE part of
+// the commission rewards of a staker gets delegated to the staker itself.
+// emitted_by: MsgSubmitBundleProposal, EndBlock
+type EventCompoundCommissionRewards struct {
+	// staker is the address of the staker
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// amount is the amount of commission rewards delegated in ukyve
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventCompoundCommissionRewards) Reset()         { *m = EventCompoundCommissionRewards{} }
+func (m *EventCompoundCommissionRewards) String() string { return proto.CompactTextString(m) }
+func (*EventCompoundCommissionRewards) ProtoMessage()    {}
+func (*EventCompoundCommissionRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{11}
+}
+func (m *EventCompoundCommissionRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCompoundCommissionRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCompoundCommissionRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCompoundCommissionRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCompoundCommissionRewards.Merge(m, src)
+}
+func (m *EventCompoundCommissionRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCompoundCommissionRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCompoundCommissionRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCompoundCommissionRewards proto.InternalMessageInfo
+
+func (m *EventCompoundCommissionRewards) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventCompoundCommissionRewards) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// EventLeavePool ...
+// emitted_by: EndBlock
+type EventLeavePool struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+}
+
+func (m *EventLeavePool) Reset()         { *m = EventLeavePool{} }
+func (m *EventLeavePool) String() string { return proto.CompactTextString(m) }
+func (*EventLeavePool) ProtoMessage()    {}
+func (*EventLeavePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{12}
+}
+func (m *EventLeavePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLeavePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLeavePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLeavePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLeavePool.Merge(m, src)
+}
+func (m *EventLeavePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLeavePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLeavePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLeavePool proto.InternalMessageInfo
+
+func (m *EventLeavePool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventLeavePool) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+// EventCancelLeavePool is an event emitted when a staker
+// cancels a pending pool leave.
+// emitted_by: MsgCancelLeavePool
+type EventCancelLeavePool struct {
+	// pool_id is the pool the staker stays in
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the address of the staker
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+}
+
+func (m *EventCancelLeavePool) Reset()         { *m = EventCancelLeavePool{} }
+func (m *EventCancelLeavePool) String() string { return proto.CompactTextString(m) }
+func (*EventCancelLeavePool) ProtoMessage()    {}
+func (*EventCancelLeavePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1b3dc9634155a0, []int{13}
+}
+func (m *EventCancelLeavePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelLeavePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelLeavePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelLeavePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelLeavePool.Merge(m, src)
+}
+func (m *EventCancelLeavePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelLeavePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelLeavePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelLeavePool proto.InternalMessageInfo
+
+func (m *EventCancelLeavePool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventCancelLeavePool) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventUpdateParams)(nil), "kyve.stakers.v1beta1.EventUpdateParams")
+	proto.RegisterType((*EventCreateStaker)(nil), "kyve.stakers.v1beta1.EventCreateStaker")
+	proto.RegisterType((*EventUpdateMetadata)(nil), "kyve.stakers.v1beta1.EventUpdateMetadata")
+	proto.RegisterType((*EventUpdateCommission)(nil), "kyve.stakers.v1beta1.EventUpdateCommission")
+	proto.RegisterType((*EventCancelCommissionChange)(nil), "kyve.stakers.v1beta1.EventCancelCommissionChange")
+	proto.RegisterType((*EventClaimCommissionRewards)(nil), "kyve.stakers.v1beta1.EventClaimCommissionRewards")
+	proto.RegisterType((*EventJoinPool)(nil), "kyve.stakers.v1beta1.EventJoinPool")
+	proto.RegisterType((*EventUpdateValaddress)(nil), "kyve.stakers.v1beta1.EventUpdateValaddress")
+	proto.RegisterType((*EventUpdateSecondaryValaddress)(nil), "kyve.stakers.v1beta1.EventUpdateSecondaryValaddress")
+	proto.RegisterType((*EventEnterMaintenance)(nil), "kyve.stakers.v1beta1.EventEnterMaintenance")
+	proto.RegisterType((*EventUpdateAutoCompoundCommission)(nil), "kyve.stakers.v1beta1.EventUpdateAutoCompoundCommission")
+	proto.RegisterType((*EventCompoundCommissionRewards)(nil), "kyve.stakers.v1beta1.EventCompoundCommissionRewards")
+	proto.RegisterType((*EventLeavePool)(nil), "kyve.stakers.v1beta1.EventLeavePool")
+	proto.RegisterType((*EventCancelLeavePool)(nil), "kyve.stakers.v1beta1.EventCancelLeavePool")
+}
+
+func init() { proto.RegisterFile("kyve/stakers/v1beta1/events.proto", fileDescriptor_7a1b3dc9634155a0) }
+
+var fileDescriptor_7a1b3dc9634155a0 = []byte{
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xc0, 0xe3, 0x65, 0xbb, 0x49, 0x1e, 0x6c, 0x68, 0xdd, 0x34, 0x58, 0x29, 0xda, 0xb6, 0xae,
+	0x2a, 0x15, 0x81, 0x6c, 0x0a, 0x97, 0x5c, 0x93, 0x25, 0x20, 0x4a, 0x0b, 0x91, 0xab, 0x06, 0xc1,
+	0xc5, 0x7a, 0xf1, 0xbc, 0x6e, 0x46, 0xb1, 0x67, 0x2c, 0xcf, 0x78, 0x9d, 0xe5, 0x53, 0xc0, 0x07,
+	0x81, 0x0b, 0xdf, 0x80, 0x53, 0x6f, 0xf4, 0x88, 0x90, 0xa8, 0x50, 0xf2, 0x45, 0x90, 0xff, 0x65,
+	0x67, 0x9b, 0x0d, 0x6c, 0x83, 0x7a, 0xdb, 0x37, 0xef, 0xbd, 0xdf, 0xfb, 0x3b, 0xb3, 0x86, 0x3b,
+	0x47, 0x93, 0x31, 0xf9, 0x4a, 0xe3, 0x11, 0x65, 0xca, 0x1f, 0x3f, 0x38, 0x20, 0x8d, 0x0f, 0x7c,
+	0x1a, 0x93, 0xd0, 0xca, 0x4b, 0x33, 0xa9, 0xa5, 0xbd, 0x5e, 0x9a, 0x78, 0x8d, 0x89, 0xd7, 0x98,
+	0x6c, 0xae, 0x8f, 0xe4, 0x48, 0x56, 0x06, 0x7e, 0xf9, 0xab, 0xb6, 0xdd, 0x9c, 0x8f, 0x4b, 0x31,
+	0xc3, 0xa4, 0xc1, 0xb9, 0xbf, 0x5a, 0x70, 0x6d, 0xb7, 0xe4, 0x3f, 0x4d, 0x19, 0x6a, 0xda, 0xab,
+	0x74, 0xf6, 0x36, 0x80, 0x8c, 0x59, 0x58, 0x5b, 0x3a, 0xd6, 0x6d, 0xeb, 0xfe, 0xdb, 0x9f, 0xbc,
+	0xef, 0xcd, 0x8b, 0xec, 0xd5, 0x1e, 0x3b, 0xdd, 0xe7, 0x2f, 0x6f, 0x2d, 0x05, 0xab, 0x32, 0x66,
+	0x53, 0x84, 0xa0, 0xa2, 0x45, 0x74, 0x16, 0x47, 0x08, 0x2a, 0x1a, 0x84, 0x03, 0xcb, 0x29, 0x4e,
+	0x62, 0x89, 0xcc, 0x79, 0xeb, 0xb6, 0x75, 0x7f, 0x35, 0x68, 0x45, 0xf7, 0xe7, 0x4e, 0x93, 0xf5,
+	0x30, 0x23, 0xd4, 0xf4, 0xa4, 0x02, 0xda, 0x1b, 0xd0, 0xab, 0xd1, 0x55, 0xc6, 0xab, 0x41, 0x4f,
+	0x9d, 0x9d, 0x63, 0x22, 0x73, 0xa1, 0xab, 0x34, 0xba, 0x41, 0x23, 0xd9, 0x43, 0x80, 0x48, 0x26,
+	0x09, 0x57, 0x8a, 0x4b, 0x51, 0x87, 0xd8, 0xb9, 0x5b, 0x26, 0xf1, 0xe7, 0xcb, 0x5b, 0x37, 0x23,
+	0xa9, 0x12, 0xa9, 0x14, 0x3b, 0xf2, 0xb8, 0xf4, 0x13, 0xd4, 0x87, 0xde, 0x23, 0x1a, 0x61, 0x34,
+	0xf9, 0x8c, 0xa2, 0xc0, 0x70, 0xb3, 0x1f, 0xc2, 0x5a, 0x82, 0xc7, 0xa1, 0x01, 0xea, 0x2e, 0x0e,
+	0xea, 0x27, 0x78, 0x3c, 0x9c, 0xb2, 0xbe, 0x85, 0x1b, 0xb3, 0xac, 0x30, 0x3a, 0x44, 0x31, 0x22,
+	0xe7, 0xca, 0xe2, 0xc8, 0xeb, 0x33, 0xc8, 0x61, 0xe5, 0xef, 0xfe, 0x66, 0xc1, 0x75, 0x63, 0xca,
+	0x8f, 0x49, 0x23, 0x43, 0x8d, 0x17, 0x76, 0xcc, 0x81, 0xe5, 0x44, 0x0a, 0x5e, 0x2a, 0x3a, 0x75,
+	0xe7, 0x1b, 0xb1, 0xd4, 0x14, 0x74, 0xa0, 0xb8, 0xa6, 0x76, 0x26, 0x8d, 0x68, 0x6f, 0xc2, 0x0a,
+	0x67, 0x24, 0x34, 0xd7, 0x93, 0xba, 0x05, 0xc1, 0x99, 0x6c, 0x7f, 0x00, 0x57, 0x15, 0x45, 0x79,
+	0xc6, 0xf5, 0x24, 0x8c, 0xa4, 0xd0, 0x18, 0xe9, 0xba, 0xa6, 0xe0, 0xdd, 0xf6, 0x7c, 0x58, 0x1f,
+	0x97, 0x01, 0x18, 0x69, 0xe4, 0xb1, 0x72, 0x7a, 0x75, 0x80, 0x46, 0x74, 0x35, 0xdc, 0x30, 0x6a,
+	0x30, 0xda, 0x76, 0x51, 0x15, 0xb3, 0xf3, 0xed, 0x5c, 0x6a, 0xbe, 0xee, 0x0f, 0x70, 0xb3, 0xde,
+	0x34, 0x14, 0x11, 0xc5, 0xaf, 0x76, 0xf6, 0xcd, 0xc6, 0xfe, 0xa6, 0x8d, 0x1d, 0x23, 0x4f, 0xa6,
+	0xa1, 0x03, 0x2a, 0x30, 0x63, 0xea, 0xdf, 0xa6, 0x57, 0x6f, 0xb8, 0x6a, 0xa7, 0xd7, 0x88, 0xee,
+	0xef, 0x16, 0xf4, 0x2b, 0xe2, 0x43, 0xc9, 0xc5, 0x9e, 0x94, 0xb1, 0xfd, 0x1e, 0x2c, 0xa7, 0x52,
+	0xc6, 0x21, 0x67, 0x15, 0xa4, 0x1b, 0xf4, 0x4a, 0xf1, 0x4b, 0x66, 0xc0, 0x3b, 0x33, 0xf0, 0x01,
+	0xc0, 0x18, 0x63, 0x64, 0x2c, 0x23, 0xa5, 0x9a, 0x1d, 0x30, 0x4e, 0x8c, 0xcb, 0xd6, 0x9d, 0xb9,
+	0x6c, 0x77, 0xa1, 0xff, 0x8c, 0x28, 0xc4, 0x38, 0x96, 0x45, 0xd9, 0xca, 0x6a, 0xfe, 0xdd, 0xe0,
+	0x9d, 0x67, 0x44, 0xdb, 0xed, 0x99, 0xfd, 0x31, 0xac, 0xcf, 0x18, 0x85, 0x29, 0x65, 0x5c, 0xb2,
+	0x6a, 0x13, 0xba, 0x81, 0x6d, 0xda, 0xee, 0x55, 0x1a, 0xf7, 0x17, 0x6b, 0x66, 0x2b, 0xf6, 0xa7,
+	0x89, 0xbc, 0x76, 0x65, 0xf7, 0x60, 0xad, 0x7c, 0xf4, 0xce, 0x55, 0xd7, 0x97, 0x31, 0x33, 0xb8,
+	0xf7, 0x60, 0xad, 0x7c, 0xd8, 0x0c, 0xb3, 0x7a, 0xdb, 0xfb, 0x82, 0x8a, 0xfd, 0x79, 0x7d, 0xb8,
+	0x62, 0xf6, 0xc1, 0xfd, 0xcb, 0x82, 0x81, 0x91, 0xf0, 0x13, 0x8a, 0xa4, 0x60, 0x98, 0x4d, 0xfe,
+	0x4f, 0xe6, 0x5b, 0xe0, 0x94, 0x99, 0xab, 0x96, 0x75, 0xbe, 0x86, 0x0d, 0x19, 0xb3, 0x79, 0xa1,
+	0xb6, 0xc0, 0x29, 0x8b, 0x99, 0xeb, 0x59, 0x97, 0xb5, 0x21, 0xa8, 0x98, 0xe7, 0x79, 0x51, 0x7d,
+	0x3f, 0xb5, 0x03, 0xd9, 0x15, 0x9a, 0xb2, 0xc7, 0xc8, 0x85, 0x26, 0x51, 0x0d, 0xf7, 0xb5, 0xcb,
+	0xda, 0x84, 0x15, 0x96, 0x67, 0xa8, 0xdb, 0xd7, 0xb9, 0x1b, 0x9c, 0xc9, 0xf6, 0x87, 0x70, 0x2d,
+	0x99, 0xb2, 0xc3, 0x5c, 0x68, 0x1e, 0x37, 0x1b, 0x77, 0xd5, 0x50, 0x3c, 0x2d, 0xcf, 0xdd, 0x1c,
+	0xee, 0x18, 0x2d, 0xdf, 0xce, 0xb5, 0x1c, 0xca, 0x24, 0x95, 0xb9, 0x60, 0x0b, 0xbc, 0x22, 0x5b,
+	0xe0, 0x60, 0xae, 0x65, 0x18, 0x35, 0x2e, 0xe1, 0x2b, 0xf7, 0x7a, 0x25, 0xd8, 0xc0, 0xb9, 0x44,
+	0x77, 0xaf, 0x99, 0xf4, 0x79, 0xd5, 0x7f, 0xdd, 0xe0, 0x0b, 0xfe, 0xb1, 0xdc, 0x6d, 0x58, 0xab,
+	0x88, 0x8f, 0x08, 0xc7, 0x74, 0xa9, 0xfb, 0xeb, 0x7e, 0x01, 0xeb, 0xc6, 0x7b, 0x76, 0x79, 0xd0,
+	0xce, 0xe7, 0xcf, 0x4f, 0x06, 0xd6, 0x8b, 0x93, 0x81, 0xf5, 0xf7, 0xc9, 0xc0, 0xfa, 0xf1, 0x74,
+	0xb0, 0xf4, 0xe2, 0x74, 0xb0, 0xf4, 0xc7, 0xe9, 0x60, 0xe9, 0xfb, 0x8f, 0x46, 0x5c, 0x1f, 0xe6,
+	0x07, 0x5e, 0x24, 0x13, 0xff, 0xab, 0xef, 0xf6, 0x77, 0xbf, 0x26, 0x5d, 0xc8, 0xec, 0xc8, 0x8f,
+	0x0e, 0x91, 0x0b, 0xff, 0xf8, 0xec, 0x83, 0x44, 0x4f, 0x52, 0x52, 0x07, 0xbd, 0xea, 0x43, 0xe4,
+	0xd3, 0x7f, 0x06, 0x00, 0xa1, 0x40, 0xe3, 0x1c, 0xfc, 0x08, 0x00, 0x00,
+}
+
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -695,37 +1052,47 @@ func (m *EventUpdateCommission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateCommission) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
-		size := m.Commission.Size()
-		i -= size
-		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventClaimCommissionRewards) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateStaker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -735,26 +1102,236 @@ func (m *EventClaimCommissionRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventClaimCommissionRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateStaker) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventClaimCommissionRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amounts) > 0 {
-		i -= len(m.Amounts)
-		copy(dAtA[i:], m.Amounts)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amounts)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.MaxCommissionChange.Size()
+		i -= size
+		if _, err := m.MaxCommissionChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelCommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelCommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClaimCommissionRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimCommissionRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimCommissionRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		i -= len(m.Amounts)
+		copy(dAtA[i:], m.Amounts)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amounts)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
 		i--
 		dAtA[i] = 0xa
@@ -782,6 +1359,16 @@ func (m *EventJoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeAllowancePeriod != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FeeAllowancePeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FeeAllowance != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FeeAllowance))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Amount != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
 		i--
@@ -809,7 +1396,7 @@ func (m *EventJoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventLeavePool) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateValaddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -819,16 +1406,35 @@ func (m *EventLeavePool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventLeavePool) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateValaddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateValaddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewValaddress) > 0 {
+		i -= len(m.NewValaddress)
+		copy(dAtA[i:], m.NewValaddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewValaddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldValaddress) > 0 {
+		i -= len(m.OldValaddress)
+		copy(dAtA[i:], m.OldValaddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldValaddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Staker) > 0 {
 		i -= len(m.Staker)
 		copy(dAtA[i:], m.Staker)
@@ -844,35 +1450,279 @@ func (m *EventLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventUpdateSecondaryValaddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.OldParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.NewParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *EventUpdateSecondaryValaddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCreateStaker) Size() (n int) {
+func (m *EventUpdateSecondaryValaddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewSecondaryValaddress) > 0 {
+		i -= len(m.NewSecondaryValaddress)
+		copy(dAtA[i:], m.NewSecondaryValaddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewSecondaryValaddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldSecondaryValaddress) > 0 {
+		i -= len(m.OldSecondaryValaddress)
+		copy(dAtA[i:], m.OldSecondaryValaddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldSecondaryValaddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEnterMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEnterMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEnterMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaintenanceUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaintenanceUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateAutoCompoundCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateAutoCompoundCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateAutoCompoundCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoCompoundCommission {
+		i--
+		if m.AutoCompoundCommission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCompoundCommissionRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCompoundCommissionRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCompoundCommissionRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLeavePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLeavePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelLeavePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelLeavePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.NewParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCreateStaker) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -887,6 +1737,10 @@ func (m *EventCreateStaker) Size() (n int) {
 	}
 	l = m.Commission.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.MaxCommissionChange.Size()
+	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
@@ -938,6 +1792,21 @@ func (m *EventUpdateCommission) Size() (n int) {
 	return n
 }
 
+func (m *EventCancelCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func (m *EventClaimCommissionRewards) Size() (n int) {
 	if m == nil {
 		return 0
@@ -975,10 +1844,16 @@ func (m *EventJoinPool) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovEvents(uint64(m.Amount))
 	}
+	if m.FeeAllowance != 0 {
+		n += 1 + sovEvents(uint64(m.FeeAllowance))
+	}
+	if m.FeeAllowancePeriod != 0 {
+		n += 1 + sovEvents(uint64(m.FeeAllowancePeriod))
+	}
 	return n
 }
 
-func (m *EventLeavePool) Size() (n int) {
+func (m *EventUpdateValaddress) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -991,16 +1866,965 @@ func (m *EventLeavePool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.OldValaddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewValaddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventUpdateSecondaryValaddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldSecondaryValaddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewSecondaryValaddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventEnterMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	if m.MaintenanceUntil != 0 {
+		n += 1 + sovEvents(uint64(m.MaintenanceUntil))
+	}
+	return n
+}
+
+func (m *EventUpdateAutoCompoundCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AutoCompoundCommission {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventCompoundCommissionRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventLeavePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCancelLeavePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozEvents(x uint64) (n int) {
 	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateStaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateStaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelCommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelCommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1023,17 +2847,17 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventClaimCommissionRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventClaimCommissionRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1043,30 +2867,29 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OldParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1076,28 +2899,128 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amounts = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJoinPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valaddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1125,8 +3048,65 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = string(dAtA[iNdEx:postIndex])
+			m.Valaddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
+			}
+			m.FeeAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowancePeriod", wireType)
+			}
+			m.FeeAllowancePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeAllowancePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1148,7 +3128,7 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateStaker) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateValaddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1171,13 +3151,32 @@ func (m *EventCreateStaker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateStaker: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateValaddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateValaddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
@@ -1209,11 +3208,11 @@ func (m *EventCreateStaker) Unmarshal(dAtA []byte) error {
 			}
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValaddress", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1223,14 +3222,27 @@ func (m *EventCreateStaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValaddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValaddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1258,10 +3270,27 @@ func (m *EventCreateStaker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NewValaddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1283,7 +3312,7 @@ func (m *EventCreateStaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateSecondaryValaddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1306,17 +3335,17 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateSecondaryValaddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateSecondaryValaddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1326,27 +3355,14 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1374,11 +3390,11 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSecondaryValaddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1406,11 +3422,11 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			m.OldSecondaryValaddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSecondaryValaddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1438,13 +3454,13 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.NewSecondaryValaddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1454,27 +3470,83 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEnterMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEnterMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEnterMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1502,8 +3574,46 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceUntil", wireType)
+			}
+			m.MaintenanceUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1525,7 +3635,7 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateAutoCompoundCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1548,10 +3658,10 @@ func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateAutoCompoundCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateAutoCompoundCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1587,10 +3697,10 @@ func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundCommission", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1600,26 +3710,12 @@ func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AutoCompoundCommission = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1641,7 +3737,7 @@ func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventClaimCommissionRewards) Unmarshal(dAtA []byte) error {
+func (m *EventCompoundCommissionRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1664,10 +3760,10 @@ func (m *EventClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventClaimCommissionRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCompoundCommissionRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClaimCommissionRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCompoundCommissionRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1703,10 +3799,10 @@ func (m *EventClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1716,24 +3812,11 @@ func (m *EventClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amounts = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1755,7 +3838,7 @@ func (m *EventClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
+func (m *EventLeavePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1778,10 +3861,10 @@ func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventJoinPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLeavePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLeavePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1835,57 +3918,6 @@ func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
 			}
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valaddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Valaddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1907,7 +3939,7 @@ func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLeavePool) Unmarshal(dAtA []byte) error {
+func (m *EventCancelLeavePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1930,10 +3962,10 @@ func (m *EventLeavePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLeavePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelLeavePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLeavePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelLeavePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"context"
+
+	"cosmossdk.io/x/feegrant"
 	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -8,4 +11,15 @@ import (
 type PoolKeeper interface {
 	AssertPoolExists(ctx sdk.Context, poolId uint64) error
 	GetPoolWithError(ctx sdk.Context, poolId uint64) (poolTypes.Pool, error)
+	GetMaxStakersOfPool(ctx sdk.Context, poolId uint64) uint64
+}
+
+type FeeGrantKeeper interface {
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+}
+
+type FeeGrantMsgServer interface {
+	RevokeAllowance(ctx context.Context, msg *feegrant.MsgRevokeAllowance) (*feegrant.MsgRevokeAllowanceResponse, error)
 }
@@ -60,10 +60,26 @@ var (
 	QUEUE_IDENTIFIER_LEAVE      QUEUE_IDENTIFIER = []byte{30, 3}
 )
 
-const MaxStakers = 50
-
 var DefaultCommission = math.LegacyMustNewDecFromStr("0.1")
 
+var (
+	DefaultMaxCommission       = math.LegacyOneDec()
+	DefaultMaxCommissionChange = math.LegacyOneDec()
+)
+
+// DefaultFeeAllowancePeriod is used if a fee allowance is granted at pool join
+// without specifying a period
+var DefaultFeeAllowancePeriod = uint64(60 * 60 * 24)
+
+// FeeAllowanceMessages are the messages a valaddress can pay the fees
+// for with the fee allowance granted by its staker
+var FeeAllowanceMessages = []string{
+	"/kyve.bundles.v1beta1.MsgSubmitBundleProposal",
+	"/kyve.bundles.v1beta1.MsgVoteBundleProposal",
+	"/kyve.bundles.v1beta1.MsgClaimUploaderRole",
+	"/kyve.bundles.v1beta1.MsgSkipUploaderRole",
+}
+
 // StakerKey returns the store Key to retrieve a Staker from the index fields
 func StakerKey(staker string) []byte {
 	return util.GetByteKey(staker)
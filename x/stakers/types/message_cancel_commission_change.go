@@ -0,0 +1,43 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgCancelCommissionChange{}
+	_ sdk.Msg            = &MsgCancelCommissionChange{}
+)
+
+func (msg *MsgCancelCommissionChange) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelCommissionChange) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelCommissionChange) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelCommissionChange) Type() string {
+	return "kyve/stakers/MsgCancelCommissionChange"
+}
+
+func (msg *MsgCancelCommissionChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/KYVENetwork/chain/util"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -44,12 +45,48 @@ func (msg *MsgCreateStaker) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid amount")
 	}
 
-	if msg.Commission.IsNil() {
-		msg.Commission = DefaultCommission
-	}
-	if util.ValidatePercentage(msg.Commission) != nil {
+	if !msg.Commission.IsNil() && util.ValidatePercentage(msg.Commission) != nil {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid commission")
 	}
 
+	if !msg.MaxCommission.IsNil() && util.ValidatePercentage(msg.MaxCommission) != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max commission")
+	}
+
+	if !msg.MaxCommissionChange.IsNil() && util.ValidatePercentage(msg.MaxCommissionChange) != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max commission change")
+	}
+
+	if commission, maxCommission := msg.GetCommissionOrDefault(), msg.GetMaxCommissionOrDefault(); commission.GT(maxCommission) {
+		return errors.Wrapf(errorsTypes.ErrLogic, ErrCommissionTooHigh.Error(), commission, maxCommission)
+	}
+
 	return nil
 }
+
+// GetCommissionOrDefault returns the commission of the message or the
+// default commission if none was provided.
+func (msg *MsgCreateStaker) GetCommissionOrDefault() math.LegacyDec {
+	if msg.Commission.IsNil() {
+		return DefaultCommission
+	}
+	return msg.Commission
+}
+
+// GetMaxCommissionOrDefault returns the max commission of the message or the
+// default max commission if none was provided.
+func (msg *MsgCreateStaker) GetMaxCommissionOrDefault() math.LegacyDec {
+	if msg.MaxCommission.IsNil() {
+		return DefaultMaxCommission
+	}
+	return msg.MaxCommission
+}
+
+// GetMaxCommissionChangeOrDefault returns the max commission change of the
+// message or the default max commission change if none was provided.
+func (msg *MsgCreateStaker) GetMaxCommissionChangeOrDefault() math.LegacyDec {
+	if msg.MaxCommissionChange.IsNil() {
+		return DefaultMaxCommissionChange
+	}
+	return msg.MaxCommissionChange
+}
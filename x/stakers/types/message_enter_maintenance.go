@@ -0,0 +1,48 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/util"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgEnterMaintenance{}
+	_ sdk.Msg            = &MsgEnterMaintenance{}
+)
+
+func (msg *MsgEnterMaintenance) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgEnterMaintenance) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgEnterMaintenance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgEnterMaintenance) Type() string {
+	return "kyve/stakers/MsgEnterMaintenance"
+}
+
+func (msg *MsgEnterMaintenance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if util.ValidateNumber(msg.Duration) != nil || msg.Duration == 0 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid duration")
+	}
+
+	return nil
+}
@@ -48,5 +48,13 @@ func (msg *MsgJoinPool) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid amount")
 	}
 
+	if util.ValidateNumber(msg.FeeAllowance) != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid fee allowance")
+	}
+
+	if util.ValidateNumber(msg.FeeAllowancePeriod) != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid fee allowance period")
+	}
+
 	return nil
 }
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/util"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgUpdateSecondaryValaddress{}
+	_ sdk.Msg            = &MsgUpdateSecondaryValaddress{}
+)
+
+func (msg *MsgUpdateSecondaryValaddress) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateSecondaryValaddress) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateSecondaryValaddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateSecondaryValaddress) Type() string {
+	return "kyve/stakers/MsgUpdateSecondaryValaddress"
+}
+
+func (msg *MsgUpdateSecondaryValaddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	// an empty secondary valaddress removes the current one
+	if msg.SecondaryValaddress == "" {
+		if msg.Amount > 0 {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "can not transfer funds to an empty secondary validator address")
+		}
+	} else if _, err := sdk.AccAddressFromBech32(msg.SecondaryValaddress); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid secondary validator address: %s", err)
+	}
+
+	if util.ValidateNumber(msg.Amount) != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid amount")
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/util"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgUpdateValaddress{}
+	_ sdk.Msg            = &MsgUpdateValaddress{}
+)
+
+func (msg *MsgUpdateValaddress) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateValaddress) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateValaddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateValaddress) Type() string {
+	return "kyve/stakers/MsgUpdateValaddress"
+}
+
+func (msg *MsgUpdateValaddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewValaddress); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid new validator address: %s", err)
+	}
+
+	if util.ValidateNumber(msg.Amount) != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid amount")
+	}
+
+	return nil
+}
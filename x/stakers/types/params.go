@@ -10,14 +10,24 @@ var DefaultCommissionChangeTime = uint64(60 * 60 * 24 * 5)
 // DefaultLeavePoolTime ...
 var DefaultLeavePoolTime = uint64(60 * 60 * 24 * 5)
 
+// DefaultMaintenancePeriod ...
+var DefaultMaintenancePeriod = uint64(60 * 60 * 24 * 30)
+
+// DefaultMaintenanceBudget ...
+var DefaultMaintenanceBudget = uint64(60 * 60 * 24 * 2)
+
 // NewParams creates a new Params instance
 func NewParams(
 	commissionChangeTime uint64,
 	leavePoolTime uint64,
+	maintenancePeriod uint64,
+	maintenanceBudget uint64,
 ) Params {
 	return Params{
 		CommissionChangeTime: commissionChangeTime,
 		LeavePoolTime:        leavePoolTime,
+		MaintenancePeriod:    maintenancePeriod,
+		MaintenanceBudget:    maintenanceBudget,
 	}
 }
 
@@ -26,6 +36,8 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultCommissionChangeTime,
 		DefaultLeavePoolTime,
+		DefaultMaintenancePeriod,
+		DefaultMaintenanceBudget,
 	)
 }
 
@@ -39,5 +51,13 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidateNumber(p.MaintenancePeriod); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.MaintenanceBudget); err != nil {
+		return err
+	}
+
 	return nil
 }
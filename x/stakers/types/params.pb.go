@@ -28,6 +28,14 @@ type Params struct {
 	CommissionChangeTime uint64 `protobuf:"varint,1,opt,name=commission_change_time,json=commissionChangeTime,proto3" json:"commission_change_time,omitempty"`
 	// commission_change_time ...
 	LeavePoolTime uint64 `protobuf:"varint,2,opt,name=leave_pool_time,json=leavePoolTime,proto3" json:"leave_pool_time,omitempty"`
+	// maintenance_period is the time in seconds after which
+	// the maintenance budget of a staker is reset. Zero disables
+	// the maintenance.
+	MaintenancePeriod uint64 `protobuf:"varint,3,opt,name=maintenance_period,json=maintenancePeriod,proto3" json:"maintenance_period,omitempty"`
+	// maintenance_budget is the time in seconds a staker can
+	// spend in maintenance per maintenance period. Zero disables
+	// the maintenance.
+	MaintenanceBudget uint64 `protobuf:"varint,4,opt,name=maintenance_budget,json=maintenanceBudget,proto3" json:"maintenance_budget,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -77,6 +85,20 @@ func (m *Params) GetLeavePoolTime() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaintenancePeriod() uint64 {
+	if m != nil {
+		return m.MaintenancePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenanceBudget() uint64 {
+	if m != nil {
+		return m.MaintenanceBudget
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.stakers.v1beta1.Params")
 }
@@ -84,21 +106,24 @@ func init() {
 func init() { proto.RegisterFile("kyve/stakers/v1beta1/params.proto", fileDescriptor_405cabd7005fc18b) }
 
 var fileDescriptor_405cabd7005fc18b = []byte{
-	// 219 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xae, 0x2c, 0x4b,
-	0xd5, 0x2f, 0x2e, 0x49, 0xcc, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x01,
-	0x29, 0xd1, 0x83, 0x2a, 0xd1, 0x83, 0x2a, 0x51, 0x4a, 0xe3, 0x62, 0x0b, 0x00, 0xab, 0x12, 0x32,
-	0xe1, 0x12, 0x4b, 0xce, 0xcf, 0xcd, 0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf, 0x8b, 0x4f, 0xce, 0x48,
-	0xcc, 0x4b, 0x4f, 0x8d, 0x2f, 0xc9, 0xcc, 0x4d, 0x95, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0x12,
-	0x41, 0xc8, 0x3a, 0x83, 0x25, 0x43, 0x32, 0x73, 0x53, 0x85, 0xd4, 0xb8, 0xf8, 0x73, 0x52, 0x13,
-	0xcb, 0x52, 0xe3, 0x0b, 0xf2, 0xf3, 0x73, 0x20, 0xca, 0x99, 0xc0, 0xca, 0x79, 0xc1, 0xc2, 0x01,
-	0xf9, 0xf9, 0x39, 0x20, 0x75, 0x4e, 0x6e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0xa5, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x1d, 0x19,
-	0xe6, 0xea, 0x97, 0x5a, 0x52, 0x9e, 0x5f, 0x94, 0xad, 0x9f, 0x9c, 0x91, 0x98, 0x99, 0xa7, 0x5f,
-	0x01, 0xf7, 0x54, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x33, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x44, 0x61, 0x13, 0x9a, 0xf1, 0x00, 0x00, 0x00,
+	// 257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0xd0, 0xb1, 0x4a, 0xc4, 0x30,
+	0x1c, 0xc7, 0xf1, 0x46, 0x8f, 0x1b, 0x0a, 0x22, 0x96, 0x43, 0x3a, 0x05, 0x75, 0x10, 0x07, 0x6d,
+	0x38, 0xf4, 0x09, 0x4e, 0x74, 0x11, 0xa4, 0x88, 0x08, 0xba, 0x94, 0x34, 0xf7, 0xa7, 0x0d, 0x6d,
+	0xf2, 0x0f, 0x49, 0xae, 0x7a, 0x6f, 0xe1, 0x43, 0x39, 0x38, 0xde, 0xe8, 0x28, 0xed, 0x8b, 0x88,
+	0xf1, 0x50, 0xd1, 0xf5, 0xf7, 0xfd, 0x4c, 0xbf, 0x78, 0xbf, 0x59, 0x76, 0xc0, 0x9c, 0xe7, 0x0d,
+	0x58, 0xc7, 0xba, 0x69, 0x09, 0x9e, 0x4f, 0x99, 0xe1, 0x96, 0x2b, 0x97, 0x19, 0x8b, 0x1e, 0x93,
+	0xc9, 0x27, 0xc9, 0xd6, 0x24, 0x5b, 0x93, 0x83, 0x17, 0x12, 0x8f, 0xf3, 0xc0, 0x92, 0xb3, 0x78,
+	0x57, 0xa0, 0x52, 0xd2, 0x39, 0x89, 0xba, 0x10, 0x35, 0xd7, 0x15, 0x14, 0x5e, 0x2a, 0x48, 0xc9,
+	0x1e, 0x39, 0x1a, 0xdd, 0x4c, 0x7e, 0xea, 0x79, 0x88, 0xb7, 0x52, 0x41, 0x72, 0x18, 0x6f, 0xb7,
+	0xc0, 0x3b, 0x28, 0x0c, 0x62, 0xfb, 0xc5, 0x37, 0x02, 0xdf, 0x0a, 0x73, 0x8e, 0xd8, 0x06, 0x77,
+	0x12, 0x27, 0x8a, 0x4b, 0xed, 0x41, 0x73, 0x2d, 0xa0, 0x30, 0x60, 0x25, 0xce, 0xd3, 0xcd, 0x40,
+	0x77, 0x7e, 0x95, 0x3c, 0x84, 0xbf, 0xbc, 0x5c, 0xcc, 0x2b, 0xf0, 0xe9, 0xe8, 0x1f, 0x9f, 0x85,
+	0x30, 0xbb, 0x7c, 0xed, 0x29, 0x59, 0xf5, 0x94, 0xbc, 0xf7, 0x94, 0x3c, 0x0f, 0x34, 0x5a, 0x0d,
+	0x34, 0x7a, 0x1b, 0x68, 0xf4, 0x70, 0x5c, 0x49, 0x5f, 0x2f, 0xca, 0x4c, 0xa0, 0x62, 0x57, 0xf7,
+	0x77, 0x17, 0xd7, 0xe0, 0x1f, 0xd1, 0x36, 0x4c, 0xd4, 0x5c, 0x6a, 0xf6, 0xf4, 0xfd, 0x99, 0x5f,
+	0x1a, 0x70, 0xe5, 0x38, 0x7c, 0x75, 0xfa, 0x31, 0x00, 0xe0, 0x04, 0x69, 0x0f, 0x50, 0x01, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +146,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaintenanceBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintenanceBudget))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaintenancePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintenancePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.LeavePoolTime != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.LeavePoolTime))
 		i--
@@ -157,6 +192,12 @@ func (m *Params) Size() (n int) {
 	if m.LeavePoolTime != 0 {
 		n += 1 + sovParams(uint64(m.LeavePoolTime))
 	}
+	if m.MaintenancePeriod != 0 {
+		n += 1 + sovParams(uint64(m.MaintenancePeriod))
+	}
+	if m.MaintenanceBudget != 0 {
+		n += 1 + sovParams(uint64(m.MaintenanceBudget))
+	}
 	return n
 }
 
@@ -233,6 +274,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenancePeriod", wireType)
+			}
+			m.MaintenancePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenancePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceBudget", wireType)
+			}
+			m.MaintenanceBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
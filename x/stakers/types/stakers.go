@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HasValaddress returns true if the given address is either the valaddress
+// or the secondary valaddress of the valaccount
+func (m *Valaccount) HasValaddress(valaddress string) bool {
+	if valaddress == "" {
+		return false
+	}
+
+	return m.Valaddress == valaddress || m.SecondaryValaddress == valaddress
+}
+
+// IsInMaintenance returns true if the maintenance of the valaccount
+// has not ended at the given UNIX-timestamp.
+func (m *Valaccount) IsInMaintenance(now uint64) bool {
+	return m.MaintenanceUntil > now
+}
+
+// DecayPoints removes all points which were received at or before
+// `windowStart`. Points without a timestamp, which were received while
+// point decay was disabled, are treated as if they were received at `now`.
+func (m *Valaccount) DecayPoints(windowStart uint64, now uint64) {
+	for uint64(len(m.PointTimestamps)) < m.Points {
+		m.PointTimestamps = append(m.PointTimestamps, now)
+	}
+
+	pointTimestamps := make([]uint64, 0, len(m.PointTimestamps))
+	for _, timestamp := range m.PointTimestamps {
+		if timestamp > windowStart {
+			pointTimestamps = append(pointTimestamps, timestamp)
+		}
+	}
+
+	m.PointTimestamps = pointTimestamps
+	m.Points = uint64(len(pointTimestamps))
+}
+
+// ValidateCommissionChange checks if the staker is allowed to change
+// its current commission to the given commission
+func (m *Staker) ValidateCommissionChange(commission math.LegacyDec) error {
+	if commission.GT(m.MaxCommission) {
+		return errors.Wrapf(errorsTypes.ErrLogic, ErrCommissionTooHigh.Error(), commission, m.MaxCommission)
+	}
+
+	if change := commission.Sub(m.Commission).Abs(); change.GT(m.MaxCommissionChange) {
+		return errors.Wrapf(errorsTypes.ErrLogic, ErrCommissionChangeTooHigh.Error(), change, m.MaxCommissionChange)
+	}
+
+	return nil
+}
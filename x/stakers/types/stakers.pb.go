@@ -46,6 +46,16 @@ type Staker struct {
 	Details string `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
 	// commission_rewards are the rewards through commission and storage cost
 	CommissionRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=commission_rewards,json=commissionRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission_rewards"`
+	// max_commission is the highest commission the staker can ever set
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change is the maximum amount the commission
+	// can be changed by a single commission change. Since only one change
+	// can be pending and it is applied after the commission_change_time,
+	// this also limits the change per commission_change_time.
+	MaxCommissionChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_commission_change,json=maxCommissionChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change"`
+	// auto_compound_commission defines if the $KYVE part of the commission
+	// rewards gets automatically delegated to the staker itself on every payout
+	AutoCompoundCommission bool `protobuf:"varint,11,opt,name=auto_compound_commission,json=autoCompoundCommission,proto3" json:"auto_compound_commission,omitempty"`
 }
 
 func (m *Staker) Reset()         { *m = Staker{} }
@@ -130,6 +140,13 @@ func (m *Staker) GetCommissionRewards() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *Staker) GetAutoCompoundCommission() bool {
+	if m != nil {
+		return m.AutoCompoundCommission
+	}
+	return false
+}
+
 // Valaccount gets authorized by a staker to
 // vote in a given pool by favor of the staker.
 type Valaccount struct {
@@ -147,6 +164,24 @@ type Valaccount struct {
 	Points uint64 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
 	// isLeaving indicates if a staker is leaving the given pool.
 	IsLeaving bool `protobuf:"varint,5,opt,name=is_leaving,json=isLeaving,proto3" json:"is_leaving,omitempty"`
+	// secondary_valaddress is an optional hot-standby account stored
+	// on a second protocol node which is also allowed to vote for the
+	// staker. Only one of both valaddresses can vote per bundle proposal.
+	SecondaryValaddress string `protobuf:"bytes,6,opt,name=secondary_valaddress,json=secondaryValaddress,proto3" json:"secondary_valaddress,omitempty"`
+	// maintenance_until is the UNIX-timestamp in seconds until the
+	// staker is in maintenance. During maintenance the staker is neither
+	// selected as uploader nor does it receive points for not voting.
+	MaintenanceUntil uint64 `protobuf:"varint,7,opt,name=maintenance_until,json=maintenanceUntil,proto3" json:"maintenance_until,omitempty"`
+	// maintenance_period_start is the UNIX-timestamp in seconds when
+	// the current maintenance period has started.
+	MaintenancePeriodStart uint64 `protobuf:"varint,8,opt,name=maintenance_period_start,json=maintenancePeriodStart,proto3" json:"maintenance_period_start,omitempty"`
+	// maintenance_used is the time in seconds the staker already
+	// spent in maintenance during the current maintenance period.
+	MaintenanceUsed uint64 `protobuf:"varint,9,opt,name=maintenance_used,json=maintenanceUsed,proto3" json:"maintenance_used,omitempty"`
+	// point_timestamps are the UNIX-timestamps in seconds of all
+	// points which are still within the point decay window. They
+	// are only tracked if point decay is enabled.
+	PointTimestamps []uint64 `protobuf:"varint,10,rep,packed,name=point_timestamps,json=pointTimestamps,proto3" json:"point_timestamps,omitempty"`
 }
 
 func (m *Valaccount) Reset()         { *m = Valaccount{} }
@@ -217,6 +252,41 @@ func (m *Valaccount) GetIsLeaving() bool {
 	return false
 }
 
+func (m *Valaccount) GetSecondaryValaddress() string {
+	if m != nil {
+		return m.SecondaryValaddress
+	}
+	return ""
+}
+
+func (m *Valaccount) GetMaintenanceUntil() uint64 {
+	if m != nil {
+		return m.MaintenanceUntil
+	}
+	return 0
+}
+
+func (m *Valaccount) GetMaintenancePeriodStart() uint64 {
+	if m != nil {
+		return m.MaintenancePeriodStart
+	}
+	return 0
+}
+
+func (m *Valaccount) GetMaintenanceUsed() uint64 {
+	if m != nil {
+		return m.MaintenanceUsed
+	}
+	return 0
+}
+
+func (m *Valaccount) GetPointTimestamps() []uint64 {
+	if m != nil {
+		return m.PointTimestamps
+	}
+	return nil
+}
+
 // CommissionChangeEntry stores the information for an
 // upcoming commission change. A commission change is never
 // instant, so delegators have time to redelegate in case
@@ -439,46 +509,56 @@ func init() {
 }
 
 var fileDescriptor_d209d1a2a74d375d = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0x36, 0x4d, 0x86, 0xdf, 0x5a, 0x05, 0x4c, 0xab, 0xba, 0x55, 0x7a, 0x29, 0x08,
-	0x6c, 0x15, 0xc4, 0x0b, 0x34, 0x2d, 0xa2, 0xa2, 0x42, 0xe0, 0x4a, 0x95, 0xe0, 0x12, 0x6d, 0xd6,
-	0x23, 0x67, 0x15, 0x7b, 0x37, 0xf2, 0x6e, 0x92, 0x46, 0x42, 0xe2, 0x15, 0x38, 0xf0, 0x10, 0x88,
-	0x0b, 0x3c, 0x46, 0x8f, 0x3d, 0x22, 0x0e, 0x05, 0x35, 0x07, 0x9e, 0x02, 0x09, 0xed, 0xae, 0x9d,
-	0xa6, 0x07, 0x24, 0xd4, 0x8b, 0xbd, 0xdf, 0xf7, 0xcd, 0xce, 0xcc, 0x7e, 0x3b, 0x36, 0x34, 0x7b,
-	0xe3, 0x21, 0x86, 0x52, 0x91, 0x1e, 0xe6, 0x32, 0x1c, 0x6e, 0x77, 0x50, 0x91, 0xed, 0x12, 0x07,
-	0xfd, 0x5c, 0x28, 0xe1, 0x2e, 0xeb, 0x98, 0xa0, 0xe4, 0x8a, 0x98, 0x95, 0x25, 0x92, 0x31, 0x2e,
-	0x42, 0xf3, 0xb4, 0x81, 0x2b, 0x3e, 0x15, 0x32, 0x13, 0x32, 0xec, 0x10, 0x89, 0xd3, 0x5c, 0x54,
-	0x30, 0x5e, 0xe8, 0xcb, 0x89, 0x48, 0x84, 0x59, 0x86, 0x7a, 0x65, 0xd9, 0xe6, 0x9f, 0x39, 0xa8,
-	0x1d, 0x9a, 0xe4, 0xae, 0x07, 0x8b, 0x24, 0x8e, 0x73, 0x94, 0xd2, 0x73, 0x36, 0x9c, 0xad, 0x46,
-	0x54, 0x42, 0xb7, 0x05, 0x40, 0x45, 0x96, 0x31, 0x29, 0x99, 0xe0, 0xde, 0x9c, 0x16, 0x77, 0x36,
-	0x4f, 0xce, 0xd6, 0x2b, 0x3f, 0xce, 0xd6, 0x57, 0x6d, 0x59, 0x19, 0xf7, 0x02, 0x26, 0xc2, 0x8c,
-	0xa8, 0x6e, 0x70, 0x80, 0x09, 0xa1, 0xe3, 0x5d, 0xa4, 0xd1, 0xcc, 0x36, 0x9d, 0x3e, 0x13, 0x9c,
-	0xf5, 0x30, 0xf7, 0xaa, 0x36, 0x7d, 0x01, 0xb5, 0x32, 0xc2, 0x8e, 0x64, 0x0a, 0xbd, 0x79, 0xab,
-	0x14, 0xd0, 0x5d, 0x81, 0x3a, 0x8b, 0x91, 0x2b, 0xa6, 0xc6, 0xde, 0x82, 0x91, 0xa6, 0xd8, 0x7d,
-	0x00, 0xb7, 0x25, 0xd2, 0x41, 0xce, 0xd4, 0xb8, 0x4d, 0x05, 0x57, 0x84, 0x2a, 0xaf, 0x66, 0x62,
-	0x6e, 0x95, 0x7c, 0xcb, 0xd2, 0xba, 0x40, 0x8c, 0x8a, 0xb0, 0x54, 0x7a, 0x8b, 0xb6, 0x40, 0x01,
-	0xdd, 0x0f, 0xe0, 0x5e, 0xb4, 0xd8, 0xce, 0x71, 0x44, 0xf2, 0x58, 0x7a, 0xf5, 0x8d, 0xea, 0xd6,
-	0xb5, 0x27, 0xf7, 0x03, 0x7b, 0xb4, 0x40, 0x3b, 0x5a, 0x3a, 0x1f, 0xb4, 0x04, 0xe3, 0x3b, 0xcf,
-	0xf4, 0xe1, 0xbf, 0xfc, 0x5c, 0xdf, 0x4a, 0x98, 0xea, 0x0e, 0x3a, 0x01, 0x15, 0x59, 0x58, 0xd8,
-	0x6f, 0x5f, 0x8f, 0x65, 0xdc, 0x0b, 0xd5, 0xb8, 0x8f, 0xd2, 0x6c, 0x90, 0x9f, 0x7f, 0x7f, 0x7b,
-	0xe8, 0x44, 0x4b, 0x17, 0xb5, 0x22, 0x5b, 0xaa, 0xf9, 0xc9, 0x01, 0x38, 0x22, 0x29, 0xa1, 0x54,
-	0x0c, 0xb8, 0x72, 0xef, 0xc1, 0x62, 0x5f, 0x88, 0xb4, 0xcd, 0x62, 0x73, 0x07, 0xf3, 0x51, 0x4d,
-	0xc3, 0xfd, 0xd8, 0xbd, 0x0b, 0x35, 0x3b, 0x03, 0xd6, 0xfe, 0xa8, 0x40, 0xae, 0x0f, 0x30, 0x24,
-	0x69, 0x79, 0x6f, 0xd6, 0xd8, 0x19, 0x46, 0xef, 0xeb, 0x0b, 0xc6, 0x95, 0x34, 0xd6, 0x9a, 0x7c,
-	0x1a, 0xb9, 0x6b, 0x00, 0x4c, 0xb6, 0x53, 0x24, 0x43, 0xc6, 0x13, 0xe3, 0x6d, 0x3d, 0x6a, 0x30,
-	0x79, 0x60, 0x89, 0xe6, 0x57, 0x07, 0xee, 0xb4, 0xa6, 0xcd, 0xb6, 0xba, 0x84, 0x27, 0xb8, 0xc7,
-	0x55, 0x3e, 0x76, 0x97, 0x61, 0x81, 0xf1, 0x18, 0x8f, 0x8b, 0xfe, 0x2c, 0xf8, 0x67, 0x7b, 0x97,
-	0x27, 0xa7, 0x7a, 0xb5, 0xc9, 0xd9, 0x84, 0x1b, 0x34, 0x47, 0xa2, 0xf4, 0x15, 0xc5, 0xa4, 0x98,
-	0x92, 0x6a, 0x74, 0xbd, 0x24, 0x77, 0x89, 0xc2, 0xe6, 0x7b, 0xb8, 0xa9, 0x9b, 0xc7, 0xd7, 0x42,
-	0xa4, 0x57, 0xe9, 0x74, 0xc6, 0xf9, 0xea, 0x25, 0xe7, 0xff, 0xab, 0xfa, 0x0b, 0x80, 0x37, 0x03,
-	0x1c, 0xe0, 0xa1, 0x22, 0x0a, 0xdd, 0x55, 0x68, 0xa4, 0x62, 0xd4, 0x9e, 0xad, 0x5e, 0x4f, 0xc5,
-	0x68, 0xdf, 0x34, 0xb0, 0x06, 0xd0, 0x65, 0x49, 0xb7, 0x50, 0xe7, 0x8c, 0xda, 0xd0, 0x8c, 0x91,
-	0x77, 0x9e, 0x9f, 0x9c, 0xfb, 0xce, 0xe9, 0xb9, 0xef, 0xfc, 0x3a, 0xf7, 0x9d, 0x8f, 0x13, 0xbf,
-	0x72, 0x3a, 0xf1, 0x2b, 0xdf, 0x27, 0x7e, 0xe5, 0xdd, 0xa3, 0x99, 0x61, 0x7b, 0xf9, 0xf6, 0x68,
-	0xef, 0x15, 0xaa, 0x91, 0xc8, 0x7b, 0x21, 0xed, 0x12, 0xc6, 0xc3, 0xe3, 0xe9, 0x7f, 0xc4, 0x8c,
-	0x5d, 0xa7, 0x66, 0xbe, 0xef, 0xa7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xd5, 0x78, 0x96,
-	0x64, 0x04, 0x00, 0x00,
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0x6b, 0xd7, 0xb1, 0x4f, 0xe9, 0x4f, 0xa6, 0x69, 0x58, 0x52, 0x75, 0x13, 0xb9, 0x37,
+	0xe1, 0x6f, 0x57, 0x01, 0x21, 0x71, 0x1d, 0xb7, 0x88, 0x42, 0x85, 0xca, 0x06, 0x82, 0xe0, 0x66,
+	0x35, 0x9e, 0x39, 0xb2, 0x47, 0xde, 0x9d, 0xb1, 0x76, 0x66, 0x63, 0x5b, 0x42, 0xe2, 0x86, 0x07,
+	0xe0, 0x31, 0x10, 0x37, 0xf0, 0x18, 0xbd, 0xec, 0x25, 0x42, 0xa8, 0xa0, 0xe4, 0x82, 0xd7, 0x40,
+	0x33, 0xb3, 0x6b, 0xaf, 0x91, 0x90, 0xa2, 0xdc, 0xd8, 0x7b, 0xbe, 0xef, 0x9c, 0xf3, 0xcd, 0x9e,
+	0xf9, 0x7c, 0x0c, 0x83, 0xe9, 0xf2, 0x1c, 0x63, 0x6d, 0xe8, 0x14, 0x0b, 0x1d, 0x9f, 0x1f, 0x8f,
+	0xd0, 0xd0, 0xe3, 0x3a, 0x8e, 0x66, 0x85, 0x32, 0x8a, 0xec, 0xda, 0x9c, 0xa8, 0xc6, 0xaa, 0x9c,
+	0xfd, 0x1d, 0x9a, 0x0b, 0xa9, 0x62, 0xf7, 0xe9, 0x13, 0xf7, 0x43, 0xa6, 0x74, 0xae, 0x74, 0x3c,
+	0xa2, 0x1a, 0x57, 0xbd, 0x98, 0x12, 0xb2, 0xe2, 0x77, 0xc7, 0x6a, 0xac, 0xdc, 0x63, 0x6c, 0x9f,
+	0x3c, 0x3a, 0xf8, 0xb3, 0x03, 0xdd, 0x53, 0xd7, 0x9c, 0x04, 0xb0, 0x4d, 0x39, 0x2f, 0x50, 0xeb,
+	0xa0, 0x75, 0xd8, 0x3a, 0xea, 0x27, 0x75, 0x48, 0x86, 0x00, 0x4c, 0xe5, 0xb9, 0xd0, 0x5a, 0x28,
+	0x19, 0xdc, 0xb0, 0xe4, 0xc9, 0xe3, 0x97, 0xaf, 0x0f, 0xb6, 0xfe, 0x78, 0x7d, 0xf0, 0xd0, 0xcb,
+	0x6a, 0x3e, 0x8d, 0x84, 0x8a, 0x73, 0x6a, 0x26, 0xd1, 0x73, 0x1c, 0x53, 0xb6, 0x7c, 0x82, 0x2c,
+	0x69, 0x94, 0xd9, 0xf6, 0xb9, 0x92, 0x62, 0x8a, 0x45, 0xd0, 0xf6, 0xed, 0xab, 0xd0, 0x32, 0x73,
+	0x1c, 0x69, 0x61, 0x30, 0xe8, 0x78, 0xa6, 0x0a, 0xc9, 0x3e, 0xf4, 0x04, 0x47, 0x69, 0x84, 0x59,
+	0x06, 0x37, 0x1d, 0xb5, 0x8a, 0xc9, 0xdb, 0x70, 0x4f, 0x23, 0x2b, 0x0b, 0x61, 0x96, 0x29, 0x53,
+	0xd2, 0x50, 0x66, 0x82, 0xae, 0xcb, 0xb9, 0x5b, 0xe3, 0x43, 0x0f, 0x5b, 0x01, 0x8e, 0x86, 0x8a,
+	0x4c, 0x07, 0xdb, 0x5e, 0xa0, 0x0a, 0xc9, 0x0f, 0x40, 0xd6, 0x47, 0x4c, 0x0b, 0x9c, 0xd3, 0x82,
+	0xeb, 0xa0, 0x77, 0xd8, 0x3e, 0xba, 0xf5, 0xc1, 0x5b, 0x91, 0x7f, 0xb5, 0xc8, 0x4e, 0xb4, 0x9e,
+	0x7c, 0x34, 0x54, 0x42, 0x9e, 0x7c, 0x64, 0x5f, 0xfe, 0x97, 0xbf, 0x0e, 0x8e, 0xc6, 0xc2, 0x4c,
+	0xca, 0x51, 0xc4, 0x54, 0x1e, 0x57, 0xe3, 0xf7, 0x5f, 0xef, 0x6b, 0x3e, 0x8d, 0xcd, 0x72, 0x86,
+	0xda, 0x15, 0xe8, 0x9f, 0xff, 0xf9, 0xed, 0x9d, 0x56, 0xb2, 0xb3, 0xd6, 0x4a, 0xbc, 0x14, 0xf9,
+	0x0c, 0xee, 0xe4, 0x74, 0x91, 0xae, 0x89, 0xa0, 0x7f, 0xf5, 0xf1, 0xde, 0xce, 0xe9, 0x62, 0xb8,
+	0x9e, 0xf0, 0x37, 0xf0, 0x60, 0xb3, 0x57, 0xca, 0x26, 0x54, 0x8e, 0x31, 0x80, 0xab, 0xb7, 0xbc,
+	0xbf, 0xd1, 0x72, 0xe8, 0xea, 0xc9, 0xc7, 0x10, 0xd0, 0xd2, 0x28, 0xdb, 0x79, 0xa6, 0x4a, 0xc9,
+	0x9b, 0xc7, 0xbd, 0x75, 0xd8, 0x3a, 0xea, 0x25, 0x7b, 0x96, 0x1f, 0x56, 0xf4, 0xba, 0x7e, 0xf0,
+	0x63, 0x1b, 0xe0, 0x8c, 0x66, 0x94, 0x31, 0x55, 0x4a, 0x43, 0xde, 0x84, 0xed, 0x99, 0x52, 0x59,
+	0x2a, 0xb8, 0xb3, 0x58, 0x27, 0xe9, 0xda, 0xf0, 0x19, 0x27, 0x7b, 0xd0, 0xf5, 0x16, 0xf7, 0xee,
+	0x4a, 0xaa, 0x88, 0x84, 0x00, 0xe7, 0x34, 0xab, 0x6d, 0xe9, 0x7d, 0xd3, 0x40, 0x6c, 0xdd, 0x4c,
+	0x09, 0x69, 0x74, 0xd0, 0xa9, 0xfb, 0xd9, 0x88, 0x3c, 0x02, 0x10, 0x3a, 0xcd, 0x90, 0x9e, 0x0b,
+	0x39, 0x76, 0xd6, 0xe9, 0x25, 0x7d, 0xa1, 0x9f, 0x7b, 0x80, 0x1c, 0xc3, 0xae, 0x46, 0xa6, 0x24,
+	0xa7, 0xc5, 0x32, 0x6d, 0x08, 0x78, 0xff, 0xdc, 0x5f, 0x71, 0x67, 0x6b, 0xa5, 0x77, 0x61, 0x27,
+	0xa7, 0x42, 0x1a, 0x94, 0x54, 0x32, 0x4c, 0x4b, 0x69, 0x44, 0xe6, 0xdc, 0xd4, 0x49, 0xee, 0x35,
+	0x88, 0xaf, 0x2d, 0x6e, 0x07, 0xd6, 0x4c, 0x9e, 0x61, 0x21, 0x14, 0x4f, 0xb5, 0xa1, 0x85, 0x09,
+	0x7a, 0xae, 0x66, 0xaf, 0xc1, 0xbf, 0x70, 0xf4, 0xa9, 0x65, 0xad, 0xab, 0x37, 0x64, 0x34, 0x72,
+	0xe7, 0x88, 0x4e, 0x72, 0xb7, 0xa9, 0xa2, 0x91, 0xdb, 0x54, 0xf7, 0xb6, 0xa9, 0x11, 0x39, 0x6a,
+	0x43, 0xf3, 0x99, 0x0e, 0xe0, 0xb0, 0x6d, 0x53, 0x1d, 0xfe, 0xd5, 0x0a, 0x1e, 0xfc, 0xda, 0x82,
+	0x07, 0xff, 0xbd, 0xd5, 0xa7, 0xd2, 0x14, 0x4b, 0xb2, 0x0b, 0x37, 0x85, 0xe4, 0xb8, 0xa8, 0xee,
+	0xc3, 0x07, 0xff, 0x7b, 0x1d, 0x9b, 0x8b, 0xa0, 0x7d, 0xbd, 0x45, 0xf0, 0x18, 0x6e, 0xb3, 0x02,
+	0xa9, 0xb1, 0x06, 0xe5, 0xb4, 0xfa, 0xd1, 0xb7, 0x93, 0x37, 0x6a, 0xf0, 0x09, 0x35, 0x38, 0xf8,
+	0x1e, 0xee, 0xd8, 0xcb, 0xc2, 0x17, 0x4a, 0x65, 0xd7, 0x39, 0x69, 0xc3, 0x69, 0xed, 0x0d, 0xa7,
+	0x5d, 0x49, 0xfd, 0x53, 0x80, 0x2f, 0x4b, 0x2c, 0xf1, 0xd4, 0x50, 0x83, 0xe4, 0x21, 0xf4, 0x33,
+	0x35, 0x4f, 0x9b, 0xea, 0xbd, 0x4c, 0xcd, 0x9f, 0xb9, 0x03, 0x3c, 0x02, 0x98, 0x88, 0xf1, 0xa4,
+	0x62, 0x6f, 0x38, 0xb6, 0x6f, 0x11, 0x47, 0x9f, 0x7c, 0xf2, 0xf2, 0x22, 0x6c, 0xbd, 0xba, 0x08,
+	0x5b, 0x7f, 0x5f, 0x84, 0xad, 0x9f, 0x2e, 0xc3, 0xad, 0x57, 0x97, 0xe1, 0xd6, 0xef, 0x97, 0xe1,
+	0xd6, 0x77, 0xef, 0x35, 0x76, 0xc7, 0xe7, 0xdf, 0x9e, 0x3d, 0xfd, 0x02, 0xcd, 0x5c, 0x15, 0xd3,
+	0x98, 0x4d, 0xa8, 0x90, 0xf1, 0x62, 0xf5, 0xb7, 0xe0, 0xb6, 0xc8, 0xa8, 0xeb, 0xd6, 0xf5, 0x87,
+	0xff, 0x0e, 0x00, 0xd2, 0x49, 0x67, 0xad, 0x33, 0x06, 0x00, 0x00,
 }
 
 func (m *Staker) Marshal() (dAtA []byte, err error) {
@@ -501,6 +581,36 @@ func (m *Staker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundCommission {
+		i--
+		if m.AutoCompoundCommission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxCommissionChange.Size()
+		i -= size
+		if _, err := m.MaxCommissionChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.CommissionRewards) > 0 {
 		for iNdEx := len(m.CommissionRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -590,6 +700,46 @@ func (m *Valaccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PointTimestamps) > 0 {
+		dAtA2 := make([]byte, len(m.PointTimestamps)*10)
+		var j1 int
+		for _, num := range m.PointTimestamps {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintStakers(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaintenanceUsed != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.MaintenanceUsed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaintenancePeriodStart != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.MaintenancePeriodStart))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaintenanceUntil != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.MaintenanceUntil))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SecondaryValaddress) > 0 {
+		i -= len(m.SecondaryValaddress)
+		copy(dAtA[i:], m.SecondaryValaddress)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.SecondaryValaddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.IsLeaving {
 		i--
 		if m.IsLeaving {
@@ -804,6 +954,13 @@ func (m *Staker) Size() (n int) {
 			n += 1 + l + sovStakers(uint64(l))
 		}
 	}
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	l = m.MaxCommissionChange.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	if m.AutoCompoundCommission {
+		n += 2
+	}
 	return n
 }
 
@@ -830,6 +987,26 @@ func (m *Valaccount) Size() (n int) {
 	if m.IsLeaving {
 		n += 2
 	}
+	l = len(m.SecondaryValaddress)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.MaintenanceUntil != 0 {
+		n += 1 + sovStakers(uint64(m.MaintenanceUntil))
+	}
+	if m.MaintenancePeriodStart != 0 {
+		n += 1 + sovStakers(uint64(m.MaintenancePeriodStart))
+	}
+	if m.MaintenanceUsed != 0 {
+		n += 1 + sovStakers(uint64(m.MaintenanceUsed))
+	}
+	if len(m.PointTimestamps) > 0 {
+		l = 0
+		for _, e := range m.PointTimestamps {
+			l += sovStakers(uint64(e))
+		}
+		n += 1 + sovStakers(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1186,6 +1363,94 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundCommission", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompoundCommission = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakers(dAtA[iNdEx:])
@@ -1358,6 +1623,171 @@ func (m *Valaccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsLeaving = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryValaddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondaryValaddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceUntil", wireType)
+			}
+			m.MaintenanceUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenancePeriodStart", wireType)
+			}
+			m.MaintenancePeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenancePeriodStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceUsed", wireType)
+			}
+			m.MaintenanceUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStakers
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PointTimestamps = append(m.PointTimestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStakers
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStakers
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStakers
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PointTimestamps) == 0 {
+					m.PointTimestamps = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStakers
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PointTimestamps = append(m.PointTimestamps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointTimestamps", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakers(dAtA[iNdEx:])
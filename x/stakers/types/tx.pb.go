@@ -43,6 +43,13 @@ type MsgCreateStaker struct {
 	// commission is the percentage that is deducted from rewards before
 	// distributing the staker's delegators.
 	Commission cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission"`
+	// max_commission is the highest commission the staker can ever set.
+	// It can not be changed after the staker was created.
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change is the maximum amount the commission
+	// can be changed by a single commission change. It can not be
+	// changed after the staker was created.
+	MaxCommissionChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_commission_change,json=maxCommissionChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change"`
 }
 
 func (m *MsgCreateStaker) Reset()         { *m = MsgCreateStaker{} }
@@ -342,6 +349,90 @@ func (m *MsgUpdateCommissionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateCommissionResponse proto.InternalMessageInfo
 
+// MsgCancelCommissionChange defines a SDK message for removing
+// a pending commission change from the queue.
+type MsgCancelCommissionChange struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgCancelCommissionChange) Reset()         { *m = MsgCancelCommissionChange{} }
+func (m *MsgCancelCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelCommissionChange) ProtoMessage()    {}
+func (*MsgCancelCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{6}
+}
+func (m *MsgCancelCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelCommissionChange.Merge(m, src)
+}
+func (m *MsgCancelCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelCommissionChange proto.InternalMessageInfo
+
+func (m *MsgCancelCommissionChange) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgCancelCommissionChangeResponse ...
+type MsgCancelCommissionChangeResponse struct {
+}
+
+func (m *MsgCancelCommissionChangeResponse) Reset()         { *m = MsgCancelCommissionChangeResponse{} }
+func (m *MsgCancelCommissionChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelCommissionChangeResponse) ProtoMessage()    {}
+func (*MsgCancelCommissionChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{7}
+}
+func (m *MsgCancelCommissionChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelCommissionChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelCommissionChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelCommissionChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelCommissionChangeResponse.Merge(m, src)
+}
+func (m *MsgCancelCommissionChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelCommissionChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelCommissionChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelCommissionChangeResponse proto.InternalMessageInfo
+
 // MsgClaimCommissionRewards ...
 type MsgClaimCommissionRewards struct {
 	// creator ...
@@ -354,7 +445,7 @@ func (m *MsgClaimCommissionRewards) Reset()         { *m = MsgClaimCommissionRew
 func (m *MsgClaimCommissionRewards) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimCommissionRewards) ProtoMessage()    {}
 func (*MsgClaimCommissionRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{6}
+	return fileDescriptor_f52b730e69b9fb06, []int{8}
 }
 func (m *MsgClaimCommissionRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +496,7 @@ func (m *MsgClaimCommissionRewardsResponse) Reset()         { *m = MsgClaimCommi
 func (m *MsgClaimCommissionRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimCommissionRewardsResponse) ProtoMessage()    {}
 func (*MsgClaimCommissionRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{7}
+	return fileDescriptor_f52b730e69b9fb06, []int{9}
 }
 func (m *MsgClaimCommissionRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,13 +535,20 @@ type MsgJoinPool struct {
 	Valaddress string `protobuf:"bytes,3,opt,name=valaddress,proto3" json:"valaddress,omitempty"`
 	// amount ...
 	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// fee_allowance is the amount the valaddress can spend per period
+	// on fees of bundle messages through a fee grant of the staker.
+	// If zero, no fee grant is created.
+	FeeAllowance uint64 `protobuf:"varint,5,opt,name=fee_allowance,json=feeAllowance,proto3" json:"fee_allowance,omitempty"`
+	// fee_allowance_period is the duration of one fee allowance
+	// period in seconds.
+	FeeAllowancePeriod uint64 `protobuf:"varint,6,opt,name=fee_allowance_period,json=feeAllowancePeriod,proto3" json:"fee_allowance_period,omitempty"`
 }
 
 func (m *MsgJoinPool) Reset()         { *m = MsgJoinPool{} }
 func (m *MsgJoinPool) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinPool) ProtoMessage()    {}
 func (*MsgJoinPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{8}
+	return fileDescriptor_f52b730e69b9fb06, []int{10}
 }
 func (m *MsgJoinPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,6 +605,20 @@ func (m *MsgJoinPool) GetAmount() uint64 {
 	return 0
 }
 
+func (m *MsgJoinPool) GetFeeAllowance() uint64 {
+	if m != nil {
+		return m.FeeAllowance
+	}
+	return 0
+}
+
+func (m *MsgJoinPool) GetFeeAllowancePeriod() uint64 {
+	if m != nil {
+		return m.FeeAllowancePeriod
+	}
+	return 0
+}
+
 // MsgJoinPoolResponse ...
 type MsgJoinPoolResponse struct {
 }
@@ -515,7 +627,7 @@ func (m *MsgJoinPoolResponse) Reset()         { *m = MsgJoinPoolResponse{} }
 func (m *MsgJoinPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinPoolResponse) ProtoMessage()    {}
 func (*MsgJoinPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{9}
+	return fileDescriptor_f52b730e69b9fb06, []int{11}
 }
 func (m *MsgJoinPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +668,7 @@ func (m *MsgLeavePool) Reset()         { *m = MsgLeavePool{} }
 func (m *MsgLeavePool) String() string { return proto.CompactTextString(m) }
 func (*MsgLeavePool) ProtoMessage()    {}
 func (*MsgLeavePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{10}
+	return fileDescriptor_f52b730e69b9fb06, []int{12}
 }
 func (m *MsgLeavePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +719,7 @@ func (m *MsgLeavePoolResponse) Reset()         { *m = MsgLeavePoolResponse{} }
 func (m *MsgLeavePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeavePoolResponse) ProtoMessage()    {}
 func (*MsgLeavePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{11}
+	return fileDescriptor_f52b730e69b9fb06, []int{13}
 }
 func (m *MsgLeavePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,26 +748,27 @@ func (m *MsgLeavePoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeavePoolResponse proto.InternalMessageInfo
 
-// MsgUpdateParams defines a SDK message for updating the module parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// payload defines the x/stakers parameters to update.
-	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+// MsgCancelLeavePool defines a SDK message for removing
+// a pending pool leave from the queue.
+type MsgCancelLeavePool struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{12}
+func (m *MsgCancelLeavePool) Reset()         { *m = MsgCancelLeavePool{} }
+func (m *MsgCancelLeavePool) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLeavePool) ProtoMessage()    {}
+func (*MsgCancelLeavePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{14}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelLeavePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelLeavePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelLeavePool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -665,48 +778,48 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCancelLeavePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLeavePool.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCancelLeavePool) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCancelLeavePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLeavePool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelLeavePool proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCancelLeavePool) GetCreator() string {
 	if m != nil {
-		return m.Authority
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetPayload() string {
+func (m *MsgCancelLeavePool) GetPoolId() uint64 {
 	if m != nil {
-		return m.Payload
+		return m.PoolId
 	}
-	return ""
+	return 0
 }
 
-// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
-type MsgUpdateParamsResponse struct {
+// MsgCancelLeavePoolResponse ...
+type MsgCancelLeavePoolResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f52b730e69b9fb06, []int{13}
+func (m *MsgCancelLeavePoolResponse) Reset()         { *m = MsgCancelLeavePoolResponse{} }
+func (m *MsgCancelLeavePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLeavePoolResponse) ProtoMessage()    {}
+func (*MsgCancelLeavePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{15}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelLeavePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelLeavePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelLeavePoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -716,1102 +829,3467 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCancelLeavePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLeavePoolResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCancelLeavePoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCancelLeavePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLeavePoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelLeavePoolResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateStaker)(nil), "kyve.stakers.v1beta1.MsgCreateStaker")
-	proto.RegisterType((*MsgCreateStakerResponse)(nil), "kyve.stakers.v1beta1.MsgCreateStakerResponse")
-	proto.RegisterType((*MsgUpdateMetadata)(nil), "kyve.stakers.v1beta1.MsgUpdateMetadata")
-	proto.RegisterType((*MsgUpdateMetadataResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateMetadataResponse")
-	proto.RegisterType((*MsgUpdateCommission)(nil), "kyve.stakers.v1beta1.MsgUpdateCommission")
-	proto.RegisterType((*MsgUpdateCommissionResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateCommissionResponse")
-	proto.RegisterType((*MsgClaimCommissionRewards)(nil), "kyve.stakers.v1beta1.MsgClaimCommissionRewards")
-	proto.RegisterType((*MsgClaimCommissionRewardsResponse)(nil), "kyve.stakers.v1beta1.MsgClaimCommissionRewardsResponse")
-	proto.RegisterType((*MsgJoinPool)(nil), "kyve.stakers.v1beta1.MsgJoinPool")
-	proto.RegisterType((*MsgJoinPoolResponse)(nil), "kyve.stakers.v1beta1.MsgJoinPoolResponse")
-	proto.RegisterType((*MsgLeavePool)(nil), "kyve.stakers.v1beta1.MsgLeavePool")
-	proto.RegisterType((*MsgLeavePoolResponse)(nil), "kyve.stakers.v1beta1.MsgLeavePoolResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "kyve.stakers.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateParamsResponse")
+// MsgUpdateValaddress defines a SDK message for replacing the valaddress
+// of a staker in a pool without leaving it.
+type MsgUpdateValaddress struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// new_valaddress is the address of the new protocol node
+	NewValaddress string `protobuf:"bytes,3,opt,name=new_valaddress,json=newValaddress,proto3" json:"new_valaddress,omitempty"`
+	// amount is the amount of funds transferred to the new valaddress
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/stakers/v1beta1/tx.proto", fileDescriptor_f52b730e69b9fb06) }
-
-var fileDescriptor_f52b730e69b9fb06 = []byte{
-	// 836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
-	0x10, 0x16, 0xfd, 0x92, 0xb5, 0x16, 0xfc, 0x60, 0x55, 0x9b, 0xa2, 0x61, 0xda, 0x96, 0x61, 0x54,
-	0x36, 0x6a, 0x12, 0x72, 0xd1, 0x16, 0xf0, 0xad, 0x52, 0x5b, 0xa0, 0xad, 0xd5, 0x1a, 0x34, 0x1a,
-	0xe4, 0x71, 0x30, 0x56, 0xe4, 0x82, 0x5a, 0x4b, 0xe4, 0x0a, 0xdc, 0x95, 0x6c, 0xdd, 0x02, 0x1f,
-	0x72, 0xce, 0x29, 0xbf, 0x21, 0xc8, 0x25, 0x3e, 0xe4, 0x47, 0xf8, 0x68, 0xe4, 0x14, 0xe4, 0xe0,
-	0x04, 0xf6, 0xc1, 0xf7, 0xfc, 0x82, 0x80, 0xaf, 0x15, 0x25, 0xeb, 0xe1, 0x04, 0xb9, 0x88, 0x9a,
-	0xfd, 0xbe, 0x99, 0xf9, 0x86, 0x3b, 0x33, 0x12, 0x58, 0xa9, 0xb5, 0x5b, 0x48, 0xa3, 0x0c, 0xd6,
-	0x90, 0x4b, 0xb5, 0x56, 0xa1, 0x82, 0x18, 0x2c, 0x68, 0xec, 0x54, 0x6d, 0xb8, 0x84, 0x11, 0x31,
-	0xe3, 0xc1, 0x6a, 0x08, 0xab, 0x21, 0x2c, 0x2f, 0x40, 0x1b, 0x3b, 0x44, 0xf3, 0x3f, 0x03, 0xa2,
-	0xac, 0x18, 0x84, 0xda, 0x84, 0x6a, 0x15, 0x48, 0x11, 0x0f, 0x63, 0x10, 0xec, 0x84, 0xf8, 0x52,
-	0x88, 0xdb, 0xd4, 0xd2, 0x5a, 0x05, 0xef, 0x11, 0x02, 0xd9, 0x00, 0x38, 0xf2, 0x2d, 0x2d, 0x30,
-	0x42, 0x28, 0x63, 0x11, 0x8b, 0x04, 0xe7, 0xde, 0xb7, 0xe0, 0x34, 0xf7, 0x42, 0x00, 0x73, 0x65,
-	0x6a, 0x95, 0x5c, 0x04, 0x19, 0x3a, 0xf4, 0x95, 0x89, 0x12, 0x48, 0x1a, 0x9e, 0x4d, 0x5c, 0x49,
-	0x58, 0x13, 0xf2, 0x29, 0x3d, 0x32, 0xc5, 0x45, 0x30, 0x05, 0x6d, 0xd2, 0x74, 0x98, 0x34, 0xb6,
-	0x26, 0xe4, 0x27, 0xf4, 0xd0, 0x12, 0x4b, 0x00, 0x18, 0xc4, 0xb6, 0x31, 0xa5, 0x98, 0x38, 0xd2,
-	0xb8, 0xe7, 0x54, 0xdc, 0xb8, 0xb8, 0x5a, 0x4d, 0xbc, 0xbf, 0x5a, 0x5d, 0x0e, 0x54, 0x50, 0xb3,
-	0xa6, 0x62, 0xa2, 0xd9, 0x90, 0x55, 0xd5, 0x7d, 0x64, 0x41, 0xa3, 0xfd, 0x3b, 0x32, 0xf4, 0x98,
-	0xdb, 0x5e, 0xfa, 0xec, 0xf6, 0x7c, 0x3b, 0x4a, 0x95, 0xcb, 0x82, 0xa5, 0x1e, 0x5d, 0x3a, 0xa2,
-	0x0d, 0xe2, 0x50, 0x94, 0xbb, 0x14, 0xc0, 0x42, 0x99, 0x5a, 0xff, 0x37, 0x4c, 0xc8, 0x50, 0x19,
-	0x31, 0x68, 0x42, 0x06, 0x87, 0xa8, 0x96, 0x40, 0xd2, 0x26, 0x0e, 0xae, 0x21, 0xd7, 0x97, 0x9d,
-	0xd2, 0x23, 0xd3, 0x43, 0x4e, 0x50, 0x85, 0x62, 0x86, 0x02, 0xd1, 0x7a, 0x64, 0x8a, 0x32, 0x98,
-	0xc6, 0x26, 0x72, 0x18, 0x66, 0x6d, 0x69, 0xc2, 0x87, 0xb8, 0x2d, 0x6e, 0x81, 0x79, 0x8a, 0x8c,
-	0xa6, 0x8b, 0x59, 0xfb, 0xc8, 0x20, 0x0e, 0x83, 0x06, 0x93, 0x26, 0x7d, 0xce, 0x5c, 0x74, 0x5e,
-	0x0a, 0x8e, 0xbd, 0x04, 0x26, 0x62, 0x10, 0xd7, 0xa9, 0x34, 0x15, 0x24, 0x08, 0xcd, 0x9e, 0x6a,
-	0x97, 0x41, 0xf6, 0x4e, 0x45, 0xbc, 0xde, 0x33, 0x01, 0x7c, 0xc7, 0xd1, 0x12, 0x7f, 0x61, 0x43,
-	0x2a, 0xee, 0xbe, 0x8f, 0xb1, 0x6f, 0x71, 0x1f, 0x2b, 0x60, 0xb9, 0x8f, 0x06, 0xae, 0xf1, 0xb5,
-	0xe0, 0x57, 0x50, 0xaa, 0x43, 0x6c, 0xc7, 0xe1, 0x13, 0xe8, 0x9a, 0x74, 0x88, 0xd2, 0x63, 0x90,
-	0x0c, 0x7a, 0x88, 0x4a, 0x63, 0x6b, 0xe3, 0xf9, 0x99, 0xdd, 0xac, 0x1a, 0x76, 0xad, 0xd7, 0xfb,
-	0xd1, 0x8c, 0xa8, 0x25, 0x82, 0x9d, 0xe2, 0xcf, 0x5e, 0x05, 0xaf, 0x3e, 0xac, 0xe6, 0x2d, 0xcc,
-	0xaa, 0xcd, 0x8a, 0x6a, 0x10, 0x3b, 0x6c, 0xf1, 0xf0, 0xb1, 0x43, 0xcd, 0x9a, 0xc6, 0xda, 0x0d,
-	0x44, 0x7d, 0x07, 0xfa, 0xf2, 0xf6, 0x7c, 0x5b, 0xd0, 0xa3, 0x04, 0x3d, 0x05, 0x6d, 0x80, 0xf5,
-	0x81, 0x82, 0x79, 0x59, 0xcf, 0x04, 0x30, 0x53, 0xa6, 0xd6, 0xdf, 0x04, 0x3b, 0x07, 0x84, 0xd4,
-	0x87, 0x14, 0xb2, 0x04, 0x92, 0x0d, 0x42, 0xea, 0x47, 0xd8, 0x8c, 0x66, 0xc3, 0x33, 0xff, 0x32,
-	0x45, 0x05, 0x80, 0x16, 0xac, 0x43, 0xd3, 0x74, 0x11, 0xa5, 0x61, 0x9b, 0xc5, 0x4e, 0x62, 0x33,
-	0x35, 0x11, 0x9f, 0xa9, 0x1e, 0xb5, 0xdf, 0xfb, 0x2d, 0x10, 0xe9, 0xe0, 0xfa, 0xfe, 0x03, 0xe9,
-	0x32, 0xb5, 0xf6, 0x11, 0x6c, 0xa1, 0xaf, 0xd4, 0xd7, 0x93, 0x67, 0x11, 0x64, 0xe2, 0x01, 0x79,
-	0x22, 0xea, 0xaf, 0x89, 0xe0, 0xfa, 0x0f, 0xa0, 0x0b, 0x6d, 0x2a, 0xfe, 0x02, 0x52, 0xb0, 0xc9,
-	0xaa, 0xc4, 0xeb, 0xf7, 0x20, 0x5b, 0x51, 0x7a, 0xfb, 0x66, 0x27, 0x13, 0xde, 0xdf, 0x6f, 0x41,
-	0x7d, 0x87, 0xcc, 0xc5, 0x8e, 0xa5, 0x77, 0xa8, 0x9e, 0xc6, 0x06, 0x6c, 0xd7, 0x09, 0x34, 0xa3,
-	0x71, 0x0c, 0xcd, 0xbd, 0x59, 0x4f, 0x4a, 0x87, 0x19, 0xee, 0x80, 0x78, 0xd2, 0x48, 0xcf, 0xee,
-	0xa7, 0x49, 0x30, 0x5e, 0xa6, 0x96, 0x68, 0x82, 0x74, 0xd7, 0xee, 0xda, 0x54, 0xfb, 0xed, 0x58,
-	0xb5, 0x67, 0x95, 0xc8, 0x3b, 0xf7, 0xa2, 0x45, 0xd9, 0xc4, 0x63, 0x30, 0xdb, 0xb3, 0x6d, 0x7e,
-	0x18, 0x18, 0xa0, 0x9b, 0x28, 0x6b, 0xf7, 0x24, 0xf2, 0x5c, 0x0d, 0x30, 0x7f, 0x67, 0xd2, 0xb7,
-	0x46, 0x04, 0xe9, 0x50, 0xe5, 0xc2, 0xbd, 0xa9, 0x3c, 0xe3, 0x99, 0x00, 0x16, 0x07, 0x0c, 0xee,
-	0x60, 0xf5, 0xfd, 0x1d, 0xe4, 0x5f, 0xbf, 0xd0, 0x81, 0x8b, 0x78, 0x08, 0xa6, 0xf9, 0x94, 0xad,
-	0x0f, 0x0c, 0x12, 0x51, 0xe4, 0xad, 0x91, 0x14, 0x1e, 0xf9, 0x09, 0x48, 0x75, 0x06, 0x24, 0x37,
-	0xd0, 0x8f, 0x73, 0xe4, 0xed, 0xd1, 0x1c, 0x1e, 0xdc, 0x04, 0xe9, 0xae, 0xa1, 0xd8, 0x1c, 0xf1,
-	0xfa, 0x03, 0xda, 0x90, 0xfe, 0xeb, 0xd7, 0xed, 0xf2, 0xe4, 0x53, 0x6f, 0x93, 0x15, 0xff, 0xbc,
-	0xb8, 0x56, 0x84, 0xcb, 0x6b, 0x45, 0xf8, 0x78, 0xad, 0x08, 0xcf, 0x6f, 0x94, 0xc4, 0xe5, 0x8d,
-	0x92, 0x78, 0x77, 0xa3, 0x24, 0x1e, 0xff, 0x18, 0x5b, 0x89, 0xff, 0x3c, 0x7a, 0xf0, 0xc7, 0xbf,
-	0x88, 0x9d, 0x10, 0xb7, 0xa6, 0x19, 0x55, 0x88, 0x1d, 0xed, 0x94, 0xff, 0x25, 0xf1, 0x97, 0x63,
-	0x65, 0xca, 0xff, 0xed, 0xff, 0xe9, 0x73, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xa4, 0x12, 0x10,
-	0xaf, 0x08, 0x00, 0x00,
+func (m *MsgUpdateValaddress) Reset()         { *m = MsgUpdateValaddress{} }
+func (m *MsgUpdateValaddress) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValaddress) ProtoMessage()    {}
+func (*MsgUpdateValaddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{16}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateStaker ...
-	CreateStaker(ctx context.Context, in *MsgCreateStaker, opts ...grpc.CallOption) (*MsgCreateStakerResponse, error)
-	// UpdateMetadata ...
-	UpdateMetadata(ctx context.Context, in *MsgUpdateMetadata, opts ...grpc.CallOption) (*MsgUpdateMetadataResponse, error)
-	// UpdateCommission ...
-	UpdateCommission(ctx context.Context, in *MsgUpdateCommission, opts ...grpc.CallOption) (*MsgUpdateCommissionResponse, error)
-	// ClaimCommissionRewards ...
-	ClaimCommissionRewards(ctx context.Context, in *MsgClaimCommissionRewards, opts ...grpc.CallOption) (*MsgClaimCommissionRewardsResponse, error)
-	// JoinPool ...
-	JoinPool(ctx context.Context, in *MsgJoinPool, opts ...grpc.CallOption) (*MsgJoinPoolResponse, error)
-	// LeavePool ...
-	LeavePool(ctx context.Context, in *MsgLeavePool, opts ...grpc.CallOption) (*MsgLeavePoolResponse, error)
-	// UpdateParams defines a governance operation for updating the x/stakers module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgUpdateValaddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateValaddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValaddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateValaddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValaddress.Merge(m, src)
+}
+func (m *MsgUpdateValaddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValaddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValaddress.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateStaker(ctx context.Context, in *MsgCreateStaker, opts ...grpc.CallOption) (*MsgCreateStakerResponse, error) {
-	out := new(MsgCreateStakerResponse)
-	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/CreateStaker", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateValaddress proto.InternalMessageInfo
+
+func (m *MsgUpdateValaddress) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateMetadata(ctx context.Context, in *MsgUpdateMetadata, opts ...grpc.CallOption) (*MsgUpdateMetadataResponse, error) {
-	out := new(MsgUpdateMetadataResponse)
-	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateValaddress) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateCommission(ctx context.Context, in *MsgUpdateCommission, opts ...grpc.CallOption) (*MsgUpdateCommissionResponse, error) {
-	out := new(MsgUpdateCommissionResponse)
-	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateCommission", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateValaddress) GetNewValaddress() string {
+	if m != nil {
+		return m.NewValaddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClaimCommissionRewards(ctx context.Context, in *MsgClaimCommissionRewards, opts ...grpc.CallOption) (*MsgClaimCommissionRewardsResponse, error) {
-	out := new(MsgClaimCommissionRewardsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/ClaimCommissionRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateValaddress) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) JoinPool(ctx context.Context, in *MsgJoinPool, opts ...grpc.CallOption) (*MsgJoinPoolResponse, error) {
-	out := new(MsgJoinPoolResponse)
-	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/JoinPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgUpdateValaddressResponse ...
+type MsgUpdateValaddressResponse struct {
 }
 
-func (c *msgClient) LeavePool(ctx context.Context, in *MsgLeavePool, opts ...grpc.CallOption) (*MsgLeavePoolResponse, error) {
-	out := new(MsgLeavePoolResponse)
-	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/LeavePool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateValaddressResponse) Reset()         { *m = MsgUpdateValaddressResponse{} }
+func (m *MsgUpdateValaddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValaddressResponse) ProtoMessage()    {}
+func (*MsgUpdateValaddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{17}
+}
+func (m *MsgUpdateValaddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValaddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValaddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateValaddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValaddressResponse.Merge(m, src)
+}
+func (m *MsgUpdateValaddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValaddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValaddressResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateValaddressResponse proto.InternalMessageInfo
+
+// MsgUpdateSecondaryValaddress defines a SDK message for setting or removing
+// the hot-standby valaddress of a staker in a pool.
+type MsgUpdateSecondaryValaddress struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// secondary_valaddress is the address of the standby protocol node,
+	// an empty string removes the current secondary valaddress
+	SecondaryValaddress string `protobuf:"bytes,3,opt,name=secondary_valaddress,json=secondaryValaddress,proto3" json:"secondary_valaddress,omitempty"`
+	// amount is the amount of funds transferred to the secondary valaddress
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgUpdateSecondaryValaddress) Reset()         { *m = MsgUpdateSecondaryValaddress{} }
+func (m *MsgUpdateSecondaryValaddress) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSecondaryValaddress) ProtoMessage()    {}
+func (*MsgUpdateSecondaryValaddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{18}
+}
+func (m *MsgUpdateSecondaryValaddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSecondaryValaddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSecondaryValaddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateSecondaryValaddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSecondaryValaddress.Merge(m, src)
+}
+func (m *MsgUpdateSecondaryValaddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSecondaryValaddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSecondaryValaddress.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateStaker ...
-	CreateStaker(context.Context, *MsgCreateStaker) (*MsgCreateStakerResponse, error)
-	// UpdateMetadata ...
-	UpdateMetadata(context.Context, *MsgUpdateMetadata) (*MsgUpdateMetadataResponse, error)
-	// UpdateCommission ...
-	UpdateCommission(context.Context, *MsgUpdateCommission) (*MsgUpdateCommissionResponse, error)
-	// ClaimCommissionRewards ...
-	ClaimCommissionRewards(context.Context, *MsgClaimCommissionRewards) (*MsgClaimCommissionRewardsResponse, error)
-	// JoinPool ...
-	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
-	// LeavePool ...
-	LeavePool(context.Context, *MsgLeavePool) (*MsgLeavePoolResponse, error)
-	// UpdateParams defines a governance operation for updating the x/stakers module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+var xxx_messageInfo_MsgUpdateSecondaryValaddress proto.InternalMessageInfo
+
+func (m *MsgUpdateSecondaryValaddress) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateSecondaryValaddress) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (*UnimplementedMsgServer) CreateStaker(ctx context.Context, req *MsgCreateStaker) (*MsgCreateStakerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStaker not implemented")
+func (m *MsgUpdateSecondaryValaddress) GetSecondaryValaddress() string {
+	if m != nil {
+		return m.SecondaryValaddress
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) UpdateMetadata(ctx context.Context, req *MsgUpdateMetadata) (*MsgUpdateMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetadata not implemented")
+
+func (m *MsgUpdateSecondaryValaddress) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) UpdateCommission(ctx context.Context, req *MsgUpdateCommission) (*MsgUpdateCommissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommission not implemented")
+
+// MsgUpdateSecondaryValaddressResponse ...
+type MsgUpdateSecondaryValaddressResponse struct {
 }
-func (*UnimplementedMsgServer) ClaimCommissionRewards(ctx context.Context, req *MsgClaimCommissionRewards) (*MsgClaimCommissionRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimCommissionRewards not implemented")
+
+func (m *MsgUpdateSecondaryValaddressResponse) Reset()         { *m = MsgUpdateSecondaryValaddressResponse{} }
+func (m *MsgUpdateSecondaryValaddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSecondaryValaddressResponse) ProtoMessage()    {}
+func (*MsgUpdateSecondaryValaddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{19}
 }
-func (*UnimplementedMsgServer) JoinPool(ctx context.Context, req *MsgJoinPool) (*MsgJoinPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinPool not implemented")
+func (m *MsgUpdateSecondaryValaddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) LeavePool(ctx context.Context, req *MsgLeavePool) (*MsgLeavePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeavePool not implemented")
+func (m *MsgUpdateSecondaryValaddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSecondaryValaddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUpdateSecondaryValaddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSecondaryValaddressResponse.Merge(m, src)
+}
+func (m *MsgUpdateSecondaryValaddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSecondaryValaddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSecondaryValaddressResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateSecondaryValaddressResponse proto.InternalMessageInfo
+
+// MsgEnterMaintenance defines a SDK message for pausing the participation
+// of a staker in a pool for a limited amount of time.
+type MsgEnterMaintenance struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// duration is the time in seconds the staker stays in maintenance
+	Duration uint64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func _Msg_CreateStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateStaker)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateStaker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.stakers.v1beta1.Msg/CreateStaker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateStaker(ctx, req.(*MsgCreateStaker))
+func (m *MsgEnterMaintenance) Reset()         { *m = MsgEnterMaintenance{} }
+func (m *MsgEnterMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgEnterMaintenance) ProtoMessage()    {}
+func (*MsgEnterMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{20}
+}
+func (m *MsgEnterMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnterMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnterMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgEnterMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnterMaintenance.Merge(m, src)
+}
+func (m *MsgEnterMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnterMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnterMaintenance.DiscardUnknown(m)
 }
 
-func _Msg_UpdateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.stakers.v1beta1.Msg/UpdateMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMetadata(ctx, req.(*MsgUpdateMetadata))
+var xxx_messageInfo_MsgEnterMaintenance proto.InternalMessageInfo
+
+func (m *MsgEnterMaintenance) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateCommission)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateCommission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.stakers.v1beta1.Msg/UpdateCommission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateCommission(ctx, req.(*MsgUpdateCommission))
+func (m *MsgEnterMaintenance) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ClaimCommissionRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimCommissionRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimCommissionRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.stakers.v1beta1.Msg/ClaimCommissionRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimCommissionRewards(ctx, req.(*MsgClaimCommissionRewards))
+func (m *MsgEnterMaintenance) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_JoinPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinPool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.stakers.v1beta1.Msg/JoinPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinPool(ctx, req.(*MsgJoinPool))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgEnterMaintenanceResponse ...
+type MsgEnterMaintenanceResponse struct {
 }
 
-func _Msg_LeavePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLeavePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LeavePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.stakers.v1beta1.Msg/LeavePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LeavePool(ctx, req.(*MsgLeavePool))
+func (m *MsgEnterMaintenanceResponse) Reset()         { *m = MsgEnterMaintenanceResponse{} }
+func (m *MsgEnterMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnterMaintenanceResponse) ProtoMessage()    {}
+func (*MsgEnterMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{21}
+}
+func (m *MsgEnterMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnterMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnterMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgEnterMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnterMaintenanceResponse.Merge(m, src)
+}
+func (m *MsgEnterMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnterMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnterMaintenanceResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.stakers.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgEnterMaintenanceResponse proto.InternalMessageInfo
+
+// MsgUpdateAutoCompoundCommission defines a SDK message for enabling or
+// disabling the auto-compounding of the commission rewards of a staker.
+type MsgUpdateAutoCompoundCommission struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// auto_compound_commission defines if the $KYVE part of the commission
+	// rewards gets automatically delegated to the staker itself
+	AutoCompoundCommission bool `protobuf:"varint,2,opt,name=auto_compound_commission,json=autoCompoundCommission,proto3" json:"auto_compound_commission,omitempty"`
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kyve.stakers.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateStaker",
-			Handler:    _Msg_CreateStaker_Handler,
-		},
-		{
-			MethodName: "UpdateMetadata",
-			Handler:    _Msg_UpdateMetadata_Handler,
-		},
-		{
-			MethodName: "UpdateCommission",
-			Handler:    _Msg_UpdateCommission_Handler,
-		},
-		{
-			MethodName: "ClaimCommissionRewards",
-			Handler:    _Msg_ClaimCommissionRewards_Handler,
-		},
-		{
-			MethodName: "JoinPool",
-			Handler:    _Msg_JoinPool_Handler,
-		},
-		{
-			MethodName: "LeavePool",
-			Handler:    _Msg_LeavePool_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kyve/stakers/v1beta1/tx.proto",
+func (m *MsgUpdateAutoCompoundCommission) Reset()         { *m = MsgUpdateAutoCompoundCommission{} }
+func (m *MsgUpdateAutoCompoundCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAutoCompoundCommission) ProtoMessage()    {}
+func (*MsgUpdateAutoCompoundCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{22}
 }
-
-func (m *MsgCreateStaker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAutoCompoundCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAutoCompoundCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAutoCompoundCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateStaker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateAutoCompoundCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAutoCompoundCommission.Merge(m, src)
+}
+func (m *MsgUpdateAutoCompoundCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAutoCompoundCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAutoCompoundCommission.DiscardUnknown(m)
 }
 
-func (m *MsgCreateStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Commission.Size()
-		i -= size
-		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgUpdateAutoCompoundCommission proto.InternalMessageInfo
+
+func (m *MsgUpdateAutoCompoundCommission) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateStakerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAutoCompoundCommission) GetAutoCompoundCommission() bool {
+	if m != nil {
+		return m.AutoCompoundCommission
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgCreateStakerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateAutoCompoundCommissionResponse ...
+type MsgUpdateAutoCompoundCommissionResponse struct {
 }
 
-func (m *MsgCreateStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateAutoCompoundCommissionResponse) Reset() {
+	*m = MsgUpdateAutoCompoundCommissionResponse{}
 }
-
-func (m *MsgUpdateMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAutoCompoundCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAutoCompoundCommissionResponse) ProtoMessage()    {}
+func (*MsgUpdateAutoCompoundCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{23}
+}
+func (m *MsgUpdateAutoCompoundCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAutoCompoundCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAutoCompoundCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateAutoCompoundCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAutoCompoundCommissionResponse.Merge(m, src)
+}
+func (m *MsgUpdateAutoCompoundCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAutoCompoundCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAutoCompoundCommissionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateAutoCompoundCommissionResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a SDK message for updating the module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// payload defines the x/stakers parameters to update.
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (m *MsgUpdateMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{24}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgUpdateCommission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetPayload() string {
+	if m != nil {
+		return m.Payload
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateCommission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Commission.Size()
-		i -= size
-		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f52b730e69b9fb06, []int{25}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateCommissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateStaker)(nil), "kyve.stakers.v1beta1.MsgCreateStaker")
+	proto.RegisterType((*MsgCreateStakerResponse)(nil), "kyve.stakers.v1beta1.MsgCreateStakerResponse")
+	proto.RegisterType((*MsgUpdateMetadata)(nil), "kyve.stakers.v1beta1.MsgUpdateMetadata")
+	proto.RegisterType((*MsgUpdateMetadataResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateMetadataResponse")
+	proto.RegisterType((*MsgUpdateCommission)(nil), "kyve.stakers.v1beta1.MsgUpdateCommission")
+	proto.RegisterType((*MsgUpdateCommissionResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateCommissionResponse")
+	proto.RegisterType((*MsgCancelCommissionChange)(nil), "kyve.stakers.v1beta1.MsgCancelCommissionChange")
+	proto.RegisterType((*MsgCancelCommissionChangeResponse)(nil), "kyve.stakers.v1beta1.MsgCancelCommissionChangeResponse")
+	proto.RegisterType((*MsgClaimCommissionRewards)(nil), "kyve.stakers.v1beta1.MsgClaimCommissionRewards")
+	proto.RegisterType((*MsgClaimCommissionRewardsResponse)(nil), "kyve.stakers.v1beta1.MsgClaimCommissionRewardsResponse")
+	proto.RegisterType((*MsgJoinPool)(nil), "kyve.stakers.v1beta1.MsgJoinPool")
+	proto.RegisterType((*MsgJoinPoolResponse)(nil), "kyve.stakers.v1beta1.MsgJoinPoolResponse")
+	proto.RegisterType((*MsgLeavePool)(nil), "kyve.stakers.v1beta1.MsgLeavePool")
+	proto.RegisterType((*MsgLeavePoolResponse)(nil), "kyve.stakers.v1beta1.MsgLeavePoolResponse")
+	proto.RegisterType((*MsgCancelLeavePool)(nil), "kyve.stakers.v1beta1.MsgCancelLeavePool")
+	proto.RegisterType((*MsgCancelLeavePoolResponse)(nil), "kyve.stakers.v1beta1.MsgCancelLeavePoolResponse")
+	proto.RegisterType((*MsgUpdateValaddress)(nil), "kyve.stakers.v1beta1.MsgUpdateValaddress")
+	proto.RegisterType((*MsgUpdateValaddressResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateValaddressResponse")
+	proto.RegisterType((*MsgUpdateSecondaryValaddress)(nil), "kyve.stakers.v1beta1.MsgUpdateSecondaryValaddress")
+	proto.RegisterType((*MsgUpdateSecondaryValaddressResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateSecondaryValaddressResponse")
+	proto.RegisterType((*MsgEnterMaintenance)(nil), "kyve.stakers.v1beta1.MsgEnterMaintenance")
+	proto.RegisterType((*MsgEnterMaintenanceResponse)(nil), "kyve.stakers.v1beta1.MsgEnterMaintenanceResponse")
+	proto.RegisterType((*MsgUpdateAutoCompoundCommission)(nil), "kyve.stakers.v1beta1.MsgUpdateAutoCompoundCommission")
+	proto.RegisterType((*MsgUpdateAutoCompoundCommissionResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateAutoCompoundCommissionResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "kyve.stakers.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kyve.stakers.v1beta1.MsgUpdateParamsResponse")
 }
 
-func (m *MsgUpdateCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("kyve/stakers/v1beta1/tx.proto", fileDescriptor_f52b730e69b9fb06) }
+
+var fileDescriptor_f52b730e69b9fb06 = []byte{
+	// 1193 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x4f, 0x1b, 0x47,
+	0x14, 0x67, 0xc1, 0xe1, 0xe3, 0xf1, 0x95, 0x2c, 0x0e, 0x98, 0x85, 0x18, 0x02, 0xa5, 0x01, 0x54,
+	0xbc, 0x31, 0x55, 0x9a, 0x0a, 0xa9, 0x07, 0x70, 0x53, 0xa9, 0x69, 0xdc, 0xa2, 0x45, 0xa1, 0x5f,
+	0x07, 0x6b, 0xd8, 0x9d, 0x2c, 0x1b, 0x7b, 0x67, 0xac, 0x9d, 0x31, 0xc6, 0xb7, 0x8a, 0x1e, 0x7b,
+	0xe9, 0xa1, 0xfd, 0x1f, 0xaa, 0x5c, 0x9a, 0x43, 0x6f, 0xfd, 0x07, 0x72, 0x44, 0x3d, 0x55, 0x95,
+	0x9a, 0x56, 0x70, 0xc8, 0xb1, 0xff, 0x42, 0xb5, 0x5f, 0xe3, 0xf5, 0x7a, 0xfd, 0x01, 0xed, 0x25,
+	0xce, 0x9b, 0xf7, 0x7b, 0xef, 0xfd, 0x7e, 0x6f, 0x66, 0xe7, 0x4d, 0x02, 0x77, 0xca, 0x8d, 0x13,
+	0xac, 0x32, 0x8e, 0xca, 0xd8, 0x61, 0xea, 0x49, 0xfe, 0x08, 0x73, 0x94, 0x57, 0xf9, 0x69, 0xae,
+	0xea, 0x50, 0x4e, 0xe5, 0xb4, 0xeb, 0xce, 0x05, 0xee, 0x5c, 0xe0, 0x56, 0x6e, 0x21, 0xdb, 0x22,
+	0x54, 0xf5, 0xfe, 0xf4, 0x81, 0x4a, 0x56, 0xa7, 0xcc, 0xa6, 0x4c, 0x3d, 0x42, 0x0c, 0x8b, 0x34,
+	0x3a, 0xb5, 0x48, 0xe0, 0x9f, 0x0b, 0xfc, 0x36, 0x33, 0xd5, 0x93, 0xbc, 0xfb, 0x13, 0x38, 0xe6,
+	0x7d, 0x47, 0xc9, 0xb3, 0x54, 0xdf, 0x08, 0x5c, 0x69, 0x93, 0x9a, 0xd4, 0x5f, 0x77, 0xff, 0xe6,
+	0xaf, 0xae, 0xfc, 0x3a, 0x08, 0xd3, 0x45, 0x66, 0x16, 0x1c, 0x8c, 0x38, 0x3e, 0xf0, 0x98, 0xc9,
+	0x19, 0x18, 0xd1, 0x5d, 0x9b, 0x3a, 0x19, 0x69, 0x59, 0x5a, 0x1f, 0xd3, 0x42, 0x53, 0x9e, 0x85,
+	0x61, 0x64, 0xd3, 0x1a, 0xe1, 0x99, 0xc1, 0x65, 0x69, 0x3d, 0xa5, 0x05, 0x96, 0x5c, 0x00, 0xd0,
+	0xa9, 0x6d, 0x5b, 0x8c, 0x59, 0x94, 0x64, 0x86, 0xdc, 0xa0, 0xbd, 0xd5, 0x57, 0xaf, 0x97, 0x06,
+	0xfe, 0x78, 0xbd, 0xb4, 0xe0, 0xb3, 0x60, 0x46, 0x39, 0x67, 0x51, 0xd5, 0x46, 0xfc, 0x38, 0xf7,
+	0x04, 0x9b, 0x48, 0x6f, 0x7c, 0x88, 0x75, 0x2d, 0x12, 0x26, 0x3f, 0x86, 0x29, 0x1b, 0x9d, 0x96,
+	0x22, 0x89, 0x52, 0xfd, 0x27, 0x9a, 0xb4, 0xd1, 0x69, 0xa1, 0x99, 0xeb, 0x73, 0xb8, 0xdd, 0x9a,
+	0xab, 0xa4, 0x1f, 0x23, 0x62, 0xe2, 0xcc, 0x8d, 0xfe, 0x53, 0xce, 0xb4, 0xa4, 0x2c, 0x78, 0xf1,
+	0x3b, 0x13, 0x67, 0x6f, 0x5e, 0x6e, 0x86, 0xfd, 0x58, 0x99, 0x87, 0xb9, 0x58, 0xf3, 0x34, 0xcc,
+	0xaa, 0x94, 0x30, 0xbc, 0x72, 0x2e, 0xc1, 0xad, 0x22, 0x33, 0x9f, 0x56, 0x0d, 0xc4, 0x71, 0x11,
+	0x73, 0x64, 0x20, 0x8e, 0xba, 0xb4, 0x36, 0x03, 0x23, 0x36, 0x25, 0x56, 0x19, 0x3b, 0x5e, 0x6f,
+	0xc7, 0xb4, 0xd0, 0x74, 0x3d, 0x75, 0x7c, 0xc4, 0x2c, 0x8e, 0xfd, 0xce, 0x6a, 0xa1, 0x29, 0x2b,
+	0x30, 0x6a, 0x19, 0x98, 0x70, 0x8b, 0x37, 0xfc, 0x5e, 0x69, 0xc2, 0x96, 0x37, 0xe0, 0x26, 0xc3,
+	0x7a, 0xcd, 0xb1, 0x78, 0xa3, 0xa4, 0x53, 0xc2, 0x91, 0xce, 0x7d, 0xf1, 0xda, 0x74, 0xb8, 0x5e,
+	0xf0, 0x97, 0xdd, 0x02, 0x06, 0xe6, 0xc8, 0xaa, 0xb0, 0xcc, 0xb0, 0x5f, 0x20, 0x30, 0x63, 0x6a,
+	0x17, 0x60, 0xbe, 0x4d, 0x91, 0xd0, 0x7b, 0x26, 0xc1, 0x8c, 0xf0, 0x46, 0x76, 0xa2, 0xb3, 0xe2,
+	0xd6, 0x43, 0x33, 0x78, 0xad, 0x43, 0x13, 0x63, 0x78, 0x07, 0x16, 0x12, 0x38, 0x08, 0x8e, 0x05,
+	0x4f, 0x40, 0x01, 0x11, 0x1d, 0x57, 0xe2, 0x3b, 0xdb, 0x99, 0x68, 0xac, 0xc6, 0x2a, 0xdc, 0xed,
+	0x98, 0x44, 0x54, 0xfa, 0x59, 0xf2, 0x4b, 0x55, 0x90, 0x65, 0x47, 0x89, 0xd4, 0x91, 0x63, 0xb0,
+	0x2e, 0x3d, 0x79, 0x0e, 0x23, 0xfe, 0x27, 0xc5, 0x32, 0x83, 0xcb, 0x43, 0xeb, 0xe3, 0xdb, 0xf3,
+	0xb9, 0xe0, 0x23, 0x76, 0xaf, 0x82, 0xf0, 0xca, 0xc8, 0x15, 0xa8, 0x45, 0xf6, 0x1e, 0xb8, 0xbd,
+	0x7a, 0xf1, 0xd7, 0xd2, 0xba, 0x69, 0xf1, 0xe3, 0xda, 0x51, 0x4e, 0xa7, 0x76, 0xf0, 0xc5, 0x07,
+	0x3f, 0x5b, 0xcc, 0x28, 0xab, 0xbc, 0x51, 0xc5, 0xcc, 0x0b, 0x60, 0x3f, 0xbd, 0x79, 0xb9, 0x29,
+	0x69, 0x61, 0x81, 0x64, 0x59, 0x89, 0x84, 0x85, 0xac, 0x3f, 0x25, 0x18, 0x2f, 0x32, 0xf3, 0x31,
+	0xb5, 0xc8, 0x3e, 0xa5, 0x95, 0x2e, 0x42, 0xe6, 0x60, 0xa4, 0x4a, 0x69, 0xa5, 0x64, 0x19, 0xe1,
+	0x55, 0xe1, 0x9a, 0x1f, 0x1b, 0x72, 0x16, 0xe0, 0x04, 0x55, 0x90, 0x61, 0x38, 0x98, 0xb1, 0xe0,
+	0x40, 0x47, 0x56, 0x22, 0x57, 0x4c, 0xaa, 0xe5, 0x8a, 0x59, 0x85, 0xc9, 0x67, 0x18, 0x97, 0x50,
+	0xa5, 0x42, 0xeb, 0x6e, 0xef, 0xbd, 0xc3, 0x9c, 0xd2, 0x26, 0x9e, 0x61, 0xbc, 0x1b, 0xae, 0xc9,
+	0xf7, 0x21, 0xdd, 0x02, 0x2a, 0x55, 0xb1, 0x63, 0x51, 0xc3, 0x3b, 0xd6, 0x29, 0x4d, 0x8e, 0x62,
+	0xf7, 0x3d, 0x4f, 0xac, 0x09, 0xb7, 0x61, 0x26, 0x22, 0x4f, 0xc8, 0xfe, 0x0c, 0x26, 0x8a, 0xcc,
+	0x7c, 0x82, 0xd1, 0x09, 0xbe, 0xa6, 0xec, 0x58, 0x9d, 0x59, 0x48, 0x47, 0x13, 0x8a, 0x42, 0x4f,
+	0x41, 0x16, 0x67, 0xeb, 0x7f, 0x2c, 0xb7, 0x08, 0x4a, 0x7b, 0x5a, 0x51, 0xf4, 0xc7, 0xe8, 0x97,
+	0x7b, 0xd8, 0xdc, 0x89, 0x6b, 0x6c, 0xee, 0x1a, 0x4c, 0x11, 0x5c, 0x2f, 0xb5, 0x6d, 0xf0, 0x24,
+	0xc1, 0xf5, 0xc3, 0x9e, 0x7b, 0xdc, 0xe5, 0x63, 0x6e, 0x06, 0x0b, 0xda, 0x2f, 0x24, 0x58, 0x14,
+	0xfe, 0x03, 0xac, 0x53, 0x62, 0x20, 0xa7, 0xf1, 0xdf, 0xf8, 0xe7, 0x21, 0xcd, 0xc2, 0x4c, 0xed,
+	0x2a, 0x66, 0x58, 0x42, 0x95, 0xfe, 0xb4, 0xbc, 0x0d, 0x6f, 0x75, 0xe3, 0x2a, 0x44, 0x39, 0xde,
+	0x56, 0x3c, 0x22, 0x1c, 0x3b, 0x45, 0x64, 0x11, 0x8e, 0x89, 0x77, 0xae, 0xaf, 0x21, 0x45, 0x81,
+	0x51, 0xa3, 0xe6, 0x20, 0x1e, 0x0e, 0xe4, 0x94, 0x26, 0xec, 0xc4, 0x3e, 0xc7, 0x6b, 0x0a, 0x4a,
+	0xdf, 0x4a, 0xb0, 0x24, 0xb8, 0xef, 0xd6, 0x38, 0x2d, 0x50, 0xbb, 0x4a, 0x6b, 0xc4, 0xe8, 0xeb,
+	0x92, 0x7f, 0x1f, 0x32, 0xa8, 0xc6, 0x69, 0x49, 0x0f, 0x82, 0x4a, 0xb1, 0x2b, 0x7f, 0x54, 0x9b,
+	0x45, 0x89, 0x39, 0x63, 0x24, 0x37, 0xe0, 0x5e, 0x0f, 0x12, 0x82, 0x30, 0x83, 0x69, 0x01, 0xdd,
+	0x47, 0x0e, 0xb2, 0x99, 0xfc, 0x1e, 0x8c, 0xa1, 0x1a, 0x3f, 0xa6, 0xee, 0xd4, 0xf3, 0x19, 0xee,
+	0x65, 0x7e, 0xfb, 0x65, 0x2b, 0x1d, 0xdc, 0xad, 0xbb, 0xfe, 0x2e, 0x1c, 0x70, 0xc7, 0x22, 0xa6,
+	0xd6, 0x84, 0xba, 0xba, 0xaa, 0xa8, 0x51, 0xa1, 0xc8, 0x08, 0x87, 0x72, 0x60, 0xee, 0x4c, 0xb9,
+	0xec, 0x9a, 0xc8, 0xe0, 0x25, 0x10, 0x2d, 0x1a, 0xf2, 0xd9, 0xfe, 0x67, 0x1c, 0x86, 0x8a, 0xcc,
+	0x94, 0x0d, 0x98, 0x68, 0x79, 0x66, 0xad, 0xe5, 0x92, 0x9e, 0x83, 0xb9, 0xd8, 0x83, 0x42, 0xd9,
+	0xea, 0x0b, 0x16, 0x56, 0x93, 0x9f, 0xc3, 0x54, 0xec, 0xcd, 0x71, 0xaf, 0x63, 0x82, 0x56, 0xa0,
+	0xa2, 0xf6, 0x09, 0x14, 0xb5, 0xaa, 0x70, 0xb3, 0x6d, 0xde, 0x6f, 0xf4, 0x48, 0xd2, 0x84, 0x2a,
+	0xf9, 0xbe, 0xa1, 0xa2, 0xe2, 0x99, 0x04, 0xb3, 0x1d, 0xe6, 0x77, 0x67, 0xf6, 0xc9, 0x01, 0xca,
+	0xc3, 0x2b, 0x06, 0xb4, 0x92, 0x48, 0x9e, 0xec, 0x5d, 0x48, 0x24, 0x06, 0x28, 0x0f, 0xaf, 0x18,
+	0x20, 0x48, 0x7c, 0x01, 0xa3, 0x62, 0x0c, 0xdf, 0xed, 0x98, 0x24, 0x84, 0x28, 0x1b, 0x3d, 0x21,
+	0x22, 0xf3, 0xd7, 0x30, 0xd6, 0x9c, 0x3d, 0x2b, 0x1d, 0xe3, 0x04, 0x46, 0xd9, 0xec, 0x8d, 0x11,
+	0xc9, 0x6d, 0x98, 0x8e, 0x8f, 0xb7, 0xf5, 0x1e, 0xfb, 0xd0, 0x2c, 0x74, 0xbf, 0x5f, 0x64, 0xfb,
+	0x09, 0x8d, 0xdc, 0xd8, 0xbd, 0x4e, 0x68, 0x13, 0xaa, 0xe4, 0xfb, 0x86, 0x8a, 0x8a, 0xdf, 0x49,
+	0x30, 0xdf, 0x79, 0x26, 0x6d, 0xf7, 0x48, 0x98, 0x10, 0xa3, 0xec, 0x5c, 0x3d, 0x26, 0xaa, 0xbf,
+	0x6d, 0x98, 0x74, 0xd6, 0x1f, 0x87, 0x2a, 0xf9, 0xbe, 0xa1, 0xa2, 0xe2, 0x0f, 0x12, 0x2c, 0x76,
+	0x9d, 0x15, 0x0f, 0x7a, 0xc8, 0x49, 0x0e, 0x53, 0x3e, 0xb8, 0x56, 0x98, 0xa0, 0x65, 0xc0, 0x44,
+	0xcb, 0x44, 0x58, 0xeb, 0x91, 0xce, 0x87, 0x29, 0x5b, 0x7d, 0xc1, 0xc2, 0x2a, 0xca, 0x8d, 0x6f,
+	0xdc, 0x27, 0xf6, 0xde, 0x47, 0xaf, 0x2e, 0xb2, 0xd2, 0xf9, 0x45, 0x56, 0xfa, 0xfb, 0x22, 0x2b,
+	0x7d, 0x7f, 0x99, 0x1d, 0x38, 0xbf, 0xcc, 0x0e, 0xfc, 0x7e, 0x99, 0x1d, 0xf8, 0xea, 0x9d, 0xc8,
+	0x5b, 0xfd, 0x93, 0x2f, 0x0f, 0x1f, 0x7d, 0x8a, 0x79, 0x9d, 0x3a, 0x65, 0x55, 0x3f, 0x46, 0x16,
+	0x51, 0x4f, 0xc5, 0x7f, 0x1d, 0x78, 0xaf, 0xf6, 0xa3, 0x61, 0xef, 0xdf, 0xe8, 0xef, 0xfe, 0x3b,
+	0x00, 0xac, 0x10, 0x7d, 0x72, 0x57, 0x10, 0x00, 0x00,
 }
 
-func (m *MsgUpdateCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateStaker ...
+	CreateStaker(ctx context.Context, in *MsgCreateStaker, opts ...grpc.CallOption) (*MsgCreateStakerResponse, error)
+	// UpdateMetadata ...
+	UpdateMetadata(ctx context.Context, in *MsgUpdateMetadata, opts ...grpc.CallOption) (*MsgUpdateMetadataResponse, error)
+	// UpdateCommission ...
+	UpdateCommission(ctx context.Context, in *MsgUpdateCommission, opts ...grpc.CallOption) (*MsgUpdateCommissionResponse, error)
+	// CancelCommissionChange ...
+	CancelCommissionChange(ctx context.Context, in *MsgCancelCommissionChange, opts ...grpc.CallOption) (*MsgCancelCommissionChangeResponse, error)
+	// ClaimCommissionRewards ...
+	ClaimCommissionRewards(ctx context.Context, in *MsgClaimCommissionRewards, opts ...grpc.CallOption) (*MsgClaimCommissionRewardsResponse, error)
+	// JoinPool ...
+	JoinPool(ctx context.Context, in *MsgJoinPool, opts ...grpc.CallOption) (*MsgJoinPoolResponse, error)
+	// LeavePool ...
+	LeavePool(ctx context.Context, in *MsgLeavePool, opts ...grpc.CallOption) (*MsgLeavePoolResponse, error)
+	// CancelLeavePool ...
+	CancelLeavePool(ctx context.Context, in *MsgCancelLeavePool, opts ...grpc.CallOption) (*MsgCancelLeavePoolResponse, error)
+	// UpdateValaddress ...
+	UpdateValaddress(ctx context.Context, in *MsgUpdateValaddress, opts ...grpc.CallOption) (*MsgUpdateValaddressResponse, error)
+	// UpdateSecondaryValaddress ...
+	UpdateSecondaryValaddress(ctx context.Context, in *MsgUpdateSecondaryValaddress, opts ...grpc.CallOption) (*MsgUpdateSecondaryValaddressResponse, error)
+	// EnterMaintenance ...
+	EnterMaintenance(ctx context.Context, in *MsgEnterMaintenance, opts ...grpc.CallOption) (*MsgEnterMaintenanceResponse, error)
+	// UpdateAutoCompoundCommission ...
+	UpdateAutoCompoundCommission(ctx context.Context, in *MsgUpdateAutoCompoundCommission, opts ...grpc.CallOption) (*MsgUpdateAutoCompoundCommissionResponse, error)
+	// UpdateParams defines a governance operation for updating the x/stakers module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgClaimCommissionRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateStaker(ctx context.Context, in *MsgCreateStaker, opts ...grpc.CallOption) (*MsgCreateStakerResponse, error) {
+	out := new(MsgCreateStakerResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/CreateStaker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgClaimCommissionRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMetadata(ctx context.Context, in *MsgUpdateMetadata, opts ...grpc.CallOption) (*MsgUpdateMetadataResponse, error) {
+	out := new(MsgUpdateMetadataResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClaimCommissionRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateCommission(ctx context.Context, in *MsgUpdateCommission, opts ...grpc.CallOption) (*MsgUpdateCommissionResponse, error) {
+	out := new(MsgUpdateCommissionResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgClaimCommissionRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelCommissionChange(ctx context.Context, in *MsgCancelCommissionChange, opts ...grpc.CallOption) (*MsgCancelCommissionChangeResponse, error) {
+	out := new(MsgCancelCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/CancelCommissionChange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgClaimCommissionRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimCommissionRewards(ctx context.Context, in *MsgClaimCommissionRewards, opts ...grpc.CallOption) (*MsgClaimCommissionRewardsResponse, error) {
+	out := new(MsgClaimCommissionRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/ClaimCommissionRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClaimCommissionRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) JoinPool(ctx context.Context, in *MsgJoinPool, opts ...grpc.CallOption) (*MsgJoinPoolResponse, error) {
+	out := new(MsgJoinPoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/JoinPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgJoinPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LeavePool(ctx context.Context, in *MsgLeavePool, opts ...grpc.CallOption) (*MsgLeavePoolResponse, error) {
+	out := new(MsgLeavePoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/LeavePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgJoinPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelLeavePool(ctx context.Context, in *MsgCancelLeavePool, opts ...grpc.CallOption) (*MsgCancelLeavePoolResponse, error) {
+	out := new(MsgCancelLeavePoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/CancelLeavePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgJoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Valaddress) > 0 {
-		i -= len(m.Valaddress)
-		copy(dAtA[i:], m.Valaddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Valaddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateValaddress(ctx context.Context, in *MsgUpdateValaddress, opts ...grpc.CallOption) (*MsgUpdateValaddressResponse, error) {
+	out := new(MsgUpdateValaddressResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateValaddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgJoinPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateSecondaryValaddress(ctx context.Context, in *MsgUpdateSecondaryValaddress, opts ...grpc.CallOption) (*MsgUpdateSecondaryValaddressResponse, error) {
+	out := new(MsgUpdateSecondaryValaddressResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateSecondaryValaddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgJoinPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) EnterMaintenance(ctx context.Context, in *MsgEnterMaintenance, opts ...grpc.CallOption) (*MsgEnterMaintenanceResponse, error) {
+	out := new(MsgEnterMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/EnterMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgJoinPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgLeavePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateAutoCompoundCommission(ctx context.Context, in *MsgUpdateAutoCompoundCommission, opts ...grpc.CallOption) (*MsgUpdateAutoCompoundCommissionResponse, error) {
+	out := new(MsgUpdateAutoCompoundCommissionResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateAutoCompoundCommission", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgLeavePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgLeavePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1beta1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgLeavePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateStaker ...
+	CreateStaker(context.Context, *MsgCreateStaker) (*MsgCreateStakerResponse, error)
+	// UpdateMetadata ...
+	UpdateMetadata(context.Context, *MsgUpdateMetadata) (*MsgUpdateMetadataResponse, error)
+	// UpdateCommission ...
+	UpdateCommission(context.Context, *MsgUpdateCommission) (*MsgUpdateCommissionResponse, error)
+	// CancelCommissionChange ...
+	CancelCommissionChange(context.Context, *MsgCancelCommissionChange) (*MsgCancelCommissionChangeResponse, error)
+	// ClaimCommissionRewards ...
+	ClaimCommissionRewards(context.Context, *MsgClaimCommissionRewards) (*MsgClaimCommissionRewardsResponse, error)
+	// JoinPool ...
+	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
+	// LeavePool ...
+	LeavePool(context.Context, *MsgLeavePool) (*MsgLeavePoolResponse, error)
+	// CancelLeavePool ...
+	CancelLeavePool(context.Context, *MsgCancelLeavePool) (*MsgCancelLeavePoolResponse, error)
+	// UpdateValaddress ...
+	UpdateValaddress(context.Context, *MsgUpdateValaddress) (*MsgUpdateValaddressResponse, error)
+	// UpdateSecondaryValaddress ...
+	UpdateSecondaryValaddress(context.Context, *MsgUpdateSecondaryValaddress) (*MsgUpdateSecondaryValaddressResponse, error)
+	// EnterMaintenance ...
+	EnterMaintenance(context.Context, *MsgEnterMaintenance) (*MsgEnterMaintenanceResponse, error)
+	// UpdateAutoCompoundCommission ...
+	UpdateAutoCompoundCommission(context.Context, *MsgUpdateAutoCompoundCommission) (*MsgUpdateAutoCompoundCommissionResponse, error)
+	// UpdateParams defines a governance operation for updating the x/stakers module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgLeavePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateStaker(ctx context.Context, req *MsgCreateStaker) (*MsgCreateStakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStaker not implemented")
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) UpdateMetadata(ctx context.Context, req *MsgUpdateMetadata) (*MsgUpdateMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetadata not implemented")
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) UpdateCommission(ctx context.Context, req *MsgUpdateCommission) (*MsgUpdateCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommission not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CancelCommissionChange(ctx context.Context, req *MsgCancelCommissionChange) (*MsgCancelCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCommissionChange not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) ClaimCommissionRewards(ctx context.Context, req *MsgClaimCommissionRewards) (*MsgClaimCommissionRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimCommissionRewards not implemented")
+}
+func (*UnimplementedMsgServer) JoinPool(ctx context.Context, req *MsgJoinPool) (*MsgJoinPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinPool not implemented")
+}
+func (*UnimplementedMsgServer) LeavePool(ctx context.Context, req *MsgLeavePool) (*MsgLeavePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeavePool not implemented")
+}
+func (*UnimplementedMsgServer) CancelLeavePool(ctx context.Context, req *MsgCancelLeavePool) (*MsgCancelLeavePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLeavePool not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValaddress(ctx context.Context, req *MsgUpdateValaddress) (*MsgUpdateValaddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValaddress not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSecondaryValaddress(ctx context.Context, req *MsgUpdateSecondaryValaddress) (*MsgUpdateSecondaryValaddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecondaryValaddress not implemented")
+}
+func (*UnimplementedMsgServer) EnterMaintenance(ctx context.Context, req *MsgEnterMaintenance) (*MsgEnterMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnterMaintenance not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAutoCompoundCommission(ctx context.Context, req *MsgUpdateAutoCompoundCommission) (*MsgUpdateAutoCompoundCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutoCompoundCommission not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_CreateStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStaker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateStaker) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStaker(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/CreateStaker",
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStaker(ctx, req.(*MsgCreateStaker))
 	}
-	l = m.Commission.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateStakerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMetadata(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/UpdateMetadata",
 	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMetadata(ctx, req.(*MsgUpdateMetadata))
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCommission)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCommission(ctx, in)
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/UpdateCommission",
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCommission(ctx, req.(*MsgUpdateCommission))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelCommissionChange)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateCommission) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CancelCommissionChange(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/CancelCommissionChange",
 	}
-	l = m.Commission.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelCommissionChange(ctx, req.(*MsgCancelCommissionChange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClaimCommissionRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimCommissionRewards)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimCommissionRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/ClaimCommissionRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimCommissionRewards(ctx, req.(*MsgClaimCommissionRewards))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimCommissionRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_JoinPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinPool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).JoinPool(ctx, in)
 	}
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/JoinPool",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinPool(ctx, req.(*MsgJoinPool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimCommissionRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LeavePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeavePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).LeavePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/LeavePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeavePool(ctx, req.(*MsgLeavePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgJoinPool) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelLeavePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLeavePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLeavePool(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/CancelLeavePool",
 	}
-	l = len(m.Valaddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLeavePool(ctx, req.(*MsgCancelLeavePool))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateValaddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValaddress)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValaddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/UpdateValaddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValaddress(ctx, req.(*MsgUpdateValaddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgJoinPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateSecondaryValaddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSecondaryValaddress)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSecondaryValaddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/UpdateSecondaryValaddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSecondaryValaddress(ctx, req.(*MsgUpdateSecondaryValaddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLeavePool) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_EnterMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnterMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnterMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/EnterMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnterMaintenance(ctx, req.(*MsgEnterMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAutoCompoundCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAutoCompoundCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAutoCompoundCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/UpdateAutoCompoundCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAutoCompoundCommission(ctx, req.(*MsgUpdateAutoCompoundCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kyve.stakers.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStaker",
+			Handler:    _Msg_CreateStaker_Handler,
+		},
+		{
+			MethodName: "UpdateMetadata",
+			Handler:    _Msg_UpdateMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateCommission",
+			Handler:    _Msg_UpdateCommission_Handler,
+		},
+		{
+			MethodName: "CancelCommissionChange",
+			Handler:    _Msg_CancelCommissionChange_Handler,
+		},
+		{
+			MethodName: "ClaimCommissionRewards",
+			Handler:    _Msg_ClaimCommissionRewards_Handler,
+		},
+		{
+			MethodName: "JoinPool",
+			Handler:    _Msg_JoinPool_Handler,
+		},
+		{
+			MethodName: "LeavePool",
+			Handler:    _Msg_LeavePool_Handler,
+		},
+		{
+			MethodName: "CancelLeavePool",
+			Handler:    _Msg_CancelLeavePool_Handler,
+		},
+		{
+			MethodName: "UpdateValaddress",
+			Handler:    _Msg_UpdateValaddress_Handler,
+		},
+		{
+			MethodName: "UpdateSecondaryValaddress",
+			Handler:    _Msg_UpdateSecondaryValaddress_Handler,
+		},
+		{
+			MethodName: "EnterMaintenance",
+			Handler:    _Msg_EnterMaintenance_Handler,
+		},
+		{
+			MethodName: "UpdateAutoCompoundCommission",
+			Handler:    _Msg_UpdateAutoCompoundCommission_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyve/stakers/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateStaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.MaxCommissionChange.Size()
+		i -= size
+		if _, err := m.MaxCommissionChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCommissionChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCommissionChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCommissionChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimCommissionRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimCommissionRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimCommissionRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimCommissionRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimCommissionRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimCommissionRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeAllowancePeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeAllowancePeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FeeAllowance != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeAllowance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Valaddress) > 0 {
+		i -= len(m.Valaddress)
+		copy(dAtA[i:], m.Valaddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Valaddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeavePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeavePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeavePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeavePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeavePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLeavePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLeavePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLeavePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLeavePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLeavePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValaddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValaddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValaddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewValaddress) > 0 {
+		i -= len(m.NewValaddress)
+		copy(dAtA[i:], m.NewValaddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValaddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValaddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValaddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValaddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSecondaryValaddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSecondaryValaddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSecondaryValaddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SecondaryValaddress) > 0 {
+		i -= len(m.SecondaryValaddress)
+		copy(dAtA[i:], m.SecondaryValaddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondaryValaddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSecondaryValaddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSecondaryValaddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSecondaryValaddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnterMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnterMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnterMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnterMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnterMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnterMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAutoCompoundCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAutoCompoundCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAutoCompoundCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoCompoundCommission {
+		i--
+		if m.AutoCompoundCommission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAutoCompoundCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAutoCompoundCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAutoCompoundCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateStaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxCommissionChange.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelCommissionChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimCommissionRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimCommissionRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgJoinPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Valaddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	if m.FeeAllowance != 0 {
+		n += 1 + sovTx(uint64(m.FeeAllowance))
+	}
+	if m.FeeAllowancePeriod != 0 {
+		n += 1 + sovTx(uint64(m.FeeAllowancePeriod))
+	}
+	return n
+}
+
+func (m *MsgJoinPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLeavePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgLeavePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelLeavePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgCancelLeavePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValaddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.NewValaddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgUpdateValaddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSecondaryValaddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.SecondaryValaddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgUpdateSecondaryValaddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnterMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgEnterMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAutoCompoundCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoCompoundCommission {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateAutoCompoundCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateStaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelCommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelCommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelCommissionChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelCommissionChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelCommissionChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimCommissionRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimCommissionRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimCommissionRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgLeavePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgClaimCommissionRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimCommissionRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimCommissionRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateStaker) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1834,10 +4312,10 @@ func (m *MsgCreateStaker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStaker: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1874,9 +4352,9 @@ func (m *MsgCreateStaker) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Amount = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1886,14 +4364,14 @@ func (m *MsgCreateStaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valaddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,10 +4399,65 @@ func (m *MsgCreateStaker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Valaddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
+			}
+			m.FeeAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowancePeriod", wireType)
+			}
+			m.FeeAllowancePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeAllowancePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1946,7 +4479,7 @@ func (m *MsgCreateStaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateStakerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1969,10 +4502,10 @@ func (m *MsgCreateStakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1996,7 +4529,7 @@ func (m *MsgCreateStakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgLeavePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2019,10 +4552,10 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeavePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeavePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2058,10 +4591,10 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2071,91 +4604,114 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeavePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeavePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeavePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLeavePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLeavePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLeavePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2183,13 +4739,13 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2199,24 +4755,11 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Details = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2238,7 +4781,7 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelLeavePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2261,10 +4804,10 @@ func (m *MsgUpdateMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelLeavePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelLeavePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2288,7 +4831,7 @@ func (m *MsgUpdateMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCommission) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValaddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2311,10 +4854,10 @@ func (m *MsgUpdateCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValaddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValaddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2350,8 +4893,27 @@ func (m *MsgUpdateCommission) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValaddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2379,10 +4941,27 @@ func (m *MsgUpdateCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewValaddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2404,7 +4983,7 @@ func (m *MsgUpdateCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValaddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,10 +5006,10 @@ func (m *MsgUpdateCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValaddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValaddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2454,7 +5033,7 @@ func (m *MsgUpdateCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimCommissionRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSecondaryValaddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2477,10 +5056,10 @@ func (m *MsgClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimCommissionRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSecondaryValaddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimCommissionRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSecondaryValaddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2516,10 +5095,29 @@ func (m *MsgClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryValaddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2529,26 +5127,43 @@ func (m *MsgClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, types.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecondaryValaddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2570,7 +5185,7 @@ func (m *MsgClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimCommissionRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSecondaryValaddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2593,10 +5208,10 @@ func (m *MsgClaimCommissionRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimCommissionRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSecondaryValaddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimCommissionRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSecondaryValaddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2620,7 +5235,7 @@ func (m *MsgClaimCommissionRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
+func (m *MsgEnterMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2643,10 +5258,10 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnterMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnterMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2701,42 +5316,10 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valaddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Valaddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			m.Amount = 0
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2746,7 +5329,7 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.Duration |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2772,7 +5355,7 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEnterMaintenanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2795,10 +5378,10 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnterMaintenanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnterMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2822,7 +5405,7 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeavePool) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAutoCompoundCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2845,10 +5428,10 @@ func (m *MsgLeavePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeavePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAutoCompoundCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeavePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAutoCompoundCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2885,9 +5468,9 @@ func (m *MsgLeavePool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundCommission", wireType)
 			}
-			m.PoolId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2897,11 +5480,12 @@ func (m *MsgLeavePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AutoCompoundCommission = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2923,7 +5507,7 @@ func (m *MsgLeavePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeavePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAutoCompoundCommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2946,10 +5530,10 @@ func (m *MsgLeavePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeavePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAutoCompoundCommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeavePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAutoCompoundCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: